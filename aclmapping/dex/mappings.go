@@ -153,6 +153,36 @@ func DexPlaceOrdersDependencyGenerator(keeper aclkeeper.Keeper, _ sdk.Context, m
 			ResourceType:       sdkacltypes.ResourceType_KV_DEX_SHORT_ORDER_COUNT,
 			IdentifierTemplate: hex.EncodeToString([]byte(dextypes.ShortOrderCountKey)),
 		},
+
+		// Reads the contract's params overrides, which only change through governance
+		{
+			AccessType:         sdkacltypes.AccessType_READ,
+			ResourceType:       sdkacltypes.ResourceType_KV_DEX_CONTRACT,
+			IdentifierTemplate: "*",
+		},
+
+		// Checks the sender's active order count and order-to-trade ratio
+		{
+			AccessType:         sdkacltypes.AccessType_READ,
+			ResourceType:       sdkacltypes.ResourceType_KV_DEX_ACCOUNT_ACTIVE_ORDERS,
+			IdentifierTemplate: hex.EncodeToString(dextypes.AccountActiveOrdersContractPrefix(contractAddr)),
+		},
+		{
+			AccessType:   sdkacltypes.AccessType_READ,
+			ResourceType: sdkacltypes.ResourceType_KV_DEX_ACCOUNT_ACTIVE_ORDERS,
+			IdentifierTemplate: hex.EncodeToString(append(
+				dextypes.AccountOrderCounterPrefix(contractAddr),
+				[]byte(placeOrdersMsg.Creator)...,
+			)),
+		},
+		{
+			AccessType:   sdkacltypes.AccessType_WRITE,
+			ResourceType: sdkacltypes.ResourceType_KV_DEX_ACCOUNT_ACTIVE_ORDERS,
+			IdentifierTemplate: hex.EncodeToString(append(
+				dextypes.AccountOrderCounterPrefix(contractAddr),
+				[]byte(placeOrdersMsg.Creator)...,
+			)),
+		},
 	}
 
 	// Last Operation should always be a commit
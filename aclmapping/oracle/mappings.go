@@ -22,6 +22,10 @@ func GetOracleDependencyGenerator() aclkeeper.DependencyGeneratorMap {
 	voteKey := acltypes.GenerateMessageKey(&oracletypes.MsgAggregateExchangeRateVote{})
 	dependencyGeneratorMap[voteKey] = MsgVoteDependencyGenerator
 
+	// prevote
+	prevoteKey := acltypes.GenerateMessageKey(&oracletypes.MsgAggregateExchangeRatePrevote{})
+	dependencyGeneratorMap[prevoteKey] = MsgPrevoteDependencyGenerator
+
 	return dependencyGeneratorMap
 }
 
@@ -31,6 +35,7 @@ func MsgVoteDependencyGenerator(_ aclkeeper.Keeper, _ sdk.Context, msg sdk.Msg)
 		return []sdkacltypes.AccessOperation{}, ErrorInvalidMsgType
 	}
 	valAddr, _ := sdk.ValAddressFromBech32(msgVote.Validator)
+	feederAddr, _ := sdk.AccAddressFromBech32(msgVote.Feeder)
 
 	accessOperations := []sdkacltypes.AccessOperation{
 		// validate feeder
@@ -40,6 +45,12 @@ func MsgVoteDependencyGenerator(_ aclkeeper.Keeper, _ sdk.Context, msg sdk.Msg)
 			AccessType:         sdkacltypes.AccessType_READ,
 			IdentifierTemplate: hex.EncodeToString(oracletypes.GetFeederDelegationKey(valAddr)),
 		},
+		// read additional feeder permission for val addr - READ
+		{
+			ResourceType:       sdkacltypes.ResourceType_KV_ORACLE_FEEDERS,
+			AccessType:         sdkacltypes.AccessType_READ,
+			IdentifierTemplate: hex.EncodeToString(oracletypes.GetFeederPermissionKey(valAddr, feederAddr)),
+		},
 		// read validator from staking - READ
 		// validator is bonded check - READ
 		// (both covered by below)
@@ -56,12 +67,84 @@ func MsgVoteDependencyGenerator(_ aclkeeper.Keeper, _ sdk.Context, msg sdk.Msg)
 			IdentifierTemplate: utils.DefaultIDTemplate,
 		},
 
+		// check exchange rate vote exists - READ
+		{
+			ResourceType:       sdkacltypes.ResourceType_KV_ORACLE_AGGREGATE_VOTES,
+			AccessType:         sdkacltypes.AccessType_READ,
+			IdentifierTemplate: hex.EncodeToString(oracletypes.GetAggregateExchangeRateVoteKey(valAddr)),
+		},
+
 		// set exchange rate vote - WRITE
 		{
 			ResourceType:       sdkacltypes.ResourceType_KV_ORACLE_AGGREGATE_VOTES,
 			AccessType:         sdkacltypes.AccessType_WRITE,
 			IdentifierTemplate: hex.EncodeToString(oracletypes.GetAggregateExchangeRateVoteKey(valAddr)),
 		},
+	}
+	if msgVote.Salt != "" {
+		// revealing a prevote reads and deletes it; prevotes have no dedicated
+		// resource type so they fall under the oracle parent resource
+		accessOperations = append(accessOperations, []sdkacltypes.AccessOperation{
+			{
+				ResourceType:       sdkacltypes.ResourceType_KV_ORACLE,
+				AccessType:         sdkacltypes.AccessType_READ,
+				IdentifierTemplate: utils.DefaultIDTemplate,
+			},
+			{
+				ResourceType:       sdkacltypes.ResourceType_KV_ORACLE,
+				AccessType:         sdkacltypes.AccessType_WRITE,
+				IdentifierTemplate: utils.DefaultIDTemplate,
+			},
+		}...)
+	}
+
+	// Last Operation should always be a commit
+	accessOperations = append(accessOperations, *acltypes.CommitAccessOp())
+	return accessOperations, nil
+}
+
+func MsgPrevoteDependencyGenerator(_ aclkeeper.Keeper, _ sdk.Context, msg sdk.Msg) ([]sdkacltypes.AccessOperation, error) {
+	msgPrevote, ok := msg.(*oracletypes.MsgAggregateExchangeRatePrevote)
+	if !ok {
+		return []sdkacltypes.AccessOperation{}, ErrorInvalidMsgType
+	}
+	valAddr, _ := sdk.ValAddressFromBech32(msgPrevote.Validator)
+	feederAddr, _ := sdk.AccAddressFromBech32(msgPrevote.Feeder)
+
+	accessOperations := []sdkacltypes.AccessOperation{
+		// validate feeder
+		// read feeder delegation for val addr - READ
+		{
+			ResourceType:       sdkacltypes.ResourceType_KV_ORACLE_FEEDERS,
+			AccessType:         sdkacltypes.AccessType_READ,
+			IdentifierTemplate: hex.EncodeToString(oracletypes.GetFeederDelegationKey(valAddr)),
+		},
+		// read additional feeder permission for val addr - READ
+		{
+			ResourceType:       sdkacltypes.ResourceType_KV_ORACLE_FEEDERS,
+			AccessType:         sdkacltypes.AccessType_READ,
+			IdentifierTemplate: hex.EncodeToString(oracletypes.GetFeederPermissionKey(valAddr, feederAddr)),
+		},
+		// read validator from staking - READ
+		{
+			ResourceType:       sdkacltypes.ResourceType_KV_STAKING_VALIDATOR,
+			AccessType:         sdkacltypes.AccessType_READ,
+			IdentifierTemplate: hex.EncodeToString(stakingtypes.GetValidatorKey(valAddr)),
+		},
+
+		// check exchange rate prevote exists - READ
+		{
+			ResourceType:       sdkacltypes.ResourceType_KV_ORACLE,
+			AccessType:         sdkacltypes.AccessType_READ,
+			IdentifierTemplate: utils.DefaultIDTemplate,
+		},
+
+		// set exchange rate prevote - WRITE
+		{
+			ResourceType:       sdkacltypes.ResourceType_KV_ORACLE,
+			AccessType:         sdkacltypes.AccessType_WRITE,
+			IdentifierTemplate: utils.DefaultIDTemplate,
+		},
 
 		// Last Operation should always be a commit
 		*acltypes.CommitAccessOp(),
@@ -65,7 +65,7 @@ func (suite *KeeperTestSuite) PrepareTest() {
 	suite.validator = suite.SetupValidator(stakingtypes.Bonded)
 
 	suite.App.OracleKeeper.SetFeederDelegation(suite.Ctx, suite.validator, suite.TestAccs[0])
-	suite.App.OracleKeeper.SetVoteTarget(suite.Ctx, suite.defaultDenom)
+	suite.App.OracleKeeper.SetVoteTarget(suite.Ctx, oracletypes.Denom{Name: suite.defaultDenom})
 }
 
 func (suite *KeeperTestSuite) TestMsgBurnDependencies() {
@@ -125,6 +125,38 @@ func (suite *KeeperTestSuite) TestMsgBurnDependencies() {
 		})
 	}
 }
+func (suite *KeeperTestSuite) TestMsgPrevoteDependencies() {
+	suite.PrepareTest()
+	params := suite.App.OracleKeeper.GetParams(suite.Ctx)
+	params.RequirePrevote = true
+	suite.App.OracleKeeper.SetParams(suite.Ctx, params)
+
+	salt := "1"
+	hash := oracletypes.GetAggregateVoteHash(salt, suite.defaultExchangeRate, suite.validator)
+	prevote := oracletypes.NewMsgAggregateExchangeRatePrevote(hash, suite.TestAccs[0], suite.validator)
+
+	handlerCtx, cms := utils.CacheTxContext(suite.Ctx)
+	_, err := suite.msgServer.AggregateExchangeRatePrevote(sdk.WrapSDKContext(handlerCtx), prevote)
+	suite.Require().NoError(err)
+	dependencies, err := oracleacl.MsgPrevoteDependencyGenerator(suite.App.AccessControlKeeper, handlerCtx, prevote)
+	suite.Require().NoError(err)
+	missing := handlerCtx.MsgValidator().ValidateAccessOperations(dependencies, cms.GetEvents())
+	suite.Require().Empty(missing)
+	cms.Write()
+
+	// reveal in the next vote period
+	revealCtx := suite.Ctx.WithBlockHeight(suite.Ctx.BlockHeight() + int64(params.VotePeriod))
+	vote := oracletypes.NewMsgAggregateExchangeRateVote(suite.defaultExchangeRate, suite.TestAccs[0], suite.validator)
+	vote.Salt = salt
+	handlerCtx, cms = utils.CacheTxContext(revealCtx)
+	_, err = suite.msgServer.AggregateExchangeRateVote(sdk.WrapSDKContext(handlerCtx), vote)
+	suite.Require().NoError(err)
+	dependencies, err = oracleacl.MsgVoteDependencyGenerator(suite.App.AccessControlKeeper, handlerCtx, vote)
+	suite.Require().NoError(err)
+	missing = handlerCtx.MsgValidator().ValidateAccessOperations(dependencies, cms.GetEvents())
+	suite.Require().Empty(missing)
+}
+
 func TestMsgVoteDependencyGenerator(t *testing.T) {
 	tm := time.Now().UTC()
 	valPub := secp256k1.GenPrivKey().PubKey()
@@ -154,9 +186,12 @@ func TestMsgVoteDependencyGeneratorInvalidMsgType(t *testing.T) {
 
 func TestOracleDependencyGenerator(t *testing.T) {
 	oracleDependencyGenerator := oracleacl.GetOracleDependencyGenerator()
-	// verify that there's one entry, for oracle aggregate vote
-	require.Equal(t, 1, len(oracleDependencyGenerator))
+	// verify that there are two entries, for oracle aggregate vote and prevote
+	require.Equal(t, 2, len(oracleDependencyGenerator))
 	// check that oracle vote dep generator is in the map
 	_, ok := oracleDependencyGenerator[acltypes.GenerateMessageKey(&oracletypes.MsgAggregateExchangeRateVote{})]
 	require.True(t, ok)
+	// check that oracle prevote dep generator is in the map
+	_, ok = oracleDependencyGenerator[acltypes.GenerateMessageKey(&oracletypes.MsgAggregateExchangeRatePrevote{})]
+	require.True(t, ok)
 }
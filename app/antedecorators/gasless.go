@@ -77,8 +77,39 @@ func (gd GaslessDecorator) AnteDeps(txDeps []sdkacltypes.AccessOperation, tx sdk
 	for _, msg := range tx.GetMsgs() {
 		// Error checking will be handled in AnteHandler
 		switch m := msg.(type) {
+		case *oracletypes.MsgAggregateExchangeRatePrevote:
+			valAddr, _ := sdk.ValAddressFromBech32(m.Validator)
+			feederAddr, _ := sdk.AccAddressFromBech32(m.Feeder)
+			deps = append(deps, []sdkacltypes.AccessOperation{
+				// validate feeder
+				// read feeder delegation for val addr - READ
+				{
+					ResourceType:       sdkacltypes.ResourceType_KV_ORACLE_FEEDERS,
+					AccessType:         sdkacltypes.AccessType_READ,
+					IdentifierTemplate: hex.EncodeToString(oracletypes.GetFeederDelegationKey(valAddr)),
+				},
+				// read additional feeder permission for val addr - READ
+				{
+					ResourceType:       sdkacltypes.ResourceType_KV_ORACLE_FEEDERS,
+					AccessType:         sdkacltypes.AccessType_READ,
+					IdentifierTemplate: hex.EncodeToString(oracletypes.GetFeederPermissionKey(valAddr, feederAddr)),
+				},
+				// read validator from staking - READ
+				{
+					ResourceType:       sdkacltypes.ResourceType_KV_STAKING_VALIDATOR,
+					AccessType:         sdkacltypes.AccessType_READ,
+					IdentifierTemplate: hex.EncodeToString(stakingtypes.GetValidatorKey(valAddr)),
+				},
+				// check exchange rate prevote exists - READ
+				{
+					ResourceType:       sdkacltypes.ResourceType_KV_ORACLE,
+					AccessType:         sdkacltypes.AccessType_READ,
+					IdentifierTemplate: "*",
+				},
+			}...)
 		case *oracletypes.MsgAggregateExchangeRateVote:
 			valAddr, _ := sdk.ValAddressFromBech32(m.Validator)
+			feederAddr, _ := sdk.AccAddressFromBech32(m.Feeder)
 			deps = append(deps, []sdkacltypes.AccessOperation{
 				// validate feeder
 				// read feeder delegation for val addr - READ
@@ -87,6 +118,12 @@ func (gd GaslessDecorator) AnteDeps(txDeps []sdkacltypes.AccessOperation, tx sdk
 					AccessType:         sdkacltypes.AccessType_READ,
 					IdentifierTemplate: hex.EncodeToString(oracletypes.GetFeederDelegationKey(valAddr)),
 				},
+				// read additional feeder permission for val addr - READ
+				{
+					ResourceType:       sdkacltypes.ResourceType_KV_ORACLE_FEEDERS,
+					AccessType:         sdkacltypes.AccessType_READ,
+					IdentifierTemplate: hex.EncodeToString(oracletypes.GetFeederPermissionKey(valAddr, feederAddr)),
+				},
 				// read validator from staking - READ
 				{
 					ResourceType:       sdkacltypes.ResourceType_KV_STAKING_VALIDATOR,
@@ -124,6 +161,11 @@ func IsTxGasless(tx sdk.Tx, ctx sdk.Context, oracleKeeper oraclekeeper.Keeper) (
 			if !dexCancelOrdersIsGasless(m) {
 				return false, nil
 			}
+		case *oracletypes.MsgAggregateExchangeRatePrevote:
+			isGasless, err := oraclePrevoteIsGasless(m, ctx, oracleKeeper)
+			if err != nil || !isGasless {
+				return false, err
+			}
 		case *oracletypes.MsgAggregateExchangeRateVote:
 			isGasless, err := oracleVoteIsGasless(m, ctx, oracleKeeper)
 			if err != nil || !isGasless {
@@ -190,3 +232,31 @@ func oracleVoteIsGasless(msg *oracletypes.MsgAggregateExchangeRateVote, ctx sdk.
 	// otherwise we allow it
 	return true, nil
 }
+
+func oraclePrevoteIsGasless(msg *oracletypes.MsgAggregateExchangeRatePrevote, ctx sdk.Context, keeper oraclekeeper.Keeper) (bool, error) {
+	feederAddr, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return false, err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return false, err
+	}
+
+	err = keeper.ValidateFeeder(ctx, feederAddr, valAddr)
+	if err != nil {
+		return false, err
+	}
+
+	// a prevote is kept until it is revealed in the next vote period, so only a
+	// prevote submitted in the current vote period blocks a gasless tx
+	prevote, err := keeper.GetAggregateExchangeRatePrevote(ctx, valAddr)
+	if err == nil {
+		votePeriod := keeper.VotePeriod(ctx)
+		if prevote.SubmitBlock/votePeriod == uint64(ctx.BlockHeight())/votePeriod {
+			return false, sdkerrors.Wrap(oracletypes.ErrAggregatePrevoteExist, valAddr.String())
+		}
+	}
+	return true, nil
+}
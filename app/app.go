@@ -129,6 +129,7 @@ import (
 	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
 
 	oraclemodule "github.com/sei-protocol/sei-chain/x/oracle"
+	oracleclient "github.com/sei-protocol/sei-chain/x/oracle/client"
 	oraclekeeper "github.com/sei-protocol/sei-chain/x/oracle/keeper"
 	oracletypes "github.com/sei-protocol/sei-chain/x/oracle/types"
 
@@ -158,6 +159,8 @@ func getGovProposalHandlers() []govclient.ProposalHandler {
 		ibcclientclient.UpgradeProposalHandler,
 		aclclient.ResourceDependencyProposalHandler,
 		mintclient.UpdateMinterHandler,
+		oracleclient.AddVoteTargetProposalHandler,
+		oracleclient.RemoveVoteTargetProposalHandler,
 		// this line is used by starport scaffolding # stargate/app/govProposalHandler
 	)
 
@@ -201,18 +204,19 @@ var (
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		acltypes.ModuleName:            nil,
-		authtypes.FeeCollectorName:     nil,
-		distrtypes.ModuleName:          nil,
-		minttypes.ModuleName:           {authtypes.Minter},
-		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:            {authtypes.Burner},
-		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
-		oracletypes.ModuleName:         nil,
-		wasm.ModuleName:                {authtypes.Burner},
-		dexmoduletypes.ModuleName:      nil,
-		tokenfactorytypes.ModuleName:   {authtypes.Minter, authtypes.Burner},
+		acltypes.ModuleName:              nil,
+		authtypes.FeeCollectorName:       nil,
+		distrtypes.ModuleName:            nil,
+		minttypes.ModuleName:             {authtypes.Minter},
+		stakingtypes.BondedPoolName:      {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:   {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:              {authtypes.Burner},
+		ibctransfertypes.ModuleName:      {authtypes.Minter, authtypes.Burner},
+		oracletypes.ModuleName:           nil,
+		wasm.ModuleName:                  {authtypes.Burner},
+		dexmoduletypes.ModuleName:        nil,
+		dexmoduletypes.IncentivePoolName: nil,
+		tokenfactorytypes.ModuleName:     {authtypes.Minter, authtypes.Burner},
 		// this line is used by starport scaffolding # stargate/app/maccPerms
 	}
 
@@ -450,10 +454,17 @@ func New(
 	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, keys[feegrant.StoreKey], app.AccountKeeper)
 	app.UpgradeKeeper = upgradekeeper.NewKeeper(skipUpgradeHeights, keys[upgradetypes.StoreKey], appCodec, homePath, app.BaseApp)
 
+	// the oracle keeper is created before the staking hooks are registered
+	// since it records validator bond heights through them
+	app.OracleKeeper = oraclekeeper.NewKeeper(
+		appCodec, keys[oracletypes.StoreKey], app.GetSubspace(oracletypes.ModuleName),
+		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, &stakingKeeper, distrtypes.ModuleName,
+	)
+
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
 	app.StakingKeeper = *stakingKeeper.SetHooks(
-		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
+		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks(), app.OracleKeeper.Hooks()),
 	)
 
 	// ... other modules keepers
@@ -485,11 +496,6 @@ func New(
 	// If evidence needs to be handled for the app, set routes in router here and seal
 	app.EvidenceKeeper = *evidenceKeeper
 
-	app.OracleKeeper = oraclekeeper.NewKeeper(
-		appCodec, keys[oracletypes.StoreKey], app.GetSubspace(oracletypes.ModuleName),
-		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, &stakingKeeper, distrtypes.ModuleName,
-	)
-
 	wasmDir := filepath.Join(homePath, "wasm")
 	wasmConfig, err := wasm.ReadWasmConfig(appOpts)
 	if err != nil {
@@ -501,8 +507,7 @@ func New(
 		keys[epochmoduletypes.StoreKey],
 		keys[epochmoduletypes.MemStoreKey],
 		app.GetSubspace(epochmoduletypes.ModuleName),
-	).SetHooks(epochmoduletypes.NewMultiEpochHooks(
-		app.MintKeeper.Hooks()))
+	)
 	app.DexKeeper = *dexmodulekeeper.NewKeeper(
 		appCodec,
 		keys[dexmoduletypes.StoreKey],
@@ -571,6 +576,12 @@ func New(
 		wasmOpts...,
 	)
 	app.DexKeeper.SetWasmKeeper(&app.WasmKeeper)
+	// epoch hooks are set once the dex keeper is fully wired, since the hooks
+	// hold a copy of it
+	app.EpochKeeper.SetHooks(epochmoduletypes.NewMultiEpochHooks(
+		app.MintKeeper.Hooks(),
+		app.DexKeeper.Hooks(),
+	))
 	dexModule := dexmodule.NewAppModule(appCodec, app.DexKeeper, app.AccountKeeper, app.BankKeeper, app.WasmKeeper, app.GetBaseApp().TracingInfo)
 	epochModule := epochmodule.NewAppModule(appCodec, app.EpochKeeper, app.AccountKeeper, app.BankKeeper)
 
@@ -584,7 +595,8 @@ func New(
 		AddRoute(dexmoduletypes.RouterKey, dexmodule.NewProposalHandler(app.DexKeeper)).
 		AddRoute(minttypes.RouterKey, mint.NewProposalHandler(app.MintKeeper)).
 		AddRoute(tokenfactorytypes.RouterKey, tokenfactorymodule.NewProposalHandler(app.TokenFactoryKeeper)).
-		AddRoute(acltypes.ModuleName, aclmodule.NewProposalHandler(app.AccessControlKeeper))
+		AddRoute(acltypes.ModuleName, aclmodule.NewProposalHandler(app.AccessControlKeeper)).
+		AddRoute(oracletypes.RouterKey, oraclemodule.NewProposalHandler(app.OracleKeeper))
 	if len(enabledProposals) != 0 {
 		govRouter.AddRoute(wasm.RouterKey, wasm.NewWasmProposalHandler(app.WasmKeeper, enabledProposals))
 	}
@@ -1262,6 +1274,8 @@ func (app *App) PartitionPrioritizedTxs(ctx sdk.Context, txs [][]byte) (prioriti
 	msgLoop:
 		for _, msg := range decodedTx.GetMsgs() {
 			switch msg.(type) {
+			case *oracletypes.MsgAggregateExchangeRatePrevote:
+				prioritized = true
 			case *oracletypes.MsgAggregateExchangeRateVote:
 				prioritized = true
 			case *dexmoduletypes.MsgRegisterContract:
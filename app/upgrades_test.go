@@ -5,7 +5,10 @@ import (
 	"reflect"
 	"testing"
 
+	dextypes "github.com/sei-protocol/sei-chain/x/dex/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 )
 
 func TestOverrideList(t *testing.T) {
@@ -47,3 +50,24 @@ func TestOverrideList(t *testing.T) {
 		})
 	}
 }
+
+func TestDexMigrationsFromV16(t *testing.T) {
+	testApp := Setup(false)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	params := testApp.DexKeeper.GetParams(ctx)
+	params.MaxOpenOrdersPerAccountPerPair = 1
+	testApp.DexKeeper.SetParams(ctx, params)
+
+	fromVM := testApp.mm.GetVersionMap()
+	fromVM[dextypes.ModuleName] = 16
+	toVM, err := testApp.mm.RunMigrations(ctx, testApp.configurator, fromVM)
+	require.NoError(t, err)
+	require.Equal(t, uint64(18), toVM[dextypes.ModuleName])
+
+	// the open order cap is left to governance on existing chains
+	params = testApp.DexKeeper.GetParams(ctx)
+	require.Equal(t, uint64(0), params.MaxOpenOrdersPerAccountPerPair)
+	require.Equal(t, dextypes.DefaultMinCampaignRewardPool, params.MinCampaignRewardPool)
+	require.Equal(t, uint64(dextypes.DefaultMaxActiveCampaignsPerPair), params.MaxActiveCampaignsPerPair)
+}
@@ -121,6 +121,8 @@ func MatchAndExtractDexAddressPrefixKeys(key []byte) (bool, []string, []byte, er
 		dextypes.MemOrderKey,
 		dextypes.MemCancelKey,
 		dextypes.MemDepositKey,
+		dextypes.ContractParamsOverrideKey,
+		dextypes.AccountOrderCounterKey,
 		dexkeeper.ContractPrefixKey,
 	}
 
@@ -23,6 +23,7 @@ import (
 	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
 	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle"
 	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/client"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/history"
 	v1 "github.com/sei-protocol/sei-chain/oracle/price-feeder/router/v1"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -33,8 +34,11 @@ const (
 	logLevelJSON = "json"
 	logLevelText = "text"
 
-	flagLogLevel  = "log-level"
-	flagLogFormat = "log-format"
+	flagLogLevel   = "log-level"
+	flagLogFormat  = "log-format"
+	flagDryRun     = "dry-run"
+	flagPricesFile = "prices-file"
+	flagWatch      = "watch-config"
 
 	envVariablePass = "PRICE_FEEDER_PASS"
 )
@@ -55,8 +59,12 @@ vote messages following the oracle voting procedure.`,
 func init() {
 	rootCmd.PersistentFlags().String(flagLogLevel, zerolog.InfoLevel.String(), "logging level")
 	rootCmd.PersistentFlags().String(flagLogFormat, logLevelText, "logging format; must be either json or text")
+	rootCmd.Flags().Bool(flagDryRun, false, "compare votes with the on-chain exchange rates instead of broadcasting them")
+	rootCmd.Flags().String(flagPricesFile, "", "read provider prices from a CSV file of [provider, base, quote, price, volume] records in dry-run mode")
+	rootCmd.Flags().Bool(flagWatch, false, "reload the config when the config file changes, besides on SIGHUP")
 
 	rootCmd.AddCommand(getVersionCmd())
+	rootCmd.AddCommand(getReplayCmd())
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
@@ -69,34 +77,26 @@ func Execute() {
 }
 
 func priceFeederCmdHandler(cmd *cobra.Command, args []string) error {
-	logLvlStr, err := cmd.Flags().GetString(flagLogLevel)
+	logger, err := getLogger(cmd)
 	if err != nil {
 		return err
 	}
 
-	logLvl, err := zerolog.ParseLevel(logLvlStr)
+	dryRun, err := cmd.Flags().GetBool(flagDryRun)
 	if err != nil {
 		return err
 	}
-
-	logFormatStr, err := cmd.Flags().GetString(flagLogFormat)
+	pricesFile, err := cmd.Flags().GetString(flagPricesFile)
 	if err != nil {
 		return err
 	}
-
-	var logWriter io.Writer
-	switch strings.ToLower(logFormatStr) {
-	case logLevelJSON:
-		logWriter = os.Stderr
-
-	case logLevelText:
-		logWriter = zerolog.ConsoleWriter{Out: os.Stderr}
-
-	default:
-		return fmt.Errorf("invalid logging format: %s", logFormatStr)
+	if len(pricesFile) > 0 && !dryRun {
+		return fmt.Errorf("--%s requires --%s", flagPricesFile, flagDryRun)
+	}
+	watch, err := cmd.Flags().GetBool(flagWatch)
+	if err != nil {
+		return err
 	}
-
-	logger := zerolog.New(logWriter).Level(logLvl).With().Timestamp().Logger()
 
 	cfg, err := config.ParseConfig(args[0])
 	if err != nil {
@@ -134,6 +134,11 @@ func priceFeederCmdHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	failoverEndpoints := make([]client.Endpoint, len(cfg.RPC.FailoverEndpoints))
+	for i, endpoint := range cfg.RPC.FailoverEndpoints {
+		failoverEndpoints[i] = client.Endpoint{TMRPC: endpoint.TMRPCEndpoint, GRPC: endpoint.GRPCEndpoint}
+	}
+
 	// Retry creating oracle client for 5 seconds
 	var oracleClient client.OracleClient
 	for i := 0; i < 5; i++ {
@@ -150,6 +155,7 @@ func priceFeederCmdHandler(cmd *cobra.Command, args []string) error {
 			cfg.Account.Validator,
 			cfg.Account.FeeGranter,
 			cfg.RPC.GRPCEndpoint,
+			failoverEndpoints,
 			cfg.GasAdjustment,
 			cfg.GasPrices,
 		)
@@ -164,23 +170,17 @@ func priceFeederCmdHandler(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("error creating oracle client: %w", err)
 	}
+	if oracleClient.Retry, err = getRetryConfig(cfg); err != nil {
+		return err
+	}
 	providerTimeout, err := time.ParseDuration(cfg.ProviderTimeout)
 	if err != nil {
 		return fmt.Errorf("failed to parse provider timeout: %w", err)
 	}
 
-	deviations := make(map[string]sdk.Dec, len(cfg.Deviations))
-	for _, deviation := range cfg.Deviations {
-		threshold, err := sdk.NewDecFromStr(deviation.Threshold)
-		if err != nil {
-			return err
-		}
-		deviations[deviation.Base] = threshold
-	}
-
-	endpoints := make(map[string]config.ProviderEndpoint, len(cfg.ProviderEndpoints))
-	for _, endpoint := range cfg.ProviderEndpoints {
-		endpoints[endpoint.Name] = endpoint
+	deviations, err := getDeviations(cfg)
+	if err != nil {
+		return err
 	}
 
 	oracle := oracle.New(
@@ -189,10 +189,27 @@ func priceFeederCmdHandler(cmd *cobra.Command, args []string) error {
 		cfg.CurrencyPairs,
 		providerTimeout,
 		deviations,
-		endpoints,
+		getProviderEndpoints(cfg),
+		getRestProviders(cfg),
+		cfg.DexProvider,
 		cfg.Healthchecks,
 	)
 
+	if len(cfg.History.Path) > 0 {
+		store, err := history.NewStore(cfg.History.Path)
+		if err != nil {
+			return err
+		}
+		defer store.Close()
+
+		oracle.EnableHistory(store)
+	}
+
+	if dryRun {
+		logger.Info().Str("prices_file", pricesFile).Msg("running in dry-run mode, votes will not be broadcasted")
+		oracle.EnableDryRun(pricesFile)
+	}
+
 	telemetryCfg := telemetry.Config{}
 	err = mapstructure.Decode(cfg.Telemetry, &telemetryCfg)
 	if err != nil {
@@ -203,6 +220,9 @@ func priceFeederCmdHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	// reload the currency pairs and providers on SIGHUP or config file changes
+	go watchConfig(ctx, logger, args[0], cfg, oracle, watch)
+
 	if cfg.EnableServer {
 		g.Go(func() error {
 			// start the process that observes and publishes exchange prices
@@ -222,6 +242,73 @@ func priceFeederCmdHandler(cmd *cobra.Command, args []string) error {
 	return g.Wait()
 }
 
+// getLogger returns a logger with the level and format of the command's flags.
+func getLogger(cmd *cobra.Command) (zerolog.Logger, error) {
+	logLvlStr, err := cmd.Flags().GetString(flagLogLevel)
+	if err != nil {
+		return zerolog.Logger{}, err
+	}
+
+	logLvl, err := zerolog.ParseLevel(logLvlStr)
+	if err != nil {
+		return zerolog.Logger{}, err
+	}
+
+	logFormatStr, err := cmd.Flags().GetString(flagLogFormat)
+	if err != nil {
+		return zerolog.Logger{}, err
+	}
+
+	var logWriter io.Writer
+	switch strings.ToLower(logFormatStr) {
+	case logLevelJSON:
+		logWriter = os.Stderr
+
+	case logLevelText:
+		logWriter = zerolog.ConsoleWriter{Out: os.Stderr}
+
+	default:
+		return zerolog.Logger{}, fmt.Errorf("invalid logging format: %s", logFormatStr)
+	}
+
+	return zerolog.New(logWriter).Level(logLvl).With().Timestamp().Logger(), nil
+}
+
+// getDeviations returns the deviation threshold of each base of the config.
+func getDeviations(cfg config.Config) (map[string]sdk.Dec, error) {
+	deviations := make(map[string]sdk.Dec, len(cfg.Deviations))
+	for _, deviation := range cfg.Deviations {
+		threshold, err := sdk.NewDecFromStr(deviation.Threshold)
+		if err != nil {
+			return nil, err
+		}
+		deviations[deviation.Base] = threshold
+	}
+
+	return deviations, nil
+}
+
+// getProviderEndpoints returns the endpoints of each provider of the config.
+func getProviderEndpoints(cfg config.Config) map[string]config.ProviderEndpoint {
+	endpoints := make(map[string]config.ProviderEndpoint, len(cfg.ProviderEndpoints))
+	for _, endpoint := range cfg.ProviderEndpoints {
+		endpoints[endpoint.Name] = endpoint
+	}
+
+	return endpoints
+}
+
+// getRestProviders returns the configuration of each REST provider of the
+// config.
+func getRestProviders(cfg config.Config) map[string]config.RestProvider {
+	restProviders := make(map[string]config.RestProvider, len(cfg.RestProviders))
+	for _, restProvider := range cfg.RestProviders {
+		restProviders[restProvider.Name] = restProvider
+	}
+
+	return restProviders
+}
+
 func getKeyringPassword() (string, error) {
 	reader := bufio.NewReader(os.Stdin)
 
@@ -323,3 +410,24 @@ func startPriceOracle(ctx context.Context, logger zerolog.Logger, oracle *oracle
 		}
 	}
 }
+
+func getRetryConfig(cfg config.Config) (client.RetryConfig, error) {
+	if cfg.Broadcast.MaxRetries < 0 {
+		return client.RetryConfig{}, nil
+	}
+
+	retryInterval, err := time.ParseDuration(cfg.Broadcast.RetryInterval)
+	if err != nil {
+		return client.RetryConfig{}, fmt.Errorf("failed to parse broadcast retry interval: %w", err)
+	}
+	feeBump, err := sdk.NewDecFromStr(cfg.Broadcast.FeeBump)
+	if err != nil {
+		return client.RetryConfig{}, fmt.Errorf("failed to parse broadcast fee bump: %w", err)
+	}
+
+	return client.RetryConfig{
+		MaxRetries: cfg.Broadcast.MaxRetries,
+		Interval:   retryInterval,
+		FeeBump:    feeBump,
+	}, nil
+}
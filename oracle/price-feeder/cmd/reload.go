@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"reflect"
+	"syscall"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/rs/zerolog"
+
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle"
+)
+
+// configPollInterval is how often the config file is checked for changes when
+// it's watched.
+const configPollInterval = 5 * time.Second
+
+// watchConfig reloads the config of the oracle when the process receives a
+// SIGHUP, and when the config file is modified if watch is set.
+func watchConfig(
+	ctx context.Context,
+	logger zerolog.Logger,
+	configPath string,
+	cfg config.Config,
+	oracle *oracle.Oracle,
+	watch bool,
+) {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGHUP)
+	defer signal.Stop(sigCh)
+
+	var pollCh <-chan time.Time
+	if watch {
+		ticker := time.NewTicker(configPollInterval)
+		defer ticker.Stop()
+		pollCh = ticker.C
+	}
+	modTime := configModTime(configPath)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case sig := <-sigCh:
+			logger.Info().Str("signal", sig.String()).Msg("caught signal; reloading config...")
+
+		case <-pollCh:
+			latestModTime := configModTime(configPath)
+			if latestModTime.Equal(modTime) {
+				continue
+			}
+			modTime = latestModTime
+			logger.Info().Str("config", configPath).Msg("config file modified; reloading config...")
+		}
+
+		cfg = reloadConfig(logger, configPath, cfg, oracle)
+	}
+}
+
+// reloadConfig parses and validates the config file, then swaps the currency
+// pairs, deviation thresholds and provider configurations of the oracle. The
+// current config is kept when the new config is invalid or can't be applied.
+// The returned config is the config the oracle runs with.
+func reloadConfig(
+	logger zerolog.Logger,
+	configPath string,
+	cfg config.Config,
+	oracle *oracle.Oracle,
+) config.Config {
+	newCfg, err := config.ParseConfig(configPath)
+	if err != nil {
+		telemetry.IncrCounter(1, "failure", "reload")
+		logger.Error().Err(err).Msg("invalid config; keeping the current config")
+		return cfg
+	}
+
+	deviations, err := getDeviations(newCfg)
+	if err != nil {
+		telemetry.IncrCounter(1, "failure", "reload")
+		logger.Error().Err(err).Msg("invalid deviation thresholds; keeping the current config")
+		return cfg
+	}
+
+	err = oracle.Reload(
+		newCfg.CurrencyPairs,
+		deviations,
+		getProviderEndpoints(newCfg),
+		getRestProviders(newCfg),
+	)
+	if err != nil {
+		telemetry.IncrCounter(1, "failure", "reload")
+		logger.Error().Err(err).Msg("failed to apply config; keeping the current config")
+		return cfg
+	}
+
+	for _, section := range restartRequired(cfg, newCfg) {
+		logger.Warn().Str("section", section).Msg("config changes of section require a restart")
+	}
+
+	cfg.CurrencyPairs = newCfg.CurrencyPairs
+	cfg.Deviations = newCfg.Deviations
+	cfg.ProviderEndpoints = newCfg.ProviderEndpoints
+	cfg.RestProviders = newCfg.RestProviders
+
+	telemetry.IncrCounter(1, "success", "reload")
+	logger.Info().Int("currency_pairs", len(cfg.CurrencyPairs)).Msg("config reloaded")
+	return cfg
+}
+
+// restartRequired returns the sections of the config which differ between two
+// configs but can't be reloaded.
+func restartRequired(cfg, newCfg config.Config) []string {
+	sections := []struct {
+		name             string
+		current, updated interface{}
+	}{
+		{"server", cfg.Server, newCfg.Server},
+		{"account", cfg.Account, newCfg.Account},
+		{"keyring", cfg.Keyring, newCfg.Keyring},
+		{"rpc", cfg.RPC, newCfg.RPC},
+		{"telemetry", cfg.Telemetry, newCfg.Telemetry},
+		{"gas_adjustment", cfg.GasAdjustment, newCfg.GasAdjustment},
+		{"gas_prices", cfg.GasPrices, newCfg.GasPrices},
+		{"provider_timeout", cfg.ProviderTimeout, newCfg.ProviderTimeout},
+		{"dex_provider", cfg.DexProvider, newCfg.DexProvider},
+		{"enable_server", cfg.EnableServer, newCfg.EnableServer},
+		{"enable_voter", cfg.EnableVoter, newCfg.EnableVoter},
+		{"healthchecks", cfg.Healthchecks, newCfg.Healthchecks},
+		{"history", cfg.History, newCfg.History},
+		{"broadcast", cfg.Broadcast, newCfg.Broadcast},
+	}
+
+	changed := []string{}
+	for _, section := range sections {
+		if !reflect.DeepEqual(section.current, section.updated) {
+			changed = append(changed, section.name)
+		}
+	}
+
+	return changed
+}
+
+func configModTime(configPath string) time.Time {
+	info, err := os.Stat(configPath)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"text/tabwriter"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/history"
+)
+
+func getReplayCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "replay [config-file] [history-file]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Recompute the prices of a price history with a config",
+		Long: `Recompute the prices of each vote of a price history, as recorded with the
+[history] section of the config, using the currency pairs, aggregations and
+deviation thresholds of the given config. Only the recorded data of the
+providers of the config's currency pairs is used. The recorded and the
+recomputed price of each base are printed for every vote.`,
+		RunE: replayCmdHandler,
+	}
+}
+
+func replayCmdHandler(cmd *cobra.Command, args []string) error {
+	logger, err := getLogger(cmd)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := config.ParseConfig(args[0])
+	if err != nil {
+		return err
+	}
+
+	deviations, err := getDeviations(cfg)
+	if err != nil {
+		return err
+	}
+
+	records, err := history.ReadRecords(args[1])
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "HEIGHT\tVOTE PERIOD\tBASE\tRECORDED\tREPLAYED\tCHANGE")
+
+	changed := 0
+	for _, record := range records {
+		prices, err := oracle.ReplayRecord(logger, record, cfg.CurrencyPairs, deviations)
+		if err != nil {
+			return fmt.Errorf("failed to replay height %d: %w", record.Height, err)
+		}
+
+		for _, base := range replayedBases(record.Prices, prices) {
+			recorded, recordedOk := record.Prices[base]
+			replayed, replayedOk := prices[base]
+
+			change := "-"
+			if recordedOk && replayedOk && recorded.IsPositive() {
+				change = replayed.Sub(recorded).Quo(recorded).MulInt64(100).String() + "%"
+			}
+			if recordedOk != replayedOk || (recordedOk && !recorded.Equal(replayed)) {
+				changed++
+			}
+
+			fmt.Fprintf(w, "%d\t%d\t%s\t%s\t%s\t%s\n",
+				record.Height, record.VotePeriod, base, decOrDash(recorded, recordedOk), decOrDash(replayed, replayedOk), change)
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "\nreplayed %d votes, %d prices changed\n", len(records), changed)
+	return nil
+}
+
+// replayedBases returns the sorted bases of the recorded and replayed prices.
+func replayedBases(recorded, replayed map[string]sdk.Dec) []string {
+	bases := make([]string, 0, len(recorded))
+	for base := range recorded {
+		bases = append(bases, base)
+	}
+	for base := range replayed {
+		if _, ok := recorded[base]; !ok {
+			bases = append(bases, base)
+		}
+	}
+	sort.Strings(bases)
+
+	return bases
+}
+
+func decOrDash(d sdk.Dec, ok bool) string {
+	if !ok {
+		return "-"
+	}
+	return d.String()
+}
@@ -15,10 +15,17 @@ import (
 const (
 	DenomUSD = "USD"
 
-	defaultListenAddr      = "0.0.0.0:7171"
-	defaultSrvWriteTimeout = 15 * time.Second
-	defaultSrvReadTimeout  = 15 * time.Second
-	defaultProviderTimeout = 100 * time.Millisecond
+	defaultListenAddr       = "0.0.0.0:7171"
+	defaultSrvWriteTimeout  = 15 * time.Second
+	defaultSrvReadTimeout   = 15 * time.Second
+	defaultProviderTimeout  = 100 * time.Millisecond
+	defaultRestPollInterval = 5 * time.Second
+	defaultDexLookback      = 10 * time.Minute
+	defaultDexCandlePeriod  = time.Minute
+	defaultDexPollInterval  = 5 * time.Second
+	defaultMaxRetries       = 3
+	defaultRetryInterval    = 500 * time.Millisecond
+	defaultFeeBump          = "1.2"
 
 	// API sources for Sei native oracle price feed - examples include price of BTC, ETH - that applications on Sei can
 	// use
@@ -31,6 +38,20 @@ const (
 	ProviderGate     = "gate"
 	ProviderCoinbase = "coinbase"
 	ProviderMock     = "mock"
+	// on-chain prices of the Sei dex, for assets mainly traded on Sei
+	ProviderDex = "dex"
+
+	// methods combining the prices of the providers of an asset
+	AggregationTVWAP                = "tvwap"
+	AggregationMedian               = "median"
+	AggregationTrimmedMean          = "trimmed_mean"
+	AggregationVolumeWeightedMedian = "volume_weighted_median"
+
+	// prices of a currency pair a provider reports: the last trade, or the
+	// mid or the microprice of the top of its order book
+	PriceSourceLast       = "last"
+	PriceSourceMid        = "mid"
+	PriceSourceMicroprice = "microprice"
 )
 
 var (
@@ -51,6 +72,32 @@ var (
 		ProviderGate:     {},
 		ProviderCoinbase: {},
 		ProviderMock:     {},
+		ProviderDex:      {},
+	}
+
+	// SupportedAggregations is a lookup table of the methods that can be used
+	// to combine the prices of the providers of an asset.
+	SupportedAggregations = map[string]struct{}{
+		AggregationTVWAP:                {},
+		AggregationMedian:               {},
+		AggregationTrimmedMean:          {},
+		AggregationVolumeWeightedMedian: {},
+	}
+
+	// SupportedPriceSources is a lookup table of the prices a provider can
+	// report for a currency pair.
+	SupportedPriceSources = map[string]struct{}{
+		PriceSourceLast:       {},
+		PriceSourceMid:        {},
+		PriceSourceMicroprice: {},
+	}
+
+	// DepthProviders is a lookup table of the providers streaming the order
+	// book of their currency pairs, which are priced by its mid or microprice
+	// when selected as the price source of a pair.
+	DepthProviders = map[string]struct{}{
+		ProviderBinance: {},
+		ProviderOkx:     {},
 	}
 
 	// maxDeviationThreshold is the maxmimum allowed amount of standard
@@ -85,9 +132,13 @@ type (
 		GasPrices         string             `toml:"gas_prices" validate:"required"`
 		ProviderTimeout   string             `toml:"provider_timeout"`
 		ProviderEndpoints []ProviderEndpoint `toml:"provider_endpoints" validate:"dive"`
+		RestProviders     []RestProvider     `toml:"rest_providers" validate:"dive"`
+		DexProvider       DexProvider        `toml:"dex_provider"`
 		EnableServer      bool               `toml:"enable_server"`
 		EnableVoter       bool               `toml:"enable_voter"`
 		Healthchecks      []Healthchecks     `toml:"healthchecks" validate:"dive"`
+		History           History            `toml:"history"`
+		Broadcast         Broadcast          `toml:"broadcast"`
 	}
 
 	// Server defines the API server configuration.
@@ -106,6 +157,26 @@ type (
 		ChainDenom string   `toml:"chain_denom" validate:"required"`
 		Quote      string   `toml:"quote" validate:"required"`
 		Providers  []string `toml:"providers" validate:"required,gt=0,dive,required"`
+
+		// Method combining the prices of the base's providers, one of "tvwap",
+		// "median", "trimmed_mean" or "volume_weighted_median". Defaults to
+		// "tvwap"
+		Aggregation string `toml:"aggregation"`
+
+		// Number of providers which must report a price for the base before it
+		// is voted on. Any number is accepted when zero
+		MinProviders int `toml:"min_providers" validate:"gte=0"`
+
+		// Price of the pair reported by the providers, one of "last", "mid" or
+		// "microprice". The mid and microprice of the top of the order book are
+		// only supported by the providers streaming it (binance and okx), and
+		// the other providers report the last trade. Defaults to "last"
+		PriceSource string `toml:"price_source"`
+
+		// Maximum age of the top of the order book priced by "mid" or
+		// "microprice", past which the provider reports the last trade until
+		// its order book updates again. Defaults to 10s
+		MaxBookAge string `toml:"max_book_age"`
 	}
 
 	// Deviation defines a maximum amount of standard deviations that a given asset can
@@ -136,6 +207,16 @@ type (
 		TMRPCEndpoint string `toml:"tmrpc_endpoint" validate:"required"`
 		GRPCEndpoint  string `toml:"grpc_endpoint" validate:"required"`
 		RPCTimeout    string `toml:"rpc_timeout" validate:"required"`
+
+		// FailoverEndpoints of other nodes, which are used when the node above
+		// is down or falls behind
+		FailoverEndpoints []RPCEndpoint `toml:"failover_endpoints" validate:"dive"`
+	}
+
+	// RPCEndpoint defines the Tendermint RPC and gRPC endpoints of a node.
+	RPCEndpoint struct {
+		TMRPCEndpoint string `toml:"tmrpc_endpoint" validate:"required"`
+		GRPCEndpoint  string `toml:"grpc_endpoint" validate:"required"`
 	}
 
 	// Telemetry defines the configuration options for application telemetry.
@@ -182,10 +263,85 @@ type (
 		Websocket string `toml:"websocket"`
 	}
 
+	// RestProvider defines a provider polling a REST/JSON API, configured
+	// declaratively instead of being implemented in the provider package.
+	RestProvider struct {
+		// Name of the provider, used in the providers of the currency pairs
+		Name string `toml:"name" validate:"required"`
+
+		// URL polled for the price of a pair. The {symbol}, {base} and {quote}
+		// placeholders are replaced by the pair's values, ex.
+		// "https://api.example.com/ticker?symbol={symbol}"
+		URL string `toml:"url" validate:"required"`
+
+		// JSONPath of the price in the response, ex. "$.data.last". The {symbol}
+		// placeholder may be used in the path as well
+		PricePath string `toml:"price_path" validate:"required"`
+
+		// JSONPath of the 24h volume in the response. Prices are given a volume
+		// of one when empty
+		VolumePath string `toml:"volume_path"`
+
+		// JSONPath of the price's unix timestamp in seconds or milliseconds, or
+		// its RFC 3339 time. The time of the request is used when empty
+		TimestampPath string `toml:"timestamp_path"`
+
+		// Symbols maps a pair, ex. "ATOMUSDT", to the symbol the API uses for it.
+		// Pairs without a mapping use the concatenation of base and quote
+		Symbols map[string]string `toml:"symbols"`
+
+		// PollInterval between two requests for the price of a pair
+		PollInterval string `toml:"poll_interval"`
+	}
+
+	// DexProvider defines the configuration of the provider reading the prices
+	// of a contract of the Sei dex over the gRPC endpoint.
+	DexProvider struct {
+		// ContractAddress of the dex contract the prices are read from
+		ContractAddress string `toml:"contract_address"`
+
+		// Lookback of the TWAPs, and of the candles whose volume weights them
+		Lookback string `toml:"lookback"`
+
+		// CandlePeriod is the length of the candles read from the historical prices
+		CandlePeriod string `toml:"candle_period"`
+
+		// PollInterval between two reads of the prices
+		PollInterval string `toml:"poll_interval"`
+
+		// Denoms maps the symbols of the currency pairs, ex. "SEI", to their denom
+		// on the dex, ex. "usei". Symbols without a mapping are used as is
+		Denoms map[string]string `toml:"denoms"`
+	}
+
 	Healthchecks struct {
 		URL     string `toml:"url" validate:"required"`
 		Timeout string `toml:"timeout" validate:"required"`
 	}
+
+	// History defines the store of the provider data, the prices and the
+	// exchange rates of each vote, which can be replayed with another config.
+	History struct {
+		// Path of the append-only price history file. Nothing is recorded when
+		// empty
+		Path string `toml:"path"`
+	}
+
+	// Broadcast defines how a vote is retried when broadcasting it fails, for
+	// example on an account sequence mismatch or a full mempool. A vote is only
+	// retried until the last block of its vote period.
+	Broadcast struct {
+		// MaxRetries of a vote after its first broadcast. Defaults to 3 when
+		// zero, and votes are not retried when negative
+		MaxRetries int `toml:"max_retries"`
+
+		// RetryInterval between two broadcasts of a vote
+		RetryInterval string `toml:"retry_interval"`
+
+		// FeeBump the gas prices are multiplied by when a vote is rejected for
+		// an insufficient fee, ex. "1.2"
+		FeeBump string `toml:"fee_bump"`
+	}
 )
 
 // telemetryValidation is custom validation for the Telemetry struct.
@@ -247,9 +403,44 @@ func ParseConfig(configPath string) (Config, error) {
 		cfg.ProviderTimeout = defaultProviderTimeout.String()
 	}
 
+	restProviders := make(map[string]bool, len(cfg.RestProviders))
+	for i, restProvider := range cfg.RestProviders {
+		if _, ok := SupportedProviders[restProvider.Name]; ok || restProviders[restProvider.Name] {
+			return cfg, fmt.Errorf("duplicate provider: %s", restProvider.Name)
+		}
+		restProviders[restProvider.Name] = true
+
+		if len(restProvider.PollInterval) == 0 {
+			cfg.RestProviders[i].PollInterval = defaultRestPollInterval.String()
+		}
+		pollInterval, err := time.ParseDuration(cfg.RestProviders[i].PollInterval)
+		if err != nil {
+			return cfg, fmt.Errorf("failed to parse poll interval of %s: %w", restProvider.Name, err)
+		}
+		if pollInterval <= 0 {
+			return cfg, fmt.Errorf("poll interval of %s must be positive", restProvider.Name)
+		}
+	}
+
+	if err := parseDexProvider(&cfg); err != nil {
+		return cfg, err
+	}
+
+	if err := parseBroadcast(&cfg); err != nil {
+		return cfg, err
+	}
+
 	pairs := make(map[string]map[string]struct{})
 	coinQuotes := make(map[string]struct{})
+	aggregations := make(map[string]CurrencyPair)
+	priceSources := make(map[string]CurrencyPair)
 	for _, cp := range cfg.CurrencyPairs {
+		if err := parseAggregation(aggregations, cp); err != nil {
+			return cfg, err
+		}
+		if err := parsePriceSource(priceSources, cp); err != nil {
+			return cfg, err
+		}
 		if _, ok := pairs[cp.Base]; !ok {
 			pairs[cp.Base] = make(map[string]struct{})
 		}
@@ -261,7 +452,7 @@ func ParseConfig(configPath string) (Config, error) {
 		}
 
 		for _, provider := range cp.Providers {
-			if _, ok := SupportedProviders[provider]; !ok {
+			if _, ok := SupportedProviders[provider]; !ok && !restProviders[provider] {
 				return cfg, fmt.Errorf("unsupported provider: %s", provider)
 			}
 			pairs[cp.Base][provider] = struct{}{}
@@ -284,6 +475,9 @@ func ParseConfig(configPath string) (Config, error) {
 		if _, ok := pairs[base]["mock"]; !ok && len(providers) < 3 {
 			return cfg, fmt.Errorf("must have at least three providers for %s", base)
 		}
+		if aggregations[base].MinProviders > len(providers) {
+			return cfg, fmt.Errorf("min providers of %s exceeds its %d providers", base, len(providers))
+		}
 	}
 
 	for _, deviation := range cfg.Deviations {
@@ -299,3 +493,149 @@ func ParseConfig(configPath string) (Config, error) {
 
 	return cfg, cfg.Validate()
 }
+
+// parseAggregation validates the aggregation of a currency pair, which must
+// match the aggregation of the other pairs of the same base when both are set.
+func parseAggregation(aggregations map[string]CurrencyPair, cp CurrencyPair) error {
+	if len(cp.Aggregation) > 0 {
+		if _, ok := SupportedAggregations[cp.Aggregation]; !ok {
+			return fmt.Errorf("unsupported aggregation: %s", cp.Aggregation)
+		}
+	}
+
+	aggregation := aggregations[cp.Base]
+	if len(cp.Aggregation) > 0 {
+		if len(aggregation.Aggregation) > 0 && aggregation.Aggregation != cp.Aggregation {
+			return fmt.Errorf("conflicting aggregations for %s", cp.Base)
+		}
+		aggregation.Aggregation = cp.Aggregation
+	}
+	if cp.MinProviders > 0 {
+		if aggregation.MinProviders > 0 && aggregation.MinProviders != cp.MinProviders {
+			return fmt.Errorf("conflicting min providers for %s", cp.Base)
+		}
+		aggregation.MinProviders = cp.MinProviders
+	}
+	aggregations[cp.Base] = aggregation
+
+	return nil
+}
+
+// parseDexProvider sets the defaults of the dex provider and validates it when a
+// currency pair uses it.
+func parseDexProvider(cfg *Config) error {
+	used := false
+	for _, cp := range cfg.CurrencyPairs {
+		for _, provider := range cp.Providers {
+			used = used || provider == ProviderDex
+		}
+	}
+	if !used {
+		return nil
+	}
+
+	if len(cfg.DexProvider.ContractAddress) == 0 {
+		return fmt.Errorf("dex provider requires a contract address")
+	}
+	if len(cfg.DexProvider.Lookback) == 0 {
+		cfg.DexProvider.Lookback = defaultDexLookback.String()
+	}
+	if len(cfg.DexProvider.CandlePeriod) == 0 {
+		cfg.DexProvider.CandlePeriod = defaultDexCandlePeriod.String()
+	}
+	if len(cfg.DexProvider.PollInterval) == 0 {
+		cfg.DexProvider.PollInterval = defaultDexPollInterval.String()
+	}
+
+	durations := map[string]string{
+		"lookback":      cfg.DexProvider.Lookback,
+		"candle period": cfg.DexProvider.CandlePeriod,
+		"poll interval": cfg.DexProvider.PollInterval,
+	}
+	for name, value := range durations {
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("failed to parse dex provider %s: %w", name, err)
+		}
+		if duration < time.Second {
+			return fmt.Errorf("dex provider %s must be at least one second", name)
+		}
+	}
+
+	lookback, _ := time.ParseDuration(cfg.DexProvider.Lookback)
+	candlePeriod, _ := time.ParseDuration(cfg.DexProvider.CandlePeriod)
+	if lookback < candlePeriod {
+		return fmt.Errorf("dex provider lookback must not be shorter than its candle period")
+	}
+
+	return nil
+}
+
+// parsePriceSource validates the price source of a currency pair and the
+// maximum age of its order book, which must match the ones of the other entries
+// of the same pair when both are set.
+func parsePriceSource(priceSources map[string]CurrencyPair, cp CurrencyPair) error {
+	if len(cp.PriceSource) > 0 {
+		if _, ok := SupportedPriceSources[cp.PriceSource]; !ok {
+			return fmt.Errorf("unsupported price source: %s", cp.PriceSource)
+		}
+	}
+	if len(cp.MaxBookAge) > 0 {
+		maxBookAge, err := time.ParseDuration(cp.MaxBookAge)
+		if err != nil {
+			return fmt.Errorf("failed to parse max book age: %w", err)
+		}
+		if maxBookAge <= 0 {
+			return fmt.Errorf("max book age must be positive")
+		}
+	}
+
+	pair := cp.Base + cp.Quote
+	priceSource := priceSources[pair]
+	if len(cp.PriceSource) > 0 {
+		if len(priceSource.PriceSource) > 0 && priceSource.PriceSource != cp.PriceSource {
+			return fmt.Errorf("conflicting price sources for %s", pair)
+		}
+		priceSource.PriceSource = cp.PriceSource
+	}
+	if len(cp.MaxBookAge) > 0 {
+		if len(priceSource.MaxBookAge) > 0 && priceSource.MaxBookAge != cp.MaxBookAge {
+			return fmt.Errorf("conflicting max book ages for %s", pair)
+		}
+		priceSource.MaxBookAge = cp.MaxBookAge
+	}
+	priceSources[pair] = priceSource
+
+	return nil
+}
+
+// parseBroadcast sets the defaults of the vote retries and validates them.
+func parseBroadcast(cfg *Config) error {
+	if cfg.Broadcast.MaxRetries == 0 {
+		cfg.Broadcast.MaxRetries = defaultMaxRetries
+	}
+	if len(cfg.Broadcast.RetryInterval) == 0 {
+		cfg.Broadcast.RetryInterval = defaultRetryInterval.String()
+	}
+	if len(cfg.Broadcast.FeeBump) == 0 {
+		cfg.Broadcast.FeeBump = defaultFeeBump
+	}
+
+	retryInterval, err := time.ParseDuration(cfg.Broadcast.RetryInterval)
+	if err != nil {
+		return fmt.Errorf("failed to parse broadcast retry interval: %w", err)
+	}
+	if retryInterval < 0 {
+		return fmt.Errorf("broadcast retry interval must not be negative")
+	}
+
+	feeBump, err := sdk.NewDecFromStr(cfg.Broadcast.FeeBump)
+	if err != nil {
+		return fmt.Errorf("broadcast fee bump must be numeric: %w", err)
+	}
+	if feeBump.LT(sdk.OneDec()) {
+		return fmt.Errorf("broadcast fee bump must be at least 1")
+	}
+
+	return nil
+}
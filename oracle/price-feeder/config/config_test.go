@@ -3,6 +3,7 @@ package config_test
 import (
 	"io/ioutil"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
@@ -556,3 +557,482 @@ global_labels = [["chain-id", "umee-local-testnet"]]
 	_, err = config.ParseConfig(tmpFile.Name())
 	require.Error(t, err)
 }
+
+func TestParseConfig_RestProviders(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "price-feeder.toml")
+	require.NoError(t, err)
+	defer os.Remove(tmpFile.Name())
+
+	content := []byte(`
+gas_adjustment = 1.5
+gas_prices = "0.00125usei"
+
+[[currency_pairs]]
+base = "ATOM"
+chain_denom = "uatom"
+quote = "USDT"
+providers = [
+	"kraken",
+	"binance",
+	"venue"
+]
+
+[[currency_pairs]]
+base = "USDT"
+chain_denom = "uusdt"
+quote = "USD"
+providers = [
+	"kraken",
+	"binance",
+	"huobi"
+]
+
+[[rest_providers]]
+name = "venue"
+url = "https://api.venue.com/ticker/{symbol}"
+price_path = "$.data.last"
+volume_path = "$.data.volume"
+
+[rest_providers.symbols]
+ATOMUSDT = "atom-usdt"
+
+[account]
+address = "sei15nejfgcaanqpw25ru4arvfd0fwy6j8clccvwx4"
+validator = "seivalcons14rjlkfzp56733j5l5nfk6fphjxymgf8mj04d5p"
+chain_id = "sei-local-testnet"
+prefix = "sei"
+
+[keyring]
+backend = "test"
+dir = "/Users/username/.sei"
+
+[rpc]
+tmrpc_endpoint = "http://localhost:26657"
+grpc_endpoint = "localhost:9090"
+rpc_timeout = "100ms"
+`)
+	_, err = tmpFile.Write(content)
+	require.NoError(t, err)
+
+	cfg, err := config.ParseConfig(tmpFile.Name())
+	require.NoError(t, err)
+	require.Len(t, cfg.RestProviders, 1)
+	require.Equal(t, "venue", cfg.RestProviders[0].Name)
+	require.Equal(t, "$.data.last", cfg.RestProviders[0].PricePath)
+	require.Equal(t, "atom-usdt", cfg.RestProviders[0].Symbols["ATOMUSDT"])
+	require.Equal(t, "5s", cfg.RestProviders[0].PollInterval)
+
+	// a REST provider can't shadow a built-in provider
+	invalidContent := strings.Replace(string(content), `name = "venue"`, `name = "kraken"`, 1)
+	require.NoError(t, os.WriteFile(tmpFile.Name(), []byte(invalidContent), 0o600))
+	_, err = config.ParseConfig(tmpFile.Name())
+	require.ErrorContains(t, err, "duplicate provider")
+
+	invalidContent = strings.Replace(string(content), `volume_path = "$.data.volume"`, `poll_interval = "soon"`, 1)
+	require.NoError(t, os.WriteFile(tmpFile.Name(), []byte(invalidContent), 0o600))
+	_, err = config.ParseConfig(tmpFile.Name())
+	require.ErrorContains(t, err, "poll interval")
+}
+
+func TestParseConfig_DexProvider(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "price-feeder.toml")
+	require.NoError(t, err)
+	defer os.Remove(tmpFile.Name())
+
+	content := []byte(`
+gas_adjustment = 1.5
+gas_prices = "0.00125usei"
+
+[[currency_pairs]]
+base = "SEI"
+chain_denom = "usei"
+quote = "USDT"
+providers = [
+	"kraken",
+	"binance",
+	"dex"
+]
+
+[[currency_pairs]]
+base = "USDT"
+chain_denom = "uusdt"
+quote = "USD"
+providers = [
+	"kraken",
+	"binance",
+	"huobi"
+]
+
+[dex_provider]
+contract_address = "sei14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sh9m79m"
+candle_period = "30s"
+
+[dex_provider.denoms]
+SEI = "usei"
+USDT = "uusdt"
+
+[account]
+address = "sei15nejfgcaanqpw25ru4arvfd0fwy6j8clccvwx4"
+validator = "seivalcons14rjlkfzp56733j5l5nfk6fphjxymgf8mj04d5p"
+chain_id = "sei-local-testnet"
+prefix = "sei"
+
+[keyring]
+backend = "test"
+dir = "/Users/username/.sei"
+
+[rpc]
+tmrpc_endpoint = "http://localhost:26657"
+grpc_endpoint = "localhost:9090"
+rpc_timeout = "100ms"
+`)
+	_, err = tmpFile.Write(content)
+	require.NoError(t, err)
+
+	cfg, err := config.ParseConfig(tmpFile.Name())
+	require.NoError(t, err)
+	require.Equal(t, "10m0s", cfg.DexProvider.Lookback)
+	require.Equal(t, "30s", cfg.DexProvider.CandlePeriod)
+	require.Equal(t, "5s", cfg.DexProvider.PollInterval)
+	require.Equal(t, "usei", cfg.DexProvider.Denoms["SEI"])
+
+	invalidContent := strings.Replace(string(content), `candle_period = "30s"`, `candle_period = "1h"`, 1)
+	require.NoError(t, os.WriteFile(tmpFile.Name(), []byte(invalidContent), 0o600))
+	_, err = config.ParseConfig(tmpFile.Name())
+	require.ErrorContains(t, err, "candle period")
+
+	invalidContent = strings.Replace(string(content), `contract_address = "sei14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sh9m79m"`, "", 1)
+	require.NoError(t, os.WriteFile(tmpFile.Name(), []byte(invalidContent), 0o600))
+	_, err = config.ParseConfig(tmpFile.Name())
+	require.ErrorContains(t, err, "contract address")
+}
+
+func TestParseConfig_Aggregation(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "price-feeder.toml")
+	require.NoError(t, err)
+	defer os.Remove(tmpFile.Name())
+
+	content := []byte(`
+gas_adjustment = 1.5
+gas_prices = "0.00125usei"
+
+[[currency_pairs]]
+base = "ATOM"
+chain_denom = "uatom"
+quote = "USDT"
+providers = [
+	"kraken",
+	"binance",
+	"huobi"
+]
+aggregation = "median"
+min_providers = 2
+
+[[currency_pairs]]
+base = "ATOM"
+chain_denom = "uatom"
+quote = "USD"
+providers = [
+	"coinbase"
+]
+
+[[currency_pairs]]
+base = "USDT"
+chain_denom = "uusdt"
+quote = "USD"
+providers = [
+	"kraken",
+	"binance",
+	"huobi"
+]
+
+[account]
+address = "sei15nejfgcaanqpw25ru4arvfd0fwy6j8clccvwx4"
+validator = "seivalcons14rjlkfzp56733j5l5nfk6fphjxymgf8mj04d5p"
+chain_id = "sei-local-testnet"
+prefix = "sei"
+
+[keyring]
+backend = "test"
+dir = "/Users/username/.sei"
+
+[rpc]
+tmrpc_endpoint = "http://localhost:26657"
+grpc_endpoint = "localhost:9090"
+rpc_timeout = "100ms"
+`)
+	_, err = tmpFile.Write(content)
+	require.NoError(t, err)
+
+	cfg, err := config.ParseConfig(tmpFile.Name())
+	require.NoError(t, err)
+	require.Equal(t, config.AggregationMedian, cfg.CurrencyPairs[0].Aggregation)
+	require.Equal(t, 2, cfg.CurrencyPairs[0].MinProviders)
+
+	testCases := map[string]struct {
+		old, new string
+		expErr   string
+	}{
+		"unsupported aggregation": {
+			old:    `aggregation = "median"`,
+			new:    `aggregation = "mode"`,
+			expErr: "unsupported aggregation",
+		},
+		"conflicting aggregations": {
+			old:    "\"coinbase\"\n]",
+			new:    "\"coinbase\"\n]\naggregation = \"trimmed_mean\"",
+			expErr: "conflicting aggregations for ATOM",
+		},
+		"conflicting min providers": {
+			old:    "\"coinbase\"\n]",
+			new:    "\"coinbase\"\n]\nmin_providers = 3",
+			expErr: "conflicting min providers for ATOM",
+		},
+		"too many min providers": {
+			old:    "min_providers = 2",
+			new:    "min_providers = 5",
+			expErr: "min providers of ATOM exceeds its 4 providers",
+		},
+	}
+
+	for name, tc := range testCases {
+		invalidContent := strings.Replace(string(content), tc.old, tc.new, 1)
+		require.NoError(t, os.WriteFile(tmpFile.Name(), []byte(invalidContent), 0o600))
+		_, err = config.ParseConfig(tmpFile.Name())
+		require.ErrorContains(t, err, tc.expErr, name)
+	}
+}
+
+func TestParseConfig_FailoverEndpoints(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "price-feeder.toml")
+	require.NoError(t, err)
+	defer os.Remove(tmpFile.Name())
+
+	content := []byte(`
+gas_adjustment = 1.5
+gas_prices = "0.00125usei"
+
+[[currency_pairs]]
+base = "USDT"
+chain_denom = "uusdt"
+quote = "USD"
+providers = [
+	"kraken",
+	"binance",
+	"huobi"
+]
+
+[account]
+address = "sei15nejfgcaanqpw25ru4arvfd0fwy6j8clccvwx4"
+validator = "seivalcons14rjlkfzp56733j5l5nfk6fphjxymgf8mj04d5p"
+chain_id = "sei-local-testnet"
+prefix = "sei"
+
+[keyring]
+backend = "test"
+dir = "/Users/username/.sei"
+
+[rpc]
+tmrpc_endpoint = "http://localhost:26657"
+grpc_endpoint = "localhost:9090"
+rpc_timeout = "100ms"
+
+[[rpc.failover_endpoints]]
+tmrpc_endpoint = "http://backup:26657"
+grpc_endpoint = "backup:9090"
+`)
+	_, err = tmpFile.Write(content)
+	require.NoError(t, err)
+
+	cfg, err := config.ParseConfig(tmpFile.Name())
+	require.NoError(t, err)
+	require.Equal(t, []config.RPCEndpoint{
+		{TMRPCEndpoint: "http://backup:26657", GRPCEndpoint: "backup:9090"},
+	}, cfg.RPC.FailoverEndpoints)
+
+	invalidContent := strings.Replace(string(content), `grpc_endpoint = "backup:9090"`, "", 1)
+	require.NoError(t, os.WriteFile(tmpFile.Name(), []byte(invalidContent), 0o600))
+	_, err = config.ParseConfig(tmpFile.Name())
+	require.Error(t, err)
+}
+
+func TestParseConfig_Broadcast(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "price-feeder.toml")
+	require.NoError(t, err)
+	defer os.Remove(tmpFile.Name())
+
+	content := []byte(`
+gas_adjustment = 1.5
+gas_prices = "0.00125usei"
+
+[[currency_pairs]]
+base = "USDT"
+chain_denom = "uusdt"
+quote = "USD"
+providers = [
+	"kraken",
+	"binance",
+	"huobi"
+]
+
+[account]
+address = "sei15nejfgcaanqpw25ru4arvfd0fwy6j8clccvwx4"
+validator = "seivalcons14rjlkfzp56733j5l5nfk6fphjxymgf8mj04d5p"
+chain_id = "sei-local-testnet"
+prefix = "sei"
+
+[keyring]
+backend = "test"
+dir = "/Users/username/.sei"
+
+[rpc]
+tmrpc_endpoint = "http://localhost:26657"
+grpc_endpoint = "localhost:9090"
+rpc_timeout = "100ms"
+`)
+	_, err = tmpFile.Write(content)
+	require.NoError(t, err)
+
+	cfg, err := config.ParseConfig(tmpFile.Name())
+	require.NoError(t, err)
+	require.Equal(t, config.Broadcast{MaxRetries: 3, RetryInterval: "500ms", FeeBump: "1.2"}, cfg.Broadcast)
+
+	testCases := map[string]struct {
+		broadcast string
+		expected  config.Broadcast
+		err       bool
+	}{
+		"custom retries": {
+			broadcast: "max_retries = 5\nretry_interval = \"1s\"\nfee_bump = \"1.5\"",
+			expected:  config.Broadcast{MaxRetries: 5, RetryInterval: "1s", FeeBump: "1.5"},
+		},
+		"disabled retries": {
+			broadcast: "max_retries = -1",
+			expected:  config.Broadcast{MaxRetries: -1, RetryInterval: "500ms", FeeBump: "1.2"},
+		},
+		"invalid retry interval": {
+			broadcast: `retry_interval = "soon"`,
+			err:       true,
+		},
+		"fee bump decreasing the fees": {
+			broadcast: `fee_bump = "0.9"`,
+			err:       true,
+		},
+	}
+
+	for name, tc := range testCases {
+		withBroadcast := string(content) + "\n[broadcast]\n" + tc.broadcast + "\n"
+		require.NoError(t, os.WriteFile(tmpFile.Name(), []byte(withBroadcast), 0o600))
+
+		cfg, err := config.ParseConfig(tmpFile.Name())
+		if tc.err {
+			require.Error(t, err, name)
+			continue
+		}
+		require.NoError(t, err, name)
+		require.Equal(t, tc.expected, cfg.Broadcast, name)
+	}
+}
+
+func TestParseConfig_PriceSource(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "price-feeder.toml")
+	require.NoError(t, err)
+	defer os.Remove(tmpFile.Name())
+
+	content := []byte(`
+gas_adjustment = 1.5
+gas_prices = "0.00125usei"
+
+[[currency_pairs]]
+base = "ATOM"
+chain_denom = "uatom"
+quote = "USDT"
+providers = [
+	"binance",
+	"okx",
+	"huobi"
+]
+price_source = "microprice"
+max_book_age = "5s"
+
+[[currency_pairs]]
+base = "ATOM"
+chain_denom = "uatom"
+quote = "USDT"
+providers = [
+	"kraken"
+]
+
+[[currency_pairs]]
+base = "USDT"
+chain_denom = "uusdt"
+quote = "USD"
+providers = [
+	"kraken",
+	"binance",
+	"huobi"
+]
+
+[account]
+address = "sei15nejfgcaanqpw25ru4arvfd0fwy6j8clccvwx4"
+validator = "seivalcons14rjlkfzp56733j5l5nfk6fphjxymgf8mj04d5p"
+chain_id = "sei-local-testnet"
+prefix = "sei"
+
+[keyring]
+backend = "test"
+dir = "/Users/username/.sei"
+
+[rpc]
+tmrpc_endpoint = "http://localhost:26657"
+grpc_endpoint = "localhost:9090"
+rpc_timeout = "100ms"
+`)
+	_, err = tmpFile.Write(content)
+	require.NoError(t, err)
+
+	cfg, err := config.ParseConfig(tmpFile.Name())
+	require.NoError(t, err)
+	require.Equal(t, config.PriceSourceMicroprice, cfg.CurrencyPairs[0].PriceSource)
+	require.Equal(t, "5s", cfg.CurrencyPairs[0].MaxBookAge)
+	require.Empty(t, cfg.CurrencyPairs[1].PriceSource)
+
+	testCases := map[string]struct {
+		old, new string
+		expErr   string
+	}{
+		"unsupported price source": {
+			old:    `price_source = "microprice"`,
+			new:    `price_source = "vwap"`,
+			expErr: "unsupported price source",
+		},
+		"conflicting price sources": {
+			old:    "\"kraken\"\n]\n\n[[currency_pairs]]\nbase = \"USDT\"",
+			new:    "\"kraken\"\n]\nprice_source = \"mid\"\n\n[[currency_pairs]]\nbase = \"USDT\"",
+			expErr: "conflicting price sources for ATOMUSDT",
+		},
+		"invalid max book age": {
+			old:    `max_book_age = "5s"`,
+			new:    `max_book_age = "5"`,
+			expErr: "failed to parse max book age",
+		},
+		"non-positive max book age": {
+			old:    `max_book_age = "5s"`,
+			new:    `max_book_age = "0s"`,
+			expErr: "max book age must be positive",
+		},
+		"conflicting max book ages": {
+			old:    "\"kraken\"\n]\n\n[[currency_pairs]]\nbase = \"USDT\"",
+			new:    "\"kraken\"\n]\nmax_book_age = \"1s\"\n\n[[currency_pairs]]\nbase = \"USDT\"",
+			expErr: "conflicting max book ages for ATOMUSDT",
+		},
+	}
+
+	for name, tc := range testCases {
+		require.Contains(t, string(content), tc.old, name)
+		invalidContent := strings.Replace(string(content), tc.old, tc.new, 1)
+		require.NoError(t, os.WriteFile(tmpFile.Name(), []byte(invalidContent), 0o600))
+		_, err = config.ParseConfig(tmpFile.Name())
+		require.ErrorContains(t, err, tc.expErr, name)
+	}
+}
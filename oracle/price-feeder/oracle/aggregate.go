@@ -0,0 +1,252 @@
+package oracle
+
+import (
+	"sort"
+
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/provider"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+// trimmedMeanRatio is the share of the provider prices dropped from each end
+// before averaging them with the trimmed mean aggregation. At least one price
+// is dropped from each end when there are three prices or more.
+var trimmedMeanRatio = sdk.MustNewDecFromStr("0.2")
+
+// Aggregation defines how the prices of the providers of an asset are combined
+// into the exchange rate voted on chain.
+type Aggregation struct {
+	Method       string
+	MinProviders int
+}
+
+// providerPrice is the price and volume reported by a provider for an asset.
+type providerPrice struct {
+	provider string
+	price    sdk.Dec
+	volume   sdk.Dec
+}
+
+// createAggregationsFromPairs returns the aggregation of each base of the
+// currency pairs.
+func createAggregationsFromPairs(currencyPairs []config.CurrencyPair) map[string]Aggregation {
+	aggregations := make(map[string]Aggregation)
+
+	for _, pair := range currencyPairs {
+		aggregation := aggregations[pair.Base]
+		if len(pair.Aggregation) > 0 {
+			aggregation.Method = pair.Aggregation
+		}
+		if pair.MinProviders > 0 {
+			aggregation.MinProviders = pair.MinProviders
+		}
+		aggregations[pair.Base] = aggregation
+	}
+
+	return aggregations
+}
+
+// AggregateCandles computes the price of each asset from the candles of its
+// providers. The TVWAP aggregation uses the TVWAP of all the candles of the
+// asset, while the other aggregations combine the TVWAP of each provider. The
+// candles are weighted by their age at now, in unix milliseconds.
+func AggregateCandles(
+	logger zerolog.Logger,
+	dropped *providerIssues,
+	candles provider.AggregatedProviderCandles,
+	aggregations map[string]Aggregation,
+	now int64,
+) (map[string]sdk.Dec, error) {
+	tvwaps, err := ComputeTVWAP(candles, now)
+	if err != nil {
+		return nil, err
+	}
+
+	providerPrices := make(map[string][]providerPrice)
+	for providerName, providerCandles := range candles {
+		for base, cp := range providerCandles {
+			weightedPrices, volumeSum := tvwapSums(provider.AggregatedProviderCandles{
+				providerName: {base: cp},
+			}, now)
+
+			volume, ok := volumeSum[base]
+			if !ok || !volume.IsPositive() {
+				logProviderDropped(logger, dropped, "candle", base, providerName, "no candle volume within the tvwap period")
+				continue
+			}
+
+			providerPrices[base] = append(providerPrices[base], providerPrice{
+				provider: providerName,
+				price:    weightedPrices[base].Quo(volume),
+				volume:   volume,
+			})
+		}
+	}
+
+	return aggregatePrices(logger, dropped, "candle", providerPrices, tvwaps, aggregations), nil
+}
+
+// AggregateTickers computes the price of each asset from the tickers of its
+// providers. The TVWAP aggregation uses the VWAP of the tickers of the asset,
+// while the other aggregations combine the ticker prices.
+func AggregateTickers(
+	logger zerolog.Logger,
+	dropped *providerIssues,
+	tickers provider.AggregatedProviderPrices,
+	aggregations map[string]Aggregation,
+) (map[string]sdk.Dec, error) {
+	vwaps, err := ComputeVWAP(tickers)
+	if err != nil {
+		return nil, err
+	}
+
+	providerPrices := make(map[string][]providerPrice)
+	for providerName, providerTickers := range tickers {
+		for base, tp := range providerTickers {
+			providerPrices[base] = append(providerPrices[base], providerPrice{
+				provider: providerName,
+				price:    tp.Price,
+				volume:   tp.Volume,
+			})
+		}
+	}
+
+	return aggregatePrices(logger, dropped, "ticker", providerPrices, vwaps, aggregations), nil
+}
+
+// aggregatePrices combines the provider prices of each base using the base's
+// aggregation. The volume weighted averages are used for the TVWAP aggregation.
+// Bases with fewer providers than their minimum are left out.
+func aggregatePrices(
+	logger zerolog.Logger,
+	dropped *providerIssues,
+	priceType string,
+	providerPrices map[string][]providerPrice,
+	averages map[string]sdk.Dec,
+	aggregations map[string]Aggregation,
+) map[string]sdk.Dec {
+	prices := make(map[string]sdk.Dec, len(providerPrices))
+
+	for base, pp := range providerPrices {
+		aggregation := aggregations[base]
+		if len(pp) < aggregation.MinProviders {
+			providers := make([]string, len(pp))
+			for i := range pp {
+				providers[i] = pp[i].provider
+			}
+			sort.Strings(providers)
+
+			logger.Debug().
+				Str("type", priceType).
+				Str("base", base).
+				Strs("providers", providers).
+				Int("min_providers", aggregation.MinProviders).
+				Msg("not enough providers to compute price")
+			continue
+		}
+
+		// sort by price, then provider, so the aggregation is deterministic
+		sort.Slice(pp, func(i, j int) bool {
+			if !pp[i].price.Equal(pp[j].price) {
+				return pp[i].price.LT(pp[j].price)
+			}
+			return pp[i].provider < pp[j].provider
+		})
+
+		switch aggregation.Method {
+		case config.AggregationMedian:
+			prices[base] = median(pp)
+		case config.AggregationTrimmedMean:
+			prices[base] = trimmedMean(logger, dropped, priceType, base, pp)
+		case config.AggregationVolumeWeightedMedian:
+			prices[base] = volumeWeightedMedian(pp)
+		default:
+			if price, ok := averages[base]; ok {
+				prices[base] = price
+			}
+		}
+	}
+
+	return prices
+}
+
+// median returns the median of prices sorted in ascending order.
+func median(pp []providerPrice) sdk.Dec {
+	mid := len(pp) / 2
+	if len(pp)%2 == 1 {
+		return pp[mid].price
+	}
+	return pp[mid-1].price.Add(pp[mid].price).QuoInt64(2)
+}
+
+// trimmedMean returns the mean of prices sorted in ascending order after
+// dropping the share of trimmedMeanRatio from each end.
+func trimmedMean(logger zerolog.Logger, dropped *providerIssues, priceType, base string, pp []providerPrice) sdk.Dec {
+	trim := int(trimmedMeanRatio.MulInt64(int64(len(pp))).TruncateInt64())
+	if trim == 0 && len(pp) >= 3 {
+		trim = 1
+	}
+
+	for _, p := range pp[:trim] {
+		logProviderDropped(logger, dropped, priceType, base, p.provider, "trimmed as one of the lowest prices")
+	}
+	for _, p := range pp[len(pp)-trim:] {
+		logProviderDropped(logger, dropped, priceType, base, p.provider, "trimmed as one of the highest prices")
+	}
+
+	sum := sdk.ZeroDec()
+	for _, p := range pp[trim : len(pp)-trim] {
+		sum = sum.Add(p.price)
+	}
+	return sum.QuoInt64(int64(len(pp) - 2*trim))
+}
+
+// volumeWeightedMedian returns the price at which half of the volume of prices
+// sorted in ascending order is reached. The median is used when there is no
+// volume.
+//
+// Ref: https://en.wikipedia.org/wiki/Weighted_median
+func volumeWeightedMedian(pp []providerPrice) sdk.Dec {
+	volumeSum := sdk.ZeroDec()
+	for _, p := range pp {
+		volumeSum = volumeSum.Add(p.volume)
+	}
+	if !volumeSum.IsPositive() {
+		return median(pp)
+	}
+
+	half := volumeSum.QuoInt64(2)
+	cumulativeVolume := sdk.ZeroDec()
+	for i, p := range pp {
+		cumulativeVolume = cumulativeVolume.Add(p.volume)
+		if cumulativeVolume.LT(half) {
+			continue
+		}
+		// the volume is split evenly between two prices
+		if cumulativeVolume.Equal(half) && i+1 < len(pp) {
+			return p.price.Add(pp[i+1].price).QuoInt64(2)
+		}
+		return p.price
+	}
+
+	return pp[len(pp)-1].price
+}
+
+func logProviderDropped(logger zerolog.Logger, dropped *providerIssues, priceType, base, providerName, reason string) {
+	logger.Debug().
+		Str("type", priceType).
+		Str("base", base).
+		Str("provider", providerName).
+		Str("reason", reason).
+		Msg("provider dropped from price computation")
+
+	dropped.add(ProviderIssue{
+		Provider: providerName,
+		Status:   ProviderFiltered,
+		Type:     priceType,
+		Base:     base,
+		Reason:   reason,
+	})
+}
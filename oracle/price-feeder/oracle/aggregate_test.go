@@ -0,0 +1,188 @@
+package oracle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/client"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/provider"
+	oracletypes "github.com/sei-protocol/sei-chain/x/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregateTickers(t *testing.T) {
+	tickers := provider.AggregatedProviderPrices{
+		config.ProviderBinance:  {"ATOM": {Price: sdk.NewDec(10), Volume: sdk.NewDec(100)}},
+		config.ProviderKraken:   {"ATOM": {Price: sdk.NewDec(11), Volume: sdk.NewDec(300)}},
+		config.ProviderHuobi:    {"ATOM": {Price: sdk.NewDec(12), Volume: sdk.NewDec(50)}},
+		config.ProviderOkx:      {"ATOM": {Price: sdk.NewDec(14), Volume: sdk.NewDec(40)}},
+		config.ProviderCoinbase: {"ATOM": {Price: sdk.NewDec(50), Volume: sdk.NewDec(10)}},
+	}
+
+	testCases := map[string]struct {
+		aggregation Aggregation
+		expected    map[string]sdk.Dec
+	}{
+		"default": {
+			expected: map[string]sdk.Dec{"ATOM": sdk.MustNewDecFromStr("11.92")},
+		},
+		"tvwap": {
+			aggregation: Aggregation{Method: config.AggregationTVWAP},
+			expected:    map[string]sdk.Dec{"ATOM": sdk.MustNewDecFromStr("11.92")},
+		},
+		"median": {
+			aggregation: Aggregation{Method: config.AggregationMedian},
+			expected:    map[string]sdk.Dec{"ATOM": sdk.NewDec(12)},
+		},
+		"trimmed mean": {
+			aggregation: Aggregation{Method: config.AggregationTrimmedMean},
+			expected:    map[string]sdk.Dec{"ATOM": sdk.MustNewDecFromStr("12.333333333333333333")},
+		},
+		"volume weighted median": {
+			aggregation: Aggregation{Method: config.AggregationVolumeWeightedMedian},
+			expected:    map[string]sdk.Dec{"ATOM": sdk.NewDec(11)},
+		},
+		"enough providers": {
+			aggregation: Aggregation{Method: config.AggregationMedian, MinProviders: 5},
+			expected:    map[string]sdk.Dec{"ATOM": sdk.NewDec(12)},
+		},
+		"not enough providers": {
+			aggregation: Aggregation{Method: config.AggregationMedian, MinProviders: 6},
+			expected:    map[string]sdk.Dec{},
+		},
+	}
+
+	for name, tc := range testCases {
+		prices, err := AggregateTickers(zerolog.Nop(), nil, tickers, map[string]Aggregation{"ATOM": tc.aggregation})
+		require.NoError(t, err, name)
+		require.Equal(t, tc.expected, prices, name)
+	}
+}
+
+func TestAggregateTickers_DroppedProviders(t *testing.T) {
+	tickers := provider.AggregatedProviderPrices{
+		config.ProviderBinance: {"ATOM": {Price: sdk.NewDec(10), Volume: sdk.NewDec(100)}},
+		config.ProviderKraken:  {"ATOM": {Price: sdk.NewDec(11), Volume: sdk.NewDec(300)}},
+		config.ProviderHuobi:   {"ATOM": {Price: sdk.NewDec(12), Volume: sdk.NewDec(50)}},
+	}
+	aggregations := map[string]Aggregation{"ATOM": {Method: config.AggregationTrimmedMean}}
+
+	// each computation collects the providers it dropped
+	dropped := &providerIssues{}
+	_, err := AggregateTickers(zerolog.Nop(), dropped, tickers, aggregations)
+	require.NoError(t, err)
+	_, err = AggregateTickers(zerolog.Nop(), &providerIssues{}, tickers, aggregations)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{config.ProviderBinance, config.ProviderHuobi}, issueProviders(dropped.drain()))
+	require.Empty(t, dropped.drain())
+}
+
+func issueProviders(issues []ProviderIssue) []string {
+	providers := make([]string, len(issues))
+	for i, issue := range issues {
+		providers[i] = issue.Provider
+	}
+	return providers
+}
+
+func TestAggregateCandles(t *testing.T) {
+	candles := provider.AggregatedProviderCandles{
+		config.ProviderBinance: {"ATOM": {
+			{Price: sdk.NewDec(10), Volume: sdk.NewDec(100), TimeStamp: provider.PastUnixTime(time.Minute)},
+		}},
+		config.ProviderKraken: {"ATOM": {
+			{Price: sdk.NewDec(20), Volume: sdk.NewDec(100), TimeStamp: provider.PastUnixTime(time.Minute)},
+		}},
+		config.ProviderHuobi: {"ATOM": {
+			{Price: sdk.NewDec(40), Volume: sdk.NewDec(100), TimeStamp: provider.PastUnixTime(time.Minute)},
+		}},
+		// dropped since its candle is older than the tvwap period
+		config.ProviderOkx: {"ATOM": {
+			{Price: sdk.NewDec(100), Volume: sdk.NewDec(100), TimeStamp: provider.PastUnixTime(10 * time.Minute)},
+		}},
+	}
+
+	prices, err := AggregateCandles(zerolog.Nop(), nil, candles, map[string]Aggregation{
+		"ATOM": {Method: config.AggregationMedian, MinProviders: 3},
+	}, provider.PastUnixTime(0))
+	require.NoError(t, err)
+	require.Equal(t, map[string]sdk.Dec{"ATOM": sdk.NewDec(20)}, prices)
+
+	prices, err = AggregateCandles(zerolog.Nop(), nil, candles, map[string]Aggregation{
+		"ATOM": {Method: config.AggregationMedian, MinProviders: 4},
+	}, provider.PastUnixTime(0))
+	require.NoError(t, err)
+	require.Empty(t, prices)
+}
+
+func TestVolumeWeightedMedian(t *testing.T) {
+	testCases := map[string]struct {
+		prices   []providerPrice
+		expected sdk.Dec
+	}{
+		"single price": {
+			prices:   []providerPrice{{price: sdk.NewDec(10), volume: sdk.NewDec(1)}},
+			expected: sdk.NewDec(10),
+		},
+		"volume split evenly": {
+			prices: []providerPrice{
+				{price: sdk.NewDec(10), volume: sdk.NewDec(1)},
+				{price: sdk.NewDec(20), volume: sdk.NewDec(1)},
+			},
+			expected: sdk.NewDec(15),
+		},
+		"no volume": {
+			prices: []providerPrice{
+				{price: sdk.NewDec(10), volume: sdk.ZeroDec()},
+				{price: sdk.NewDec(20), volume: sdk.ZeroDec()},
+				{price: sdk.NewDec(40), volume: sdk.ZeroDec()},
+			},
+			expected: sdk.NewDec(20),
+		},
+		"heaviest price": {
+			prices: []providerPrice{
+				{price: sdk.NewDec(10), volume: sdk.NewDec(1)},
+				{price: sdk.NewDec(20), volume: sdk.NewDec(1)},
+				{price: sdk.NewDec(40), volume: sdk.NewDec(5)},
+			},
+			expected: sdk.NewDec(40),
+		},
+	}
+
+	for name, tc := range testCases {
+		require.Equal(t, tc.expected, volumeWeightedMedian(tc.prices), name)
+	}
+}
+
+func TestSetPrices_MinProviders(t *testing.T) {
+	oracle := New(
+		zerolog.Nop(),
+		client.OracleClient{},
+		[]config.CurrencyPair{
+			{Base: "ATOM", ChainDenom: "uatom", Quote: "USD", Providers: []string{config.ProviderBinance}, MinProviders: 2},
+			{Base: "SEI", ChainDenom: "usei", Quote: "USD", Providers: []string{config.ProviderBinance}},
+		},
+		time.Millisecond*100,
+		make(map[string]sdk.Dec),
+		make(map[string]config.ProviderEndpoint),
+		make(map[string]config.RestProvider),
+		config.DexProvider{},
+		nil,
+	)
+	oracle.priceProviders[config.ProviderBinance] = mockProvider{
+		prices: map[string]provider.TickerPrice{
+			"ATOMUSD": {Price: sdk.NewDec(10), Volume: sdk.NewDec(100)},
+			"SEIUSD":  {Price: sdk.NewDec(1), Volume: sdk.NewDec(100)},
+		},
+	}
+	oracle.paramCache = ParamCache{params: &oracletypes.Params{Whitelist: denomList("uatom", "usei")}}
+
+	// ATOM has fewer providers than its minimum, so no base is voted on
+	require.ErrorContains(t, oracle.SetPrices(context.Background()), "fewer than 2 providers reported a price for ATOM")
+	require.True(t, oracle.GetPrices().IsZero())
+}
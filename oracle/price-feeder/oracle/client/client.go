@@ -3,6 +3,7 @@ package client
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -10,7 +11,6 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
-	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/simapp"
@@ -21,8 +21,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/rs/zerolog"
-	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
-	tmjsonclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type (
@@ -46,6 +46,8 @@ type (
 		GRPCEndpoint        string
 		KeyringPassphrase   string
 		BlockHeightEvents   chan int64
+		Endpoints           *EndpointPool
+		Retry               RetryConfig
 
 		// MockBroadcastTx allows for a basic mock without refactoring this to an interface
 		MockBroadcastTx func(clientCtx client.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error)
@@ -70,6 +72,7 @@ func NewOracleClient(
 	validatorAddrString string,
 	feeGranterAddrString string,
 	grpcEndpoint string,
+	failoverEndpoints []Endpoint,
 	gasAdjustment float64,
 	gasPrices string,
 ) (OracleClient, error) {
@@ -100,13 +103,20 @@ func NewOracleClient(
 		BlockHeightEvents:   make(chan int64, 1),
 	}
 
-	clientCtx, err := oracleClient.CreateClientContext()
+	endpoints := append([]Endpoint{{TMRPC: tmRPC, GRPC: grpcEndpoint}}, failoverEndpoints...)
+	oracleClient.Endpoints, err = NewEndpointPool(oracleClient.Logger, endpoints, rpcTimeout)
 	if err != nil {
 		return OracleClient{}, err
 	}
 
-	blockHeight, err := rpc.GetChainHeight(clientCtx)
-	if err != nil {
+	oracleClient.Endpoints.Check(ctx)
+	blockHeight := oracleClient.Endpoints.MaxHeight()
+	if blockHeight == 0 {
+		return OracleClient{}, fmt.Errorf("failed to get chain height from any of the %d endpoints", len(endpoints))
+	}
+	oracleClient.Endpoints.Start(ctx)
+
+	if _, err := oracleClient.CreateClientContext(); err != nil {
 		return OracleClient{}, err
 	}
 
@@ -114,9 +124,10 @@ func NewOracleClient(
 		Logger:        logger,
 		LastHeight:    blockHeight,
 		ChBlockHeight: oracleClient.BlockHeightEvents,
+		Endpoints:     oracleClient.Endpoints,
 	}
 
-	err = chainHeightUpdater.Start(ctx, clientCtx.Client, oracleClient.Logger)
+	err = chainHeightUpdater.Start(ctx, oracleClient.Logger)
 	if err != nil {
 		return OracleClient{}, err
 	}
@@ -162,9 +173,55 @@ func (oc OracleClient) BroadcastTx(
 	startTime := time.Now()
 	defer telemetry.MeasureSince(startTime, "latency", "broadcast")
 
+	var (
+		resp *sdk.TxResponse
+		err  error
+	)
+	for _, endpoint := range oc.FailoverEndpoints() {
+		endpointCtx := clientCtx
+		if endpoint.TMRPC != clientCtx.NodeURI {
+			rpcClient, err := newRPCClient(endpoint.TMRPC, oc.RPCTimeout)
+			if err != nil {
+				return nil, err
+			}
+			endpointCtx = clientCtx.WithNodeURI(endpoint.TMRPC).WithClient(rpcClient)
+		}
+
+		resp, err = oc.broadcastTx(endpointCtx, msgs...)
+		if err == nil || resp != nil {
+			// the node received the tx, so it's not failed over on errors of the tx itself
+			return resp, err
+		}
+		if errors.As(err, &localTxError{}) {
+			// building or signing the tx fails the same way on every node, and
+			// isn't retried either
+			return nil, err
+		}
+		if !isEndpointFailure(err) {
+			return nil, err
+		}
+		oc.reportFailure(endpoint, err)
+	}
+
+	return resp, err
+}
+
+// localTxError wraps the errors of building, signing and encoding a tx, which
+// don't involve the node it's broadcast to.
+type localTxError struct {
+	err error
+}
+
+func (e localTxError) Error() string { return e.err.Error() }
+
+func (e localTxError) Unwrap() error { return e.err }
+
+func (oc OracleClient) broadcastTx(
+	clientCtx client.Context,
+	msgs ...sdk.Msg) (*sdk.TxResponse, error) {
 	txf, err := oc.CreateTxFactory()
 	if err != nil {
-		return nil, err
+		return nil, localTxError{err}
 	}
 
 	// Getting account number and next sequence
@@ -176,18 +233,18 @@ func (oc OracleClient) BroadcastTx(
 	// Build unsigned tx
 	transaction, err := tx.BuildUnsignedTx(txf, msgs...)
 	if err != nil {
-		return nil, err
+		return nil, localTxError{err}
 	}
 
 	// Sign the transaction
 	if err = tx.Sign(txf, clientCtx.GetFromName(), transaction, true); err != nil {
-		return nil, err
+		return nil, localTxError{err}
 	}
 
 	// Get bytes to send
 	txBytes, err := clientCtx.TxConfig.TxEncoder()(transaction.GetTx())
 	if err != nil {
-		return nil, err
+		return nil, localTxError{err}
 	}
 
 	oc.Logger.Info().Msg(fmt.Sprintf("Sending broadcastTx with account sequence number %d", txf.Sequence()))
@@ -204,7 +261,65 @@ func (oc OracleClient) BroadcastTx(
 		txAccountInfo.AccountSequence++
 	}
 	return resp, err
+}
 
+// Endpoint returns the endpoints of the node in use.
+func (oc OracleClient) Endpoint() Endpoint {
+	if oc.Endpoints == nil {
+		return Endpoint{TMRPC: oc.TMRPC, GRPC: oc.GRPCEndpoint}
+	}
+	return oc.Endpoints.Selected()
+}
+
+// FailoverEndpoints returns the endpoints of the nodes in the order they're
+// failed over to, starting with the node in use.
+func (oc OracleClient) FailoverEndpoints() []Endpoint {
+	if oc.Endpoints == nil {
+		return []Endpoint{oc.Endpoint()}
+	}
+	return oc.Endpoints.Endpoints()
+}
+
+// WithFailover calls fn with the endpoints of the node in use, failing over to
+// the other nodes when fn fails because of its node. The error of the last
+// call is returned when fn fails on every node.
+func (oc OracleClient) WithFailover(fn func(endpoint Endpoint) error) error {
+	var err error
+	for _, endpoint := range oc.FailoverEndpoints() {
+		err = fn(endpoint)
+		if err == nil || !isEndpointFailure(err) {
+			return err
+		}
+		oc.reportFailure(endpoint, err)
+	}
+	return err
+}
+
+func (oc OracleClient) reportFailure(endpoint Endpoint, err error) {
+	if oc.Endpoints != nil {
+		oc.Endpoints.ReportFailure(endpoint, err)
+	}
+}
+
+// isEndpointFailure returns false for gRPC or ABCI errors returned by a node
+// that's up, such as a query for a missing validator or account.
+func isEndpointFailure(err error) bool {
+	var abciErr *sdkerrors.Error
+	if errors.As(err, &abciErr) {
+		return false
+	}
+
+	var grpcErr interface{ GRPCStatus() *status.Status }
+	if !errors.As(err, &grpcErr) {
+		return true
+	}
+
+	switch grpcErr.GRPCStatus().Code() {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
 }
 
 // CreateClientContext creates an SDK client Context instance used for transaction
@@ -222,14 +337,8 @@ func (oc OracleClient) CreateClientContext() (client.Context, error) {
 		return client.Context{}, err
 	}
 
-	httpClient, err := tmjsonclient.DefaultHTTPClient(oc.TMRPC)
-	if err != nil {
-		return client.Context{}, err
-	}
-
-	httpClient.Timeout = oc.RPCTimeout
-
-	tmRPC, err := rpchttp.NewWithClient(oc.TMRPC, httpClient)
+	endpoint := oc.Endpoint()
+	tmRPC, err := newRPCClient(endpoint.TMRPC, oc.RPCTimeout)
 	if err != nil {
 		return client.Context{}, err
 	}
@@ -250,7 +359,7 @@ func (oc OracleClient) CreateClientContext() (client.Context, error) {
 		Codec:             oc.Encoding.Marshaler,
 		LegacyAmino:       oc.Encoding.Amino,
 		Input:             os.Stdin,
-		NodeURI:           oc.TMRPC,
+		NodeURI:           endpoint.TMRPC,
 		Client:            tmRPC,
 		Keyring:           kr,
 		FromAddress:       oc.OracleAddr,
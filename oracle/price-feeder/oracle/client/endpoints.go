@@ -0,0 +1,282 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/rs/zerolog"
+	tmrpcclient "github.com/tendermint/tendermint/rpc/client"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	tmjsonclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
+)
+
+const (
+	// endpointCheckInterval is how often the health of the endpoints is checked
+	endpointCheckInterval = 5 * time.Second
+
+	// maxHeightLag is the number of blocks an endpoint can be behind the highest
+	// endpoint before it's considered unhealthy
+	maxHeightLag = int64(2)
+)
+
+// Endpoint defines the Tendermint RPC and gRPC endpoints of a node.
+type Endpoint struct {
+	TMRPC string
+	GRPC  string
+}
+
+type endpointHealth struct {
+	height  int64
+	latency time.Duration
+	// failed is set when the last health check or use of the endpoint failed
+	failed bool
+}
+
+// EndpointPool keeps track of the health of the nodes the oracle client
+// connects to, scored by their latest height and latency. The selected node is
+// kept until it fails or falls behind, then the client fails over to the
+// healthy node with the lowest latency.
+type EndpointPool struct {
+	logger    zerolog.Logger
+	endpoints []Endpoint
+	clients   map[string]*rpchttp.HTTP
+	status    func(ctx context.Context, endpoint Endpoint) (int64, error)
+
+	mtx       sync.RWMutex
+	health    []endpointHealth
+	selected  int
+	maxHeight int64
+}
+
+// NewEndpointPool returns a pool of the given endpoints, the first one being
+// selected until the endpoints are checked.
+func NewEndpointPool(logger zerolog.Logger, endpoints []Endpoint, rpcTimeout time.Duration) (*EndpointPool, error) {
+	clients := make(map[string]*rpchttp.HTTP, len(endpoints))
+	for _, endpoint := range endpoints {
+		rpcClient, err := newRPCClient(endpoint.TMRPC, rpcTimeout)
+		if err != nil {
+			return nil, err
+		}
+		clients[endpoint.TMRPC] = rpcClient
+	}
+
+	pool := newEndpointPool(logger, endpoints, func(ctx context.Context, endpoint Endpoint) (int64, error) {
+		status, err := clients[endpoint.TMRPC].Status(ctx)
+		if err != nil {
+			return 0, err
+		}
+		return status.SyncInfo.LatestBlockHeight, nil
+	})
+	pool.clients = clients
+
+	return pool, nil
+}
+
+func newEndpointPool(
+	logger zerolog.Logger,
+	endpoints []Endpoint,
+	status func(ctx context.Context, endpoint Endpoint) (int64, error),
+) *EndpointPool {
+	return &EndpointPool{
+		logger:    logger.With().Str("module", "endpoint_pool").Logger(),
+		endpoints: endpoints,
+		status:    status,
+		health:    make([]endpointHealth, len(endpoints)),
+	}
+}
+
+// Start checks the health of the endpoints every endpointCheckInterval until
+// the context is done.
+func (p *EndpointPool) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(endpointCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				p.Check(ctx)
+			}
+		}
+	}()
+}
+
+// Check queries the latest height of every endpoint, then selects the
+// endpoint to use.
+func (p *EndpointPool) Check(ctx context.Context) {
+	health := make([]endpointHealth, len(p.endpoints))
+
+	var wg sync.WaitGroup
+	for i, endpoint := range p.endpoints {
+		wg.Add(1)
+		go func(i int, endpoint Endpoint) {
+			defer wg.Done()
+
+			startTime := time.Now()
+			height, err := p.status(ctx, endpoint)
+			health[i] = endpointHealth{
+				height:  height,
+				latency: time.Since(startTime),
+				failed:  err != nil,
+			}
+			if err != nil {
+				p.logger.Debug().Err(err).Str("endpoint", endpoint.TMRPC).Msg("endpoint health check failed")
+			}
+		}(i, endpoint)
+	}
+	wg.Wait()
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.health = health
+	for _, h := range health {
+		if !h.failed && h.height > p.maxHeight {
+			p.maxHeight = h.height
+		}
+	}
+	p.selectEndpoint()
+}
+
+// Selected returns the endpoint in use.
+func (p *EndpointPool) Selected() Endpoint {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	return p.endpoints[p.selected]
+}
+
+// Endpoints returns the endpoints in the order they're failed over to: the
+// selected endpoint, the healthy endpoints by latency, then the others.
+func (p *EndpointPool) Endpoints() []Endpoint {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	order := p.failoverOrder()
+	endpoints := make([]Endpoint, len(order))
+	for i, index := range order {
+		endpoints[i] = p.endpoints[index]
+	}
+
+	return endpoints
+}
+
+// EventsClient returns the client of the Tendermint RPC endpoint of a node.
+func (p *EndpointPool) EventsClient(endpoint Endpoint) tmrpcclient.EventsClient {
+	return p.clients[endpoint.TMRPC]
+}
+
+// ReportFailure marks an endpoint as failed until its next health check,
+// failing over to another endpoint if it's the selected one.
+func (p *EndpointPool) ReportFailure(endpoint Endpoint, err error) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for i := range p.endpoints {
+		if p.endpoints[i] == endpoint {
+			p.logger.Warn().Err(err).Str("endpoint", endpoint.TMRPC).Msg("endpoint failed")
+			p.health[i].failed = true
+		}
+	}
+	p.selectEndpoint()
+}
+
+// ObserveHeight records a height received from an endpoint.
+func (p *EndpointPool) ObserveHeight(endpoint Endpoint, height int64) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for i := range p.endpoints {
+		if p.endpoints[i] == endpoint && height > p.health[i].height {
+			p.health[i].height = height
+		}
+	}
+	if height > p.maxHeight {
+		p.maxHeight = height
+	}
+}
+
+// IsBehind returns true when a height is behind the highest height seen on
+// the endpoints by more than maxHeightLag blocks.
+func (p *EndpointPool) IsBehind(height int64) bool {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	return height < p.maxHeight-maxHeightLag
+}
+
+// MaxHeight returns the highest height seen on the endpoints.
+func (p *EndpointPool) MaxHeight() int64 {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	return p.maxHeight
+}
+
+func (p *EndpointPool) isHealthy(i int) bool {
+	return !p.health[i].failed && p.health[i].height >= p.maxHeight-maxHeightLag
+}
+
+// failoverOrder returns the indexes of the endpoints in failover order.
+func (p *EndpointPool) failoverOrder() []int {
+	order := make([]int, 0, len(p.endpoints))
+	order = append(order, p.selected)
+	for i := range p.endpoints {
+		if i != p.selected {
+			order = append(order, i)
+		}
+	}
+
+	sort.SliceStable(order[1:], func(i, j int) bool {
+		a, b := order[1+i], order[1+j]
+		if p.isHealthy(a) != p.isHealthy(b) {
+			return p.isHealthy(a)
+		}
+		return p.health[a].latency < p.health[b].latency
+	})
+
+	return order
+}
+
+// selectEndpoint keeps the selected endpoint while it's healthy, otherwise
+// it selects the healthy endpoint with the lowest latency.
+func (p *EndpointPool) selectEndpoint() {
+	if p.isHealthy(p.selected) {
+		return
+	}
+
+	order := p.failoverOrder()
+	if len(order) < 2 || !p.isHealthy(order[1]) {
+		return
+	}
+	next := order[1]
+
+	p.logger.Warn().
+		Str("from", p.endpoints[p.selected].TMRPC).
+		Str("to", p.endpoints[next].TMRPC).
+		Int64("height", p.health[next].height).
+		Msg("failing over to another endpoint")
+	telemetry.IncrCounterWithLabels([]string{"failover", "endpoint"}, 1, []metrics.Label{
+		{Name: "from", Value: p.endpoints[p.selected].TMRPC},
+		{Name: "to", Value: p.endpoints[next].TMRPC},
+	})
+	p.selected = next
+}
+
+func newRPCClient(tmRPC string, rpcTimeout time.Duration) (*rpchttp.HTTP, error) {
+	httpClient, err := tmjsonclient.DefaultHTTPClient(tmRPC)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint %s: %w", tmRPC, err)
+	}
+
+	httpClient.Timeout = rpcTimeout
+
+	return rpchttp.NewWithClient(tmRPC, httpClient)
+}
@@ -0,0 +1,156 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockNode struct {
+	height  int64
+	latency time.Duration
+	err     error
+}
+
+func newMockPool(nodes map[string]*mockNode, endpoints ...Endpoint) *EndpointPool {
+	return newEndpointPool(zerolog.Nop(), endpoints, func(ctx context.Context, endpoint Endpoint) (int64, error) {
+		node := nodes[endpoint.TMRPC]
+		time.Sleep(node.latency)
+		return node.height, node.err
+	})
+}
+
+func TestEndpointPool_Check(t *testing.T) {
+	primary := Endpoint{TMRPC: "primary", GRPC: "primary:9090"}
+	slow := Endpoint{TMRPC: "slow", GRPC: "slow:9090"}
+	fast := Endpoint{TMRPC: "fast", GRPC: "fast:9090"}
+	nodes := map[string]*mockNode{
+		primary.TMRPC: {height: 100},
+		slow.TMRPC:    {height: 100, latency: 20 * time.Millisecond},
+		fast.TMRPC:    {height: 100, latency: time.Millisecond},
+	}
+	pool := newMockPool(nodes, primary, slow, fast)
+
+	pool.Check(context.Background())
+	require.Equal(t, primary, pool.Selected())
+	require.Equal(t, []Endpoint{primary, fast, slow}, pool.Endpoints())
+	require.Equal(t, int64(100), pool.MaxHeight())
+
+	// the selected node falls behind
+	nodes[slow.TMRPC].height = 110
+	nodes[fast.TMRPC].height = 110
+	pool.Check(context.Background())
+	require.Equal(t, fast, pool.Selected())
+	require.Equal(t, []Endpoint{fast, slow, primary}, pool.Endpoints())
+	require.True(t, pool.IsBehind(100))
+	require.False(t, pool.IsBehind(108))
+
+	// the selected node is kept once the previous one catches up
+	nodes[primary.TMRPC].height = 110
+	pool.Check(context.Background())
+	require.Equal(t, fast, pool.Selected())
+
+	// the selected node is down
+	nodes[fast.TMRPC].err = fmt.Errorf("connection refused")
+	pool.Check(context.Background())
+	require.Equal(t, primary, pool.Selected())
+	require.Equal(t, []Endpoint{primary, slow, fast}, pool.Endpoints())
+}
+
+func TestEndpointPool_ReportFailure(t *testing.T) {
+	primary := Endpoint{TMRPC: "primary", GRPC: "primary:9090"}
+	backup := Endpoint{TMRPC: "backup", GRPC: "backup:9090"}
+	nodes := map[string]*mockNode{
+		primary.TMRPC: {height: 100},
+		backup.TMRPC:  {height: 100},
+	}
+	pool := newMockPool(nodes, primary, backup)
+	pool.Check(context.Background())
+
+	pool.ReportFailure(primary, fmt.Errorf("timeout"))
+	require.Equal(t, backup, pool.Selected())
+
+	// the failed node stays selected when no other node is healthy
+	pool.ReportFailure(backup, fmt.Errorf("timeout"))
+	require.Equal(t, backup, pool.Selected())
+
+	pool.ObserveHeight(primary, 105)
+	require.Equal(t, int64(105), pool.MaxHeight())
+	require.True(t, pool.IsBehind(102))
+}
+
+func TestOracleClient_WithFailover(t *testing.T) {
+	primary := Endpoint{TMRPC: "primary", GRPC: "primary:9090"}
+	backup := Endpoint{TMRPC: "backup", GRPC: "backup:9090"}
+	nodes := map[string]*mockNode{
+		primary.TMRPC: {height: 100},
+		backup.TMRPC:  {height: 100},
+	}
+	oc := OracleClient{Endpoints: newMockPool(nodes, primary, backup)}
+	oc.Endpoints.Check(context.Background())
+
+	var called []Endpoint
+	err := oc.WithFailover(func(endpoint Endpoint) error {
+		called = append(called, endpoint)
+		if endpoint == primary {
+			return fmt.Errorf("failed to query: %w", status.Error(codes.Unavailable, "connection refused"))
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []Endpoint{primary, backup}, called)
+	require.Equal(t, backup, oc.Endpoint())
+
+	// errors returned by a node that's up aren't failed over
+	called = nil
+	err = oc.WithFailover(func(endpoint Endpoint) error {
+		called = append(called, endpoint)
+		return status.Error(codes.NotFound, "validator not found")
+	})
+	require.Error(t, err)
+	require.Equal(t, []Endpoint{backup}, called)
+
+	// without an endpoint pool, only the configured endpoints are used
+	oc = OracleClient{TMRPC: primary.TMRPC, GRPCEndpoint: primary.GRPC}
+	err = oc.WithFailover(func(endpoint Endpoint) error {
+		require.Equal(t, primary, endpoint)
+		return fmt.Errorf("connection refused")
+	})
+	require.Error(t, err)
+}
+
+func TestOracleClient_BroadcastTxFailover(t *testing.T) {
+	primary := Endpoint{TMRPC: "primary", GRPC: "primary:9090"}
+	backup := Endpoint{TMRPC: "backup", GRPC: "backup:9090"}
+	nodes := map[string]*mockNode{
+		primary.TMRPC: {height: 100},
+		backup.TMRPC:  {height: 100},
+	}
+	oc := OracleClient{
+		Logger:         zerolog.Nop(),
+		KeyringBackend: "invalid",
+		Endpoints:      newMockPool(nodes, primary, backup),
+	}
+	oc.Endpoints.Check(context.Background())
+
+	// the tx can't be signed with an invalid keyring on any node, so the node in
+	// use isn't failed over
+	_, err := oc.BroadcastTx(client.Context{NodeURI: primary.TMRPC})
+	require.Error(t, err)
+	require.Equal(t, primary, oc.Endpoint())
+}
+
+func TestIsEndpointFailure(t *testing.T) {
+	require.True(t, isEndpointFailure(fmt.Errorf("connection refused")))
+	require.True(t, isEndpointFailure(status.Error(codes.Unavailable, "connection refused")))
+	require.False(t, isEndpointFailure(status.Error(codes.NotFound, "validator not found")))
+	require.False(t, isEndpointFailure(sdkerrors.Wrap(sdkerrors.ErrUnknownAddress, "account not found")))
+}
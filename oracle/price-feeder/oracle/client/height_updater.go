@@ -17,24 +17,23 @@ var (
 )
 
 // HeightUpdater is used to provide the updates of the latest chain
-// It starts a goroutine to subscribe to new block event and send the latest block height to the channel
+// It starts a goroutine to subscribe to new block event and send the latest block height to the channel.
+// The events are received from the endpoint selected by the endpoint pool, and the heights
+// of an endpoint falling behind the others are ignored.
 type HeightUpdater struct {
 	Logger        zerolog.Logger
 	LastHeight    int64
 	ChBlockHeight chan int64
+	Endpoints     *EndpointPool
 }
 
 // Start will start a new goroutine subscribed to EventNewBlockHeader.
 func (heightUpdater HeightUpdater) Start(
 	ctx context.Context,
-	rpcClient tmrpcclient.Client,
 	logger zerolog.Logger,
 ) error {
 	if !started {
-		if err := rpcClient.Start(ctx); err != nil {
-			return err
-		}
-		go heightUpdater.subscribe(ctx, rpcClient, logger)
+		go heightUpdater.subscribe(ctx, logger)
 		started = true
 	}
 	return nil
@@ -44,13 +43,23 @@ func (heightUpdater HeightUpdater) Start(
 // and updates the chain height.
 func (heightUpdater HeightUpdater) subscribe(
 	ctx context.Context,
-	eventsClient tmrpcclient.EventsClient,
 	logger zerolog.Logger,
 ) {
 	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		endpoint := heightUpdater.Endpoints.Selected()
+		eventsClient := heightUpdater.Endpoints.EventsClient(endpoint)
 		eventData, err := tmrpcclient.WaitForOneEvent(ctx, eventsClient, queryEventNewBlockHeader.String())
 		if err != nil {
 			logger.Debug().Err(err).Msg("Failed to query EventNewBlockHeader")
+			if ctx.Err() == nil {
+				heightUpdater.Endpoints.ReportFailure(endpoint, err)
+			}
+			time.Sleep(queryInterval)
+			continue
 		}
 		eventDataNewBlockHeader, ok := eventData.(tmtypes.EventDataNewBlockHeader)
 		if !ok {
@@ -58,6 +67,13 @@ func (heightUpdater HeightUpdater) subscribe(
 			continue
 		}
 		eventHeight := eventDataNewBlockHeader.Header.Height
+		heightUpdater.Endpoints.ObserveHeight(endpoint, eventHeight)
+		if heightUpdater.Endpoints.IsBehind(eventHeight) {
+			// the vote period is only decided from the heights of nodes keeping up with the chain
+			logger.Warn().Str("endpoint", endpoint.TMRPC).Msg(fmt.Sprintf("Ignored Chain Height: %d of lagging endpoint", eventHeight))
+			heightUpdater.Endpoints.ReportFailure(endpoint, fmt.Errorf("height %d is behind", eventHeight))
+			continue
+		}
 		if eventHeight > heightUpdater.LastHeight {
 			logger.Info().Msg(fmt.Sprintf("Received new Chain Height: %d", eventHeight))
 			heightUpdater.LastHeight = eventHeight
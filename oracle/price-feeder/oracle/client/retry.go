@@ -0,0 +1,181 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// reasons a broadcast is retried for
+const (
+	retrySequence = "sequence"
+	retryFee      = "fee"
+	retryMempool  = "mempool"
+	retryNode     = "node"
+)
+
+// outcomes of a broadcast with retries
+const (
+	outcomeSuccess      = "success"
+	outcomeRetried      = "success_after_retry"
+	outcomeNotRetryable = "not_retryable"
+	outcomeExhausted    = "retries_exhausted"
+	outcomeDeadline     = "deadline"
+	outcomeCanceled     = "canceled"
+)
+
+// RetryConfig defines how a transaction is retried when broadcasting it fails.
+// Transactions are not retried with the zero value.
+type RetryConfig struct {
+	// MaxRetries of a transaction after its first broadcast
+	MaxRetries int
+	// Interval between two broadcasts of a transaction
+	Interval time.Duration
+	// FeeBump the gas prices are multiplied by when a transaction is rejected
+	// for an insufficient fee. The gas prices are kept when nil
+	FeeBump sdk.Dec
+}
+
+// BroadcastTxWithRetry broadcasts a transaction like BroadcastTx, and retries
+// it when it's rejected for a reason which may be gone on the next broadcast:
+//
+//   - an account sequence mismatch, after which the sequence is queried again
+//     from the chain (see AccountInfo),
+//   - an insufficient fee, after which the gas prices are bumped,
+//   - a full mempool, or none of the nodes being reachable.
+//
+// The transaction is retried up to MaxRetries times, and only while the chain
+// height is below the deadline height. The outcome is exported as the
+// broadcast_outcome counter labeled by outcome.
+func (oc OracleClient) BroadcastTxWithRetry(
+	ctx context.Context,
+	clientCtx client.Context,
+	deadlineHeight int64,
+	msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	attempt := oc
+	for retries := 0; ; retries++ {
+		resp, err := attempt.BroadcastTx(clientCtx, msgs...)
+		if err == nil {
+			if retries == 0 {
+				recordBroadcastOutcome(outcomeSuccess, retries)
+			} else {
+				recordBroadcastOutcome(outcomeRetried, retries)
+			}
+			return resp, nil
+		}
+
+		reason := retryReason(resp, err)
+		var outcome string
+		switch {
+		case len(reason) == 0:
+			outcome = outcomeNotRetryable
+		case retries >= oc.Retry.MaxRetries:
+			outcome = outcomeExhausted
+		case deadlineHeight > 0 && oc.ChainHeight() >= deadlineHeight:
+			outcome = outcomeDeadline
+		}
+		if len(outcome) > 0 {
+			recordBroadcastOutcome(outcome, retries)
+			return resp, err
+		}
+
+		if reason == retryFee && !oc.Retry.FeeBump.IsNil() {
+			gasPrices, bumpErr := bumpGasPrices(attempt.GasPrices, oc.Retry.FeeBump)
+			if bumpErr != nil {
+				recordBroadcastOutcome(outcomeNotRetryable, retries)
+				return resp, err
+			}
+			oc.Logger.Warn().
+				Str("gas_prices", gasPrices).
+				Msg("bumped gas prices of rejected tx; consider raising gas_prices")
+			attempt.GasPrices = gasPrices
+		}
+
+		oc.Logger.Warn().Err(err).
+			Str("reason", reason).
+			Int("retry", retries+1).
+			Int64("deadline_height", deadlineHeight).
+			Msg("retrying broadcast")
+		telemetry.IncrCounterWithLabels([]string{"retry", "broadcast"}, 1, []metrics.Label{
+			{Name: "reason", Value: reason},
+		})
+
+		select {
+		case <-ctx.Done():
+			recordBroadcastOutcome(outcomeCanceled, retries)
+			return resp, err
+		case <-time.After(oc.Retry.Interval):
+		}
+	}
+}
+
+// ChainHeight returns the highest height seen on the nodes, or zero when it's
+// unknown.
+func (oc OracleClient) ChainHeight() int64 {
+	if oc.Endpoints == nil {
+		return 0
+	}
+	return oc.Endpoints.MaxHeight()
+}
+
+// retryReason returns the reason a failed broadcast is retried for, or an
+// empty string when the failure isn't expected to go away on a retry.
+func retryReason(resp *sdk.TxResponse, err error) string {
+	if resp != nil && resp.Code != 0 {
+		if resp.Codespace != sdkerrors.RootCodespace {
+			return ""
+		}
+		switch resp.Code {
+		case sdkerrors.ErrWrongSequence.ABCICode():
+			return retrySequence
+		case sdkerrors.ErrInsufficientFee.ABCICode():
+			return retryFee
+		case sdkerrors.ErrMempoolIsFull.ABCICode():
+			return retryMempool
+		default:
+			return ""
+		}
+	}
+
+	// building or signing the tx fails the same way on every attempt
+	if errors.As(err, &localTxError{}) {
+		return ""
+	}
+
+	// errors of the node before the tx is checked, such as the simulation of
+	// the tx, only keep the message of the error
+	switch {
+	case sdkerrors.ErrWrongSequence.Is(err) || strings.Contains(err.Error(), sdkerrors.ErrWrongSequence.Error()):
+		return retrySequence
+	case sdkerrors.ErrInsufficientFee.Is(err) || strings.Contains(err.Error(), sdkerrors.ErrInsufficientFee.Error()):
+		return retryFee
+	case resp == nil:
+		return retryNode
+	default:
+		return ""
+	}
+}
+
+// bumpGasPrices multiplies the gas prices of a transaction by a factor.
+func bumpGasPrices(gasPrices string, factor sdk.Dec) (string, error) {
+	prices, err := sdk.ParseDecCoins(gasPrices)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse gas prices: %w", err)
+	}
+	return prices.MulDec(factor).String(), nil
+}
+
+func recordBroadcastOutcome(outcome string, retries int) {
+	telemetry.IncrCounterWithLabels([]string{"broadcast", "outcome"}, 1, []metrics.Label{
+		{Name: "outcome", Value: outcome},
+	})
+	telemetry.SetGauge(float32(retries), "broadcast", "retries")
+}
@@ -0,0 +1,131 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func errorResponse(err *sdkerrors.Error) *sdk.TxResponse {
+	return &sdk.TxResponse{Codespace: err.Codespace(), Code: err.ABCICode()}
+}
+
+func TestBroadcastTxWithRetry(t *testing.T) {
+	testCases := map[string]struct {
+		responses        []*sdk.TxResponse
+		errs             []error
+		maxRetries       int
+		height           int64
+		deadlineHeight   int64
+		expectedErr      bool
+		expectedAttempts int
+	}{
+		"success": {
+			responses:        []*sdk.TxResponse{{TxHash: "A"}},
+			errs:             []error{nil},
+			maxRetries:       3,
+			expectedAttempts: 1,
+		},
+		"sequence mismatch and full mempool retried": {
+			responses:        []*sdk.TxResponse{errorResponse(sdkerrors.ErrWrongSequence), errorResponse(sdkerrors.ErrMempoolIsFull), {TxHash: "A"}},
+			errs:             []error{fmt.Errorf("sequence"), fmt.Errorf("mempool"), nil},
+			maxRetries:       3,
+			expectedAttempts: 3,
+		},
+		"unreachable nodes retried": {
+			responses:        []*sdk.TxResponse{nil, {TxHash: "A"}},
+			errs:             []error{fmt.Errorf("connection refused"), nil},
+			maxRetries:       3,
+			expectedAttempts: 2,
+		},
+		"retries exhausted": {
+			responses:        []*sdk.TxResponse{nil, nil, nil},
+			errs:             []error{fmt.Errorf("connection refused"), fmt.Errorf("connection refused"), fmt.Errorf("connection refused")},
+			maxRetries:       2,
+			expectedErr:      true,
+			expectedAttempts: 3,
+		},
+		"tx failing to be signed not retried": {
+			responses:        []*sdk.TxResponse{nil},
+			errs:             []error{localTxError{fmt.Errorf("key not found")}},
+			maxRetries:       3,
+			expectedErr:      true,
+			expectedAttempts: 1,
+		},
+		"not retryable": {
+			responses:        []*sdk.TxResponse{errorResponse(sdkerrors.ErrUnauthorized)},
+			errs:             []error{fmt.Errorf("unauthorized")},
+			maxRetries:       3,
+			expectedErr:      true,
+			expectedAttempts: 1,
+		},
+		"deadline reached": {
+			responses:        []*sdk.TxResponse{errorResponse(sdkerrors.ErrWrongSequence)},
+			errs:             []error{fmt.Errorf("sequence")},
+			maxRetries:       3,
+			height:           19,
+			deadlineHeight:   19,
+			expectedErr:      true,
+			expectedAttempts: 1,
+		},
+		"retries disabled": {
+			responses:        []*sdk.TxResponse{errorResponse(sdkerrors.ErrWrongSequence)},
+			errs:             []error{fmt.Errorf("sequence")},
+			expectedErr:      true,
+			expectedAttempts: 1,
+		},
+	}
+
+	for name, tc := range testCases {
+		attempts := 0
+		endpoint := Endpoint{TMRPC: "node", GRPC: "node:9090"}
+		oc := OracleClient{
+			Logger:    zerolog.Nop(),
+			GasPrices: "0.1usei",
+			Endpoints: newMockPool(map[string]*mockNode{endpoint.TMRPC: {height: tc.height}}, endpoint),
+			Retry:     RetryConfig{MaxRetries: tc.maxRetries, FeeBump: sdk.MustNewDecFromStr("1.5")},
+			MockBroadcastTx: func(clientCtx client.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+				attempts++
+				return tc.responses[attempts-1], tc.errs[attempts-1]
+			},
+		}
+		oc.Endpoints.Check(context.Background())
+
+		resp, err := oc.BroadcastTxWithRetry(context.Background(), client.Context{}, tc.deadlineHeight)
+		require.Equal(t, tc.expectedAttempts, attempts, name)
+		if tc.expectedErr {
+			require.Error(t, err, name)
+			continue
+		}
+		require.NoError(t, err, name)
+		require.Equal(t, "A", resp.TxHash, name)
+	}
+}
+
+func TestRetryReason(t *testing.T) {
+	require.Equal(t, retrySequence, retryReason(errorResponse(sdkerrors.ErrWrongSequence), fmt.Errorf("code 32")))
+	require.Equal(t, retryFee, retryReason(errorResponse(sdkerrors.ErrInsufficientFee), fmt.Errorf("code 13")))
+	require.Equal(t, retryMempool, retryReason(errorResponse(sdkerrors.ErrMempoolIsFull), fmt.Errorf("code 20")))
+	require.Equal(t, "", retryReason(&sdk.TxResponse{Codespace: "oracle", Code: 32}, fmt.Errorf("code 32")))
+
+	simulationErr := fmt.Errorf("rpc error: account sequence mismatch, expected 5, got 4: %s", sdkerrors.ErrWrongSequence.Error())
+	require.Equal(t, retrySequence, retryReason(nil, simulationErr))
+	require.Equal(t, retryFee, retryReason(nil, sdkerrors.Wrap(sdkerrors.ErrInsufficientFee, "got 1usei")))
+	require.Equal(t, retryNode, retryReason(nil, fmt.Errorf("connection refused")))
+	require.Equal(t, "", retryReason(nil, localTxError{fmt.Errorf("key not found")}))
+}
+
+func TestBumpGasPrices(t *testing.T) {
+	gasPrices, err := bumpGasPrices("0.1usei,2uatom", sdk.MustNewDecFromStr("1.5"))
+	require.NoError(t, err)
+	require.Equal(t, "3.000000000000000000uatom,0.150000000000000000usei", gasPrices)
+
+	_, err = bumpGasPrices("usei", sdk.MustNewDecFromStr("1.5"))
+	require.Error(t, err)
+}
@@ -38,9 +38,11 @@ func getUSDBasedProviders(asset string, providerPairs map[string][]types.Currenc
 // Ref: https://github.com/umee-network/umee/blob/4348c3e433df8c37dd98a690e96fc275de609bc1/price-feeder/oracle/filter.go#L41
 func convertCandlesToUSD(
 	logger zerolog.Logger,
+	dropped *providerIssues,
 	candles provider.AggregatedProviderCandles,
 	providerPairs map[string][]types.CurrencyPair,
 	deviationThresholds map[string]sdk.Dec,
+	now int64,
 ) (provider.AggregatedProviderCandles, error) {
 	if len(candles) == 0 {
 		return candles, nil
@@ -81,14 +83,16 @@ func convertCandlesToUSD(
 
 				filteredCandles, err := FilterCandleDeviations(
 					logger,
+					dropped,
 					validCandleList,
 					deviationThresholds,
+					now,
 				)
 				if err != nil {
 					return nil, err
 				}
 
-				tvwap, err := ComputeTVWAP(filteredCandles)
+				tvwap, err := ComputeTVWAP(filteredCandles, now)
 				if err != nil {
 					return nil, err
 				}
@@ -122,6 +126,7 @@ func convertCandlesToUSD(
 // Ref: https://github.com/umee-network/umee/blob/4348c3e433df8c37dd98a690e96fc275de609bc1/price-feeder/oracle/filter.go#L41
 func convertTickersToUSD(
 	logger zerolog.Logger,
+	dropped *providerIssues,
 	tickers provider.AggregatedProviderPrices,
 	providerPairs map[string][]types.CurrencyPair,
 	deviationThresholds map[string]sdk.Dec,
@@ -166,6 +171,7 @@ func convertTickersToUSD(
 
 				filteredTickers, err := FilterTickerDeviations(
 					logger,
+					dropped,
 					validTickerList,
 					deviationThresholds,
 				)
@@ -103,9 +103,11 @@ func TestConvertCandlesToUSD(t *testing.T) {
 
 	convertedCandles, err := convertCandlesToUSD(
 		zerolog.Nop(),
+		nil,
 		providerCandles,
 		providerPairs,
 		make(map[string]sdk.Dec),
+		provider.PastUnixTime(0),
 	)
 	require.NoError(t, err)
 
@@ -164,9 +166,11 @@ func TestConvertCandlesToUSDFiltering(t *testing.T) {
 
 	convertedCandles, err := convertCandlesToUSD(
 		zerolog.Nop(),
+		nil,
 		providerCandles,
 		providerPairs,
 		make(map[string]sdk.Dec),
+		provider.PastUnixTime(0),
 	)
 	require.NoError(t, err)
 
@@ -203,6 +207,7 @@ func TestConvertTickersToUSD(t *testing.T) {
 
 	convertedTickers, err := convertTickersToUSD(
 		zerolog.Nop(),
+		nil,
 		providerPrices,
 		providerPairs,
 		make(map[string]sdk.Dec),
@@ -257,6 +262,7 @@ func TestConvertTickersToUSDFiltering(t *testing.T) {
 
 	covertedDeviation, err := convertTickersToUSD(
 		zerolog.Nop(),
+		nil,
 		providerPrices,
 		providerPairs,
 		make(map[string]sdk.Dec),
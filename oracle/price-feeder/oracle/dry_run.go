@@ -0,0 +1,290 @@
+package oracle
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc"
+
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/client"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/provider"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/types"
+	oracletypes "github.com/sei-protocol/sei-chain/x/oracle/types"
+)
+
+// SimulatedVote is the expected outcome of the vote of a price-feeder for a
+// vote target, computed in dry-run mode instead of broadcasting the vote.
+type SimulatedVote struct {
+	Denom string
+	// ExchangeRate is the voted rate, nil when abstaining
+	ExchangeRate sdk.Dec
+	// OnChainRate is the current exchange rate of the denom, nil when the
+	// denom has no exchange rate yet
+	OnChainRate sdk.Dec
+	RewardBand  sdk.Dec
+	// Deviation is the relative difference between the voted and the
+	// on-chain rate
+	Deviation sdk.Dec
+	Abstain   bool
+	Miss      bool
+}
+
+// EnableDryRun makes the oracle compare its votes with the on-chain exchange
+// rates instead of broadcasting them. When a prices file is given, the prices
+// are read from it on every tick instead of being fetched from the providers.
+func (o *Oracle) EnableDryRun(pricesFile string) {
+	o.dryRun = true
+	o.pricesFile = pricesFile
+}
+
+// SimulateVote reports whether the vote for each vote target would land within
+// its reward band around the current on-chain exchange rate. Vote targets
+// without a price are abstained on, which is counted as a miss.
+//
+// The reward band is the lower bound of the spread of the ballot, which is
+// widened to the standard deviation of the votes when it is larger.
+func SimulateVote(
+	prices sdk.DecCoins,
+	exchangeRates oracletypes.DenomOracleExchangeRatePairs,
+	params oracletypes.Params,
+) []SimulatedVote {
+	onChainRates := make(map[string]sdk.Dec, len(exchangeRates))
+	for _, rate := range exchangeRates {
+		if !rate.Stale && rate.OracleExchangeRate.ExchangeRate.IsPositive() {
+			onChainRates[rate.Denom] = rate.OracleExchangeRate.ExchangeRate
+		}
+	}
+
+	votes := make([]SimulatedVote, 0, len(params.Whitelist))
+	for _, denom := range params.Whitelist {
+		vote := SimulatedVote{
+			Denom:       denom.Name,
+			OnChainRate: onChainRates[denom.Name],
+			RewardBand:  denom.GetRewardBand(params.RewardBand),
+		}
+
+		price := prices.AmountOf(denom.Name)
+		if !price.IsPositive() {
+			vote.Abstain = true
+			vote.Miss = true
+			votes = append(votes, vote)
+			continue
+		}
+		vote.ExchangeRate = price
+
+		if !vote.OnChainRate.IsNil() {
+			spread := vote.OnChainRate.Mul(vote.RewardBand.QuoInt64(2))
+			vote.Deviation = price.Sub(vote.OnChainRate).Abs().Quo(vote.OnChainRate)
+			vote.Miss = !isBetween(price, vote.OnChainRate, spread)
+		}
+
+		votes = append(votes, vote)
+	}
+
+	return votes
+}
+
+// simulateVote compares the prices of a vote with the on-chain exchange rates,
+// then logs and exports the expected outcome of each vote target as metrics.
+func (o *Oracle) simulateVote(ctx context.Context, prices sdk.DecCoins, params oracletypes.Params) error {
+	exchangeRates, err := o.GetExchangeRates(ctx)
+	if err != nil {
+		return err
+	}
+
+	misses := 0
+	abstains := 0
+	for _, vote := range SimulateVote(prices, exchangeRates, params) {
+		labels := []metrics.Label{{Name: "denom", Value: vote.Denom}}
+		telemetry.SetGaugeWithLabels([]string{"dry_run", "miss"}, boolToFloat32(vote.Miss), labels)
+		telemetry.SetGaugeWithLabels([]string{"dry_run", "abstain"}, boolToFloat32(vote.Abstain), labels)
+
+		event := o.logger.Info()
+		if vote.Miss {
+			misses++
+			event = o.logger.Warn()
+		}
+		if vote.Abstain {
+			abstains++
+		}
+		event = event.
+			Str("denom", vote.Denom).
+			Str("reward_band", vote.RewardBand.String()).
+			Bool("abstain", vote.Abstain).
+			Bool("miss", vote.Miss)
+		if !vote.ExchangeRate.IsNil() {
+			event = event.Str("exchange_rate", vote.ExchangeRate.String())
+		}
+		if !vote.OnChainRate.IsNil() {
+			event = event.Str("on_chain_rate", vote.OnChainRate.String())
+		}
+		if !vote.Deviation.IsNil() {
+			deviation, err := vote.Deviation.Float64()
+			if err == nil {
+				telemetry.SetGaugeWithLabels([]string{"dry_run", "deviation"}, float32(deviation), labels)
+			}
+			event = event.Str("deviation", vote.Deviation.String())
+		}
+		event.Msg("simulated vote")
+	}
+
+	telemetry.SetGauge(float32(misses), "dry_run", "misses")
+	telemetry.SetGauge(float32(abstains), "dry_run", "abstains")
+	telemetry.IncrCounter(1, "dry_run", "vote")
+
+	return nil
+}
+
+// GetExchangeRates returns the current on-chain exchange rates of the x/oracle
+// module.
+func (o *Oracle) GetExchangeRates(ctx context.Context) (exchangeRates oracletypes.DenomOracleExchangeRatePairs, err error) {
+	if o.mockGetExchangeRates != nil {
+		return o.mockGetExchangeRates(ctx)
+	}
+
+	err = o.oracleClient.WithFailover(func(endpoint client.Endpoint) error {
+		grpcConn, err := grpc.Dial(
+			endpoint.GRPC,
+			// the Cosmos SDK doesn't support any transport security mechanism
+			grpc.WithInsecure(),
+			grpc.WithContextDialer(dialerFunc),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to dial Cosmos gRPC service: %w", err)
+		}
+
+		defer grpcConn.Close()
+		queryClient := oracletypes.NewQueryClient(grpcConn)
+
+		ctx, cancel := context.WithTimeout(ctx, 15*time.Second)
+		defer cancel()
+
+		queryResponse, err := queryClient.ExchangeRates(ctx, &oracletypes.QueryExchangeRatesRequest{})
+		if err != nil {
+			return fmt.Errorf("failed to get x/oracle exchange rates: %w", err)
+		}
+
+		exchangeRates = queryResponse.DenomOracleExchangeRatePairs
+		return nil
+	})
+
+	return exchangeRates, err
+}
+
+// setPricesFromFile computes the prices from the tickers of a prices file. The
+// records of the file are of the form [provider, base, quote, price, volume]
+// and its first record is a header.
+func (o *Oracle) setPricesFromFile() error {
+	providerPrices, providerPairs, err := ReadPricesFile(o.pricesFile)
+	if err != nil {
+		return err
+	}
+
+	priceConfig := o.getPriceConfig()
+	requiredRates := make(map[string]struct{})
+	for _, pairs := range providerPairs {
+		for _, pair := range pairs {
+			if o.paramCache.params.Whitelist.Contains(priceConfig.chainDenomMapping[pair.Base]) {
+				requiredRates[pair.Base] = struct{}{}
+			}
+		}
+	}
+
+	o.snapshotPrices(providerPairs, providerPrices, make(provider.AggregatedProviderCandles), requiredRates)
+
+	latestPrices := []ProviderPrice{}
+	fetchedAt := time.Now()
+	for providerName, pairs := range providerPairs {
+		for _, pair := range pairs {
+			tp := providerPrices[providerName][pair.Base]
+			latestPrices = append(latestPrices, ProviderPrice{
+				Provider:  providerName,
+				Pair:      pair,
+				Ticker:    &tp,
+				FetchedAt: fetchedAt,
+			})
+		}
+	}
+
+	dropped := &providerIssues{}
+	computedPrices, err := GetComputedPrices(
+		o.logger,
+		dropped,
+		make(provider.AggregatedProviderCandles),
+		providerPrices,
+		providerPairs,
+		priceConfig.deviations,
+		priceConfig.aggregations,
+		requiredRates,
+		provider.PastUnixTime(0),
+	)
+	o.setProviderStatus(latestPrices, dropped.drain())
+	if err != nil {
+		return err
+	}
+
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	o.prices = computedPrices
+	return nil
+}
+
+// ReadPricesFile reads the provider tickers of a prices file, along with the
+// pairs of each provider. The records of the file are of the form
+// [provider, base, quote, price, volume] and its first record is a header.
+func ReadPricesFile(path string) (provider.AggregatedProviderPrices, map[string][]types.CurrencyPair, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open prices file: %w", err)
+	}
+	defer f.Close()
+
+	csvReader := csv.NewReader(f)
+	csvReader.FieldsPerRecord = 5
+	records, err := csvReader.ReadAll()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read prices file: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, nil, fmt.Errorf("empty prices file")
+	}
+
+	providerPrices := make(provider.AggregatedProviderPrices)
+	providerPairs := make(map[string][]types.CurrencyPair)
+	for _, r := range records[1:] {
+		providerName := r[0]
+		pair := types.CurrencyPair{Base: strings.ToUpper(r[1]), Quote: strings.ToUpper(r[2])}
+
+		price, err := sdk.NewDecFromStr(r[3])
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read price (%s) of %s for %s", r[3], providerName, pair)
+		}
+		volume, err := sdk.NewDecFromStr(r[4])
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read volume (%s) of %s for %s", r[4], providerName, pair)
+		}
+
+		if _, ok := providerPrices[providerName]; !ok {
+			providerPrices[providerName] = make(map[string]provider.TickerPrice)
+		}
+		providerPrices[providerName][pair.Base] = provider.TickerPrice{Price: price, Volume: volume}
+		providerPairs[providerName] = append(providerPairs[providerName], pair)
+	}
+
+	return providerPrices, providerPairs, nil
+}
+
+func boolToFloat32(b bool) float32 {
+	if b {
+		return 1
+	}
+	return 0
+}
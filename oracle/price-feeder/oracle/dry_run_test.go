@@ -0,0 +1,133 @@
+package oracle
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/provider"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/types"
+	oracletypes "github.com/sei-protocol/sei-chain/x/oracle/types"
+)
+
+func newExchangeRate(denom, rate string) oracletypes.DenomOracleExchangeRatePair {
+	return oracletypes.DenomOracleExchangeRatePair{
+		Denom:              denom,
+		OracleExchangeRate: oracletypes.OracleExchangeRate{ExchangeRate: sdk.MustNewDecFromStr(rate)},
+	}
+}
+
+func TestSimulateVote(t *testing.T) {
+	atomRewardBand := sdk.MustNewDecFromStr("0.2")
+	params := oracletypes.DefaultParams()
+	params.RewardBand = sdk.MustNewDecFromStr("0.02")
+	params.Whitelist = oracletypes.DenomList{
+		{Name: "ubtc"},
+		{Name: "ueth"},
+		{Name: "uatom", RewardBand: &atomRewardBand},
+		{Name: "usei"},
+		{Name: "uusdc"},
+	}
+
+	prices := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("ubtc", sdk.MustNewDecFromStr("20100")),
+		sdk.NewDecCoinFromDec("ueth", sdk.MustNewDecFromStr("1600")),
+		sdk.NewDecCoinFromDec("uatom", sdk.MustNewDecFromStr("11")),
+		sdk.NewDecCoinFromDec("uusdc", sdk.OneDec()),
+	)
+	exchangeRates := oracletypes.DenomOracleExchangeRatePairs{
+		newExchangeRate("ubtc", "20000"),
+		newExchangeRate("ueth", "1500"),
+		newExchangeRate("uatom", "10"),
+		newExchangeRate("usei", "0.25"),
+	}
+
+	votes := SimulateVote(prices, exchangeRates, params)
+	require.Len(t, votes, 5)
+
+	// within 1% of the on-chain rate
+	require.Equal(t, "ubtc", votes[0].Denom)
+	require.False(t, votes[0].Miss)
+	require.Equal(t, sdk.MustNewDecFromStr("0.005"), votes[0].Deviation)
+
+	// more than 1% away from the on-chain rate
+	require.Equal(t, "ueth", votes[1].Denom)
+	require.True(t, votes[1].Miss)
+	require.False(t, votes[1].Abstain)
+
+	// the reward band of the denom overrides the default one
+	require.Equal(t, "uatom", votes[2].Denom)
+	require.Equal(t, atomRewardBand, votes[2].RewardBand)
+	require.False(t, votes[2].Miss)
+
+	// no price is an abstain
+	require.Equal(t, "usei", votes[3].Denom)
+	require.True(t, votes[3].Abstain)
+	require.True(t, votes[3].Miss)
+	require.True(t, votes[3].ExchangeRate.IsNil())
+
+	// nothing to compare with before the first exchange rate
+	require.Equal(t, "uusdc", votes[4].Denom)
+	require.False(t, votes[4].Miss)
+	require.True(t, votes[4].OnChainRate.IsNil())
+}
+
+func TestReadPricesFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prices.csv")
+	require.NoError(t, os.WriteFile(path, []byte(`provider,base,quote,price,volume
+binance,ATOM,USDT,10.5,1000
+kraken,atom,usd,10.4,500
+kraken,USDT,USD,1,100000
+`), 0o600))
+
+	providerPrices, providerPairs, err := ReadPricesFile(path)
+	require.NoError(t, err)
+	require.Equal(t, provider.AggregatedProviderPrices{
+		config.ProviderBinance: {
+			"ATOM": {Price: sdk.MustNewDecFromStr("10.5"), Volume: sdk.NewDec(1000)},
+		},
+		config.ProviderKraken: {
+			"ATOM": {Price: sdk.MustNewDecFromStr("10.4"), Volume: sdk.NewDec(500)},
+			"USDT": {Price: sdk.OneDec(), Volume: sdk.NewDec(100000)},
+		},
+	}, providerPrices)
+	require.Equal(t, map[string][]types.CurrencyPair{
+		config.ProviderBinance: {{Base: "ATOM", Quote: "USDT"}},
+		config.ProviderKraken:  {{Base: "ATOM", Quote: "USD"}, {Base: "USDT", Quote: "USD"}},
+	}, providerPairs)
+
+	require.NoError(t, os.WriteFile(path, []byte("provider,base,quote,price,volume\nbinance,ATOM,USDT,n/a,1000\n"), 0o600))
+	_, _, err = ReadPricesFile(path)
+	require.Error(t, err)
+
+	_, _, err = ReadPricesFile(filepath.Join(t.TempDir(), "missing.csv"))
+	require.Error(t, err)
+}
+
+func TestTickDryRun(t *testing.T) {
+	// the prices file is read instead of the providers
+	path := filepath.Join(t.TempDir(), "prices.csv")
+	require.NoError(t, os.WriteFile(path, []byte(`provider,base,quote,price,volume
+binance,BTC,USD,20100,10
+kraken,BTC,USD,20000,10
+`), 0o600))
+
+	broadcastCount := 0
+	oracle := newTickTestOracle(1, map[string]sdk.Dec{config.ProviderBinance: sdk.NewDec(30000)})
+	oracle.oracleClient.MockBroadcastTx = func(ctx sdkclient.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+		broadcastCount++
+		return &sdk.TxResponse{}, nil
+	}
+	oracle.EnableDryRun(path)
+
+	require.NoError(t, oracle.tick(context.Background(), sdkclient.Context{}, 1))
+	require.Equal(t, 0, broadcastCount)
+	require.Equal(t, float64(2), oracle.previousVotePeriod)
+	require.Equal(t, sdk.NewDec(20050), oracle.prices["BTC"])
+}
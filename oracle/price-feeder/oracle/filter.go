@@ -18,6 +18,7 @@ var defaultDeviationThreshold = sdk.MustNewDecFromStr("1.0")
 // all assets, and filters out any providers that are not within 2𝜎 of the mean.
 func FilterTickerDeviations(
 	logger zerolog.Logger,
+	dropped *providerIssues,
 	prices provider.AggregatedProviderPrices,
 	deviationThresholds map[string]sdk.Dec,
 ) (provider.AggregatedProviderPrices, error) {
@@ -71,6 +72,7 @@ func FilterTickerDeviations(
 					Str("provider", providerName).
 					Str("price", tp.Price.String()).
 					Msg("provider deviating from other prices")
+				logProviderDropped(logger, dropped, "ticker", base, providerName, "deviating from the mean by more than "+d.Mul(t).String())
 			}
 		}
 	}
@@ -82,8 +84,10 @@ func FilterTickerDeviations(
 // all assets, and filters out any providers that are not within 2𝜎 of the mean.
 func FilterCandleDeviations(
 	logger zerolog.Logger,
+	dropped *providerIssues,
 	candles provider.AggregatedProviderCandles,
 	deviationThresholds map[string]sdk.Dec,
+	now int64,
 ) (provider.AggregatedProviderCandles, error) {
 	var (
 		filteredCandles = make(provider.AggregatedProviderCandles)
@@ -102,7 +106,7 @@ func FilterCandleDeviations(
 			p[base] = cp
 		}
 
-		tvwap, err := ComputeTVWAP(candlePrices)
+		tvwap, err := ComputeTVWAP(candlePrices, now)
 		if err != nil {
 			return nil, err
 		}
@@ -150,6 +154,7 @@ func FilterCandleDeviations(
 					Str("provider", providerName).
 					Str("price", price.String()).
 					Msg("provider deviating from other candles")
+				logProviderDropped(logger, dropped, "candle", base, providerName, "deviating from the mean by more than "+d.Mul(t).String())
 			}
 		}
 	}
@@ -52,8 +52,10 @@ func TestSuccessFilterCandleDeviations(t *testing.T) {
 
 	pricesFiltered, err := FilterCandleDeviations(
 		zerolog.Nop(),
+		nil,
 		providerCandles,
 		make(map[string]sdk.Dec),
+		provider.PastUnixTime(0),
 	)
 
 	_, ok := pricesFiltered[config.ProviderCoinbase]
@@ -65,8 +67,10 @@ func TestSuccessFilterCandleDeviations(t *testing.T) {
 
 	pricesFilteredCustom, err := FilterCandleDeviations(
 		zerolog.Nop(),
+		nil,
 		providerCandles,
 		customDeviations,
+		provider.PastUnixTime(0),
 	)
 
 	_, ok = pricesFilteredCustom[config.ProviderCoinbase]
@@ -107,6 +111,7 @@ func TestSuccessFilterTickerDeviations(t *testing.T) {
 
 	pricesFiltered, err := FilterTickerDeviations(
 		zerolog.Nop(),
+		nil,
 		providerTickers,
 		make(map[string]sdk.Dec),
 	)
@@ -120,6 +125,7 @@ func TestSuccessFilterTickerDeviations(t *testing.T) {
 
 	pricesFilteredCustom, err := FilterTickerDeviations(
 		zerolog.Nop(),
+		nil,
 		providerTickers,
 		customDeviations,
 	)
@@ -0,0 +1,134 @@
+package history
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/provider"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/types"
+)
+
+// Record defines the data the prices of a vote were computed from, along with
+// the computed prices and the voted exchange rates, or the error the prices
+// failed to be computed with.
+type Record struct {
+	Height     int64 `json:"height"`
+	VotePeriod int64 `json:"vote_period"`
+	// Timestamp is the unix time in milliseconds at which the provider data
+	// was collected
+	Timestamp       int64                              `json:"timestamp"`
+	ProviderPairs   map[string][]types.CurrencyPair    `json:"provider_pairs"`
+	ProviderPrices  provider.AggregatedProviderPrices  `json:"provider_prices"`
+	ProviderCandles provider.AggregatedProviderCandles `json:"provider_candles"`
+	RequiredRates   []string                           `json:"required_rates"`
+	Prices          map[string]sdk.Dec                 `json:"prices"`
+	ExchangeRates   string                             `json:"exchange_rates"`
+	Error           string                             `json:"error,omitempty"`
+}
+
+// NewRecord returns a record of a copy of the provider data, as the data is
+// modified when the prices are computed.
+func NewRecord(
+	timestamp int64,
+	providerPairs map[string][]types.CurrencyPair,
+	providerPrices provider.AggregatedProviderPrices,
+	providerCandles provider.AggregatedProviderCandles,
+	requiredRates map[string]struct{},
+) *Record {
+	record := &Record{
+		Timestamp:       timestamp,
+		ProviderPairs:   make(map[string][]types.CurrencyPair, len(providerPairs)),
+		ProviderPrices:  make(provider.AggregatedProviderPrices, len(providerPrices)),
+		ProviderCandles: make(provider.AggregatedProviderCandles, len(providerCandles)),
+		RequiredRates:   make([]string, 0, len(requiredRates)),
+	}
+
+	for providerName, pairs := range providerPairs {
+		record.ProviderPairs[providerName] = append([]types.CurrencyPair{}, pairs...)
+	}
+	for providerName, prices := range providerPrices {
+		record.ProviderPrices[providerName] = make(map[string]provider.TickerPrice, len(prices))
+		for base, tp := range prices {
+			record.ProviderPrices[providerName][base] = tp
+		}
+	}
+	for providerName, candles := range providerCandles {
+		record.ProviderCandles[providerName] = make(map[string][]provider.CandlePrice, len(candles))
+		for base, cp := range candles {
+			record.ProviderCandles[providerName][base] = append([]provider.CandlePrice{}, cp...)
+		}
+	}
+	for base := range requiredRates {
+		record.RequiredRates = append(record.RequiredRates, base)
+	}
+	sort.Strings(record.RequiredRates)
+
+	return record
+}
+
+// Store defines an append-only file of records, with one JSON encoded record
+// per line.
+type Store struct {
+	mtx     sync.Mutex
+	file    *os.File
+	encoder *json.Encoder
+}
+
+// NewStore opens the store at the given path, creating it if needed.
+func NewStore(path string) (*Store, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open price history: %w", err)
+	}
+
+	return &Store{
+		file:    file,
+		encoder: json.NewEncoder(file),
+	}, nil
+}
+
+// Append appends a record to the store.
+func (s *Store) Append(record Record) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.encoder.Encode(record)
+}
+
+// Close closes the file of the store.
+func (s *Store) Close() error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.file.Close()
+}
+
+// ReadRecords returns the records of the store at the given path.
+func ReadRecords(path string) ([]Record, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open price history: %w", err)
+	}
+	defer file.Close()
+
+	records := []Record{}
+	decoder := json.NewDecoder(file)
+	for {
+		var record Record
+		err := decoder.Decode(&record)
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode record %d of price history: %w", len(records)+1, err)
+		}
+		records = append(records, record)
+	}
+}
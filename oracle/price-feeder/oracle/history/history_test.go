@@ -0,0 +1,82 @@
+package history
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/provider"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/types"
+)
+
+func TestNewRecord(t *testing.T) {
+	pairs := map[string][]types.CurrencyPair{"binance": {{Base: "ATOM", Quote: "USDT"}}}
+	prices := provider.AggregatedProviderPrices{
+		"binance": {"ATOM": {Price: sdk.NewDec(10), Volume: sdk.NewDec(100)}},
+	}
+	candles := provider.AggregatedProviderCandles{
+		"binance": {"ATOM": {{Price: sdk.NewDec(10), Volume: sdk.NewDec(100), TimeStamp: 1661000000000}}},
+	}
+
+	record := NewRecord(1661000001000, pairs, prices, candles, map[string]struct{}{"USDT": {}, "ATOM": {}})
+	require.Equal(t, int64(1661000001000), record.Timestamp)
+	require.Equal(t, []string{"ATOM", "USDT"}, record.RequiredRates)
+
+	// the record is not modified along with the provider data
+	pairs["binance"][0].Quote = "USD"
+	prices["binance"]["ATOM"] = provider.TickerPrice{Price: sdk.NewDec(11), Volume: sdk.NewDec(100)}
+	candles["binance"]["ATOM"][0].Price = sdk.NewDec(11)
+
+	require.Equal(t, "USDT", record.ProviderPairs["binance"][0].Quote)
+	require.Equal(t, sdk.NewDec(10), record.ProviderPrices["binance"]["ATOM"].Price)
+	require.Equal(t, sdk.NewDec(10), record.ProviderCandles["binance"]["ATOM"][0].Price)
+}
+
+func TestStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+
+	store, err := NewStore(path)
+	require.NoError(t, err)
+
+	record := NewRecord(
+		1661000001000,
+		map[string][]types.CurrencyPair{"binance": {{Base: "ATOM", Quote: "USDT"}}},
+		provider.AggregatedProviderPrices{
+			"binance": {"ATOM": {Price: sdk.MustNewDecFromStr("10.5"), Volume: sdk.NewDec(100)}},
+		},
+		provider.AggregatedProviderCandles{
+			"binance": {"ATOM": {{Price: sdk.MustNewDecFromStr("10.4"), Volume: sdk.NewDec(100), TimeStamp: 1661000000000}}},
+		},
+		map[string]struct{}{"ATOM": {}},
+	)
+	record.Height = 10
+	record.VotePeriod = 5
+	record.Prices = map[string]sdk.Dec{"ATOM": sdk.MustNewDecFromStr("10.45")}
+	record.ExchangeRates = "10.450000000000000000uatom"
+	require.NoError(t, store.Append(*record))
+	require.NoError(t, store.Close())
+
+	// records are appended to the existing ones
+	store, err = NewStore(path)
+	require.NoError(t, err)
+	record.Height = 12
+	require.NoError(t, store.Append(*record))
+	require.NoError(t, store.Close())
+
+	records, err := ReadRecords(path)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	require.Equal(t, int64(10), records[0].Height)
+	require.Equal(t, int64(12), records[1].Height)
+	require.Equal(t, *record, records[1])
+
+	// a partially written record fails to be read
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, bz[:len(bz)-10], 0o600))
+	_, err = ReadRecords(path)
+	require.ErrorContains(t, err, "record 2")
+}
@@ -7,6 +7,8 @@ import (
 
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"google.golang.org/grpc"
+
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/client"
 )
 
 const (
@@ -41,32 +43,39 @@ func (o *Oracle) GetCachedJailedState(ctx context.Context, currentBlockHeight in
 		return false, err
 	}
 
+	o.mtx.Lock()
 	o.jailCache.Update(currentBlockHeight, isJailed)
+	o.mtx.Unlock()
 	return isJailed, nil
 }
 
 // GetJailedState returns the current on-chain jailing state of the validator
-func (o *Oracle) GetJailedState(ctx context.Context) (bool, error) {
-	grpcConn, err := grpc.Dial(
-		o.oracleClient.GRPCEndpoint,
-		// the Cosmos SDK doesn't support any transport security mechanism
-		grpc.WithInsecure(),
-		grpc.WithContextDialer(dialerFunc),
-	)
-	if err != nil {
-		return false, fmt.Errorf("failed to dial Cosmos gRPC service: %w", err)
-	}
+func (o *Oracle) GetJailedState(ctx context.Context) (isJailed bool, err error) {
+	err = o.oracleClient.WithFailover(func(endpoint client.Endpoint) error {
+		grpcConn, err := grpc.Dial(
+			endpoint.GRPC,
+			// the Cosmos SDK doesn't support any transport security mechanism
+			grpc.WithInsecure(),
+			grpc.WithContextDialer(dialerFunc),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to dial Cosmos gRPC service: %w", err)
+		}
 
-	defer grpcConn.Close()
-	queryClient := stakingtypes.NewQueryClient(grpcConn)
+		defer grpcConn.Close()
+		queryClient := stakingtypes.NewQueryClient(grpcConn)
 
-	ctx, cancel := context.WithTimeout(ctx, 15*time.Second)
-	defer cancel()
+		ctx, cancel := context.WithTimeout(ctx, 15*time.Second)
+		defer cancel()
 
-	queryResponse, err := queryClient.Validator(ctx, &stakingtypes.QueryValidatorRequest{ValidatorAddr: o.oracleClient.ValidatorAddrString})
-	if err != nil {
-		return false, fmt.Errorf("failed to get staking validator: %w", err)
-	}
+		queryResponse, err := queryClient.Validator(ctx, &stakingtypes.QueryValidatorRequest{ValidatorAddr: o.oracleClient.ValidatorAddrString})
+		if err != nil {
+			return fmt.Errorf("failed to get staking validator: %w", err)
+		}
+
+		isJailed = queryResponse.Validator.Jailed
+		return nil
+	})
 
-	return queryResponse.Validator.Jailed, nil
+	return isJailed, err
 }
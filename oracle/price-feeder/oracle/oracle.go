@@ -2,6 +2,8 @@ package oracle
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"math"
@@ -20,9 +22,11 @@ import (
 
 	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
 	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/client"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/history"
 	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/provider"
 	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/types"
 	pfsync "github.com/sei-protocol/sei-chain/oracle/price-feeder/pkg/sync"
+	dextypes "github.com/sei-protocol/sei-chain/x/dex/types"
 	oracletypes "github.com/sei-protocol/sei-chain/x/oracle/types"
 )
 
@@ -37,19 +41,44 @@ type Oracle struct {
 	providerPairs      map[string][]types.CurrencyPair
 	chainDenomMapping  map[string]string
 	previousVotePeriod float64
+	previousPrevote    *PreviousPrevote
 	priceProviders     map[string]provider.Provider
 	failedProviders    map[string]error
+	providersMtx       sync.Mutex
 	oracleClient       client.OracleClient
 	deviations         map[string]sdk.Dec
+	aggregations       map[string]Aggregation
+	priceSources       map[types.CurrencyPair]priceSource
 	endpoints          map[string]config.ProviderEndpoint
+	restProviders      map[string]config.RestProvider
+	dexProvider        config.DexProvider
 
 	mtx             sync.RWMutex
 	lastPriceSyncTS time.Time
 	prices          map[string]sdk.Dec
 	paramCache      ParamCache
 	jailCache       JailCache
+	status          oracleStatus
 	healthchecks    map[string]http.Client
 	mockSetPrices   func(ctx context.Context) error
+
+	// dry-run mode simulates the votes instead of broadcasting them
+	dryRun               bool
+	pricesFile           string
+	mockGetExchangeRates func(ctx context.Context) (oracletypes.DenomOracleExchangeRatePairs, error)
+
+	// price history records the data each vote was computed from
+	history       *history.Store
+	pendingRecord *history.Record
+}
+
+// PreviousPrevote defines a structure for tracking the salt and exchange rates
+// committed to by the last aggregate prevote, which are revealed in the
+// following vote period.
+type PreviousPrevote struct {
+	Salt             string
+	ExchangeRates    string
+	SubmitVotePeriod float64
 }
 
 // createMappingsFromPairs is a helper function to initialize maps from currencyPairs
@@ -79,6 +108,8 @@ func New(
 	providerTimeout time.Duration,
 	deviations map[string]sdk.Dec,
 	endpoints map[string]config.ProviderEndpoint,
+	restProviders map[string]config.RestProvider,
+	dexProvider config.DexProvider,
 	healthchecksConfig []config.Healthchecks,
 ) *Oracle {
 
@@ -107,10 +138,14 @@ func New(
 		priceProviders:    make(map[string]provider.Provider),
 		providerTimeout:   providerTimeout,
 		deviations:        deviations,
+		aggregations:      createAggregationsFromPairs(currencyPairs),
+		priceSources:      createPriceSourcesFromPairs(currencyPairs),
 		paramCache:        ParamCache{},
 		jailCache:         JailCache{},
 		failedProviders:   make(map[string]error),
 		endpoints:         endpoints,
+		restProviders:     restProviders,
+		dexProvider:       dexProvider,
 		healthchecks:      healthchecks,
 	}
 }
@@ -222,13 +257,19 @@ func (o *Oracle) SetPrices(ctx context.Context) error {
 	if o.mockSetPrices != nil {
 		return o.mockSetPrices(ctx)
 	}
+	if len(o.pricesFile) > 0 {
+		return o.setPricesFromFile()
+	}
 	g := new(errgroup.Group)
 	mtx := new(sync.Mutex)
 	providerPrices := make(provider.AggregatedProviderPrices)
 	providerCandles := make(provider.AggregatedProviderCandles)
 	requiredRates := make(map[string]struct{})
+	latestPrices := []ProviderPrice{}
+	issues := []ProviderIssue{}
+	priceConfig := o.getPriceConfig()
 
-	for providerName, currencyPairs := range o.providerPairs {
+	for providerName, currencyPairs := range priceConfig.providerPairs {
 		providerName := providerName
 		currencyPairs := currencyPairs
 
@@ -239,12 +280,13 @@ func (o *Oracle) SetPrices(ctx context.Context) error {
 				{Name: "provider", Value: providerName},
 			})
 			o.logger.Debug().AnErr("err", err).Msgf("Failed to get or set provider %s", providerName)
+			issues = append(issues, ProviderIssue{Provider: providerName, Status: ProviderFailing, Reason: err.Error()})
 			continue // don't block everything on one provider having an issue
 		}
 
 		for _, pair := range currencyPairs {
 			if _, ok := requiredRates[pair.Base]; !ok {
-				if o.paramCache.params.Whitelist.Contains(o.chainDenomMapping[pair.Base]) {
+				if o.paramCache.params.Whitelist.Contains(priceConfig.chainDenomMapping[pair.Base]) {
 					requiredRates[pair.Base] = struct{}{}
 				}
 			}
@@ -260,14 +302,22 @@ func (o *Oracle) SetPrices(ctx context.Context) error {
 				prices, err = priceProvider.GetTickerPrices(currencyPairs...)
 				if err != nil {
 					o.logger.Debug().Err(err).Msg("failed to get ticker prices from provider")
+					mtx.Lock()
+					issues = append(issues, ProviderIssue{Provider: providerName, Status: ProviderFailing, Type: "ticker", Reason: err.Error()})
+					mtx.Unlock()
 				}
 				reportPriceErrMetrics(providerName, "ticker", prices, currencyPairs)
 
 				candles, err = priceProvider.GetCandlePrices(currencyPairs...)
 				if err != nil {
 					o.logger.Debug().Err(err).Msg("failed to get candle prices from provider")
+					mtx.Lock()
+					issues = append(issues, ProviderIssue{Provider: providerName, Status: ProviderFailing, Type: "candle", Reason: err.Error()})
+					mtx.Unlock()
 				}
 				reportPriceErrMetrics(providerName, "candle", candles, currencyPairs)
+
+				applyBookPrices(o.logger, providerName, priceProvider, currencyPairs, priceConfig.priceSources, prices, candles, time.Now())
 			}()
 
 			select {
@@ -279,6 +329,9 @@ func (o *Oracle) SetPrices(ctx context.Context) error {
 					{Name: "provider", Value: providerName},
 				})
 				o.logger.Error().Msgf("provider timed out: %s", providerName)
+				mtx.Lock()
+				issues = append(issues, ProviderIssue{Provider: providerName, Status: ProviderFailing, Reason: "timed out"})
+				mtx.Unlock()
 				// returning nil to avoid canceling other providers that might succeed
 				return nil
 			}
@@ -287,9 +340,16 @@ func (o *Oracle) SetPrices(ctx context.Context) error {
 			//
 			// e.g.: {ProviderKraken: {"ATOM": <price, volume>, ...}}
 			mtx.Lock()
+			fetchedAt := time.Now()
+			for _, pair := range currencyPairs {
+				if pp, ok := latestProviderPrice(providerName, pair, prices, candles, fetchedAt); ok {
+					latestPrices = append(latestPrices, pp)
+				}
+			}
 			for _, pair := range currencyPairs {
 				success := SetProviderTickerPricesAndCandles(providerName, providerPrices, providerCandles, prices, candles, pair)
 				if !success {
+					issues = append(issues, ProviderIssue{Provider: providerName, Status: ProviderFailing, Base: pair.Base, Reason: "no ticker or candle for " + pair.String()})
 					mtx.Unlock()
 					telemetry.IncrCounterWithLabels([]string{"failure", "provider"}, 1, []metrics.Label{
 						{Name: "reason", Value: "set-prices"},
@@ -311,22 +371,39 @@ func (o *Oracle) SetPrices(ctx context.Context) error {
 		o.logger.Error().Err(err).Msg("set-prices errgroup returned an error")
 	}
 
+	o.snapshotPrices(priceConfig.providerPairs, providerPrices, providerCandles, requiredRates)
+
+	dropped := &providerIssues{}
 	computedPrices, err := GetComputedPrices(
 		o.logger,
+		dropped,
 		providerCandles,
 		providerPrices,
-		o.providerPairs,
-		o.deviations,
+		priceConfig.providerPairs,
+		priceConfig.deviations,
+		priceConfig.aggregations,
 		requiredRates,
+		provider.PastUnixTime(0),
 	)
+
+	mtx.Lock()
+	o.setProviderStatus(latestPrices, append(issues, dropped.drain()...))
+	mtx.Unlock()
+
 	if err != nil {
 		return err
 	}
 
 	for base := range requiredRates {
-		if _, ok := computedPrices[base]; !ok {
-			return fmt.Errorf("reported prices were not equal to required rates, missed: %s", base)
+		if _, ok := computedPrices[base]; ok {
+			continue
+		}
+		// leaving the base out of the vote would count as a miss on chain, while
+		// not voting at all counts as an abstain
+		if minProviders := priceConfig.aggregations[base].MinProviders; minProviders > 0 {
+			return fmt.Errorf("fewer than %d providers reported a price for %s, skipping the vote", minProviders, base)
 		}
+		return fmt.Errorf("reported prices were not equal to required rates, missed: %s", base)
 	}
 
 	o.prices = computedPrices
@@ -334,16 +411,21 @@ func (o *Oracle) SetPrices(ctx context.Context) error {
 }
 
 // GetComputedPrices gets the candle and ticker prices and computes it.
-// It returns candles' TVWAP if possible, if not possible (not available
-// or due to some staleness) it will use the most recent ticker prices
-// and the VWAP formula instead.
+// It aggregates the candles of each asset if possible, if not possible (not
+// available or due to some staleness) it will aggregate the most recent
+// ticker prices instead. Assets use TVWAP and VWAP unless another aggregation
+// is configured. The candles are weighted by their age at now, in unix
+// milliseconds.
 func GetComputedPrices(
 	logger zerolog.Logger,
+	dropped *providerIssues,
 	providerCandles provider.AggregatedProviderCandles,
 	providerPrices provider.AggregatedProviderPrices,
 	providerPairs map[string][]types.CurrencyPair,
 	deviations map[string]sdk.Dec,
+	aggregations map[string]Aggregation,
 	requiredRates map[string]struct{},
+	now int64,
 ) (prices map[string]sdk.Dec, err error) {
 	// only do asset provider map logic is log level is debug
 	if logger.GetLevel() == zerolog.DebugLevel {
@@ -374,9 +456,11 @@ func GetComputedPrices(
 	// convert any non-USD denominated candles into USD
 	convertedCandles, err := convertCandlesToUSD(
 		logger,
+		dropped,
 		providerCandles,
 		providerPairs,
 		deviations,
+		now,
 	)
 	if err != nil {
 		return nil, err
@@ -385,15 +469,17 @@ func GetComputedPrices(
 	// filter out any erroneous candles
 	filteredCandles, err := FilterCandleDeviations(
 		logger,
+		dropped,
 		convertedCandles,
 		deviations,
+		now,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	// attempt to use candles for TVWAP calculations
-	computedPrices, err := ComputeTVWAP(filteredCandles)
+	// attempt to use candles for price calculations
+	computedPrices, err := AggregateCandles(logger, dropped, filteredCandles, aggregations, now)
 	if err != nil {
 		return nil, err
 	}
@@ -415,6 +501,7 @@ func GetComputedPrices(
 		logger.Debug().Msg("Evaluating tickers because some required rates were not provided via candles")
 		convertedTickers, err := convertTickersToUSD(
 			logger,
+			dropped,
 			providerPrices,
 			providerPairs,
 			deviations,
@@ -425,6 +512,7 @@ func GetComputedPrices(
 
 		filteredProviderPrices, err := FilterTickerDeviations(
 			logger,
+			dropped,
 			convertedTickers,
 			deviations,
 		)
@@ -432,19 +520,19 @@ func GetComputedPrices(
 			return nil, err
 		}
 
-		vwapPrices, err := ComputeVWAP(filteredProviderPrices)
+		tickerPrices, err := AggregateTickers(logger, dropped, filteredProviderPrices, aggregations)
 		if err != nil {
 			return nil, err
 		}
 
-		for asset, price := range vwapPrices {
+		for asset, price := range tickerPrices {
 			if _, ok := computedPrices[asset]; !ok {
 				tickerAssets = append(tickerAssets, asset)
 				computedPrices[asset] = price
 			}
 		}
 	}
-	logger.Debug().Msg(fmt.Sprint("Assets using Candle TVWAP: ", candleAssets, " Assets using Ticker VWAP: ", tickerAssets))
+	logger.Debug().Msg(fmt.Sprint("Assets using candles: ", candleAssets, " Assets using tickers: ", tickerAssets))
 	return computedPrices, nil
 }
 
@@ -497,29 +585,34 @@ func (o *Oracle) GetParamCache(ctx context.Context, currentBlockHeight int64) (o
 }
 
 // GetParams returns the current on-chain parameters of the x/oracle module.
-func (o *Oracle) GetParams(ctx context.Context) (oracletypes.Params, error) {
-	grpcConn, err := grpc.Dial(
-		o.oracleClient.GRPCEndpoint,
-		// the Cosmos SDK doesn't support any transport security mechanism
-		grpc.WithInsecure(),
-		grpc.WithContextDialer(dialerFunc),
-	)
-	if err != nil {
-		return oracletypes.Params{}, fmt.Errorf("failed to dial Cosmos gRPC service: %w", err)
-	}
+func (o *Oracle) GetParams(ctx context.Context) (params oracletypes.Params, err error) {
+	err = o.oracleClient.WithFailover(func(endpoint client.Endpoint) error {
+		grpcConn, err := grpc.Dial(
+			endpoint.GRPC,
+			// the Cosmos SDK doesn't support any transport security mechanism
+			grpc.WithInsecure(),
+			grpc.WithContextDialer(dialerFunc),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to dial Cosmos gRPC service: %w", err)
+		}
 
-	defer grpcConn.Close()
-	queryClient := oracletypes.NewQueryClient(grpcConn)
+		defer grpcConn.Close()
+		queryClient := oracletypes.NewQueryClient(grpcConn)
 
-	ctx, cancel := context.WithTimeout(ctx, 15*time.Second)
-	defer cancel()
+		ctx, cancel := context.WithTimeout(ctx, 15*time.Second)
+		defer cancel()
 
-	queryResponse, err := queryClient.Params(ctx, &oracletypes.QueryParamsRequest{})
-	if err != nil {
-		return oracletypes.Params{}, fmt.Errorf("failed to get x/oracle params: %w", err)
-	}
+		queryResponse, err := queryClient.Params(ctx, &oracletypes.QueryParamsRequest{})
+		if err != nil {
+			return fmt.Errorf("failed to get x/oracle params: %w", err)
+		}
 
-	return queryResponse.Params, nil
+		params = queryResponse.Params
+		return nil
+	})
+
+	return params, err
 }
 
 func (o *Oracle) getOrSetProvider(ctx context.Context, providerName string) (provider.Provider, error) {
@@ -528,6 +621,9 @@ func (o *Oracle) getOrSetProvider(ctx context.Context, providerName string) (pro
 		ok            bool
 	)
 
+	o.providersMtx.Lock()
+	defer o.providersMtx.Unlock()
+
 	//TODO: replace with a exponential backoff mechanism
 	if err, ok := o.failedProviders[providerName]; ok {
 		return nil, errors.Wrap(err, "failed at first init (skipping provider)")
@@ -535,13 +631,39 @@ func (o *Oracle) getOrSetProvider(ctx context.Context, providerName string) (pro
 
 	priceProvider, ok = o.priceProviders[providerName]
 	if !ok {
-		newProvider, err := NewProvider(
-			ctx,
-			providerName,
-			o.logger,
-			o.endpoints[providerName],
-			o.providerPairs[providerName]...,
+		var (
+			newProvider provider.Provider
+			err         error
 		)
+
+		o.mtx.RLock()
+		restProvider, isRestProvider := o.restProviders[providerName]
+		endpoint := o.endpoints[providerName]
+		providerPairs := o.providerPairs[providerName]
+		priceSources := o.priceSources
+		o.mtx.RUnlock()
+
+		if isRestProvider {
+			newProvider, err = provider.NewRestProvider(
+				ctx,
+				o.logger,
+				restProvider,
+				providerPairs...,
+			)
+		} else if providerName == config.ProviderDex {
+			newProvider, err = o.newDexProvider(ctx, providerPairs)
+		} else {
+			newProvider, err = NewProvider(
+				ctx,
+				providerName,
+				o.logger,
+				endpoint,
+				providerPairs...,
+			)
+		}
+		if err == nil {
+			err = o.subscribeDepth(providerName, newProvider, depthPairs(providerPairs, priceSources))
+		}
 		if err != nil {
 			o.failedProviders[providerName] = err
 			return nil, err
@@ -554,6 +676,91 @@ func (o *Oracle) getOrSetProvider(ctx context.Context, providerName string) (pro
 	return priceProvider, nil
 }
 
+// newDexProvider creates the dex provider, which reads the dex prices over the
+// gRPC endpoint of the node in use.
+func (o *Oracle) newDexProvider(ctx context.Context, providerPairs []types.CurrencyPair) (provider.Provider, error) {
+	return provider.NewDexProvider(
+		ctx,
+		o.logger,
+		o.dexProvider,
+		failoverDexQueryClient{oracleClient: o.oracleClient},
+		providerPairs...,
+	)
+}
+
+// failoverDexQueryClient queries the dex over the gRPC endpoint of the node in
+// use, failing over to the other nodes like the other queries of the oracle.
+type failoverDexQueryClient struct {
+	oracleClient client.OracleClient
+}
+
+var _ provider.DexQueryClient = failoverDexQueryClient{}
+
+func (c failoverDexQueryClient) query(fn func(queryClient dextypes.QueryClient) error) error {
+	return c.oracleClient.WithFailover(func(endpoint client.Endpoint) error {
+		grpcConn, err := grpc.Dial(
+			endpoint.GRPC,
+			// the Cosmos SDK doesn't support any transport security mechanism
+			grpc.WithInsecure(),
+			grpc.WithContextDialer(dialerFunc),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to dial Cosmos gRPC service: %w", err)
+		}
+		defer grpcConn.Close()
+
+		return fn(dextypes.NewQueryClient(grpcConn))
+	})
+}
+
+func (c failoverDexQueryClient) GetRegisteredPairs(
+	ctx context.Context,
+	in *dextypes.QueryRegisteredPairsRequest,
+	opts ...grpc.CallOption,
+) (resp *dextypes.QueryRegisteredPairsResponse, err error) {
+	err = c.query(func(queryClient dextypes.QueryClient) error {
+		resp, err = queryClient.GetRegisteredPairs(ctx, in, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (c failoverDexQueryClient) GetTwaps(
+	ctx context.Context,
+	in *dextypes.QueryGetTwapsRequest,
+	opts ...grpc.CallOption,
+) (resp *dextypes.QueryGetTwapsResponse, err error) {
+	err = c.query(func(queryClient dextypes.QueryClient) error {
+		resp, err = queryClient.GetTwaps(ctx, in, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (c failoverDexQueryClient) GetHistoricalPrices(
+	ctx context.Context,
+	in *dextypes.QueryGetHistoricalPricesRequest,
+	opts ...grpc.CallOption,
+) (resp *dextypes.QueryGetHistoricalPricesResponse, err error) {
+	err = c.query(func(queryClient dextypes.QueryClient) error {
+		resp, err = queryClient.GetHistoricalPrices(ctx, in, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (c failoverDexQueryClient) GetLatestPrice(
+	ctx context.Context,
+	in *dextypes.QueryGetLatestPriceRequest,
+	opts ...grpc.CallOption,
+) (resp *dextypes.QueryGetLatestPriceResponse, err error) {
+	err = c.query(func(queryClient dextypes.QueryClient) error {
+		resp, err = queryClient.GetLatestPrice(ctx, in, opts...)
+		return err
+	})
+	return resp, err
+}
+
 // Create various providers to pull priace data for oracle price feeds
 func NewProvider(
 	ctx context.Context,
@@ -596,7 +803,7 @@ func NewProvider(
 
 func (o *Oracle) checkWhitelist(params oracletypes.Params) {
 	chainDenomSet := make(map[string]struct{})
-	for _, v := range o.chainDenomMapping {
+	for _, v := range o.getPriceConfig().chainDenomMapping {
 		chainDenomSet[v] = struct{}{}
 	}
 	for _, denom := range params.Whitelist {
@@ -645,11 +852,7 @@ func (o *Oracle) tick(
 	if err != nil {
 		return err
 	}
-
-	if err = o.SetPrices(ctx); err != nil {
-		return err
-	}
-	o.lastPriceSyncTS = time.Now()
+	o.setTickStatus(blockHeight, int64(oracleParams.VotePeriod))
 
 	// Get oracle vote period, next block height, current vote period, and index
 	// in the vote period.
@@ -657,6 +860,16 @@ func (o *Oracle) tick(
 	nextBlockHeight := blockHeight + 1
 	currentVotePeriod := math.Floor(float64(nextBlockHeight) / float64(oracleVotePeriod))
 
+	// the snapshot of a previous tick must not be recorded for this one
+	o.pendingRecord = nil
+	if err = o.SetPrices(ctx); err != nil {
+		if currentVotePeriod != o.previousVotePeriod {
+			o.recordFailedVote(blockHeight, currentVotePeriod, err)
+		}
+		return err
+	}
+	o.lastPriceSyncTS = time.Now()
+
 	// Skip until new voting period. Specifically, skip when:
 	// index [0, oracleVotePeriod - 1] > oracleVotePeriod - 2 OR index is 0
 	if currentVotePeriod == o.previousVotePeriod {
@@ -680,12 +893,66 @@ func (o *Oracle) tick(
 	filteredPrices := filterPricesByDenomList(prices, oracleParams.Whitelist)
 	exchangeRatesStr := GenerateExchangeRatesString(filteredPrices)
 
+	o.recordVote(blockHeight, currentVotePeriod, exchangeRatesStr)
+
+	if o.dryRun {
+		o.logger.Info().
+			Str("exchange_rates", exchangeRatesStr).
+			Str("validator", valAddr.String()).
+			Float64("vote_period", currentVotePeriod).
+			Int64("tick_duration", time.Since(startTime).Milliseconds()).
+			Msg("Simulating vote (dry run)")
+
+		if err := o.simulateVote(ctx, filteredPrices, oracleParams); err != nil {
+			return err
+		}
+
+		o.setVoteStatus(VoteStatus{
+			Height:     blockHeight,
+			VotePeriod: int64(currentVotePeriod),
+			Success:    true,
+			DryRun:     true,
+			Timestamp:  time.Now(),
+		})
+		o.previousVotePeriod = currentVotePeriod
+		return nil
+	}
+
 	// otherwise, we're in the next voting period and thus we vote
 	voteMsg := &oracletypes.MsgAggregateExchangeRateVote{
 		ExchangeRates: exchangeRatesStr,
 		Feeder:        o.oracleClient.OracleAddrString,
 		Validator:     valAddr.String(),
 	}
+	msgs := []sdk.Msg{voteMsg}
+
+	var prevote *PreviousPrevote
+	if oracleParams.RequirePrevote {
+		// with commit-reveal enabled we reveal the prevote of the previous
+		// vote period (if any) and commit to the current prices in the same tx
+		msgs = []sdk.Msg{}
+		if o.previousPrevote != nil && o.previousPrevote.SubmitVotePeriod == currentVotePeriod-1 {
+			voteMsg.ExchangeRates = o.previousPrevote.ExchangeRates
+			voteMsg.Salt = o.previousPrevote.Salt
+			msgs = append(msgs, voteMsg)
+		}
+
+		salt, err := GenerateSalt(16)
+		if err != nil {
+			return err
+		}
+		prevote = &PreviousPrevote{
+			Salt:             salt,
+			ExchangeRates:    exchangeRatesStr,
+			SubmitVotePeriod: currentVotePeriod,
+		}
+		hash := oracletypes.GetAggregateVoteHash(salt, exchangeRatesStr, valAddr)
+		msgs = append(msgs, &oracletypes.MsgAggregateExchangeRatePrevote{
+			Hash:      hash.String(),
+			Feeder:    o.oracleClient.OracleAddrString,
+			Validator: valAddr.String(),
+		})
+	}
 
 	o.logger.Debug().
 		Str("exchange_rates", GenerateExchangeRatesString(prices)).
@@ -699,7 +966,24 @@ func (o *Oracle) tick(
 		Int64("tick_duration", time.Since(startTime).Milliseconds()).
 		Msg("Going to broadcast vote")
 
-	resp, err := o.oracleClient.BroadcastTx(clientCtx, voteMsg)
+	// the vote is retried until the last block of the vote period, after
+	// which it would land in the next vote period
+	deadlineHeight := (int64(currentVotePeriod)+1)*oracleVotePeriod - 1
+	resp, err := o.oracleClient.BroadcastTxWithRetry(ctx, clientCtx, deadlineHeight, msgs...)
+	vote := VoteStatus{
+		Height:     blockHeight,
+		VotePeriod: int64(currentVotePeriod),
+		Success:    err == nil,
+		Timestamp:  time.Now(),
+	}
+	if resp != nil {
+		vote.TxHash = resp.TxHash
+		vote.ResponseCode = resp.Code
+	}
+	if err != nil {
+		vote.Error = err.Error()
+	}
+	o.setVoteStatus(vote)
 	if err != nil {
 		o.logResponseError(err, resp, startTime, blockHeight)
 		telemetry.IncrCounter(1, "failure", "broadcast")
@@ -715,6 +999,7 @@ func (o *Oracle) tick(
 	telemetry.IncrCounter(1, "success", "broadcast")
 
 	o.previousVotePeriod = currentVotePeriod
+	o.previousPrevote = prevote
 	o.healthchecksPing()
 
 	return nil
@@ -748,6 +1033,17 @@ func (o *Oracle) healthchecksPing() {
 	}
 }
 
+// GenerateSalt generates a random salt of the given byte length, hex encoded
+// as required by the aggregate prevote hash.
+func GenerateSalt(length int) (string, error) {
+	bz := make([]byte, length)
+	if _, err := rand.Read(bz); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(bz), nil
+}
+
 // GenerateExchangeRatesString generates a canonical string representation of
 // the aggregated exchange rates.
 func GenerateExchangeRatesString(prices sdk.DecCoins) string {
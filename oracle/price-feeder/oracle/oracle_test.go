@@ -3,6 +3,7 @@ package oracle
 import (
 	"context"
 	"fmt"
+	"net"
 	"sync"
 	"testing"
 	"time"
@@ -15,11 +16,13 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"golang.org/x/exp/slices"
+	"google.golang.org/grpc"
 
 	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
 	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/client"
 	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/provider"
 	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/types"
+	dextypes "github.com/sei-protocol/sei-chain/x/dex/types"
 	oracletypes "github.com/sei-protocol/sei-chain/x/oracle/types"
 )
 
@@ -150,6 +153,48 @@ func (m failingProvider) GetAvailablePairs() (map[string]struct{}, error) {
 	return map[string]struct{}{}, nil
 }
 
+// newTickTestOracle returns an oracle voting on BTC every vote period blocks,
+// whose providers report the given BTC prices through SetPrices. The on-chain
+// BTC rate is 20000.
+func newTickTestOracle(votePeriod uint64, prices map[string]sdk.Dec) *Oracle {
+	providers := make([]string, 0, len(prices))
+	for providerName := range prices {
+		providers = append(providers, providerName)
+	}
+	slices.Sort(providers)
+
+	oracle := New(
+		zerolog.Nop(),
+		client.OracleClient{
+			OracleAddrString:    generateAcctAddr(),
+			ValidatorAddrString: generateValidatorAddr(),
+		},
+		[]config.CurrencyPair{{Base: "BTC", ChainDenom: "ubtc", Quote: "USD", Providers: providers}},
+		time.Millisecond*100,
+		make(map[string]sdk.Dec),
+		make(map[string]config.ProviderEndpoint),
+		make(map[string]config.RestProvider),
+		config.DexProvider{},
+		nil,
+	)
+	for providerName, price := range prices {
+		oracle.priceProviders[providerName] = mockProvider{
+			prices: map[string]provider.TickerPrice{"BTCUSD": {Price: price, Volume: sdk.NewDec(10)}},
+		}
+	}
+	oracle.paramCache = ParamCache{
+		params: &oracletypes.Params{
+			Whitelist:  denomList("ubtc"),
+			VotePeriod: votePeriod,
+			RewardBand: sdk.MustNewDecFromStr("0.02"),
+		},
+	}
+	oracle.mockGetExchangeRates = func(ctx context.Context) (oracletypes.DenomOracleExchangeRatePairs, error) {
+		return oracletypes.DenomOracleExchangeRatePairs{newExchangeRate("ubtc", "20000")}, nil
+	}
+	return oracle
+}
+
 type OracleTestSuite struct {
 	suite.Suite
 
@@ -197,6 +242,8 @@ func (ots *OracleTestSuite) SetupSuite() {
 		time.Millisecond*100,
 		make(map[string]sdk.Dec),
 		make(map[string]config.ProviderEndpoint),
+		make(map[string]config.RestProvider),
+		config.DexProvider{},
 		[]config.Healthchecks{
 			{URL: "https://hc-ping.com/HEALTHCHECK-UUID", Timeout: "200ms"},
 		},
@@ -739,6 +786,66 @@ func TestTickScenarios(t *testing.T) {
 	}
 }
 
+func TestTickWithPrevote(t *testing.T) {
+	validatorAddr := generateValidatorAddr()
+	feederAddr := generateAcctAddr()
+	valAddr, err := sdk.ValAddressFromBech32(validatorAddr)
+	require.NoError(t, err)
+
+	pairs := []config.CurrencyPair{{Base: "BTC", ChainDenom: "ubtc", Quote: "USD"}}
+	cdm, _ := createMappingsFromPairs(pairs)
+
+	var broadcasted [][]sdk.Msg
+	oracle := &Oracle{
+		mockSetPrices:     func(ctx context.Context) error { return nil },
+		chainDenomMapping: cdm,
+		prices:            map[string]sdk.Dec{"BTC": sdk.MustNewDecFromStr("2.2")},
+		paramCache: ParamCache{
+			params: &oracletypes.Params{
+				Whitelist:      denomList("ubtc"),
+				VotePeriod:     2,
+				RequirePrevote: true,
+			},
+		},
+		oracleClient: client.OracleClient{
+			OracleAddrString:    feederAddr,
+			ValidatorAddrString: validatorAddr,
+			MockBroadcastTx: func(ctx sdkclient.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+				broadcasted = append(broadcasted, msgs)
+				return &sdk.TxResponse{TxHash: "0xhash", Code: 0}, nil
+			},
+		},
+	}
+	ctx := context.Background()
+
+	// first period only commits to the prices
+	require.NoError(t, oracle.tick(ctx, sdkclient.Context{}, 1))
+	require.Len(t, broadcasted, 1)
+	require.Len(t, broadcasted[0], 1)
+	prevote, ok := broadcasted[0][0].(*oracletypes.MsgAggregateExchangeRatePrevote)
+	require.True(t, ok)
+
+	// the next period reveals the committed prices and commits again
+	oracle.prices = map[string]sdk.Dec{"BTC": sdk.MustNewDecFromStr("3.3")}
+	require.NoError(t, oracle.tick(ctx, sdkclient.Context{}, 3))
+	require.Len(t, broadcasted, 2)
+	require.Len(t, broadcasted[1], 2)
+	vote, ok := broadcasted[1][0].(*oracletypes.MsgAggregateExchangeRateVote)
+	require.True(t, ok)
+	require.Equal(t, "2.200000000000000000ubtc", vote.ExchangeRates)
+	require.Equal(t, prevote.Hash, oracletypes.GetAggregateVoteHash(vote.Salt, vote.ExchangeRates, valAddr).String())
+	nextPrevote, ok := broadcasted[1][1].(*oracletypes.MsgAggregateExchangeRatePrevote)
+	require.True(t, ok)
+	require.Equal(t, nextPrevote.Hash, oracletypes.GetAggregateVoteHash(oracle.previousPrevote.Salt, "3.300000000000000000ubtc", valAddr).String())
+
+	// skipping a period leaves nothing to reveal
+	require.NoError(t, oracle.tick(ctx, sdkclient.Context{}, 7))
+	require.Len(t, broadcasted, 3)
+	require.Len(t, broadcasted[2], 1)
+	_, ok = broadcasted[2][0].(*oracletypes.MsgAggregateExchangeRatePrevote)
+	require.True(t, ok)
+}
+
 func TestFilterPricesWithDenomList(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -917,13 +1024,16 @@ func TestSuccessGetComputedPricesCandles(t *testing.T) {
 
 	prices, err := GetComputedPrices(
 		zerolog.Nop(),
+		nil,
 		providerCandles,
 		make(provider.AggregatedProviderPrices, 1),
 		providerPair,
 		make(map[string]sdk.Dec),
+		make(map[string]Aggregation),
 		map[string]struct{}{
 			"ATOM": {},
 		},
+		provider.PastUnixTime(0),
 	)
 
 	require.NoError(t, err, "It should successfully get computed candle prices")
@@ -953,13 +1063,16 @@ func TestSuccessGetComputedPricesTickers(t *testing.T) {
 
 	prices, err := GetComputedPrices(
 		zerolog.Nop(),
+		nil,
 		make(provider.AggregatedProviderCandles, 1),
 		providerPrices,
 		providerPair,
 		make(map[string]sdk.Dec),
+		make(map[string]Aggregation),
 		map[string]struct{}{
 			"ATOM": {},
 		},
+		provider.PastUnixTime(0),
 	)
 
 	require.NoError(t, err, "It should successfully get computed ticker prices")
@@ -1052,13 +1165,16 @@ func TestGetComputedPricesCandlesConversion(t *testing.T) {
 
 	prices, err := GetComputedPrices(
 		zerolog.Nop(),
+		nil,
 		providerCandles,
 		make(provider.AggregatedProviderPrices, 1),
 		providerPair,
 		make(map[string]sdk.Dec),
+		make(map[string]Aggregation),
 		map[string]struct{}{
 			"BTC": {},
 		},
+		provider.PastUnixTime(0),
 	)
 
 	require.NoError(t, err,
@@ -1139,13 +1255,16 @@ func TestGetComputedPricesTickersConversion(t *testing.T) {
 
 	prices, err := GetComputedPrices(
 		zerolog.Nop(),
+		nil,
 		make(provider.AggregatedProviderCandles, 1),
 		providerPrices,
 		providerPair,
 		make(map[string]sdk.Dec),
+		make(map[string]Aggregation),
 		map[string]struct{}{
 			"BTC": {},
 		},
+		provider.PastUnixTime(0),
 	)
 
 	require.NoError(t, err,
@@ -1157,3 +1276,43 @@ func TestGetComputedPricesTickersConversion(t *testing.T) {
 		prices[btcPair.Base],
 	)
 }
+
+type mockDexQueryServer struct {
+	dextypes.UnimplementedQueryServer
+}
+
+func (*mockDexQueryServer) GetLatestPrice(
+	context.Context,
+	*dextypes.QueryGetLatestPriceRequest,
+) (*dextypes.QueryGetLatestPriceResponse, error) {
+	return &dextypes.QueryGetLatestPriceResponse{
+		Price: &dextypes.Price{Price: sdk.MustNewDecFromStr("1.5")},
+	}, nil
+}
+
+func TestFailoverDexQueryClient(t *testing.T) {
+	// the first node is down
+	down, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	downAddr := down.Addr().String()
+	require.NoError(t, down.Close())
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	dextypes.RegisterQueryServer(server, &mockDexQueryServer{})
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	endpoints, err := client.NewEndpointPool(zerolog.Nop(), []client.Endpoint{
+		{TMRPC: "tcp://127.0.0.1:26657", GRPC: downAddr},
+		{TMRPC: "tcp://127.0.0.1:26658", GRPC: listener.Addr().String()},
+	}, time.Second)
+	require.NoError(t, err)
+	queryClient := failoverDexQueryClient{oracleClient: client.OracleClient{Endpoints: endpoints}}
+
+	resp, err := queryClient.GetLatestPrice(context.Background(), &dextypes.QueryGetLatestPriceRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("1.5"), resp.Price.Price)
+	require.Equal(t, listener.Addr().String(), endpoints.Selected().GRPC)
+}
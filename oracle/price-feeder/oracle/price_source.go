@@ -0,0 +1,149 @@
+package oracle
+
+import (
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/provider"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/types"
+)
+
+// defaultMaxBookAge is the maximum age of the top of an order book when the
+// currency pair doesn't set one.
+const defaultMaxBookAge = 10 * time.Second
+
+// priceSource is the price of a currency pair priced from the order book of its
+// providers, and the maximum age of the top of their order book.
+type priceSource struct {
+	source string
+	maxAge time.Duration
+}
+
+// createPriceSourcesFromPairs returns the price source of the currency pairs
+// priced from the order book of their providers rather than the last trade.
+func createPriceSourcesFromPairs(currencyPairs []config.CurrencyPair) map[types.CurrencyPair]priceSource {
+	priceSources := make(map[types.CurrencyPair]priceSource)
+
+	for _, pair := range currencyPairs {
+		cp := types.CurrencyPair{Base: pair.Base, Quote: pair.Quote}
+		source := priceSources[cp]
+		if pair.PriceSource == config.PriceSourceMid || pair.PriceSource == config.PriceSourceMicroprice {
+			source.source = pair.PriceSource
+		}
+		if maxAge, err := time.ParseDuration(pair.MaxBookAge); err == nil {
+			source.maxAge = maxAge
+		}
+		priceSources[cp] = source
+	}
+
+	for cp, source := range priceSources {
+		if len(source.source) == 0 {
+			delete(priceSources, cp)
+			continue
+		}
+		if source.maxAge <= 0 {
+			source.maxAge = defaultMaxBookAge
+			priceSources[cp] = source
+		}
+	}
+
+	return priceSources
+}
+
+// depthPairs returns the pairs of a provider which are priced from its order
+// book.
+func depthPairs(pairs []types.CurrencyPair, priceSources map[types.CurrencyPair]priceSource) []types.CurrencyPair {
+	depthPairs := []types.CurrencyPair{}
+	for _, pair := range pairs {
+		if _, ok := priceSources[pair]; ok {
+			depthPairs = append(depthPairs, pair)
+		}
+	}
+	return depthPairs
+}
+
+// subscribeDepth subscribes a provider to the order book of the pairs priced
+// from it. Providers which don't stream their order book keep reporting the
+// last trade price of these pairs.
+func (o *Oracle) subscribeDepth(providerName string, priceProvider provider.Provider, pairs []types.CurrencyPair) error {
+	if len(pairs) == 0 {
+		return nil
+	}
+
+	depthProvider, ok := priceProvider.(provider.DepthProvider)
+	if !ok {
+		o.logger.Warn().
+			Str("provider", providerName).
+			Interface("pairs", pairs).
+			Msg("provider doesn't stream its order book; reporting the last trade price")
+		return nil
+	}
+
+	return depthProvider.SubscribeDepth(pairs...)
+}
+
+// applyBookPrices replaces the ticker price and the newest candle price of the
+// pairs priced from the order book of a provider with the mid or the microprice
+// of the top of its book. The volumes are kept, so the provider is still
+// weighted by its traded volume, and the older candles keep their trade prices.
+// Pairs without an order book yet, or whose book is older than its maximum age,
+// keep their last trade price.
+func applyBookPrices(
+	logger zerolog.Logger,
+	providerName string,
+	priceProvider provider.Provider,
+	pairs []types.CurrencyPair,
+	priceSources map[types.CurrencyPair]priceSource,
+	prices map[string]provider.TickerPrice,
+	candles map[string][]provider.CandlePrice,
+	now time.Time,
+) {
+	depthProvider, ok := priceProvider.(provider.DepthProvider)
+	pairs = depthPairs(pairs, priceSources)
+	if !ok || len(pairs) == 0 {
+		return
+	}
+
+	books, err := depthProvider.GetBookTops(pairs...)
+	if err != nil {
+		logger.Debug().Err(err).Str("provider", providerName).Msg("failed to get order books from provider")
+		return
+	}
+
+	for _, pair := range pairs {
+		book, ok := books[pair.String()]
+		if !ok {
+			logger.Debug().Str("provider", providerName).Msgf("no order book for %s; using the last trade price", pair)
+			continue
+		}
+		source := priceSources[pair]
+		if age := now.Sub(time.UnixMilli(book.TimeStamp)); age > source.maxAge {
+			logger.Debug().Str("provider", providerName).Msgf("order book for %s is %s old; using the last trade price", pair, age)
+			continue
+		}
+		price, err := book.Price(source.source)
+		if err != nil {
+			logger.Debug().Err(err).Str("provider", providerName).Msgf("failed to price the order book of %s", pair)
+			continue
+		}
+
+		if tp, ok := prices[pair.String()]; ok {
+			tp.Price = price
+			prices[pair.String()] = tp
+		}
+		if cps, ok := candles[pair.String()]; ok && len(cps) > 0 {
+			bookCandles := make([]provider.CandlePrice, len(cps))
+			copy(bookCandles, cps)
+			newest := 0
+			for i, cp := range bookCandles {
+				if cp.TimeStamp > bookCandles[newest].TimeStamp {
+					newest = i
+				}
+			}
+			bookCandles[newest].Price = price
+			candles[pair.String()] = bookCandles
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package oracle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/client"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/provider"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/types"
+	oracletypes "github.com/sei-protocol/sei-chain/x/oracle/types"
+)
+
+type depthProvider struct {
+	mockProvider
+	books      map[string]provider.BookTop
+	subscribed *[]types.CurrencyPair
+}
+
+func (p depthProvider) SubscribeDepth(cps ...types.CurrencyPair) error {
+	*p.subscribed = append(*p.subscribed, cps...)
+	return nil
+}
+
+func (p depthProvider) GetBookTops(_ ...types.CurrencyPair) (map[string]provider.BookTop, error) {
+	return p.books, nil
+}
+
+func TestCreatePriceSourcesFromPairs(t *testing.T) {
+	priceSources := createPriceSourcesFromPairs([]config.CurrencyPair{
+		{Base: "ATOM", Quote: "USDT", PriceSource: config.PriceSourceMid},
+		{Base: "ATOM", Quote: "USD"},
+		{Base: "SEI", Quote: "USDT", PriceSource: config.PriceSourceLast},
+		{Base: "BTC", Quote: "USDT", MaxBookAge: "5s"},
+		{Base: "BTC", Quote: "USDT", PriceSource: config.PriceSourceMicroprice},
+	})
+	require.Equal(t, map[types.CurrencyPair]priceSource{
+		{Base: "ATOM", Quote: "USDT"}: {source: config.PriceSourceMid, maxAge: defaultMaxBookAge},
+		{Base: "BTC", Quote: "USDT"}:  {source: config.PriceSourceMicroprice, maxAge: 5 * time.Second},
+	}, priceSources)
+}
+
+func TestApplyBookPrices(t *testing.T) {
+	now := time.UnixMilli(100000)
+	atom := types.CurrencyPair{Base: "ATOM", Quote: "USDT"}
+	sei := types.CurrencyPair{Base: "SEI", Quote: "USDT"}
+	eth := types.CurrencyPair{Base: "ETH", Quote: "USDT"}
+	btc := types.CurrencyPair{Base: "BTC", Quote: "USDT"}
+	priceSources := map[types.CurrencyPair]priceSource{
+		atom: {source: config.PriceSourceMicroprice, maxAge: defaultMaxBookAge},
+		sei:  {source: config.PriceSourceMid, maxAge: defaultMaxBookAge},
+		eth:  {source: config.PriceSourceMid, maxAge: time.Second},
+	}
+
+	newPrices := func() (map[string]provider.TickerPrice, map[string][]provider.CandlePrice) {
+		return map[string]provider.TickerPrice{
+			"ATOMUSDT": {Price: sdk.NewDec(11), Volume: sdk.NewDec(100)},
+			"SEIUSDT":  {Price: sdk.NewDec(1), Volume: sdk.NewDec(1000)},
+			"ETHUSDT":  {Price: sdk.NewDec(1500), Volume: sdk.NewDec(50)},
+			"BTCUSDT":  {Price: sdk.NewDec(20000), Volume: sdk.NewDec(10)},
+		}, map[string][]provider.CandlePrice{
+			"ATOMUSDT": {
+				{Price: sdk.NewDec(11), Volume: sdk.NewDec(5), TimeStamp: 1000},
+				{Price: sdk.NewDec(12), Volume: sdk.NewDec(7), TimeStamp: 2000},
+			},
+		}
+	}
+
+	bookProvider := depthProvider{
+		books: map[string]provider.BookTop{
+			"ATOMUSDT": {
+				BidPrice:  sdk.NewDec(10),
+				BidSize:   sdk.NewDec(3),
+				AskPrice:  sdk.MustNewDecFromStr("10.4"),
+				AskSize:   sdk.NewDec(1),
+				TimeStamp: now.Add(-time.Second).UnixMilli(),
+			},
+			// older than the max age of the pair
+			"ETHUSDT": {
+				BidPrice:  sdk.NewDec(1000),
+				BidSize:   sdk.NewDec(1),
+				AskPrice:  sdk.NewDec(1002),
+				AskSize:   sdk.NewDec(1),
+				TimeStamp: now.Add(-2 * time.Second).UnixMilli(),
+			},
+		},
+	}
+	prices, candles := newPrices()
+	applyBookPrices(zerolog.Nop(), config.ProviderBinance, bookProvider, []types.CurrencyPair{atom, sei, eth, btc}, priceSources, prices, candles, now)
+
+	// the ticker and the newest candle of the pairs with an order book are
+	// repriced, keeping the volumes
+	require.Equal(t, provider.TickerPrice{Price: sdk.MustNewDecFromStr("10.3"), Volume: sdk.NewDec(100)}, prices["ATOMUSDT"])
+	require.Equal(t, []provider.CandlePrice{
+		{Price: sdk.NewDec(11), Volume: sdk.NewDec(5), TimeStamp: 1000},
+		{Price: sdk.MustNewDecFromStr("10.3"), Volume: sdk.NewDec(7), TimeStamp: 2000},
+	}, candles["ATOMUSDT"])
+	require.Equal(t, sdk.NewDec(1), prices["SEIUSDT"].Price)
+	require.Equal(t, sdk.NewDec(1500), prices["ETHUSDT"].Price)
+	require.Equal(t, sdk.NewDec(20000), prices["BTCUSDT"].Price)
+
+	// providers without an order book report the last trade
+	prices, candles = newPrices()
+	applyBookPrices(zerolog.Nop(), config.ProviderKraken, mockProvider{}, []types.CurrencyPair{atom, sei, eth, btc}, priceSources, prices, candles, now)
+	expectedPrices, expectedCandles := newPrices()
+	require.Equal(t, expectedPrices, prices)
+	require.Equal(t, expectedCandles, candles)
+}
+
+func TestSetPrices_PriceSource(t *testing.T) {
+	pairs := []config.CurrencyPair{
+		{Base: "ATOM", ChainDenom: "uatom", Quote: "USD", Providers: []string{config.ProviderBinance}, PriceSource: config.PriceSourceMid},
+	}
+	oracle := New(
+		zerolog.Nop(),
+		client.OracleClient{},
+		pairs,
+		time.Millisecond*100,
+		make(map[string]sdk.Dec),
+		make(map[string]config.ProviderEndpoint),
+		make(map[string]config.RestProvider),
+		config.DexProvider{},
+		nil,
+	)
+
+	subscribed := []types.CurrencyPair{}
+	bookProvider := depthProvider{
+		mockProvider: mockProvider{
+			prices: map[string]provider.TickerPrice{
+				"ATOMUSD": {Price: sdk.NewDec(11), Volume: sdk.NewDec(100)},
+			},
+		},
+		books: map[string]provider.BookTop{
+			"ATOMUSD": {
+				BidPrice:  sdk.NewDec(10),
+				BidSize:   sdk.NewDec(3),
+				AskPrice:  sdk.MustNewDecFromStr("10.4"),
+				AskSize:   sdk.NewDec(1),
+				TimeStamp: time.Now().UnixMilli(),
+			},
+		},
+		subscribed: &subscribed,
+	}
+	oracle.priceProviders[config.ProviderBinance] = bookProvider
+	oracle.paramCache = ParamCache{params: &oracletypes.Params{Whitelist: denomList("uatom")}}
+
+	require.NoError(t, oracle.SetPrices(context.Background()))
+	require.Equal(t, sdk.MustNewDecFromStr("10.2"), oracle.GetPrices().AmountOf("uatom"))
+
+	// pairs switching to the order book are subscribed to it on reload
+	pairs = append(pairs, config.CurrencyPair{
+		Base: "SEI", ChainDenom: "usei", Quote: "USD", Providers: []string{config.ProviderBinance}, PriceSource: config.PriceSourceMicroprice,
+	})
+	require.NoError(t, oracle.Reload(pairs, make(map[string]sdk.Dec), nil, nil))
+	require.Equal(t, []types.CurrencyPair{{Base: "SEI", Quote: "USD"}}, subscribed)
+}
@@ -25,7 +25,7 @@ const (
 	binanceRestPath = "/api/v3/ticker/price"
 )
 
-var _ Provider = (*BinanceProvider)(nil)
+var _ DepthProvider = (*BinanceProvider)(nil)
 
 type (
 	// BinanceProvider defines an Oracle provider implemented by the Binance public
@@ -33,6 +33,7 @@ type (
 	//
 	// REF: https://binance-docs.github.io/apidocs/spot/en/#individual-symbol-mini-ticker-stream
 	// REF: https://binance-docs.github.io/apidocs/spot/en/#kline-candlestick-streams
+	// REF: https://binance-docs.github.io/apidocs/spot/en/#individual-symbol-book-ticker-streams
 	BinanceProvider struct {
 		wsURL           url.URL
 		wsClient        *websocket.Conn
@@ -42,6 +43,7 @@ type (
 		tickers         map[string]BinanceTicker      // Symbol => BinanceTicker
 		candles         map[string][]BinanceCandle    // Symbol => BinanceCandle
 		subscribedPairs map[string]types.CurrencyPair // Symbol => types.CurrencyPair
+		depth           *DepthSubscription
 	}
 
 	// BinanceTicker ticker price response. https://pkg.go.dev/encoding/json#Unmarshal
@@ -69,6 +71,16 @@ type (
 		Metadata BinanceCandleMetadata `json:"k"` // Metadata for candle
 	}
 
+	// BinanceBookTicker best bid and ask binance websocket channel "bookTicker"
+	// response.
+	BinanceBookTicker struct {
+		Symbol   string `json:"s"` // Symbol ex.: BTCUSDT
+		BidPrice string `json:"b"` // Best bid price ex.: 25.35190000
+		BidQty   string `json:"B"` // Best bid quantity ex.: 31.21000000
+		AskPrice string `json:"a"` // Best ask price ex.: 25.36520000
+		AskQty   string `json:"A"` // Best ask quantity ex.: 40.66000000
+	}
+
 	// BinanceSubscribeMsg Msg to subscribe all the tickers channels.
 	BinanceSubscriptionMsg struct {
 		Method string   `json:"method"` // SUBSCRIBE/UNSUBSCRIBE
@@ -121,6 +133,7 @@ func NewBinanceProvider(
 		tickers:         map[string]BinanceTicker{},
 		candles:         map[string][]BinanceCandle{},
 		subscribedPairs: map[string]types.CurrencyPair{},
+		depth:           NewDepthSubscription(types.CurrencyPair.String),
 	}
 
 	if err := provider.SubscribeCurrencyPairs(pairs...); err != nil {
@@ -180,6 +193,26 @@ func (p *BinanceProvider) SubscribeCurrencyPairs(cps ...types.CurrencyPair) erro
 	return nil
 }
 
+// SubscribeDepth subscribe all currency pairs into the book ticker channel,
+// which streams the best bid and ask of the pairs.
+func (p *BinanceProvider) SubscribeDepth(cps ...types.CurrencyPair) error {
+	if len(cps) == 0 {
+		return fmt.Errorf("currency pairs is empty")
+	}
+
+	if err := p.subscribeDepth(cps...); err != nil {
+		return err
+	}
+
+	p.depth.AddPairs(cps...)
+	return nil
+}
+
+// GetBookTops returns the best bid and ask of the provided pairs.
+func (p *BinanceProvider) GetBookTops(pairs ...types.CurrencyPair) (map[string]BookTop, error) {
+	return p.depth.GetBookTops(pairs...), nil
+}
+
 // subscribeChannels subscribe to the ticker and candle channels for all currency pairs.
 func (p *BinanceProvider) subscribeChannels(cps ...types.CurrencyPair) error {
 	if err := p.subscribeTickers(cps...); err != nil {
@@ -211,6 +244,21 @@ func (p *BinanceProvider) subscribeCandles(cps ...types.CurrencyPair) error {
 	return p.subscribePairs(pairs...)
 }
 
+// subscribeDepth subscribe to the book ticker channel for all currency pairs.
+func (p *BinanceProvider) subscribeDepth(cps ...types.CurrencyPair) error {
+	if len(cps) == 0 {
+		return nil
+	}
+
+	pairs := make([]string, len(cps))
+
+	for i, cp := range cps {
+		pairs[i] = currencyPairToBinanceBookTickerPair(cp)
+	}
+
+	return p.subscribePairs(pairs...)
+}
+
 // subscribedPairsToSlice returns the map of subscribed pairs as a slice.
 func (p *BinanceProvider) subscribedPairsToSlice() []types.CurrencyPair {
 	p.mtx.RLock()
@@ -293,6 +341,19 @@ func (p *BinanceProvider) messageReceived(messageType int, bz []byte) {
 		return
 	}
 
+	if p.depth.HandleMessage(bz, parseBinanceBookTicker) {
+		telemetry.IncrCounter(
+			1,
+			"websocket",
+			"message",
+			"type",
+			"depth",
+			"provider",
+			config.ProviderBinance,
+		)
+		return
+	}
+
 	p.logger.Error().
 		Int("length", len(bz)).
 		AnErr("ticker", tickerErr).
@@ -330,6 +391,23 @@ func (candle BinanceCandle) toCandlePrice() (CandlePrice, error) {
 		candle.Metadata.TimeStamp)
 }
 
+// parseBinanceBookTicker parses the best bid and ask of a book ticker message,
+// which has no timestamp so the time it's received at is used.
+func parseBinanceBookTicker(bz []byte) (string, BookTop, bool) {
+	var bookTicker BinanceBookTicker
+	if err := json.Unmarshal(bz, &bookTicker); err != nil ||
+		len(bookTicker.Symbol) == 0 || len(bookTicker.BidPrice) == 0 || len(bookTicker.AskPrice) == 0 {
+		return "", BookTop{}, false
+	}
+
+	book, err := newBookTop("Binance", bookTicker.Symbol, bookTicker.BidPrice, bookTicker.BidQty,
+		bookTicker.AskPrice, bookTicker.AskQty, time.Now().UnixMilli())
+	if err != nil {
+		return "", BookTop{}, false
+	}
+	return bookTicker.Symbol, book, true
+}
+
 func (p *BinanceProvider) handleWebSocketMsgs(ctx context.Context) {
 	reconnectTicker := time.NewTicker(defaultMaxConnectionTime)
 	defer reconnectTicker.Stop()
@@ -362,7 +440,7 @@ func (p *BinanceProvider) handleWebSocketMsgs(ctx context.Context) {
 }
 
 // reconnect closes the last WS connection then create a new one and subscribe to
-// all subscribed pairs in the ticker and candle pais. A single connection to
+// all subscribed pairs in the ticker, candle and book ticker channels. A single connection to
 // stream.binance.com is only valid for 24 hours; expect to be disconnected at the
 // 24 hour mark. The websocket server will send a ping frame every 3 minutes. If
 // the websocket server does not receive a pong frame back from the connection
@@ -391,7 +469,10 @@ func (p *BinanceProvider) reconnect() error {
 		"provider",
 		config.ProviderBinance,
 	)
-	return p.subscribeChannels(currencyPairs...)
+	if err := p.subscribeChannels(currencyPairs...); err != nil {
+		return err
+	}
+	return p.subscribeDepth(p.depth.Pairs()...)
 }
 
 // keepReconnecting keeps trying to reconnect if an error occurs in reconnect.
@@ -464,6 +545,12 @@ func currencyPairToBinanceCandlePair(cp types.CurrencyPair) string {
 	return strings.ToLower(cp.String() + "@kline_1m")
 }
 
+// currencyPairToBinanceBookTickerPair receives a currency pair and return
+// binance book ticker symbol atomusdt@bookTicker.
+func currencyPairToBinanceBookTickerPair(cp types.CurrencyPair) string {
+	return strings.ToLower(cp.String()) + "@bookTicker"
+}
+
 // newBinanceSubscriptionMsg returns a new subscription Msg.
 func newBinanceSubscriptionMsg(params ...string) BinanceSubscriptionMsg {
 	return BinanceSubscriptionMsg{
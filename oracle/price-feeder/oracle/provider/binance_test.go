@@ -8,6 +8,7 @@ import (
 	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gorilla/websocket"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
 )
@@ -115,3 +116,39 @@ func TestBinanceCurrencyPairToBinancePair(t *testing.T) {
 	binanceSymbol := currencyPairToBinanceTickerPair(cp)
 	require.Equal(t, binanceSymbol, "atomusdt@ticker")
 }
+
+func TestBinanceProvider_GetBookTops(t *testing.T) {
+	server := NewMockProviderServer()
+	server.Start()
+	defer server.Close()
+
+	atom := types.CurrencyPair{Base: "ATOM", Quote: "USDT"}
+	p, err := NewBinanceProvider(
+		context.TODO(),
+		zerolog.Nop(),
+		config.ProviderEndpoint{
+			Name:      config.ProviderBinance,
+			Rest:      "",
+			Websocket: server.GetBaseURL(),
+		},
+		atom,
+	)
+	require.NoError(t, err)
+
+	require.ErrorContains(t, p.SubscribeDepth(), "currency pairs is empty")
+	require.NoError(t, p.SubscribeDepth(atom))
+
+	p.messageReceived(websocket.TextMessage, []byte(`{"u":400900217,"s":"ATOMUSDT","b":"10.00000000","B":"3.00000000","a":"10.40000000","A":"1.00000000"}`))
+	// tickers also carry the best bid and ask, but aren't order book updates
+	p.messageReceived(websocket.TextMessage, []byte(`{"e":"24hrTicker","s":"ATOMUSDT","c":"10.20000000","v":"2396974.02000000","b":"9.00000000","B":"1.00000000","a":"11.00000000","A":"1.00000000"}`))
+
+	books, err := p.GetBookTops(atom, types.CurrencyPair{Base: "SEI", Quote: "USDT"})
+	require.NoError(t, err)
+	require.Len(t, books, 1)
+	require.Equal(t, sdk.MustNewDecFromStr("10.2"), books["ATOMUSDT"].Mid())
+	require.Equal(t, sdk.MustNewDecFromStr("10.3"), books["ATOMUSDT"].Microprice())
+
+	prices, err := p.GetTickerPrices(atom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("10.2"), prices["ATOMUSDT"].Price)
+}
@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"fmt"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/types"
+)
+
+type (
+	// DepthParser parses the top of the order book of a symbol out of a
+	// websocket message, and returns false when the message isn't an update
+	// of the order book.
+	DepthParser func([]byte) (symbol string, book BookTop, ok bool)
+
+	// BookTop defines the best bid and ask of the order book of a symbol.
+	BookTop struct {
+		BidPrice  sdk.Dec
+		BidSize   sdk.Dec
+		AskPrice  sdk.Dec
+		AskSize   sdk.Dec
+		TimeStamp int64 // unix time in milliseconds of the update
+	}
+
+	// DepthSubscription keeps the top of the order book of the currency pairs
+	// subscribed to the depth channel of a websocket provider. Each provider
+	// subscribes to its depth channel and passes the messages of its websocket
+	// to HandleMessage.
+	DepthSubscription struct {
+		mtx    sync.RWMutex
+		symbol func(types.CurrencyPair) string
+		pairs  map[string]types.CurrencyPair // Symbol => types.CurrencyPair
+		books  map[string]BookTop            // Symbol => BookTop
+	}
+)
+
+// NewDepthSubscription returns a DepthSubscription whose pairs are mapped to
+// the symbols of the provider by symbol.
+func NewDepthSubscription(symbol func(types.CurrencyPair) string) *DepthSubscription {
+	return &DepthSubscription{
+		symbol: symbol,
+		pairs:  map[string]types.CurrencyPair{},
+		books:  map[string]BookTop{},
+	}
+}
+
+// AddPairs adds currency pairs to the subscription and returns the pairs which
+// weren't subscribed yet.
+func (ds *DepthSubscription) AddPairs(cps ...types.CurrencyPair) []types.CurrencyPair {
+	ds.mtx.Lock()
+	defer ds.mtx.Unlock()
+
+	newPairs := []types.CurrencyPair{}
+	for _, cp := range cps {
+		symbol := ds.symbol(cp)
+		if _, ok := ds.pairs[symbol]; !ok {
+			ds.pairs[symbol] = cp
+			newPairs = append(newPairs, cp)
+		}
+	}
+
+	return newPairs
+}
+
+// Pairs returns the currency pairs of the subscription, to subscribe to again
+// on reconnect.
+func (ds *DepthSubscription) Pairs() []types.CurrencyPair {
+	ds.mtx.RLock()
+	defer ds.mtx.RUnlock()
+
+	return types.MapPairsToSlice(ds.pairs)
+}
+
+// HandleMessage updates the order book of a symbol when the message is an
+// update of it, and returns false otherwise.
+func (ds *DepthSubscription) HandleMessage(bz []byte, parse DepthParser) bool {
+	symbol, book, ok := parse(bz)
+	if !ok {
+		return false
+	}
+
+	ds.mtx.Lock()
+	defer ds.mtx.Unlock()
+
+	if _, ok := ds.pairs[symbol]; ok {
+		ds.books[symbol] = book
+	}
+	return true
+}
+
+// GetBookTops returns the top of the order book of the currency pairs, keyed
+// by pair. Pairs without an order book yet are left out.
+func (ds *DepthSubscription) GetBookTops(cps ...types.CurrencyPair) map[string]BookTop {
+	ds.mtx.RLock()
+	defer ds.mtx.RUnlock()
+
+	books := make(map[string]BookTop, len(cps))
+	for _, cp := range cps {
+		if book, ok := ds.books[ds.symbol(cp)]; ok {
+			books[cp.String()] = book
+		}
+	}
+
+	return books
+}
+
+func newBookTop(provider, symbol, bidPrice, bidSize, askPrice, askSize string, timeStamp int64) (BookTop, error) {
+	values := []string{bidPrice, bidSize, askPrice, askSize}
+	decs := make([]sdk.Dec, len(values))
+	for i, value := range values {
+		dec, err := sdk.NewDecFromStr(value)
+		if err != nil {
+			return BookTop{}, fmt.Errorf("failed to parse %s order book (%s) for %s", provider, value, symbol)
+		}
+		decs[i] = dec
+	}
+
+	book := BookTop{BidPrice: decs[0], BidSize: decs[1], AskPrice: decs[2], AskSize: decs[3], TimeStamp: timeStamp}
+	if !book.BidPrice.IsPositive() || book.AskPrice.LT(book.BidPrice) {
+		return BookTop{}, fmt.Errorf("invalid %s order book for %s: bid %s, ask %s", provider, symbol, bidPrice, askPrice)
+	}
+
+	return book, nil
+}
+
+// Mid returns the average of the best bid and ask.
+func (b BookTop) Mid() sdk.Dec {
+	return b.BidPrice.Add(b.AskPrice).QuoInt64(2)
+}
+
+// Microprice returns the average of the best bid and ask weighted by the size
+// on the opposite side, which leans towards the side the price is likely to
+// move to. The mid is returned when both sides are empty.
+func (b BookTop) Microprice() sdk.Dec {
+	size := b.BidSize.Add(b.AskSize)
+	if !size.IsPositive() {
+		return b.Mid()
+	}
+	return b.BidPrice.Mul(b.AskSize).Add(b.AskPrice.Mul(b.BidSize)).Quo(size)
+}
+
+// Price returns the price of the order book for a price source, either its
+// mid or its microprice.
+func (b BookTop) Price(priceSource string) (sdk.Dec, error) {
+	switch priceSource {
+	case config.PriceSourceMid:
+		return b.Mid(), nil
+	case config.PriceSourceMicroprice:
+		return b.Microprice(), nil
+	default:
+		return sdk.Dec{}, fmt.Errorf("unsupported order book price source: %s", priceSource)
+	}
+}
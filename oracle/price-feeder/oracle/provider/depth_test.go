@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBookTop(t *testing.T) {
+	book, err := newBookTop("test", "ATOMUSDT", "10", "3", "10.4", "1", 1000)
+	require.NoError(t, err)
+
+	require.Equal(t, sdk.MustNewDecFromStr("10.2"), book.Mid())
+	// leans towards the ask, which has less size to take out
+	require.Equal(t, sdk.MustNewDecFromStr("10.3"), book.Microprice())
+
+	price, err := book.Price(config.PriceSourceMicroprice)
+	require.NoError(t, err)
+	require.Equal(t, book.Microprice(), price)
+	_, err = book.Price(config.PriceSourceLast)
+	require.Error(t, err)
+
+	emptyBook, err := newBookTop("test", "ATOMUSDT", "10", "0", "10.4", "0", 1000)
+	require.NoError(t, err)
+	require.Equal(t, emptyBook.Mid(), emptyBook.Microprice())
+
+	_, err = newBookTop("test", "ATOMUSDT", "10.5", "1", "10.4", "1", 1000)
+	require.ErrorContains(t, err, "invalid test order book for ATOMUSDT")
+	_, err = newBookTop("test", "ATOMUSDT", "ten", "1", "10.4", "1", 1000)
+	require.Error(t, err)
+}
+
+func TestDepthSubscription(t *testing.T) {
+	atom := types.CurrencyPair{Base: "ATOM", Quote: "USDT"}
+	sei := types.CurrencyPair{Base: "SEI", Quote: "USDT"}
+	depth := NewDepthSubscription(types.CurrencyPair.String)
+
+	require.Equal(t, []types.CurrencyPair{atom}, depth.AddPairs(atom))
+	require.Equal(t, []types.CurrencyPair{sei}, depth.AddPairs(atom, sei))
+	require.Len(t, depth.Pairs(), 2)
+
+	parse := func(bz []byte) (string, BookTop, bool) {
+		symbol, price, ok := strings.Cut(string(bz), "=")
+		if !ok {
+			return "", BookTop{}, false
+		}
+		book, err := newBookTop("test", symbol, price, "1", price, "1", 1000)
+		return symbol, book, err == nil
+	}
+
+	require.True(t, depth.HandleMessage([]byte("ATOMUSDT=10"), parse))
+	// depth updates of other symbols are ignored
+	require.True(t, depth.HandleMessage([]byte("BTCUSDT=20000"), parse))
+	require.False(t, depth.HandleMessage([]byte("ticker"), parse))
+
+	books := depth.GetBookTops(atom, sei)
+	require.Len(t, books, 1)
+	require.Equal(t, sdk.NewDec(10), books["ATOMUSDT"].Mid())
+}
@@ -0,0 +1,365 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/types"
+	dextypes "github.com/sei-protocol/sei-chain/x/dex/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+)
+
+const dexQueryTimeout = 5 * time.Second
+
+var _ Provider = (*DexProvider)(nil)
+
+type (
+	// DexQueryClient defines the dex queries the DexProvider reads the prices
+	// with.
+	DexQueryClient interface {
+		GetRegisteredPairs(ctx context.Context, in *dextypes.QueryRegisteredPairsRequest, opts ...grpc.CallOption) (*dextypes.QueryRegisteredPairsResponse, error)
+		GetTwaps(ctx context.Context, in *dextypes.QueryGetTwapsRequest, opts ...grpc.CallOption) (*dextypes.QueryGetTwapsResponse, error)
+		GetHistoricalPrices(ctx context.Context, in *dextypes.QueryGetHistoricalPricesRequest, opts ...grpc.CallOption) (*dextypes.QueryGetHistoricalPricesResponse, error)
+		GetLatestPrice(ctx context.Context, in *dextypes.QueryGetLatestPriceRequest, opts ...grpc.CallOption) (*dextypes.QueryGetLatestPriceResponse, error)
+	}
+
+	// DexProvider defines an Oracle provider reading the prices of a contract of
+	// the Sei dex. The ticker price of a pair is its TWAP over the lookback,
+	// weighted by the on-chain volume of the same period, and its candles are
+	// the historical price candlesticks of the contract.
+	DexProvider struct {
+		logger          zerolog.Logger
+		queryClient     DexQueryClient
+		mtx             sync.RWMutex
+		config          config.DexProvider
+		lookback        time.Duration
+		candlePeriod    time.Duration
+		pollInterval    time.Duration
+		tickers         map[string]TickerPrice        // Symbol => TickerPrice
+		candles         map[string][]CandlePrice      // Symbol => CandlePrice
+		subscribedPairs map[string]types.CurrencyPair // Symbol => types.CurrencyPair
+	}
+)
+
+func NewDexProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	dexConfig config.DexProvider,
+	queryClient DexQueryClient,
+	pairs ...types.CurrencyPair,
+) (*DexProvider, error) {
+	lookback, err := time.ParseDuration(dexConfig.Lookback)
+	if err != nil {
+		return nil, fmt.Errorf("invalid dex lookback: %w", err)
+	}
+	candlePeriod, err := time.ParseDuration(dexConfig.CandlePeriod)
+	if err != nil {
+		return nil, fmt.Errorf("invalid dex candle period: %w", err)
+	}
+	pollInterval, err := time.ParseDuration(dexConfig.PollInterval)
+	if err != nil {
+		return nil, fmt.Errorf("invalid dex poll interval: %w", err)
+	}
+	if candlePeriod < time.Second || lookback < candlePeriod || pollInterval <= 0 {
+		return nil, fmt.Errorf("invalid dex lookback %s, candle period %s or poll interval %s", lookback, candlePeriod, pollInterval)
+	}
+
+	provider := &DexProvider{
+		logger:          logger.With().Str("provider", config.ProviderDex).Logger(),
+		queryClient:     queryClient,
+		config:          dexConfig,
+		lookback:        lookback,
+		candlePeriod:    candlePeriod,
+		pollInterval:    pollInterval,
+		tickers:         map[string]TickerPrice{},
+		candles:         map[string][]CandlePrice{},
+		subscribedPairs: map[string]types.CurrencyPair{},
+	}
+
+	if err := provider.SubscribeCurrencyPairs(pairs...); err != nil {
+		return nil, err
+	}
+
+	go provider.pollPrices(ctx)
+
+	return provider, nil
+}
+
+// GetTickerPrices returns the tickerPrices based on the provided pairs.
+func (p *DexProvider) GetTickerPrices(pairs ...types.CurrencyPair) (map[string]TickerPrice, error) {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	tickerPrices := make(map[string]TickerPrice, len(pairs))
+	for _, cp := range pairs {
+		key := cp.String()
+		ticker, ok := p.tickers[key]
+		if !ok {
+			p.logger.Debug().Msg(fmt.Sprint("failed to fetch tickers for pair ", cp))
+			continue
+		}
+		tickerPrices[key] = ticker
+	}
+
+	return tickerPrices, nil
+}
+
+// GetCandlePrices returns the candlePrices based on the provided pairs.
+func (p *DexProvider) GetCandlePrices(pairs ...types.CurrencyPair) (map[string][]CandlePrice, error) {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	candlePrices := make(map[string][]CandlePrice, len(pairs))
+	for _, cp := range pairs {
+		key := cp.String()
+		candles, ok := p.candles[key]
+		if !ok {
+			p.logger.Debug().Msg(fmt.Sprint("failed to fetch candles for pair ", cp))
+			continue
+		}
+		candlePrices[key] = append([]CandlePrice{}, candles...)
+	}
+
+	return candlePrices, nil
+}
+
+// SubscribeCurrencyPairs adds the currency pairs to the pairs read by the
+// provider and fetches their prices.
+func (p *DexProvider) SubscribeCurrencyPairs(cps ...types.CurrencyPair) error {
+	p.mtx.Lock()
+	for _, cp := range cps {
+		p.subscribedPairs[cp.String()] = cp
+	}
+	p.mtx.Unlock()
+
+	p.updatePrices(context.Background(), cps...)
+	return nil
+}
+
+// GetAvailablePairs returns the pairs registered on the contract the dex
+// provider reads from.
+func (p *DexProvider) GetAvailablePairs() (map[string]struct{}, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), dexQueryTimeout)
+	defer cancel()
+
+	resp, err := p.queryClient.GetRegisteredPairs(ctx, &dextypes.QueryRegisteredPairsRequest{
+		ContractAddr: p.config.ContractAddress,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	symbols := make(map[string]string, len(p.config.Denoms))
+	for symbol, denom := range p.config.Denoms {
+		symbols[denom] = symbol
+	}
+
+	availablePairs := make(map[string]struct{}, len(resp.Pairs))
+	for _, pair := range resp.Pairs {
+		cp := types.CurrencyPair{
+			Base:  dexSymbol(symbols, pair.AssetDenom),
+			Quote: dexSymbol(symbols, pair.PriceDenom),
+		}
+		availablePairs[strings.ToUpper(cp.String())] = struct{}{}
+	}
+
+	return availablePairs, nil
+}
+
+// pollPrices reads the prices of the subscribed pairs every poll interval
+// until the context is done.
+func (p *DexProvider) pollPrices(ctx context.Context) {
+	ticker := time.NewTicker(p.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.updatePrices(ctx, p.subscribedPairsToSlice()...)
+		}
+	}
+}
+
+func (p *DexProvider) subscribedPairsToSlice() []types.CurrencyPair {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	return types.MapPairsToSlice(p.subscribedPairs)
+}
+
+func (p *DexProvider) updatePrices(ctx context.Context, cps ...types.CurrencyPair) {
+	if len(cps) == 0 {
+		return
+	}
+
+	twaps, err := p.getTwaps(ctx)
+	if err != nil {
+		// the latest price of each pair is used instead
+		p.logger.Err(err).Msg("failed to fetch dex twaps")
+	}
+
+	for _, cp := range cps {
+		ticker, candles, err := p.fetchPrices(ctx, cp, twaps)
+		if err != nil {
+			p.logger.Err(err).Str("pair", cp.String()).Msg("failed to fetch dex prices")
+			continue
+		}
+
+		p.setPrices(cp.String(), ticker, candles)
+		telemetry.IncrCounter(
+			1,
+			"dex",
+			"poll",
+			"provider",
+			config.ProviderDex,
+		)
+	}
+}
+
+func (p *DexProvider) setPrices(key string, ticker TickerPrice, candles []CandlePrice) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.tickers[key] = ticker
+	p.candles[key] = candles
+}
+
+// getTwaps returns the TWAPs of the contract's pairs, keyed by asset and price
+// denom.
+func (p *DexProvider) getTwaps(ctx context.Context) (map[dextypes.Pair]sdk.Dec, error) {
+	ctx, cancel := context.WithTimeout(ctx, dexQueryTimeout)
+	defer cancel()
+
+	resp, err := p.queryClient.GetTwaps(ctx, &dextypes.QueryGetTwapsRequest{
+		ContractAddr:    p.config.ContractAddress,
+		LookbackSeconds: uint64(p.lookback.Seconds()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	twaps := make(map[dextypes.Pair]sdk.Dec, len(resp.Twaps))
+	for _, twap := range resp.Twaps {
+		if twap.Pair == nil {
+			continue
+		}
+		twaps[dextypes.Pair{AssetDenom: twap.Pair.AssetDenom, PriceDenom: twap.Pair.PriceDenom}] = twap.Twap
+	}
+
+	return twaps, nil
+}
+
+// fetchPrices returns the ticker and candles of a pair. The ticker's volume is
+// the on-chain volume of the candles.
+func (p *DexProvider) fetchPrices(
+	ctx context.Context,
+	cp types.CurrencyPair,
+	twaps map[dextypes.Pair]sdk.Dec,
+) (TickerPrice, []CandlePrice, error) {
+	pair := dextypes.Pair{
+		AssetDenom: p.denom(cp.Base),
+		PriceDenom: p.denom(cp.Quote),
+	}
+
+	candles, err := p.getCandles(ctx, pair)
+	if err != nil {
+		return TickerPrice{}, nil, err
+	}
+
+	volume := sdk.ZeroDec()
+	for _, candle := range candles {
+		volume = volume.Add(candle.Volume)
+	}
+
+	price, ok := twaps[pair]
+	if !ok || price.IsNil() || !price.IsPositive() {
+		price, err = p.getLatestPrice(ctx, pair)
+		if err != nil {
+			return TickerPrice{}, nil, err
+		}
+	}
+
+	return TickerPrice{Price: price, Volume: volume}, candles, nil
+}
+
+// getCandles returns the candles of the lookback in which the pair was traded.
+func (p *DexProvider) getCandles(ctx context.Context, pair dextypes.Pair) ([]CandlePrice, error) {
+	ctx, cancel := context.WithTimeout(ctx, dexQueryTimeout)
+	defer cancel()
+
+	resp, err := p.queryClient.GetHistoricalPrices(ctx, &dextypes.QueryGetHistoricalPricesRequest{
+		ContractAddr:          p.config.ContractAddress,
+		PriceDenom:            pair.PriceDenom,
+		AssetDenom:            pair.AssetDenom,
+		PeriodLengthInSeconds: uint64(p.candlePeriod.Seconds()),
+		NumOfPeriods:          uint64(p.lookback / p.candlePeriod),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	candles := []CandlePrice{}
+	for _, candlestick := range resp.Prices {
+		if candlestick == nil || candlestick.Close == nil || !candlestick.Close.IsPositive() {
+			continue
+		}
+
+		volume := sdk.ZeroDec()
+		if candlestick.Volume != nil {
+			volume = *candlestick.Volume
+		}
+
+		candles = append(candles, CandlePrice{
+			Price:     *candlestick.Close,
+			Volume:    volume,
+			TimeStamp: int64(candlestick.EndTimestamp) * int64(time.Second/time.Millisecond),
+		})
+	}
+
+	return candles, nil
+}
+
+func (p *DexProvider) getLatestPrice(ctx context.Context, pair dextypes.Pair) (sdk.Dec, error) {
+	ctx, cancel := context.WithTimeout(ctx, dexQueryTimeout)
+	defer cancel()
+
+	resp, err := p.queryClient.GetLatestPrice(ctx, &dextypes.QueryGetLatestPriceRequest{
+		ContractAddr: p.config.ContractAddress,
+		PriceDenom:   pair.PriceDenom,
+		AssetDenom:   pair.AssetDenom,
+	})
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if resp.Price == nil || resp.Price.Price.IsNil() || !resp.Price.Price.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("no dex price for %s/%s", pair.AssetDenom, pair.PriceDenom)
+	}
+
+	return resp.Price.Price, nil
+}
+
+// denom returns the dex denom of a symbol.
+func (p *DexProvider) denom(symbol string) string {
+	if denom, ok := p.config.Denoms[symbol]; ok {
+		return denom
+	}
+	return symbol
+}
+
+// dexSymbol returns the symbol of a dex denom given the denom => symbol mapping.
+func dexSymbol(symbols map[string]string, denom string) string {
+	if symbol, ok := symbols[denom]; ok {
+		return symbol
+	}
+	return denom
+}
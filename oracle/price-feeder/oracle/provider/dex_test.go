@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/types"
+	dextypes "github.com/sei-protocol/sei-chain/x/dex/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+const testDexContract = "sei14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sh9m79m"
+
+type mockDexQueryClient struct {
+	dextypes.QueryClient
+
+	twaps        []*dextypes.Twap
+	twapsErr     error
+	latestPrices map[string]sdk.Dec                      // asset denom => price
+	candles      map[string][]*dextypes.PriceCandlestick // asset denom => candles
+	pairs        []dextypes.Pair
+}
+
+func (m mockDexQueryClient) GetTwaps(_ context.Context, req *dextypes.QueryGetTwapsRequest, _ ...grpc.CallOption) (*dextypes.QueryGetTwapsResponse, error) {
+	if req.ContractAddr != testDexContract || req.LookbackSeconds != 600 {
+		return nil, fmt.Errorf("unexpected request %v", req)
+	}
+	return &dextypes.QueryGetTwapsResponse{Twaps: m.twaps}, m.twapsErr
+}
+
+func (m mockDexQueryClient) GetLatestPrice(_ context.Context, req *dextypes.QueryGetLatestPriceRequest, _ ...grpc.CallOption) (*dextypes.QueryGetLatestPriceResponse, error) {
+	price, ok := m.latestPrices[req.AssetDenom]
+	if !ok {
+		return &dextypes.QueryGetLatestPriceResponse{}, nil
+	}
+	return &dextypes.QueryGetLatestPriceResponse{Price: &dextypes.Price{Price: price}}, nil
+}
+
+func (m mockDexQueryClient) GetHistoricalPrices(_ context.Context, req *dextypes.QueryGetHistoricalPricesRequest, _ ...grpc.CallOption) (*dextypes.QueryGetHistoricalPricesResponse, error) {
+	if req.PeriodLengthInSeconds != 60 || req.NumOfPeriods != 10 {
+		return nil, fmt.Errorf("unexpected request %v", req)
+	}
+	return &dextypes.QueryGetHistoricalPricesResponse{Prices: m.candles[req.AssetDenom]}, nil
+}
+
+func (m mockDexQueryClient) GetRegisteredPairs(_ context.Context, _ *dextypes.QueryRegisteredPairsRequest, _ ...grpc.CallOption) (*dextypes.QueryRegisteredPairsResponse, error) {
+	return &dextypes.QueryRegisteredPairsResponse{Pairs: m.pairs}, nil
+}
+
+func newTestCandlestick(endTimestamp uint64, price, volume string) *dextypes.PriceCandlestick {
+	closePrice := sdk.MustNewDecFromStr(price)
+	volumeDec := sdk.MustNewDecFromStr(volume)
+	return &dextypes.PriceCandlestick{EndTimestamp: endTimestamp, Close: &closePrice, Volume: &volumeDec}
+}
+
+func TestDexProvider_GetTickerPrices(t *testing.T) {
+	zero := sdk.ZeroDec()
+	queryClient := mockDexQueryClient{
+		twaps: []*dextypes.Twap{
+			{Pair: &dextypes.Pair{AssetDenom: "usei", PriceDenom: "uusdc"}, Twap: sdk.MustNewDecFromStr("0.25")},
+		},
+		latestPrices: map[string]sdk.Dec{"uatom": sdk.MustNewDecFromStr("10.5")},
+		candles: map[string][]*dextypes.PriceCandlestick{
+			"usei": {
+				newTestCandlestick(1661000060, "0.26", "1000"),
+				newTestCandlestick(1661000000, "0.24", "500"),
+				// periods without prices are skipped
+				{EndTimestamp: 1660999940, Close: &zero, Volume: &zero},
+			},
+			"uatom": {
+				newTestCandlestick(1661000060, "10.5", "20"),
+			},
+		},
+		pairs: []dextypes.Pair{
+			{AssetDenom: "usei", PriceDenom: "uusdc"},
+			{AssetDenom: "uatom", PriceDenom: "uusdc"},
+		},
+	}
+
+	p, err := NewDexProvider(
+		context.Background(),
+		zerolog.Nop(),
+		config.DexProvider{
+			ContractAddress: testDexContract,
+			Lookback:        "10m",
+			CandlePeriod:    "1m",
+			PollInterval:    "1m",
+			Denoms:          map[string]string{"SEI": "usei", "ATOM": "uatom", "USDC": "uusdc"},
+		},
+		queryClient,
+		types.CurrencyPair{Base: "SEI", Quote: "USDC"},
+		types.CurrencyPair{Base: "ATOM", Quote: "USDC"},
+		types.CurrencyPair{Base: "BTC", Quote: "USDC"},
+	)
+	require.NoError(t, err)
+
+	prices, err := p.GetTickerPrices(
+		types.CurrencyPair{Base: "SEI", Quote: "USDC"},
+		types.CurrencyPair{Base: "ATOM", Quote: "USDC"},
+		types.CurrencyPair{Base: "BTC", Quote: "USDC"},
+	)
+	require.NoError(t, err)
+	require.Len(t, prices, 2)
+	require.Equal(t, TickerPrice{Price: sdk.MustNewDecFromStr("0.25"), Volume: sdk.NewDec(1500)}, prices["SEIUSDC"])
+	// pairs without a TWAP use the latest price
+	require.Equal(t, TickerPrice{Price: sdk.MustNewDecFromStr("10.5"), Volume: sdk.NewDec(20)}, prices["ATOMUSDC"])
+
+	candles, err := p.GetCandlePrices(types.CurrencyPair{Base: "SEI", Quote: "USDC"})
+	require.NoError(t, err)
+	require.Equal(t, []CandlePrice{
+		{Price: sdk.MustNewDecFromStr("0.26"), Volume: sdk.NewDec(1000), TimeStamp: 1661000060000},
+		{Price: sdk.MustNewDecFromStr("0.24"), Volume: sdk.NewDec(500), TimeStamp: 1661000000000},
+	}, candles["SEIUSDC"])
+
+	pairs, err := p.GetAvailablePairs()
+	require.NoError(t, err)
+	require.Equal(t, map[string]struct{}{"SEIUSDC": {}, "ATOMUSDC": {}}, pairs)
+}
+
+func TestDexProvider_TwapsUnavailable(t *testing.T) {
+	queryClient := mockDexQueryClient{
+		twapsErr:     fmt.Errorf("unavailable"),
+		latestPrices: map[string]sdk.Dec{"usei": sdk.MustNewDecFromStr("0.3")},
+	}
+
+	p, err := NewDexProvider(
+		context.Background(),
+		zerolog.Nop(),
+		config.DexProvider{
+			ContractAddress: testDexContract,
+			Lookback:        "10m",
+			CandlePeriod:    "1m",
+			PollInterval:    "1m",
+			Denoms:          map[string]string{"SEI": "usei", "USDC": "uusdc"},
+		},
+		queryClient,
+		types.CurrencyPair{Base: "SEI", Quote: "USDC"},
+	)
+	require.NoError(t, err)
+
+	prices, err := p.GetTickerPrices(types.CurrencyPair{Base: "SEI", Quote: "USDC"})
+	require.NoError(t, err)
+	require.Equal(t, TickerPrice{Price: sdk.MustNewDecFromStr("0.3"), Volume: sdk.ZeroDec()}, prices["SEIUSDC"])
+}
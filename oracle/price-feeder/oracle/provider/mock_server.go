@@ -62,6 +62,13 @@ func (m *MockProviderServer) GetWebsocketURL() string {
 	return ""
 }
 
+func (m *MockProviderServer) GetHTTPClient() *http.Client {
+	if m.server != nil {
+		return m.server.Client()
+	}
+	return nil
+}
+
 func (m *MockProviderServer) InjectServerCertificatesIntoDefaultDialer() {
 	certs := x509.NewCertPool()
 	for _, c := range m.server.TLS.Certificates {
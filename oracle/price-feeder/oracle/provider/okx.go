@@ -27,13 +27,14 @@ const (
 	okxRestPath  = "/api/v5/market/tickers?instType=SPOT"
 )
 
-var _ Provider = (*OkxProvider)(nil)
+var _ DepthProvider = (*OkxProvider)(nil)
 
 type (
 	// OkxProvider defines an Oracle provider implemented by the Okx public
 	// API.
 	//
 	// REF: https://www.okx.com/docs-v5/en/#websocket-api-public-channel-tickers-channel
+	// REF: https://www.okx.com/docs-v5/en/#websocket-api-public-channel-order-book-channel
 	OkxProvider struct {
 		wsURL           url.URL
 		wsClient        *websocket.Conn
@@ -44,6 +45,7 @@ type (
 		tickers         map[string]OkxTickerPair      // InstId => OkxTickerPair
 		candles         map[string][]OkxCandlePair    // InstId => 0kxCandlePair
 		subscribedPairs map[string]types.CurrencyPair // Symbol => types.CurrencyPair
+		depth           *DepthSubscription
 	}
 
 	// OkxInstId defines the id Symbol of an pair.
@@ -84,6 +86,21 @@ type (
 		ID   OkxID      `json:"arg"`
 	}
 
+	// OkxBookData defines the top of the order book of Okx, whose levels are
+	// arrays of price, size, a deprecated field and the number of orders.
+	OkxBookData struct {
+		Asks      [][]string `json:"asks"` // Best ask ex.: [["8446", "95", "0", "3"]]
+		Bids      [][]string `json:"bids"` // Best bid ex.: [["8445", "24", "0", "2"]]
+		TimeStamp string     `json:"ts"`   // Linux epoch timestamp in milliseconds
+	}
+
+	// OkxBookResponse defines the response structure of a Okx "bbo-tbt" order
+	// book request.
+	OkxBookResponse struct {
+		Data []OkxBookData `json:"data"`
+		ID   OkxID         `json:"arg"`
+	}
+
 	// OkxSubscriptionTopic Topic with the ticker to be subscribed/unsubscribed.
 	OkxSubscriptionTopic struct {
 		Channel string `json:"channel"` // Channel name ex.: tickers
@@ -142,6 +159,7 @@ func NewOkxProvider(
 		tickers:         map[string]OkxTickerPair{},
 		candles:         map[string][]OkxCandlePair{},
 		subscribedPairs: map[string]types.CurrencyPair{},
+		depth:           NewDepthSubscription(currencyPairToOkxPair),
 	}
 	provider.wsClient.SetPongHandler(provider.pongHandler)
 
@@ -210,6 +228,26 @@ func (p *OkxProvider) SubscribeCurrencyPairs(cps ...types.CurrencyPair) error {
 	return nil
 }
 
+// SubscribeDepth subscribe all currency pairs into the order book channel
+// streaming their best bid and ask.
+func (p *OkxProvider) SubscribeDepth(cps ...types.CurrencyPair) error {
+	if len(cps) == 0 {
+		return fmt.Errorf("currency pairs is empty")
+	}
+
+	if err := p.subscribeDepth(cps...); err != nil {
+		return err
+	}
+
+	p.depth.AddPairs(cps...)
+	return nil
+}
+
+// GetBookTops returns the best bid and ask of the provided pairs.
+func (p *OkxProvider) GetBookTops(pairs ...types.CurrencyPair) (map[string]BookTop, error) {
+	return p.depth.GetBookTops(pairs...), nil
+}
+
 // subscribeChannels subscribe all currency pairs into ticker and candle channels.
 func (p *OkxProvider) subscribeChannels(cps ...types.CurrencyPair) error {
 
@@ -234,6 +272,21 @@ func (p *OkxProvider) subscribeTickers(cps ...types.CurrencyPair) error {
 	return p.subscribePairs(topics...)
 }
 
+// subscribeDepth subscribe all currency pairs into the order book channel.
+func (p *OkxProvider) subscribeDepth(cps ...types.CurrencyPair) error {
+	if len(cps) == 0 {
+		return nil
+	}
+
+	topics := make([]OkxSubscriptionTopic, len(cps))
+
+	for i, cp := range cps {
+		topics[i] = newOkxBookSubscriptionTopic(currencyPairToOkxPair(cp))
+	}
+
+	return p.subscribePairs(topics...)
+}
+
 // CONTEXT: commented out because okx candles are currently unused
 // // subscribeCandles subscribe all currency pairs into candle channel.
 // func (p *OkxProvider) subscribeCandles(cps ...types.CurrencyPair) error {
@@ -366,6 +419,19 @@ func (p *OkxProvider) messageReceived(messageType int, bz []byte) {
 		return
 	}
 
+	if p.depth.HandleMessage(bz, parseOkxBook) {
+		telemetry.IncrCounter(
+			1,
+			"websocket",
+			"message",
+			"type",
+			"depth",
+			"provider",
+			config.ProviderOkx,
+		)
+		return
+	}
+
 	p.logger.Error().
 		Int("length", len(bz)).
 		AnErr("ticker", tickerErr).
@@ -461,7 +527,10 @@ func (p *OkxProvider) reconnect() error {
 		"provider",
 		config.ProviderOkx,
 	)
-	return p.subscribeChannels(currencyPairs...)
+	if err := p.subscribeChannels(currencyPairs...); err != nil {
+		return err
+	}
+	return p.subscribeDepth(p.depth.Pairs()...)
 }
 
 // ping to check websocket connection.
@@ -515,6 +584,29 @@ func (candle OkxCandlePair) toCandlePrice() (CandlePrice, error) {
 	return newCandlePrice("Okx", candle.InstID, candle.Close, candle.Volume, candle.TimeStamp)
 }
 
+// parseOkxBook parses the latest best bid and ask of an order book message.
+func parseOkxBook(bz []byte) (string, BookTop, bool) {
+	var bookResp OkxBookResponse
+	if err := json.Unmarshal(bz, &bookResp); err != nil || bookResp.ID.Channel != "bbo-tbt" || len(bookResp.Data) == 0 {
+		return "", BookTop{}, false
+	}
+
+	data := bookResp.Data[len(bookResp.Data)-1]
+	if len(data.Bids) == 0 || len(data.Asks) == 0 || len(data.Bids[0]) < 2 || len(data.Asks[0]) < 2 {
+		return "", BookTop{}, false
+	}
+	ts, err := strconv.ParseInt(data.TimeStamp, 10, 64)
+	if err != nil {
+		return "", BookTop{}, false
+	}
+
+	book, err := newBookTop("Okx", bookResp.ID.InstID, data.Bids[0][0], data.Bids[0][1], data.Asks[0][0], data.Asks[0][1], ts)
+	if err != nil {
+		return "", BookTop{}, false
+	}
+	return bookResp.ID.InstID, book, true
+}
+
 // currencyPairToOkxPair returns the expected pair instrument ID for Okx
 // ex.: "BTC-USDT".
 func currencyPairToOkxPair(pair types.CurrencyPair) string {
@@ -529,6 +621,15 @@ func newOkxTickerSubscriptionTopic(instID string) OkxSubscriptionTopic {
 	}
 }
 
+// newOkxBookSubscriptionTopic returns a new subscription topic of the order
+// book channel pushing the best bid and ask on every change.
+func newOkxBookSubscriptionTopic(instID string) OkxSubscriptionTopic {
+	return OkxSubscriptionTopic{
+		Channel: "bbo-tbt",
+		InstID:  instID,
+	}
+}
+
 // CONTEXT: commented out because okx candles are unused
 // // newOkxSubscriptionTopic returns a new subscription topic.
 // func newOkxCandleSubscriptionTopic(instID string) OkxSubscriptionTopic {
@@ -8,6 +8,7 @@ import (
 	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gorilla/websocket"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
 )
@@ -105,3 +106,34 @@ func TestOkxCurrencyPairToOkxPair(t *testing.T) {
 	okxSymbol := currencyPairToOkxPair(cp)
 	require.Equal(t, okxSymbol, "ATOM-USDT")
 }
+
+func TestOkxProvider_GetBookTops(t *testing.T) {
+	server := NewMockProviderServer()
+	server.Start()
+	defer server.Close()
+
+	atom := types.CurrencyPair{Base: "ATOM", Quote: "USDT"}
+	p, err := NewOkxProvider(
+		context.TODO(),
+		zerolog.Nop(),
+		config.ProviderEndpoint{
+			Name:      config.ProviderOkx,
+			Rest:      "",
+			Websocket: server.GetBaseURL(),
+		},
+		atom,
+	)
+	require.NoError(t, err)
+	require.NoError(t, p.SubscribeDepth(atom))
+
+	p.messageReceived(websocket.TextMessage, []byte(`{"arg":{"channel":"bbo-tbt","instId":"ATOM-USDT"},"data":[{"asks":[["10.4","1","0","2"]],"bids":[["10","3","0","1"]],"ts":"1597026383085","seqId":123}]}`))
+	// order books of pairs which aren't subscribed are ignored
+	p.messageReceived(websocket.TextMessage, []byte(`{"arg":{"channel":"bbo-tbt","instId":"SEI-USDT"},"data":[{"asks":[["1.1","1","0","2"]],"bids":[["1","3","0","1"]],"ts":"1597026383085","seqId":124}]}`))
+
+	books, err := p.GetBookTops(atom, types.CurrencyPair{Base: "SEI", Quote: "USDT"})
+	require.NoError(t, err)
+	require.Len(t, books, 1)
+	require.Equal(t, sdk.MustNewDecFromStr("10.2"), books["ATOMUSDT"].Mid())
+	require.Equal(t, sdk.MustNewDecFromStr("10.3"), books["ATOMUSDT"].Microprice())
+	require.Equal(t, int64(1597026383085), books["ATOMUSDT"].TimeStamp)
+}
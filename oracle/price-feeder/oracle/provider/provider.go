@@ -35,6 +35,19 @@ type Provider interface {
 	SubscribeCurrencyPairs(...types.CurrencyPair) error
 }
 
+// DepthProvider defines a provider which also streams the top of the order
+// book of its pairs, whose mid or microprice can be reported instead of the
+// last trade price.
+type DepthProvider interface {
+	Provider
+
+	// SubscribeDepth subscribe to the order book channel for all pairs.
+	SubscribeDepth(...types.CurrencyPair) error
+
+	// GetBookTops returns the top of the order book of the provided pairs.
+	GetBookTops(...types.CurrencyPair) (map[string]BookTop, error)
+}
+
 // TickerPrice defines price and volume information for a symbol or ticker
 // exchange rate.
 type TickerPrice struct {
@@ -60,18 +73,14 @@ type AggregatedProviderCandles map[string]map[string][]CandlePrice
 
 // preventRedirect avoid any redirect in the http.Client the request call
 // will not return an error, but a valid response with redirect response code.
-//
-//nolint:unused
 func preventRedirect(_ *http.Request, _ []*http.Request) error {
 	return http.ErrUseLastResponse
 }
 
-//nolint:unused,deadcode
 func newDefaultHTTPClient() *http.Client {
 	return newHTTPClientWithTimeout(defaultTimeout)
 }
 
-//nolint:unused
 func newHTTPClientWithTimeout(timeout time.Duration) *http.Client {
 	return &http.Client{
 		Timeout:       timeout,
@@ -0,0 +1,393 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+// unix timestamps at or above this value are in milliseconds
+const restMillisecondsThreshold = 1e12
+
+var _ Provider = (*RestProvider)(nil)
+
+type (
+	// RestProvider defines an Oracle provider polling a REST/JSON API described
+	// by a config.RestProvider, which extracts the price, volume and timestamp
+	// of each pair from the responses using JSONPath expressions.
+	RestProvider struct {
+		logger          zerolog.Logger
+		client          *http.Client
+		mtx             sync.RWMutex
+		config          config.RestProvider
+		pollInterval    time.Duration
+		tickers         map[string]TickerPrice        // Symbol => TickerPrice
+		candles         map[string][]CandlePrice      // Symbol => CandlePrice
+		subscribedPairs map[string]types.CurrencyPair // Symbol => types.CurrencyPair
+	}
+)
+
+func NewRestProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	restConfig config.RestProvider,
+	pairs ...types.CurrencyPair,
+) (*RestProvider, error) {
+	return newRestProvider(ctx, logger, restConfig, newDefaultHTTPClient(), pairs...)
+}
+
+func newRestProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	restConfig config.RestProvider,
+	client *http.Client,
+	pairs ...types.CurrencyPair,
+) (*RestProvider, error) {
+	pollInterval, err := time.ParseDuration(restConfig.PollInterval)
+	if err != nil {
+		return nil, fmt.Errorf("invalid poll interval for %s: %w", restConfig.Name, err)
+	}
+	if pollInterval <= 0 {
+		return nil, fmt.Errorf("poll interval of %s must be positive", restConfig.Name)
+	}
+
+	provider := &RestProvider{
+		logger:          logger.With().Str("provider", restConfig.Name).Logger(),
+		client:          client,
+		config:          restConfig,
+		pollInterval:    pollInterval,
+		tickers:         map[string]TickerPrice{},
+		candles:         map[string][]CandlePrice{},
+		subscribedPairs: map[string]types.CurrencyPair{},
+	}
+
+	if err := provider.SubscribeCurrencyPairs(pairs...); err != nil {
+		return nil, err
+	}
+
+	go provider.pollPrices(ctx)
+
+	return provider, nil
+}
+
+// GetTickerPrices returns the tickerPrices based on the provided pairs.
+func (p *RestProvider) GetTickerPrices(pairs ...types.CurrencyPair) (map[string]TickerPrice, error) {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	tickerPrices := make(map[string]TickerPrice, len(pairs))
+	for _, cp := range pairs {
+		key := cp.String()
+		ticker, ok := p.tickers[key]
+		if !ok {
+			p.logger.Debug().Msg(fmt.Sprint("failed to fetch tickers for pair ", cp))
+			continue
+		}
+		tickerPrices[key] = ticker
+	}
+
+	return tickerPrices, nil
+}
+
+// GetCandlePrices returns the candlePrices based on the provided pairs.
+func (p *RestProvider) GetCandlePrices(pairs ...types.CurrencyPair) (map[string][]CandlePrice, error) {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	candlePrices := make(map[string][]CandlePrice, len(pairs))
+	for _, cp := range pairs {
+		key := cp.String()
+		candles, ok := p.candles[key]
+		if !ok {
+			p.logger.Debug().Msg(fmt.Sprint("failed to fetch candles for pair ", cp))
+			continue
+		}
+		candlePrices[key] = append([]CandlePrice{}, candles...)
+	}
+
+	return candlePrices, nil
+}
+
+// SubscribeCurrencyPairs adds the currency pairs to the pairs polled by the
+// provider and fetches their prices.
+func (p *RestProvider) SubscribeCurrencyPairs(cps ...types.CurrencyPair) error {
+	p.mtx.Lock()
+	for _, cp := range cps {
+		p.subscribedPairs[cp.String()] = cp
+	}
+	p.mtx.Unlock()
+
+	p.updatePrices(cps...)
+	return nil
+}
+
+// GetAvailablePairs returns the subscribed pairs, since a REST provider has no
+// generic way of listing the pairs its API supports.
+func (p *RestProvider) GetAvailablePairs() (map[string]struct{}, error) {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	availablePairs := make(map[string]struct{}, len(p.subscribedPairs))
+	for symbol := range p.subscribedPairs {
+		availablePairs[symbol] = struct{}{}
+	}
+
+	return availablePairs, nil
+}
+
+// pollPrices fetches the prices of the subscribed pairs every poll interval
+// until the context is done.
+func (p *RestProvider) pollPrices(ctx context.Context) {
+	ticker := time.NewTicker(p.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.updatePrices(p.subscribedPairsToSlice()...)
+		}
+	}
+}
+
+func (p *RestProvider) subscribedPairsToSlice() []types.CurrencyPair {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	return types.MapPairsToSlice(p.subscribedPairs)
+}
+
+func (p *RestProvider) updatePrices(cps ...types.CurrencyPair) {
+	for _, cp := range cps {
+		candle, err := p.fetchPrice(cp)
+		if err != nil {
+			p.logger.Err(err).Str("pair", cp.String()).Msg("failed to fetch price")
+			continue
+		}
+
+		p.setPrice(cp.String(), candle)
+		telemetry.IncrCounter(
+			1,
+			"rest",
+			"poll",
+			"provider",
+			p.config.Name,
+		)
+	}
+}
+
+func (p *RestProvider) setPrice(key string, candle CandlePrice) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.tickers[key] = TickerPrice{Price: candle.Price, Volume: candle.Volume}
+
+	staleTime := PastUnixTime(providerCandlePeriod)
+	candleList := []CandlePrice{candle}
+	for _, c := range p.candles[key] {
+		if staleTime < c.TimeStamp {
+			candleList = append(candleList, c)
+		}
+	}
+	p.candles[key] = candleList
+}
+
+// fetchPrice requests the price of a pair and extracts it from the response.
+func (p *RestProvider) fetchPrice(cp types.CurrencyPair) (CandlePrice, error) {
+	symbol := p.symbol(cp)
+	replacer := strings.NewReplacer("{symbol}", symbol, "{base}", cp.Base, "{quote}", cp.Quote)
+
+	resp, err := p.client.Get(replacer.Replace(p.config.URL))
+	if err != nil {
+		return CandlePrice{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return CandlePrice{}, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	bz, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return CandlePrice{}, err
+	}
+
+	return parseRestPrice(bz, symbol, replacer, p.config, time.Now())
+}
+
+// symbol returns the symbol the API uses for the pair.
+func (p *RestProvider) symbol(cp types.CurrencyPair) string {
+	if symbol, ok := p.config.Symbols[cp.String()]; ok {
+		return symbol
+	}
+	return cp.String()
+}
+
+// parseRestPrice extracts the price, volume and timestamp of a response using
+// the JSONPath expressions of the config.
+func parseRestPrice(
+	bz []byte,
+	symbol string,
+	replacer *strings.Replacer,
+	restConfig config.RestProvider,
+	now time.Time,
+) (CandlePrice, error) {
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+
+	var doc interface{}
+	if err := decoder.Decode(&doc); err != nil {
+		return CandlePrice{}, fmt.Errorf("failed to decode %s response for %s: %w", restConfig.Name, symbol, err)
+	}
+
+	price, err := lookupJSONPath(doc, replacer.Replace(restConfig.PricePath))
+	if err != nil {
+		return CandlePrice{}, fmt.Errorf("failed to find %s price for %s: %w", restConfig.Name, symbol, err)
+	}
+
+	volume := "1"
+	if len(restConfig.VolumePath) > 0 {
+		value, err := lookupJSONPath(doc, replacer.Replace(restConfig.VolumePath))
+		if err != nil {
+			return CandlePrice{}, fmt.Errorf("failed to find %s volume for %s: %w", restConfig.Name, symbol, err)
+		}
+		volume = jsonValueToString(value)
+	}
+
+	timeStamp := now.UnixMilli()
+	if len(restConfig.TimestampPath) > 0 {
+		value, err := lookupJSONPath(doc, replacer.Replace(restConfig.TimestampPath))
+		if err != nil {
+			return CandlePrice{}, fmt.Errorf("failed to find %s timestamp for %s: %w", restConfig.Name, symbol, err)
+		}
+		timeStamp, err = parseRestTimestamp(jsonValueToString(value))
+		if err != nil {
+			return CandlePrice{}, fmt.Errorf("failed to parse %s timestamp for %s: %w", restConfig.Name, symbol, err)
+		}
+	}
+
+	return newCandlePrice(restConfig.Name, symbol, trimDecimals(jsonValueToString(price)), trimDecimals(volume), timeStamp)
+}
+
+// parseRestTimestamp converts a unix timestamp in seconds or milliseconds, or
+// an RFC 3339 time, to a unix timestamp in milliseconds.
+func parseRestTimestamp(value string) (int64, error) {
+	if timeStamp, err := strconv.ParseFloat(value, 64); err == nil {
+		if timeStamp >= restMillisecondsThreshold {
+			return int64(timeStamp), nil
+		}
+		return int64(timeStamp * 1000), nil
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return 0, err
+	}
+	return t.UnixMilli(), nil
+}
+
+// trimDecimals truncates a decimal string to the 18 decimals sdk.Dec supports.
+func trimDecimals(value string) string {
+	if split := strings.SplitN(value, ".", 2); len(split) == 2 && len(split[1]) > sdk.Precision {
+		return split[0] + "." + split[1][:sdk.Precision]
+	}
+	return value
+}
+
+func jsonValueToString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// lookupJSONPath returns the value at a JSONPath expression. Only child
+// operators are supported, in dot notation ($.data.price), bracket notation
+// ($['data']['price']) and array indexes ($.data[0].price). The leading $ is
+// optional.
+func lookupJSONPath(doc interface{}, path string) (interface{}, error) {
+	path = strings.TrimPrefix(strings.TrimSpace(path), "$")
+	if len(path) > 0 && path[0] != '.' && path[0] != '[' {
+		path = "." + path
+	}
+	current := doc
+
+	for len(path) > 0 {
+		var (
+			key   string
+			index = -1
+		)
+
+		switch path[0] {
+		case '.':
+			path = path[1:]
+			end := strings.IndexAny(path, ".[")
+			if end == -1 {
+				end = len(path)
+			}
+			key, path = path[:end], path[end:]
+			if len(key) == 0 {
+				return nil, fmt.Errorf("empty key in JSONPath")
+			}
+
+		case '[':
+			end := strings.IndexByte(path, ']')
+			if end == -1 {
+				return nil, fmt.Errorf("unterminated bracket in JSONPath")
+			}
+			selector := path[1:end]
+			path = path[end+1:]
+
+			if quoted := strings.Trim(selector, `'"`); len(quoted) == len(selector)-2 && len(selector) >= 2 {
+				key = quoted
+			} else {
+				i, err := strconv.Atoi(selector)
+				if err != nil || i < 0 {
+					return nil, fmt.Errorf("invalid JSONPath selector [%s]", selector)
+				}
+				index = i
+			}
+
+		default:
+			return nil, fmt.Errorf("invalid JSONPath at %q", path)
+		}
+
+		if index >= 0 {
+			array, ok := current.([]interface{})
+			if !ok || index >= len(array) {
+				return nil, fmt.Errorf("index %d not found", index)
+			}
+			current = array[index]
+			continue
+		}
+
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("key %s not found", key)
+		}
+		if current, ok = object[key]; !ok {
+			return nil, fmt.Errorf("key %s not found", key)
+		}
+	}
+
+	return current, nil
+}
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestProvider_GetTickerPrices(t *testing.T) {
+	server := NewMockProviderServer()
+	server.Start()
+	defer server.Close()
+
+	server.SetHandler(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ticker/atom-usdt":
+			fmt.Fprint(w, `{"data": [{"last": "34.69", "vol24h": 2396974.02}], "ts": 1661000000}`)
+		case "/ticker/SEIUSDT":
+			fmt.Fprint(w, `{"data": [{"last": 0.25, "vol24h": "1000"}], "ts": 1661000000500}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	p, err := newRestProvider(
+		ctx,
+		zerolog.Nop(),
+		config.RestProvider{
+			Name:          "venue",
+			URL:           "https://" + server.GetBaseURL() + "/ticker/{symbol}",
+			PricePath:     "$.data[0].last",
+			VolumePath:    "$.data[0]['vol24h']",
+			TimestampPath: "$.ts",
+			Symbols:       map[string]string{"ATOMUSDT": "atom-usdt"},
+			PollInterval:  "1m",
+		},
+		server.GetHTTPClient(),
+		types.CurrencyPair{Base: "ATOM", Quote: "USDT"},
+		types.CurrencyPair{Base: "SEI", Quote: "USDT"},
+		types.CurrencyPair{Base: "BTC", Quote: "USDT"},
+	)
+	require.NoError(t, err)
+
+	prices, err := p.GetTickerPrices(
+		types.CurrencyPair{Base: "ATOM", Quote: "USDT"},
+		types.CurrencyPair{Base: "SEI", Quote: "USDT"},
+		types.CurrencyPair{Base: "BTC", Quote: "USDT"},
+	)
+	require.NoError(t, err)
+	require.Len(t, prices, 2)
+	require.Equal(t, sdk.MustNewDecFromStr("34.69"), prices["ATOMUSDT"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("2396974.02"), prices["ATOMUSDT"].Volume)
+	require.Equal(t, sdk.MustNewDecFromStr("0.25"), prices["SEIUSDT"].Price)
+	require.Equal(t, sdk.NewDec(1000), prices["SEIUSDT"].Volume)
+
+	candles, err := p.GetCandlePrices(types.CurrencyPair{Base: "ATOM", Quote: "USDT"}, types.CurrencyPair{Base: "SEI", Quote: "USDT"})
+	require.NoError(t, err)
+	require.Equal(t, int64(1661000000000), candles["ATOMUSDT"][0].TimeStamp)
+	require.Equal(t, int64(1661000000500), candles["SEIUSDT"][0].TimeStamp)
+
+	pairs, err := p.GetAvailablePairs()
+	require.NoError(t, err)
+	require.Equal(t, map[string]struct{}{"ATOMUSDT": {}, "SEIUSDT": {}, "BTCUSDT": {}}, pairs)
+}
+
+func TestRestProvider_ParsePrice(t *testing.T) {
+	restConfig := config.RestProvider{
+		Name:      "venue",
+		PricePath: "result.{symbol}.c[0]",
+	}
+	replacer := strings.NewReplacer("{symbol}", "XATOMZUSD")
+	now := time.Unix(1661000000, 0)
+
+	candle, err := parseRestPrice([]byte(`{"result": {"XATOMZUSD": {"c": ["34.123456789012345678901"]}}}`), "XATOMZUSD", replacer, restConfig, now)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("34.123456789012345678"), candle.Price)
+	require.Equal(t, sdk.OneDec(), candle.Volume)
+	require.Equal(t, now.UnixMilli(), candle.TimeStamp)
+
+	restConfig.TimestampPath = "$.time"
+	candle, err = parseRestPrice([]byte(`{"result": {"XATOMZUSD": {"c": ["34"]}}, "time": "2022-08-20T12:53:20Z"}`), "XATOMZUSD", replacer, restConfig, now)
+	require.NoError(t, err)
+	require.Equal(t, int64(1661000000000), candle.TimeStamp)
+
+	_, err = parseRestPrice([]byte(`{"result": {}}`), "XATOMZUSD", replacer, restConfig, now)
+	require.Error(t, err)
+
+	_, err = parseRestPrice([]byte(`{"result": {"XATOMZUSD": {"c": ["n/a"]}}, "time": 1}`), "XATOMZUSD", replacer, restConfig, now)
+	require.Error(t, err)
+}
+
+func TestLookupJSONPath(t *testing.T) {
+	doc := map[string]interface{}{
+		"data": []interface{}{
+			map[string]interface{}{"price": "1.5", "a.b": "2"},
+		},
+	}
+
+	testCases := []struct {
+		path     string
+		expected interface{}
+		expErr   bool
+	}{
+		{path: "$.data[0].price", expected: "1.5"},
+		{path: "data[0].price", expected: "1.5"},
+		{path: "$['data'][0]['a.b']", expected: "2"},
+		{path: "$.data[1].price", expErr: true},
+		{path: "$.data.price", expErr: true},
+		{path: "$.data[0].missing", expErr: true},
+		{path: "$.data[x]", expErr: true},
+		{path: "$.data[0", expErr: true},
+		{path: "$..price", expErr: true},
+	}
+
+	for _, tc := range testCases {
+		value, err := lookupJSONPath(doc, tc.path)
+		if tc.expErr {
+			require.Error(t, err, tc.path)
+			continue
+		}
+		require.NoError(t, err, tc.path)
+		require.Equal(t, tc.expected, value, tc.path)
+	}
+}
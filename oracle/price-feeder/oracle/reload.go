@@ -0,0 +1,96 @@
+package oracle
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/types"
+)
+
+// priceConfig is the part of the oracle configuration the prices are computed
+// with, which is swapped as a whole when the configuration is reloaded.
+type priceConfig struct {
+	providerPairs     map[string][]types.CurrencyPair
+	chainDenomMapping map[string]string
+	deviations        map[string]sdk.Dec
+	aggregations      map[string]Aggregation
+	priceSources      map[types.CurrencyPair]priceSource
+}
+
+// getPriceConfig returns the current price configuration. The maps are
+// replaced rather than modified on reload, so they're safe to read without
+// holding the lock.
+func (o *Oracle) getPriceConfig() priceConfig {
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	return priceConfig{
+		providerPairs:     o.providerPairs,
+		chainDenomMapping: o.chainDenomMapping,
+		deviations:        o.deviations,
+		aggregations:      o.aggregations,
+		priceSources:      o.priceSources,
+	}
+}
+
+// Reload swaps the currency pairs, deviation thresholds and provider
+// configurations of the oracle, which are used from the next price update on.
+// The running providers are subscribed to the pairs added to them first, as
+// well as to the order book of the pairs newly priced from it, and the
+// previous configuration is kept when any of them fails to subscribe.
+//
+// Providers are created with their configuration on first use, so changes to
+// the endpoints of running providers only take effect after a restart.
+func (o *Oracle) Reload(
+	currencyPairs []config.CurrencyPair,
+	deviations map[string]sdk.Dec,
+	endpoints map[string]config.ProviderEndpoint,
+	restProviders map[string]config.RestProvider,
+) error {
+	chainDenomMapping, providerPairs := createMappingsFromPairs(currencyPairs)
+	priceSources := createPriceSourcesFromPairs(currencyPairs)
+
+	o.providersMtx.Lock()
+	defer o.providersMtx.Unlock()
+
+	previous := o.getPriceConfig()
+	for providerName, priceProvider := range o.priceProviders {
+		newPairs := []types.CurrencyPair{}
+		newDepthPairs := []types.CurrencyPair{}
+		for _, pair := range providerPairs[providerName] {
+			if !containsPair(previous.providerPairs[providerName], pair) {
+				newPairs = append(newPairs, pair)
+			}
+			if _, ok := priceSources[pair]; ok && !containsPair(depthPairs(previous.providerPairs[providerName], previous.priceSources), pair) {
+				newDepthPairs = append(newDepthPairs, pair)
+			}
+		}
+
+		if len(newPairs) > 0 {
+			if err := priceProvider.SubscribeCurrencyPairs(newPairs...); err != nil {
+				return fmt.Errorf("failed to subscribe %s to %v: %w", providerName, newPairs, err)
+			}
+		}
+		if err := o.subscribeDepth(providerName, priceProvider, newDepthPairs); err != nil {
+			return fmt.Errorf("failed to subscribe %s to the order book of %v: %w", providerName, newDepthPairs, err)
+		}
+	}
+
+	// providers which failed to start are retried with the new configuration
+	o.failedProviders = make(map[string]error)
+
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	o.providerPairs = providerPairs
+	o.chainDenomMapping = chainDenomMapping
+	o.deviations = deviations
+	o.aggregations = createAggregationsFromPairs(currencyPairs)
+	o.priceSources = priceSources
+	o.endpoints = endpoints
+	o.restProviders = restProviders
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package oracle
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/client"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/provider"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/types"
+)
+
+type subscribingProvider struct {
+	mockProvider
+	subscribed   *[]types.CurrencyPair
+	subscribeErr error
+}
+
+func (p subscribingProvider) SubscribeCurrencyPairs(cps ...types.CurrencyPair) error {
+	if p.subscribeErr != nil {
+		return p.subscribeErr
+	}
+	*p.subscribed = append(*p.subscribed, cps...)
+	return nil
+}
+
+func TestReload(t *testing.T) {
+	pairs := []config.CurrencyPair{
+		{Base: "ATOM", ChainDenom: "uatom", Quote: "USDT", Providers: []string{config.ProviderBinance, config.ProviderKraken}},
+	}
+	oracle := New(
+		zerolog.Nop(),
+		client.OracleClient{},
+		pairs,
+		0,
+		map[string]sdk.Dec{"ATOM": sdk.NewDec(2)},
+		map[string]config.ProviderEndpoint{},
+		map[string]config.RestProvider{},
+		config.DexProvider{},
+		nil,
+	)
+
+	binanceSubscribed := []types.CurrencyPair{}
+	krakenSubscribed := []types.CurrencyPair{}
+	oracle.priceProviders = map[string]provider.Provider{
+		config.ProviderBinance: subscribingProvider{subscribed: &binanceSubscribed},
+		config.ProviderKraken:  subscribingProvider{subscribed: &krakenSubscribed},
+	}
+	oracle.failedProviders[config.ProviderHuobi] = fmt.Errorf("failed to connect")
+
+	newPairs := []config.CurrencyPair{
+		{Base: "ATOM", ChainDenom: "uatom", Quote: "USDT", Providers: []string{config.ProviderBinance, config.ProviderKraken}},
+		{
+			Base:         "SEI",
+			ChainDenom:   "usei",
+			Quote:        "USDT",
+			Providers:    []string{config.ProviderBinance, config.ProviderHuobi},
+			Aggregation:  config.AggregationMedian,
+			MinProviders: 2,
+		},
+	}
+	newDeviations := map[string]sdk.Dec{"SEI": sdk.NewDec(1)}
+	require.NoError(t, oracle.Reload(newPairs, newDeviations, nil, nil))
+
+	// only the running providers are subscribed to the pairs added to them
+	require.Equal(t, []types.CurrencyPair{{Base: "SEI", Quote: "USDT"}}, binanceSubscribed)
+	require.Empty(t, krakenSubscribed)
+	require.Empty(t, oracle.failedProviders)
+
+	priceConfig := oracle.getPriceConfig()
+	require.Equal(t, []types.CurrencyPair{{Base: "ATOM", Quote: "USDT"}, {Base: "SEI", Quote: "USDT"}}, priceConfig.providerPairs[config.ProviderBinance])
+	require.Equal(t, []types.CurrencyPair{{Base: "SEI", Quote: "USDT"}}, priceConfig.providerPairs[config.ProviderHuobi])
+	require.Equal(t, "usei", priceConfig.chainDenomMapping["SEI"])
+	require.Equal(t, newDeviations, priceConfig.deviations)
+	require.Equal(t, Aggregation{Method: config.AggregationMedian, MinProviders: 2}, priceConfig.aggregations["SEI"])
+
+	// the current configuration is kept when a provider fails to subscribe
+	oracle.priceProviders[config.ProviderKraken] = subscribingProvider{
+		subscribed:   &krakenSubscribed,
+		subscribeErr: fmt.Errorf("connection closed"),
+	}
+	err := oracle.Reload(append(newPairs, config.CurrencyPair{
+		Base: "BTC", ChainDenom: "ubtc", Quote: "USDT", Providers: []string{config.ProviderKraken},
+	}), map[string]sdk.Dec{}, nil, nil)
+	require.ErrorContains(t, err, "failed to subscribe kraken")
+	require.Equal(t, priceConfig, oracle.getPriceConfig())
+}
@@ -0,0 +1,152 @@
+package oracle
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/history"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/provider"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/types"
+)
+
+// EnableHistory makes the oracle record the provider data, the computed prices
+// and the exchange rates of each vote in the given store.
+func (o *Oracle) EnableHistory(store *history.Store) {
+	o.history = store
+}
+
+// snapshotPrices keeps a copy of the provider data the prices are about to be
+// computed from, which is recorded when the prices are voted.
+func (o *Oracle) snapshotPrices(
+	providerPairs map[string][]types.CurrencyPair,
+	providerPrices provider.AggregatedProviderPrices,
+	providerCandles provider.AggregatedProviderCandles,
+	requiredRates map[string]struct{},
+) {
+	if o.history == nil {
+		return
+	}
+
+	o.pendingRecord = history.NewRecord(
+		time.Now().UnixMilli(),
+		providerPairs,
+		providerPrices,
+		providerCandles,
+		requiredRates,
+	)
+}
+
+// recordVote appends the last provider data snapshot, along with the computed
+// prices and the voted exchange rates, to the price history.
+func (o *Oracle) recordVote(blockHeight int64, votePeriod float64, exchangeRates string) {
+	if o.history == nil || o.pendingRecord == nil {
+		return
+	}
+
+	record := *o.pendingRecord
+	record.Height = blockHeight
+	record.VotePeriod = int64(votePeriod)
+	record.ExchangeRates = exchangeRates
+
+	o.mtx.RLock()
+	record.Prices = make(map[string]sdk.Dec, len(o.prices))
+	for base, price := range o.prices {
+		record.Prices[base] = price
+	}
+	o.mtx.RUnlock()
+
+	o.appendRecord(record)
+}
+
+// recordFailedVote appends the last provider data snapshot to the price
+// history, along with the error the prices failed to be computed with, when
+// the vote is skipped.
+func (o *Oracle) recordFailedVote(blockHeight int64, votePeriod float64, voteErr error) {
+	if o.history == nil || o.pendingRecord == nil {
+		return
+	}
+
+	record := *o.pendingRecord
+	record.Height = blockHeight
+	record.VotePeriod = int64(votePeriod)
+	record.Error = voteErr.Error()
+
+	o.appendRecord(record)
+}
+
+func (o *Oracle) appendRecord(record history.Record) {
+	if err := o.history.Append(record); err != nil {
+		telemetry.IncrCounter(1, "failure", "history")
+		o.logger.Error().Err(err).Msg("failed to record vote in price history")
+	}
+}
+
+// ReplayRecord recomputes the prices of a price history record with the given
+// currency pairs and deviation thresholds, as of the time the record's data was
+// collected. Only the recorded data of the pairs of each provider in the
+// currency pairs is used.
+func ReplayRecord(
+	logger zerolog.Logger,
+	record history.Record,
+	currencyPairs []config.CurrencyPair,
+	deviations map[string]sdk.Dec,
+) (map[string]sdk.Dec, error) {
+	_, configuredPairs := createMappingsFromPairs(currencyPairs)
+
+	providerPairs := make(map[string][]types.CurrencyPair)
+	providerPrices := make(provider.AggregatedProviderPrices)
+	providerCandles := make(provider.AggregatedProviderCandles)
+	for providerName, pairs := range record.ProviderPairs {
+		for _, pair := range pairs {
+			if !containsPair(configuredPairs[providerName], pair) {
+				continue
+			}
+			providerPairs[providerName] = append(providerPairs[providerName], pair)
+
+			if tp, ok := record.ProviderPrices[providerName][pair.Base]; ok {
+				if _, ok := providerPrices[providerName]; !ok {
+					providerPrices[providerName] = make(map[string]provider.TickerPrice)
+				}
+				providerPrices[providerName][pair.Base] = tp
+			}
+			if cp, ok := record.ProviderCandles[providerName][pair.Base]; ok {
+				if _, ok := providerCandles[providerName]; !ok {
+					providerCandles[providerName] = make(map[string][]provider.CandlePrice)
+				}
+				providerCandles[providerName][pair.Base] = cp
+			}
+		}
+	}
+
+	requiredRates := make(map[string]struct{}, len(record.RequiredRates))
+	for _, base := range record.RequiredRates {
+		requiredRates[base] = struct{}{}
+	}
+
+	// the providers dropped from a replayed vote are only logged, and candles
+	// are weighted by their age at the time of the record
+	return GetComputedPrices(
+		logger,
+		nil,
+		providerCandles,
+		providerPrices,
+		providerPairs,
+		deviations,
+		createAggregationsFromPairs(currencyPairs),
+		requiredRates,
+		record.Timestamp,
+	)
+}
+
+func containsPair(pairs []types.CurrencyPair, pair types.CurrencyPair) bool {
+	for _, p := range pairs {
+		if p == pair {
+			return true
+		}
+	}
+	return false
+}
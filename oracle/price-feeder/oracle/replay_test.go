@@ -0,0 +1,139 @@
+package oracle
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/history"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/provider"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/types"
+)
+
+func TestReplayRecord(t *testing.T) {
+	// candles are a minute old at the time of the record, long ago
+	timestamp := int64(1661000060000)
+	pair := types.CurrencyPair{Base: "ATOM", Quote: "USD"}
+	newCandles := func(price int64) map[string][]provider.CandlePrice {
+		return map[string][]provider.CandlePrice{
+			"ATOM": {{Price: sdk.NewDec(price), Volume: sdk.NewDec(100), TimeStamp: timestamp - 60000}},
+		}
+	}
+	newRecord := func() history.Record {
+		return history.Record{
+			Timestamp: timestamp,
+			ProviderPairs: map[string][]types.CurrencyPair{
+				config.ProviderBinance: {pair},
+				config.ProviderKraken:  {pair},
+				config.ProviderHuobi:   {pair},
+			},
+			ProviderPrices: provider.AggregatedProviderPrices{},
+			ProviderCandles: provider.AggregatedProviderCandles{
+				config.ProviderBinance: newCandles(10),
+				config.ProviderKraken:  newCandles(11),
+				config.ProviderHuobi:   newCandles(30),
+			},
+			RequiredRates: []string{"ATOM"},
+		}
+	}
+
+	prices, err := ReplayRecord(
+		zerolog.Nop(),
+		newRecord(),
+		[]config.CurrencyPair{{
+			Base:        "ATOM",
+			ChainDenom:  "uatom",
+			Quote:       "USD",
+			Providers:   []string{config.ProviderBinance, config.ProviderKraken, config.ProviderHuobi},
+			Aggregation: config.AggregationMedian,
+		}},
+		map[string]sdk.Dec{"ATOM": sdk.MustNewDecFromStr("3")},
+	)
+	require.NoError(t, err)
+	require.Equal(t, map[string]sdk.Dec{"ATOM": sdk.NewDec(11)}, prices)
+
+	// providers missing from the config are left out
+	prices, err = ReplayRecord(
+		zerolog.Nop(),
+		newRecord(),
+		[]config.CurrencyPair{{
+			Base:       "ATOM",
+			ChainDenom: "uatom",
+			Quote:      "USD",
+			Providers:  []string{config.ProviderBinance, config.ProviderKraken},
+		}},
+		map[string]sdk.Dec{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, map[string]sdk.Dec{"ATOM": sdk.MustNewDecFromStr("10.5")}, prices)
+}
+
+func TestTickRecordsVote(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+	store, err := history.NewStore(path)
+	require.NoError(t, err)
+
+	oracle := newTickTestOracle(2, map[string]sdk.Dec{
+		config.ProviderBinance: sdk.NewDec(20100),
+		config.ProviderKraken:  sdk.NewDec(20000),
+	})
+	oracle.EnableDryRun("")
+	oracle.EnableHistory(store)
+
+	require.NoError(t, oracle.tick(context.Background(), sdkclient.Context{}, 3))
+	// the same vote period is not recorded again
+	require.NoError(t, oracle.tick(context.Background(), sdkclient.Context{}, 4))
+	require.NoError(t, store.Close())
+
+	records, err := history.ReadRecords(path)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, int64(3), records[0].Height)
+	require.Equal(t, int64(2), records[0].VotePeriod)
+	require.Equal(t, "20050.000000000000000000ubtc", records[0].ExchangeRates)
+	require.Empty(t, records[0].Error)
+	require.Equal(t, map[string]sdk.Dec{"BTC": sdk.NewDec(20050)}, records[0].Prices)
+	require.Equal(t, sdk.NewDec(20100), records[0].ProviderPrices[config.ProviderBinance]["BTC"].Price)
+	require.Equal(t, []string{"BTC"}, records[0].RequiredRates)
+
+	// replaying the record with the same config gives the same prices
+	prices, err := ReplayRecord(zerolog.Nop(), records[0], []config.CurrencyPair{{
+		Base:       "BTC",
+		ChainDenom: "ubtc",
+		Quote:      "USD",
+		Providers:  []string{config.ProviderBinance, config.ProviderKraken},
+	}}, map[string]sdk.Dec{})
+	require.NoError(t, err)
+	require.Equal(t, records[0].Prices, prices)
+}
+
+func TestTickRecordsFailedVote(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+	store, err := history.NewStore(path)
+	require.NoError(t, err)
+
+	oracle := newTickTestOracle(2, map[string]sdk.Dec{config.ProviderBinance: sdk.NewDec(20100)})
+	oracle.aggregations["BTC"] = Aggregation{Method: config.AggregationMedian, MinProviders: 2}
+	oracle.EnableDryRun("")
+	oracle.EnableHistory(store)
+
+	require.Error(t, oracle.tick(context.Background(), sdkclient.Context{}, 3))
+	require.NoError(t, store.Close())
+
+	// the data the vote failed to be computed from is recorded, without rates
+	records, err := history.ReadRecords(path)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, int64(3), records[0].Height)
+	require.Equal(t, int64(2), records[0].VotePeriod)
+	require.Empty(t, records[0].ExchangeRates)
+	require.Empty(t, records[0].Prices)
+	require.Equal(t, "fewer than 2 providers reported a price for BTC, skipping the vote", records[0].Error)
+	require.Equal(t, sdk.NewDec(20100), records[0].ProviderPrices[config.ProviderBinance]["BTC"].Price)
+}
@@ -0,0 +1,265 @@
+package oracle
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/provider"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/types"
+)
+
+const (
+	// ProviderFailing is the status of a provider which failed to report
+	// prices on the last price update
+	ProviderFailing = "failing"
+
+	// ProviderFiltered is the status of a provider whose prices were dropped
+	// from the last price computation
+	ProviderFiltered = "filtered"
+)
+
+// ProviderPrice defines the latest ticker and candle reported by a provider
+// for a currency pair.
+type ProviderPrice struct {
+	Provider string
+	Pair     types.CurrencyPair
+	// Ticker is nil when the provider hasn't reported a ticker for the pair
+	Ticker *provider.TickerPrice
+	// Candle is the most recent candle of the pair, nil when the provider
+	// hasn't reported candles for the pair
+	Candle *provider.CandlePrice
+	// FetchedAt is the time the ticker and candle were fetched
+	FetchedAt time.Time
+}
+
+// ProviderIssue defines a provider which failed on the last price update, or
+// whose prices of an asset were dropped from the last price computation.
+type ProviderIssue struct {
+	Provider string
+	Status   string
+	// Type of the dropped prices, either ticker or candle, empty when the
+	// provider failed
+	Type string
+	// Base is empty when the provider failed for all of its pairs
+	Base   string
+	Reason string
+}
+
+// VoteStatus defines the outcome of the last vote of the oracle.
+type VoteStatus struct {
+	Height       int64
+	VotePeriod   int64
+	TxHash       string
+	ResponseCode uint32
+	Success      bool
+	DryRun       bool
+	Error        string
+	Timestamp    time.Time
+}
+
+// JailStatus defines the jailing state of the validator from the jail cache.
+type JailStatus struct {
+	IsJailed          bool
+	LastUpdatedHeight int64
+}
+
+// NextVote defines when the oracle expects to vote next.
+type NextVote struct {
+	VotePeriod      int64
+	Height          int64
+	CurrentHeight   int64
+	BlocksRemaining int64
+}
+
+// oracleStatus is the state of the oracle reported by the API, it's guarded by
+// the mutex of the oracle.
+type oracleStatus struct {
+	// providerPrices are the latest prices of each provider, by pair
+	providerPrices map[string]map[string]ProviderPrice
+	providerIssues []ProviderIssue
+	lastVote       *VoteStatus
+	height         int64
+	votePeriod     int64
+	votedPeriod    int64
+}
+
+// providerIssues is a list of provider issues safe for concurrent use.
+type providerIssues struct {
+	mtx    sync.Mutex
+	issues []ProviderIssue
+}
+
+// add appends an issue to the list. Issues are discarded by a nil list, for
+// price computations which don't report the dropped providers.
+func (pi *providerIssues) add(issue ProviderIssue) {
+	if pi == nil {
+		return
+	}
+
+	pi.mtx.Lock()
+	defer pi.mtx.Unlock()
+
+	pi.issues = append(pi.issues, issue)
+}
+
+// drain returns the collected issues and empties the list.
+func (pi *providerIssues) drain() []ProviderIssue {
+	pi.mtx.Lock()
+	defer pi.mtx.Unlock()
+
+	issues := pi.issues
+	pi.issues = nil
+	return issues
+}
+
+// latestProviderPrice returns the ticker and the most recent candle of a pair
+// reported by a provider.
+func latestProviderPrice(
+	providerName string,
+	pair types.CurrencyPair,
+	prices map[string]provider.TickerPrice,
+	candles map[string][]provider.CandlePrice,
+	fetchedAt time.Time,
+) (ProviderPrice, bool) {
+	providerPrice := ProviderPrice{
+		Provider:  providerName,
+		Pair:      pair,
+		FetchedAt: fetchedAt,
+	}
+
+	if tp, ok := prices[pair.String()]; ok {
+		providerPrice.Ticker = &tp
+	}
+	for _, cp := range candles[pair.String()] {
+		if providerPrice.Candle == nil || cp.TimeStamp > providerPrice.Candle.TimeStamp {
+			cp := cp
+			providerPrice.Candle = &cp
+		}
+	}
+
+	return providerPrice, providerPrice.Ticker != nil || providerPrice.Candle != nil
+}
+
+// setProviderStatus keeps the latest prices reported by the providers, and
+// replaces the provider issues with the ones of the last price update.
+func (o *Oracle) setProviderStatus(providerPrices []ProviderPrice, issues []ProviderIssue) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	if o.status.providerPrices == nil {
+		o.status.providerPrices = make(map[string]map[string]ProviderPrice)
+	}
+	for _, pp := range providerPrices {
+		if _, ok := o.status.providerPrices[pp.Provider]; !ok {
+			o.status.providerPrices[pp.Provider] = make(map[string]ProviderPrice)
+		}
+		o.status.providerPrices[pp.Provider][pp.Pair.String()] = pp
+	}
+	o.status.providerIssues = issues
+}
+
+// setTickStatus records the height of the last tick and the vote period of
+// the oracle params.
+func (o *Oracle) setTickStatus(blockHeight int64, votePeriod int64) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	o.status.height = blockHeight
+	o.status.votePeriod = votePeriod
+}
+
+// setVoteStatus records the outcome of a vote, and the vote period voted in
+// when it succeeded.
+func (o *Oracle) setVoteStatus(vote VoteStatus) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	o.status.lastVote = &vote
+	if vote.Success {
+		o.status.votedPeriod = vote.VotePeriod
+	}
+}
+
+// GetProviderPrices returns the latest ticker and candle of each pair of each
+// provider, sorted by provider and pair.
+func (o *Oracle) GetProviderPrices() []ProviderPrice {
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	providerPrices := []ProviderPrice{}
+	for _, pairs := range o.status.providerPrices {
+		for _, pp := range pairs {
+			providerPrices = append(providerPrices, pp)
+		}
+	}
+	sort.Slice(providerPrices, func(i, j int) bool {
+		if providerPrices[i].Provider != providerPrices[j].Provider {
+			return providerPrices[i].Provider < providerPrices[j].Provider
+		}
+		return providerPrices[i].Pair.String() < providerPrices[j].Pair.String()
+	})
+
+	return providerPrices
+}
+
+// GetProviderIssues returns the providers which failed on the last price
+// update, and the providers dropped from the last price computation.
+func (o *Oracle) GetProviderIssues() []ProviderIssue {
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	return append([]ProviderIssue{}, o.status.providerIssues...)
+}
+
+// GetLastVote returns the outcome of the last vote, false when the oracle
+// hasn't voted yet.
+func (o *Oracle) GetLastVote() (VoteStatus, bool) {
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	if o.status.lastVote == nil {
+		return VoteStatus{}, false
+	}
+	return *o.status.lastVote, true
+}
+
+// GetJailStatus returns the jailing state of the validator from the jail
+// cache.
+func (o *Oracle) GetJailStatus() JailStatus {
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	return JailStatus{
+		IsJailed:          o.jailCache.isJailed,
+		LastUpdatedHeight: o.jailCache.lastUpdatedBlock,
+	}
+}
+
+// GetNextVote returns the vote period and height at which the oracle expects
+// to vote next, false before the first tick. The oracle votes in the first
+// block of each vote period, or on the next block when it has yet to vote in
+// the current vote period.
+func (o *Oracle) GetNextVote() (NextVote, bool) {
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	if o.status.height < 1 || o.status.votePeriod < 1 {
+		return NextVote{}, false
+	}
+
+	// the vote period of a height is floor((height + 1) / vote period)
+	votePeriod := o.status.votedPeriod + 1
+	height := votePeriod*o.status.votePeriod - 1
+	if height <= o.status.height {
+		height = o.status.height + 1
+		votePeriod = (height + 1) / o.status.votePeriod
+	}
+
+	return NextVote{
+		VotePeriod:      votePeriod,
+		Height:          height,
+		CurrentHeight:   o.status.height,
+		BlocksRemaining: height - o.status.height,
+	}, true
+}
@@ -0,0 +1,114 @@
+package oracle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/provider"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/types"
+)
+
+func TestGetNextVote(t *testing.T) {
+	testCases := map[string]struct {
+		status   oracleStatus
+		expected NextVote
+	}{
+		"voted in the current vote period": {
+			status:   oracleStatus{height: 21, votePeriod: 10, votedPeriod: 2},
+			expected: NextVote{VotePeriod: 3, Height: 29, CurrentHeight: 21, BlocksRemaining: 8},
+		},
+		"voted in the last block of the vote period": {
+			status:   oracleStatus{height: 28, votePeriod: 10, votedPeriod: 2},
+			expected: NextVote{VotePeriod: 3, Height: 29, CurrentHeight: 28, BlocksRemaining: 1},
+		},
+		"missed the current vote period": {
+			status:   oracleStatus{height: 35, votePeriod: 10, votedPeriod: 2},
+			expected: NextVote{VotePeriod: 3, Height: 36, CurrentHeight: 35, BlocksRemaining: 1},
+		},
+		"not voted yet": {
+			status:   oracleStatus{height: 15, votePeriod: 10},
+			expected: NextVote{VotePeriod: 1, Height: 16, CurrentHeight: 15, BlocksRemaining: 1},
+		},
+	}
+
+	for name, tc := range testCases {
+		oracle := &Oracle{status: tc.status}
+		nextVote, ok := oracle.GetNextVote()
+		require.True(t, ok, name)
+		require.Equal(t, tc.expected, nextVote, name)
+	}
+
+	_, ok := (&Oracle{}).GetNextVote()
+	require.False(t, ok)
+}
+
+func TestLatestProviderPrice(t *testing.T) {
+	pair := types.CurrencyPair{Base: "ATOM", Quote: "USDT"}
+	fetchedAt := time.Now()
+
+	providerPrice, ok := latestProviderPrice(config.ProviderBinance, pair,
+		map[string]provider.TickerPrice{
+			"ATOMUSDT": {Price: sdk.NewDec(10), Volume: sdk.NewDec(1)},
+		},
+		map[string][]provider.CandlePrice{
+			"ATOMUSDT": {
+				{Price: sdk.NewDec(9), Volume: sdk.NewDec(1), TimeStamp: 2000},
+				{Price: sdk.NewDec(11), Volume: sdk.NewDec(1), TimeStamp: 3000},
+				{Price: sdk.NewDec(8), Volume: sdk.NewDec(1), TimeStamp: 1000},
+			},
+		},
+		fetchedAt,
+	)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewDec(10), providerPrice.Ticker.Price)
+	require.Equal(t, sdk.NewDec(11), providerPrice.Candle.Price)
+	require.Equal(t, fetchedAt, providerPrice.FetchedAt)
+
+	_, ok = latestProviderPrice(config.ProviderBinance, pair, nil, nil, fetchedAt)
+	require.False(t, ok)
+}
+
+func TestTickStatus(t *testing.T) {
+	oracle := newTickTestOracle(5, map[string]sdk.Dec{
+		config.ProviderBinance: sdk.NewDec(20100),
+		config.ProviderKraken:  sdk.NewDec(20000),
+		config.ProviderHuobi:   sdk.NewDec(30000),
+	})
+	oracle.EnableDryRun("")
+
+	_, ok := oracle.GetLastVote()
+	require.False(t, ok)
+
+	require.NoError(t, oracle.tick(context.Background(), sdkclient.Context{}, 4))
+
+	vote, ok := oracle.GetLastVote()
+	require.True(t, ok)
+	require.Equal(t, int64(4), vote.Height)
+	require.Equal(t, int64(1), vote.VotePeriod)
+	require.True(t, vote.Success)
+	require.True(t, vote.DryRun)
+
+	nextVote, ok := oracle.GetNextVote()
+	require.True(t, ok)
+	require.Equal(t, NextVote{VotePeriod: 2, Height: 9, CurrentHeight: 4, BlocksRemaining: 5}, nextVote)
+
+	providerPrices := oracle.GetProviderPrices()
+	require.Len(t, providerPrices, 3)
+	require.Equal(t, config.ProviderBinance, providerPrices[0].Provider)
+	require.Equal(t, sdk.NewDec(20100), providerPrices[0].Ticker.Price)
+
+	// the providers report candles, which the prices are computed from
+	require.Equal(t, []ProviderIssue{{
+		Provider: config.ProviderHuobi,
+		Status:   ProviderFiltered,
+		Type:     "candle",
+		Base:     "BTC",
+		Reason:   "deviating from the mean by more than 4690.652643526508478550",
+	}}, oracle.GetProviderIssues())
+}
@@ -11,9 +11,6 @@ import (
 
 var minimumTimeWeight = sdk.MustNewDecFromStr("0.2")
 
-// this lets us mock now for tests
-var mockNow int64
-
 const (
 	// tvwapCandlePeriod represents the time period we use for tvwap in minutes
 	tvwapCandlePeriod = 5 * time.Minute
@@ -69,22 +66,24 @@ func ComputeVWAP(prices provider.AggregatedProviderPrices) (map[string]sdk.Dec,
 
 // ComputeTVWAP computes the time volume weighted average price for all points
 // for each exchange pair. Filters out any candles that did not occur within
-// timePeriod. The provided prices argument reflects a mapping of
-// provider => {<base> => <TickerPrice>, ...}.
+// timePeriod before now, in unix milliseconds. The provided prices argument
+// reflects a mapping of provider => {<base> => <TickerPrice>, ...}.
 //
 // Ref : https://en.wikipedia.org/wiki/Time-weighted_average_price
-func ComputeTVWAP(prices provider.AggregatedProviderCandles) (map[string]sdk.Dec, error) {
+func ComputeTVWAP(prices provider.AggregatedProviderCandles, now int64) (map[string]sdk.Dec, error) {
+	weightedPrices, volumeSum := tvwapSums(prices, now)
+	return vwap(weightedPrices, volumeSum)
+}
+
+// tvwapSums returns the Σ {P * V} and Σ {V} of the candles of each base, where
+// the volume of a candle is weighted by its age at now.
+func tvwapSums(prices provider.AggregatedProviderCandles, now int64) (map[string]sdk.Dec, map[string]sdk.Dec) {
 	var (
 		weightedPrices = make(map[string]sdk.Dec)
 		volumeSum      = make(map[string]sdk.Dec)
-		now            = provider.PastUnixTime(0)
-		timePeriod     = provider.PastUnixTime(tvwapCandlePeriod)
 	)
 
-	// this lets us mock now for tests
-	if mockNow > 0 {
-		now = mockNow
-	}
+	timePeriod := now - tvwapCandlePeriod.Milliseconds()
 
 	for _, providerPrices := range prices {
 		for base := range providerPrices {
@@ -130,7 +129,7 @@ func ComputeTVWAP(prices provider.AggregatedProviderCandles) (map[string]sdk.Dec
 		}
 	}
 
-	return vwap(weightedPrices, volumeSum)
+	return weightedPrices, volumeSum
 }
 
 // StandardDeviation returns maps of the standard deviations and means of assets.
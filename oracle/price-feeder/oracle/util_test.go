@@ -155,12 +155,11 @@ func TestComputeTVWAP(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			if tc.now > 0 {
-				mockNow = tc.now
-			} else {
-				mockNow = 0
+			now := tc.now
+			if now == 0 {
+				now = provider.PastUnixTime(0)
 			}
-			tvwap, err := ComputeTVWAP(tc.prices)
+			tvwap, err := ComputeTVWAP(tc.prices, now)
 			require.NoError(t, err)
 			require.Len(t, tvwap, len(tc.expected))
 
@@ -4,10 +4,17 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle"
 )
 
 // Oracle defines the Oracle interface contract that the v1 router depends on.
 type Oracle interface {
 	GetLastPriceSyncTimestamp() time.Time
 	GetPrices() sdk.DecCoins
+	GetProviderPrices() []oracle.ProviderPrice
+	GetProviderIssues() []oracle.ProviderIssue
+	GetLastVote() (oracle.VoteStatus, bool)
+	GetJailStatus() oracle.JailStatus
+	GetNextVote() (oracle.NextVote, bool)
 }
@@ -26,6 +26,87 @@ type (
 	PricesResponse struct {
 		Prices map[string]sdk.Dec `json:"prices"`
 	}
+
+	// ProviderPricesResponse defines the response type for getting the latest
+	// ticker and candle of each pair of each provider.
+	ProviderPricesResponse struct {
+		Prices []ProviderPrice `json:"prices"`
+	}
+
+	// ProviderPrice defines the latest ticker and candle of a provider for a
+	// currency pair.
+	ProviderPrice struct {
+		Provider string  `json:"provider"`
+		Base     string  `json:"base"`
+		Quote    string  `json:"quote"`
+		Ticker   *Ticker `json:"ticker,omitempty"`
+		Candle   *Candle `json:"candle,omitempty"`
+	}
+
+	// Ticker defines a ticker of a provider, its age is the time since it
+	// was fetched.
+	Ticker struct {
+		Price     sdk.Dec `json:"price"`
+		Volume    sdk.Dec `json:"volume"`
+		FetchedAt string  `json:"fetched_at"`
+		AgeMs     int64   `json:"age_ms"`
+	}
+
+	// Candle defines a candle of a provider, its age is the time since its
+	// timestamp.
+	Candle struct {
+		Price     sdk.Dec `json:"price"`
+		Volume    sdk.Dec `json:"volume"`
+		Timestamp string  `json:"timestamp"`
+		AgeMs     int64   `json:"age_ms"`
+	}
+
+	// ProviderHealthResponse defines the response type for getting the
+	// providers which are failing or filtered out of the price computation.
+	ProviderHealthResponse struct {
+		Failing  []ProviderIssue `json:"failing"`
+		Filtered []ProviderIssue `json:"filtered"`
+	}
+
+	// ProviderIssue defines a provider which is failing, or whose prices of an
+	// asset are filtered out.
+	ProviderIssue struct {
+		Provider string `json:"provider"`
+		Type     string `json:"type,omitempty"`
+		Base     string `json:"base,omitempty"`
+		Reason   string `json:"reason"`
+	}
+
+	// VoteResponse defines the response type for getting the status of the
+	// votes of the oracle.
+	VoteResponse struct {
+		LastVote *LastVote `json:"last_vote"`
+		Jailed   struct {
+			IsJailed          bool  `json:"is_jailed"`
+			LastUpdatedHeight int64 `json:"last_updated_height"`
+		} `json:"jailed"`
+		NextVote *NextVote `json:"next_vote"`
+	}
+
+	// LastVote defines the outcome of the last vote of the oracle.
+	LastVote struct {
+		Height       int64  `json:"height"`
+		VotePeriod   int64  `json:"vote_period"`
+		TxHash       string `json:"tx_hash"`
+		ResponseCode uint32 `json:"response_code"`
+		Success      bool   `json:"success"`
+		DryRun       bool   `json:"dry_run"`
+		Error        string `json:"error,omitempty"`
+		Timestamp    string `json:"timestamp"`
+	}
+
+	// NextVote defines when the oracle expects to vote next.
+	NextVote struct {
+		VotePeriod      int64 `json:"vote_period"`
+		Height          int64 `json:"height"`
+		CurrentHeight   int64 `json:"current_height"`
+		BlocksRemaining int64 `json:"blocks_remaining"`
+	}
 )
 
 // errorResponse defines the attributes of a JSON error response.
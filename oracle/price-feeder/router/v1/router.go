@@ -11,6 +11,7 @@ import (
 	"github.com/rs/zerolog"
 
 	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle"
 	"github.com/sei-protocol/sei-chain/oracle/price-feeder/pkg/httputil"
 	"github.com/sei-protocol/sei-chain/oracle/price-feeder/router/middleware"
 )
@@ -70,6 +71,21 @@ func (r *Router) RegisterRoutes(rtr *mux.Router, prefix string) {
 		mChain.ThenFunc(r.pricesHandler()),
 	).Methods(httputil.MethodGET)
 
+	v1Router.Handle(
+		"/providers/prices",
+		mChain.ThenFunc(r.providerPricesHandler()),
+	).Methods(httputil.MethodGET)
+
+	v1Router.Handle(
+		"/providers/health",
+		mChain.ThenFunc(r.providerHealthHandler()),
+	).Methods(httputil.MethodGET)
+
+	v1Router.Handle(
+		"/vote",
+		mChain.ThenFunc(r.voteHandler()),
+	).Methods(httputil.MethodGET)
+
 	if r.cfg.Telemetry.Enabled {
 		v1Router.Handle(
 			"/metrics",
@@ -104,6 +120,99 @@ func (r *Router) pricesHandler() http.HandlerFunc {
 	}
 }
 
+func (r *Router) providerPricesHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		now := time.Now()
+		resp := ProviderPricesResponse{
+			Prices: []ProviderPrice{},
+		}
+		for _, pp := range r.oracle.GetProviderPrices() {
+			price := ProviderPrice{
+				Provider: pp.Provider,
+				Base:     pp.Pair.Base,
+				Quote:    pp.Pair.Quote,
+			}
+			if pp.Ticker != nil {
+				price.Ticker = &Ticker{
+					Price:     pp.Ticker.Price,
+					Volume:    pp.Ticker.Volume,
+					FetchedAt: pp.FetchedAt.Format(time.RFC3339),
+					AgeMs:     now.Sub(pp.FetchedAt).Milliseconds(),
+				}
+			}
+			if pp.Candle != nil {
+				price.Candle = &Candle{
+					Price:     pp.Candle.Price,
+					Volume:    pp.Candle.Volume,
+					Timestamp: time.UnixMilli(pp.Candle.TimeStamp).Format(time.RFC3339),
+					AgeMs:     now.UnixMilli() - pp.Candle.TimeStamp,
+				}
+			}
+			resp.Prices = append(resp.Prices, price)
+		}
+
+		httputil.RespondWithJSON(w, http.StatusOK, resp)
+	}
+}
+
+func (r *Router) providerHealthHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		resp := ProviderHealthResponse{
+			Failing:  []ProviderIssue{},
+			Filtered: []ProviderIssue{},
+		}
+		for _, issue := range r.oracle.GetProviderIssues() {
+			providerIssue := ProviderIssue{
+				Provider: issue.Provider,
+				Type:     issue.Type,
+				Base:     issue.Base,
+				Reason:   issue.Reason,
+			}
+			if issue.Status == oracle.ProviderFiltered {
+				resp.Filtered = append(resp.Filtered, providerIssue)
+			} else {
+				resp.Failing = append(resp.Failing, providerIssue)
+			}
+		}
+
+		httputil.RespondWithJSON(w, http.StatusOK, resp)
+	}
+}
+
+func (r *Router) voteHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		resp := VoteResponse{}
+
+		if vote, ok := r.oracle.GetLastVote(); ok {
+			resp.LastVote = &LastVote{
+				Height:       vote.Height,
+				VotePeriod:   vote.VotePeriod,
+				TxHash:       vote.TxHash,
+				ResponseCode: vote.ResponseCode,
+				Success:      vote.Success,
+				DryRun:       vote.DryRun,
+				Error:        vote.Error,
+				Timestamp:    vote.Timestamp.Format(time.RFC3339),
+			}
+		}
+
+		jailStatus := r.oracle.GetJailStatus()
+		resp.Jailed.IsJailed = jailStatus.IsJailed
+		resp.Jailed.LastUpdatedHeight = jailStatus.LastUpdatedHeight
+
+		if nextVote, ok := r.oracle.GetNextVote(); ok {
+			resp.NextVote = &NextVote{
+				VotePeriod:      nextVote.VotePeriod,
+				Height:          nextVote.Height,
+				CurrentHeight:   nextVote.CurrentHeight,
+				BlocksRemaining: nextVote.BlocksRemaining,
+			}
+		}
+
+		httputil.RespondWithJSON(w, http.StatusOK, resp)
+	}
+}
+
 func (r *Router) metricsHandler() http.HandlerFunc {
 	return func(w http.ResponseWriter, req *http.Request) {
 		format := strings.TrimSpace(req.FormValue("format"))
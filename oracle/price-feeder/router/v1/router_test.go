@@ -13,6 +13,9 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/provider"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/types"
 	v1 "github.com/sei-protocol/sei-chain/oracle/price-feeder/router/v1"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -25,6 +28,21 @@ var (
 		sdk.NewDecCoinFromDec("ATOM", sdk.MustNewDecFromStr("34.84")),
 		sdk.NewDecCoinFromDec("UMEE", sdk.MustNewDecFromStr("4.21")),
 	}
+
+	mockProviderPrices = []oracle.ProviderPrice{
+		{
+			Provider:  config.ProviderBinance,
+			Pair:      types.CurrencyPair{Base: "ATOM", Quote: "USDT"},
+			Ticker:    &provider.TickerPrice{Price: sdk.MustNewDecFromStr("34.84"), Volume: sdk.NewDec(100)},
+			Candle:    &provider.CandlePrice{Price: sdk.MustNewDecFromStr("34.80"), Volume: sdk.NewDec(10), TimeStamp: provider.PastUnixTime(time.Minute)},
+			FetchedAt: time.Now(),
+		},
+	}
+
+	mockProviderIssues = []oracle.ProviderIssue{
+		{Provider: config.ProviderKraken, Status: oracle.ProviderFailing, Reason: "timed out"},
+		{Provider: config.ProviderHuobi, Status: oracle.ProviderFiltered, Type: "ticker", Base: "ATOM", Reason: "deviating"},
+	}
 )
 
 type mockOracle struct{}
@@ -37,6 +55,26 @@ func (m mockOracle) GetPrices() sdk.DecCoins {
 	return mockPrices
 }
 
+func (m mockOracle) GetProviderPrices() []oracle.ProviderPrice {
+	return mockProviderPrices
+}
+
+func (m mockOracle) GetProviderIssues() []oracle.ProviderIssue {
+	return mockProviderIssues
+}
+
+func (m mockOracle) GetLastVote() (oracle.VoteStatus, bool) {
+	return oracle.VoteStatus{Height: 99, VotePeriod: 10, TxHash: "ABCD", Success: true, Timestamp: time.Now()}, true
+}
+
+func (m mockOracle) GetJailStatus() oracle.JailStatus {
+	return oracle.JailStatus{IsJailed: false, LastUpdatedHeight: 90}
+}
+
+func (m mockOracle) GetNextVote() (oracle.NextVote, bool) {
+	return oracle.NextVote{VotePeriod: 11, Height: 109, CurrentHeight: 100, BlocksRemaining: 9}, true
+}
+
 type mockMetrics struct{}
 
 func (mockMetrics) Gather(format string) (telemetry.GatherResponse, error) {
@@ -103,3 +141,54 @@ func (rts *RouterTestSuite) TestPrices() {
 	rts.Require().Equal(respBody.Prices["UMEE"], mockPrices.AmountOf("UMEE"))
 	rts.Require().Equal(respBody.Prices["FOO"], sdk.Dec{})
 }
+
+func (rts *RouterTestSuite) TestProviderPrices() {
+	req, err := http.NewRequest("GET", "/api/v1/providers/prices", nil)
+	rts.Require().NoError(err)
+
+	response := rts.executeRequest(req)
+	rts.Require().Equal(http.StatusOK, response.Code)
+
+	var respBody v1.ProviderPricesResponse
+	rts.Require().NoError(json.Unmarshal(response.Body.Bytes(), &respBody))
+	rts.Require().Len(respBody.Prices, 1)
+	price := respBody.Prices[0]
+	rts.Require().Equal(config.ProviderBinance, price.Provider)
+	rts.Require().Equal("ATOM", price.Base)
+	rts.Require().Equal("USDT", price.Quote)
+	rts.Require().Equal(sdk.MustNewDecFromStr("34.84"), price.Ticker.Price)
+	rts.Require().Equal(sdk.MustNewDecFromStr("34.80"), price.Candle.Price)
+	rts.Require().GreaterOrEqual(price.Candle.AgeMs, time.Minute.Milliseconds())
+}
+
+func (rts *RouterTestSuite) TestProviderHealth() {
+	req, err := http.NewRequest("GET", "/api/v1/providers/health", nil)
+	rts.Require().NoError(err)
+
+	response := rts.executeRequest(req)
+	rts.Require().Equal(http.StatusOK, response.Code)
+
+	var respBody v1.ProviderHealthResponse
+	rts.Require().NoError(json.Unmarshal(response.Body.Bytes(), &respBody))
+	rts.Require().Equal([]v1.ProviderIssue{{Provider: config.ProviderKraken, Reason: "timed out"}}, respBody.Failing)
+	rts.Require().Equal([]v1.ProviderIssue{
+		{Provider: config.ProviderHuobi, Type: "ticker", Base: "ATOM", Reason: "deviating"},
+	}, respBody.Filtered)
+}
+
+func (rts *RouterTestSuite) TestVote() {
+	req, err := http.NewRequest("GET", "/api/v1/vote", nil)
+	rts.Require().NoError(err)
+
+	response := rts.executeRequest(req)
+	rts.Require().Equal(http.StatusOK, response.Code)
+
+	var respBody v1.VoteResponse
+	rts.Require().NoError(json.Unmarshal(response.Body.Bytes(), &respBody))
+	rts.Require().Equal(int64(99), respBody.LastVote.Height)
+	rts.Require().Equal("ABCD", respBody.LastVote.TxHash)
+	rts.Require().True(respBody.LastVote.Success)
+	rts.Require().False(respBody.Jailed.IsJailed)
+	rts.Require().Equal(int64(90), respBody.Jailed.LastUpdatedHeight)
+	rts.Require().Equal(&v1.NextVote{VotePeriod: 11, Height: 109, CurrentHeight: 100, BlocksRemaining: 9}, respBody.NextVote)
+}
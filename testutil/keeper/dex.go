@@ -64,8 +64,9 @@ func DexKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 	blackListAddrs := map[string]bool{}
 
 	maccPerms := map[string][]string{
-		types.ModuleName:     nil,
-		minttypes.ModuleName: {authtypes.Minter},
+		types.ModuleName:        nil,
+		types.IncentivePoolName: nil,
+		minttypes.ModuleName:    {authtypes.Minter},
 	}
 
 	db := tmdb.NewMemDB()
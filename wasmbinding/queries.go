@@ -62,6 +62,61 @@ func (qp QueryPlugin) HandleOracleQuery(ctx sdk.Context, queryData json.RawMessa
 			return nil, oracletypes.ErrEncodingOracleTwaps
 		}
 
+		return bz, nil
+	case parsedQuery.FreshExchangeRate != nil:
+		res, err := qp.oracleHandler.GetFreshExchangeRate(ctx, parsedQuery.FreshExchangeRate)
+		if err != nil {
+			return nil, err
+		}
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, oracletypes.ErrEncodingFreshRate
+		}
+
+		return bz, nil
+	case parsedQuery.OracleEmas != nil:
+		res, err := qp.oracleHandler.GetOracleEmas(ctx, parsedQuery.OracleEmas)
+		if err != nil {
+			return nil, err
+		}
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, oracletypes.ErrEncodingOracleEmas
+		}
+
+		return bz, nil
+	case parsedQuery.OraclePriceRanges != nil:
+		res, err := qp.oracleHandler.GetOraclePriceRanges(ctx, parsedQuery.OraclePriceRanges)
+		if err != nil {
+			return nil, err
+		}
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, oracletypes.ErrEncodingPriceRanges
+		}
+
+		return bz, nil
+	case parsedQuery.OracleVolatilities != nil:
+		res, err := qp.oracleHandler.GetOracleVolatilities(ctx, parsedQuery.OracleVolatilities)
+		if err != nil {
+			return nil, err
+		}
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, oracletypes.ErrEncodingVolatilities
+		}
+
+		return bz, nil
+	case parsedQuery.PriceAtTimestamp != nil:
+		res, err := qp.oracleHandler.GetPriceAtTimestamp(ctx, parsedQuery.PriceAtTimestamp)
+		if err != nil {
+			return nil, err
+		}
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, oracletypes.ErrEncodingPriceAtTime
+		}
+
 		return bz, nil
 	default:
 		return nil, oracletypes.ErrUnknownSeiOracleQuery
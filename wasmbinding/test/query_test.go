@@ -136,7 +136,7 @@ func TestWasmGetOracleTwaps(t *testing.T) {
 		oracletypes.NewPriceSnapshotItem(oracleutils.MicroAtomDenom, oracletypes.OracleExchangeRate{ExchangeRate: sdk.NewDec(20), LastUpdate: sdk.NewInt(10)}),
 	}}
 	testWrapper.App.OracleKeeper.AddPriceSnapshot(testWrapper.Ctx, priceSnapshot)
-	testWrapper.App.OracleKeeper.SetVoteTarget(testWrapper.Ctx, oracleutils.MicroAtomDenom)
+	testWrapper.App.OracleKeeper.SetVoteTarget(testWrapper.Ctx, oracletypes.Denom{Name: oracleutils.MicroAtomDenom})
 
 	testWrapper.Ctx = testWrapper.Ctx.WithBlockHeight(14).WithBlockTime(time.Unix(3700, 0))
 
@@ -178,6 +178,78 @@ func TestWasmGetOracleTwapsErrorHandling(t *testing.T) {
 	require.Equal(t, err, oracletypes.ErrInvalidTwapLookback)
 }
 
+func TestWasmGetFreshExchangeRate(t *testing.T) {
+	testWrapper, customQuerier := SetupWasmbindingTest(t)
+
+	req := oraclebinding.SeiOracleQuery{FreshExchangeRate: &oracletypes.QueryFreshExchangeRateRequest{Denom: oracleutils.MicroAtomDenom, MaxAgeSeconds: 60}}
+	queryData, err := json.Marshal(req)
+	require.NoError(t, err)
+	query := wasmbinding.SeiQueryWrapper{Route: wasmbinding.OracleRoute, QueryData: queryData}
+	rawQuery, err := json.Marshal(query)
+	require.NoError(t, err)
+
+	testWrapper.Ctx = testWrapper.Ctx.WithBlockHeight(11).WithBlockTime(time.Unix(3600, 0))
+	testWrapper.App.OracleKeeper.SetBaseExchangeRate(testWrapper.Ctx, oracleutils.MicroAtomDenom, sdk.NewDec(12))
+	confidence := oracletypes.ExchangeRateConfidence{
+		NumVoters:         3,
+		VotingPowerShare:  sdk.OneDec(),
+		StandardDeviation: sdk.ZeroDec(),
+		Spread:            sdk.ZeroDec(),
+	}
+	testWrapper.App.OracleKeeper.SetExchangeRateConfidence(testWrapper.Ctx, oracleutils.MicroAtomDenom, confidence)
+
+	testWrapper.Ctx = testWrapper.Ctx.WithBlockHeight(14).WithBlockTime(time.Unix(3660, 0))
+	res, err := customQuerier(testWrapper.Ctx, rawQuery)
+	require.NoError(t, err)
+
+	var parsedRes oracletypes.QueryFreshExchangeRateResponse
+	err = json.Unmarshal(res, &parsedRes)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(12), parsedRes.OracleExchangeRate.ExchangeRate)
+	require.Equal(t, uint64(3), parsedRes.Confidence.NumVoters)
+	require.Equal(t, sdk.OneDec(), parsedRes.Confidence.VotingPowerShare)
+
+	// the exchange rate is now older than the max age
+	testWrapper.Ctx = testWrapper.Ctx.WithBlockHeight(15).WithBlockTime(time.Unix(3661, 0))
+	_, err = customQuerier(testWrapper.Ctx, rawQuery)
+	require.ErrorIs(t, err, oracletypes.ErrStaleExchangeRate)
+}
+
+func TestWasmGetOraclePriceRanges(t *testing.T) {
+	testWrapper, customQuerier := SetupWasmbindingTest(t)
+
+	req := oraclebinding.SeiOracleQuery{OraclePriceRanges: &oracletypes.QueryPriceRangesRequest{LookbackSeconds: 200}}
+	queryData, err := json.Marshal(req)
+	require.NoError(t, err)
+	query := wasmbinding.SeiQueryWrapper{Route: wasmbinding.OracleRoute, QueryData: queryData}
+	rawQuery, err := json.Marshal(query)
+	require.NoError(t, err)
+
+	// this should error because there is no snapshots to build the ranges from
+	_, err = customQuerier(testWrapper.Ctx, rawQuery)
+	require.Error(t, err)
+
+	testWrapper.Ctx = testWrapper.Ctx.WithBlockHeight(11).WithBlockTime(time.Unix(3600, 0))
+	testWrapper.App.OracleKeeper.AddPriceSnapshot(testWrapper.Ctx, oracletypes.PriceSnapshot{SnapshotTimestamp: 3600, PriceSnapshotItems: oracletypes.PriceSnapshotItems{
+		oracletypes.NewPriceSnapshotItem(oracleutils.MicroAtomDenom, oracletypes.OracleExchangeRate{ExchangeRate: sdk.NewDec(20), LastUpdate: sdk.NewInt(10)}),
+	}})
+	testWrapper.App.OracleKeeper.AddPriceSnapshot(testWrapper.Ctx, oracletypes.PriceSnapshot{SnapshotTimestamp: 3650, PriceSnapshotItems: oracletypes.PriceSnapshotItems{
+		oracletypes.NewPriceSnapshotItem(oracleutils.MicroAtomDenom, oracletypes.OracleExchangeRate{ExchangeRate: sdk.NewDec(25), LastUpdate: sdk.NewInt(12)}),
+	}})
+	testWrapper.App.OracleKeeper.SetVoteTarget(testWrapper.Ctx, oracletypes.Denom{Name: oracleutils.MicroAtomDenom})
+
+	testWrapper.Ctx = testWrapper.Ctx.WithBlockHeight(14).WithBlockTime(time.Unix(3700, 0))
+	res, err := customQuerier(testWrapper.Ctx, rawQuery)
+	require.NoError(t, err)
+
+	var parsedRes oracletypes.QueryPriceRangesResponse
+	err = json.Unmarshal(res, &parsedRes)
+	require.NoError(t, err)
+	require.Equal(t, oracletypes.QueryPriceRangesResponse{OraclePriceRanges: oracletypes.OraclePriceRanges{
+		oracletypes.OraclePriceRange{Denom: oracleutils.MicroAtomDenom, Min: sdk.NewDec(20), Max: sdk.NewDec(25), LookbackSeconds: 100},
+	}}, parsedRes)
+}
+
 func TestWasmGetDexTwaps(t *testing.T) {
 	testWrapper, customQuerier := SetupWasmbindingTest(t)
 
@@ -131,13 +131,20 @@ func (d CheckDexGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		switch m := msg.(type) {
 		case *types.MsgPlaceOrders:
 			numDependencies := len(memState.GetContractToDependencies(ctx, m.ContractAddr, d.dexKeeper.GetContractWithoutGasCharge))
-			dexGasRequired += params.DefaultGasPerOrder * uint64(len(m.Orders)*numDependencies)
+			gasPerOrder := d.dexKeeper.GetEffectiveParams(ctx, m.ContractAddr).DefaultGasPerOrder
+			dexGasRequired += gasPerOrder * uint64(len(m.Orders)*numDependencies)
 			for _, order := range m.Orders {
 				dexGasRequired += params.DefaultGasPerOrderDataByte * uint64(len(order.Data))
 			}
 		case *types.MsgCancelOrders:
 			numDependencies := len(memState.GetContractToDependencies(ctx, m.ContractAddr, d.dexKeeper.GetContractWithoutGasCharge))
 			dexGasRequired += params.DefaultGasPerCancel * uint64(len(m.Cancellations)*numDependencies)
+		case *types.MsgPlaceRoutedMarketOrder:
+			for _, leg := range m.Legs {
+				numDependencies := len(memState.GetContractToDependencies(ctx, leg.ContractAddr, d.dexKeeper.GetContractWithoutGasCharge))
+				gasPerOrder := d.dexKeeper.GetEffectiveParams(ctx, leg.ContractAddr).DefaultGasPerOrder
+				dexGasRequired += gasPerOrder*uint64(numDependencies) + params.DefaultGasPerOrderDataByte*uint64(len(leg.Data))
+			}
 		}
 	}
 	if dexGasRequired == 0 {
@@ -161,7 +168,7 @@ func (d CheckDexGasDecorator) AnteDeps(txDeps []sdkacltypes.AccessOperation, tx
 	for _, msg := range tx.GetMsgs() {
 		// Error checking will be handled in AnteHandler
 		switch msg.(type) {
-		case *types.MsgPlaceOrders, *types.MsgCancelOrders:
+		case *types.MsgPlaceOrders, *types.MsgCancelOrders, *types.MsgPlaceRoutedMarketOrder:
 			deps = append(deps, []sdkacltypes.AccessOperation{
 				// read the dex contract info
 				{
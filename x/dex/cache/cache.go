@@ -88,6 +88,15 @@ func (s *MemState) GetDepositInfo(ctx sdk.Context, contractAddr types.ContractAd
 	)
 }
 
+func (s *MemState) GetBlockRoutedOrders(ctx sdk.Context) *BlockRoutedOrders {
+	return NewRoutedOrders(
+		prefix.NewStore(
+			ctx.KVStore(s.storeKey),
+			types.KeyPrefix(types.MemRoutedOrderKey),
+		),
+	)
+}
+
 func (s *MemState) GetContractToDependencies(ctx sdk.Context, contractAddress string, loader func(sdk.Context, string) (types.ContractInfoV2, error)) []string {
 	s.contractsToDepsMtx.Lock()
 	defer s.contractsToDepsMtx.Unlock()
@@ -118,6 +127,7 @@ func (s *MemState) Clear(ctx sdk.Context) {
 	DeepDelete(ctx.KVStore(s.storeKey), types.KeyPrefix(types.MemOrderKey), func(_ []byte) bool { return true })
 	DeepDelete(ctx.KVStore(s.storeKey), types.KeyPrefix(types.MemCancelKey), func(_ []byte) bool { return true })
 	DeepDelete(ctx.KVStore(s.storeKey), types.KeyPrefix(types.MemDepositKey), func(_ []byte) bool { return true })
+	DeepDelete(ctx.KVStore(s.storeKey), types.KeyPrefix(types.MemRoutedOrderKey), func(_ []byte) bool { return true })
 
 	newContractToDependencies := datastructures.NewSyncSet([]string{})
 	s.contractsToProcess = &newContractToDependencies
@@ -165,6 +175,8 @@ func (s *MemState) DeepFilterAccount(ctx sdk.Context, account string) {
 		}
 		return d.Creator == account
 	})
+	// routed orders are kept since their funds are held by the module: a route
+	// through a filtered contract fails when it's executed and is refunded
 }
 
 func (s *MemState) SynchronizeAccess(ctx sdk.Context, contractAddr types.ContractAddress) {
@@ -0,0 +1,43 @@
+package dex
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+type BlockRoutedOrders struct {
+	routedOrderStore *prefix.Store
+}
+
+func NewRoutedOrders(routedOrderStore prefix.Store) *BlockRoutedOrders {
+	return &BlockRoutedOrders{routedOrderStore: &routedOrderStore}
+}
+
+// Add appends the routed order after all routed orders already added in this block,
+// so that routed orders are executed in the order they were received.
+func (o *BlockRoutedOrders) Add(newItem *types.RoutedOrder) {
+	keybz := make([]byte, 8)
+	binary.BigEndian.PutUint64(keybz, uint64(len(o.Get())))
+	valbz, err := newItem.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	o.routedOrderStore.Set(keybz, valbz)
+}
+
+func (o *BlockRoutedOrders) Get() (list []*types.RoutedOrder) {
+	iterator := sdk.KVStorePrefixIterator(o.routedOrderStore, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RoutedOrder
+		if err := val.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		list = append(list, &val)
+	}
+
+	return
+}
@@ -25,6 +25,7 @@ func GetQueryCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryEffectiveParams())
 	cmd.AddCommand(CmdListLongBook())
 	cmd.AddCommand(CmdShowLongBook())
 	cmd.AddCommand(CmdListShortBook())
@@ -41,6 +42,9 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdGetOrdersByID())
 	cmd.AddCommand(CmdGetMatchResult())
 	cmd.AddCommand(CmdGetOrderCount())
+	cmd.AddCommand(CmdShowIncentiveCampaign())
+	cmd.AddCommand(CmdListIncentiveCampaigns())
+	cmd.AddCommand(CmdListIncentiveScores())
 
 	// this line is used by starport scaffolding # 1
 
@@ -0,0 +1,93 @@
+package query
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdShowIncentiveCampaign() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-incentive-campaign [campaign id]",
+		Short: "shows a market-making incentive campaign",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			id, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IncentiveCampaign(context.Background(), &types.QueryIncentiveCampaignRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListIncentiveCampaigns() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-incentive-campaigns",
+		Short: "lists the market-making incentive campaigns that have not been paid out",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IncentiveCampaigns(context.Background(), &types.QueryIncentiveCampaignsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListIncentiveScores() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-incentive-scores [campaign id]",
+		Short: "lists the accumulated scores of a market-making incentive campaign",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			id, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IncentiveScores(context.Background(), &types.QueryIncentiveScoresRequest{CampaignId: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -32,3 +32,27 @@ func CmdQueryParams() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryEffectiveParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "effective-params [contract address]",
+		Short: "shows the parameters in effect for a contract, including its overrides",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EffectiveParams(context.Background(), &types.QueryEffectiveParamsRequest{ContractAddr: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
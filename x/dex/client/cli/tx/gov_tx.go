@@ -58,3 +58,56 @@ func NewAddAssetProposalTxCmd() *cobra.Command {
 
 	return cmd
 }
+
+// NewUpdateParamsProposalTxCmd returns a CLI command handler for creating
+// an update dex params proposal governance transaction.
+func NewUpdateParamsProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-params-proposal [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an update dex params proposal",
+		Long: strings.TrimSpace(`
+			Submit a proposal to update the dex module params and/or per-contract param overrides.
+			Params may be omitted to only change overrides. An override with all values set to
+			zero removes the existing override for that contract.
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := cutils.ParseUpdateParamsProposalJSON(clientCtx.LegacyAmino, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.UpdateParamsProposal{
+				Title:             proposal.Title,
+				Description:       proposal.Description,
+				Params:            proposal.Params,
+				ContractOverrides: proposal.ContractOverrides,
+			}
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
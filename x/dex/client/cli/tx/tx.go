@@ -33,7 +33,10 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdUpdatePriceTickSize())
 	cmd.AddCommand(CmdUpdateQuantityTickSize())
 	cmd.AddCommand(NewAddAssetProposalTxCmd())
+	cmd.AddCommand(NewUpdateParamsProposalTxCmd())
 	cmd.AddCommand(CmdUnsuspendContract())
+	cmd.AddCommand(CmdPlaceRoutedMarketOrder())
+	cmd.AddCommand(CmdCreateIncentiveCampaign())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
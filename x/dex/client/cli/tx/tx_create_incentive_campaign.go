@@ -0,0 +1,68 @@
+package tx
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdCreateIncentiveCampaign() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-incentive-campaign [contract address] [price denom] [asset denom] [reward pool] [num epochs] [max spread] [max levels]",
+		Short: "Create a market-making incentive campaign",
+		Long: strings.TrimSpace(`
+			Fund a reward pool for accounts that rest two-sided liquidity close to the mid price of a pair. The campaign starts
+			with the next epoch and runs for [num epochs] epochs. Orders further than [max spread] (a ratio of the mid price) from
+			the mid, or beyond the first [max levels] price levels of each side, are not scored.
+		`),
+		Args: cobra.ExactArgs(7),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argRewardPool, err := sdk.ParseCoinsNormalized(args[3])
+			if err != nil {
+				return err
+			}
+			argNumEpochs, err := cast.ToUint64E(args[4])
+			if err != nil {
+				return err
+			}
+			argMaxSpread, err := sdk.NewDecFromStr(args[5])
+			if err != nil {
+				return err
+			}
+			argMaxLevels, err := cast.ToUint64E(args[6])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateIncentiveCampaign(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				args[2],
+				argRewardPool,
+				argNumEpochs,
+				argMaxSpread,
+				argMaxLevels,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
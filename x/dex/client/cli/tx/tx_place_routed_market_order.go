@@ -0,0 +1,84 @@
+package tx
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdPlaceRoutedMarketOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "place-routed-market-order [amount in] [min amount out] [legs...]",
+		Short: "Place a multi-hop market order",
+		Long: strings.TrimSpace(`
+			Place a market order that is routed through several order books, possibly in different contracts. All legs are filled atomically at the end of the block, or none is.
+			The amount in is sent along with the order and the output of the route is paid to the sender, or the amount in is refunded if the route isn't filled.
+			Legs are represented as strings with the leg details separated by "?". Leg details format is ContractAddress?OrderDirection?PriceAsset?QuoteAsset?OrderData.
+		`),
+		Args: cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			amountIn, err := sdk.NewDecFromStr(args[0])
+			if err != nil {
+				return err
+			}
+			minAmountOut, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+			legs := []*types.RouteLeg{}
+			for _, leg := range args[2:] {
+				legDetails := strings.Split(leg, "?")
+				if len(legDetails) < 4 {
+					return errors.New("leg must be of the form ContractAddress?OrderDirection?PriceAsset?QuoteAsset?OrderData")
+				}
+				argPositionDir, err := types.GetPositionDirectionFromStr(legDetails[1])
+				if err != nil {
+					return err
+				}
+				newLeg := types.RouteLeg{
+					ContractAddr:      legDetails[0],
+					PositionDirection: argPositionDir,
+					PriceDenom:        legDetails[2],
+					AssetDenom:        legDetails[3],
+				}
+				if len(legDetails) > 4 {
+					newLeg.Data = legDetails[4]
+				}
+				legs = append(legs, &newLeg)
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// the funds of a route are its amount in
+			if !amountIn.IsInteger() {
+				return errors.New("amount in must be a whole amount of the first leg's denom")
+			}
+			amount := sdk.NewCoins(sdk.NewCoin(legs[0].InputDenom(), amountIn.TruncateInt()))
+
+			msg := types.NewMsgPlaceRoutedMarketOrder(
+				clientCtx.GetFromAddress().String(),
+				legs,
+				amountIn,
+				minAmountOut,
+				amount,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
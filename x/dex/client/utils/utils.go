@@ -52,6 +52,14 @@ type (
 		AssetList   AssetListJSON `json:"asset_list" yaml:"asset_list"`
 		Deposit     string        `json:"deposit" yaml:"deposit"`
 	}
+
+	UpdateParamsProposalJSON struct {
+		Title             string                            `json:"title" yaml:"title"`
+		Description       string                            `json:"description" yaml:"description"`
+		Params            *dextypes.Params                  `json:"params" yaml:"params"`
+		ContractOverrides []dextypes.ContractParamsOverride `json:"contract_overrides" yaml:"contract_overrides"`
+		Deposit           string                            `json:"deposit" yaml:"deposit"`
+	}
 )
 
 // TODO: ADD utils to convert Each type to dex/type (string to denom)
@@ -201,3 +209,20 @@ func ParseAddAssetMetadataProposalJSON(cdc *codec.LegacyAmino, proposalFile stri
 
 	return proposal, nil
 }
+
+// ParseUpdateParamsProposalJSON reads and parses an UpdateParamsProposalJSON from
+// a file.
+func ParseUpdateParamsProposalJSON(cdc *codec.LegacyAmino, proposalFile string) (UpdateParamsProposalJSON, error) {
+	proposal := UpdateParamsProposalJSON{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
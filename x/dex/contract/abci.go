@@ -70,6 +70,7 @@ func EndBlockerAtomic(ctx sdk.Context, keeper *keeper.Keeper, validContractsInfo
 		panic(err)
 	}
 
+	handleRoutedOrders(spanCtx, cachedCtx, env, keeper, tracer)
 	handleSettlements(spanCtx, cachedCtx, env, keeper, tracer)
 	handleUnfulfilledMarketOrders(spanCtx, cachedCtx, env, keeper, tracer)
 
@@ -195,6 +196,7 @@ func handleSettlements(ctx context.Context, sdkCtx sdk.Context, env *environment
 			contractsNeedOrderMatching.Add(contract.ContractAddr)
 		}
 	}
+	trackFills := keeper.GetParams(sdkCtx).MaxOrderToTradeRatio > 0
 	env.settlementsByContract.Range(func(contractAddr string, settlements []*types.SettlementEntry) bool {
 		if !contractsNeedOrderMatching.Contains(contractAddr) {
 			return true
@@ -202,6 +204,10 @@ func handleSettlements(ctx context.Context, sdkCtx sdk.Context, env *environment
 		if err := HandleSettlements(sdkCtx, contractAddr, keeper, settlements); err != nil {
 			sdkCtx.Logger().Error(fmt.Sprintf("Error handling settlements for %s", contractAddr))
 			env.addError(contractAddr, err)
+			return true
+		}
+		if trackFills {
+			RecordFills(sdkCtx, contractAddr, keeper, settlements)
 		}
 		return true
 	})
@@ -0,0 +1,95 @@
+package contract
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+)
+
+// EmitOrderFillEvents emits one typed fill event for every order that has settlements
+// in this block. A limit order is filled once it no longer rests on the order book, and
+// a market order once matching has marked it as fulfilled in the block's mem state.
+// It is expected to be called after the order books have been flushed.
+func EmitOrderFillEvents(ctx sdk.Context, dexkeeper *keeper.Keeper, contractAddr string, settlements []*types.SettlementEntry) {
+	orderIDs := []uint64{}
+	filledQuantities := map[uint64]sdk.Dec{}
+	entries := map[uint64]*types.SettlementEntry{}
+	for _, settlement := range settlements {
+		if _, ok := entries[settlement.OrderId]; !ok {
+			orderIDs = append(orderIDs, settlement.OrderId)
+			entries[settlement.OrderId] = settlement
+			filledQuantities[settlement.OrderId] = sdk.ZeroDec()
+		}
+		filledQuantities[settlement.OrderId] = filledQuantities[settlement.OrderId].Add(settlement.Quantity)
+	}
+	for _, orderID := range orderIDs {
+		entry := entries[orderID]
+		direction, err := types.GetPositionDirectionFromStr(entry.PositionDirection)
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("unknown position direction %s in settlement of order %d", entry.PositionDirection, orderID))
+			continue
+		}
+		orderType, err := types.GetOrderTypeFromStr(entry.OrderType)
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("unknown order type %s in settlement of order %d", entry.OrderType, orderID))
+			continue
+		}
+		var filled bool
+		if orderType == types.OrderType_LIMIT {
+			filled = !isOnOrderBook(ctx, dexkeeper, contractAddr, entry, direction)
+		} else {
+			pair := types.Pair{PriceDenom: entry.PriceDenom, AssetDenom: entry.AssetDenom}
+			order := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(contractAddr), pair).GetByID(orderID)
+			filled = order.Status == types.OrderStatus_FULFILLED
+		}
+		var event proto.Message
+		if filled {
+			event = &types.EventOrderFilled{
+				ContractAddr:      contractAddr,
+				OrderId:           orderID,
+				Account:           entry.Account,
+				PriceDenom:        entry.PriceDenom,
+				AssetDenom:        entry.AssetDenom,
+				PositionDirection: direction,
+				OrderType:         orderType,
+				Quantity:          filledQuantities[orderID],
+			}
+		} else {
+			event = &types.EventOrderPartiallyFilled{
+				ContractAddr:      contractAddr,
+				OrderId:           orderID,
+				Account:           entry.Account,
+				PriceDenom:        entry.PriceDenom,
+				AssetDenom:        entry.AssetDenom,
+				PositionDirection: direction,
+				OrderType:         orderType,
+				Quantity:          filledQuantities[orderID],
+			}
+		}
+		if err := types.EmitTypedEvent(ctx, event); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to emit fill event for order %d of %s: %s", orderID, contractAddr, err))
+		}
+	}
+}
+
+func isOnOrderBook(ctx sdk.Context, dexkeeper *keeper.Keeper, contractAddr string, entry *types.SettlementEntry, direction types.PositionDirection) bool {
+	getter := dexkeeper.GetLongOrderBookEntryByPrice
+	if direction == types.PositionDirection_SHORT {
+		getter = dexkeeper.GetShortOrderBookEntryByPrice
+	}
+	// limit order settlements carry the price level of the order as the expected price
+	bookEntry, found := getter(ctx, contractAddr, entry.ExpectedCostOrProceed, entry.PriceDenom, entry.AssetDenom)
+	if !found {
+		return false
+	}
+	for _, allocation := range bookEntry.GetOrderEntry().Allocations {
+		if allocation.OrderId == entry.OrderId {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+package contract_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/contract"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutil "github.com/sei-protocol/sei-chain/x/dex/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmitOrderFillEvents(t *testing.T) {
+	pair := types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"}
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	// order 1 is still partially on the book while order 2 is gone
+	dexkeeper.SetLongBook(ctx, TEST_CONTRACT, types.LongBook{
+		Price: sdk.NewDec(100),
+		Entry: &types.OrderEntry{
+			Price:       sdk.NewDec(100),
+			Quantity:    sdk.NewDec(1),
+			Allocations: []*types.Allocation{{OrderId: 1, Account: "abc", Quantity: sdk.NewDec(1)}},
+			PriceDenom:  pair.PriceDenom,
+			AssetDenom:  pair.AssetDenom,
+		},
+	})
+	blockOrders := dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(TEST_CONTRACT), pair)
+	blockOrders.Add(&types.Order{Id: 3, Account: "ghi", OrderType: types.OrderType_MARKET, PriceDenom: pair.PriceDenom, AssetDenom: pair.AssetDenom, Status: types.OrderStatus_FULFILLED})
+	blockOrders.Add(&types.Order{Id: 4, Account: "ghi", OrderType: types.OrderType_MARKET, PriceDenom: pair.PriceDenom, AssetDenom: pair.AssetDenom, Status: types.OrderStatus_PLACED})
+
+	settlements := []*types.SettlementEntry{
+		types.NewSettlementEntry(ctx, 1, "abc", types.PositionDirection_LONG, pair.PriceDenom, pair.AssetDenom, sdk.NewDec(1), sdk.NewDec(100), sdk.NewDec(100), types.OrderType_LIMIT),
+		types.NewSettlementEntry(ctx, 2, "def", types.PositionDirection_LONG, pair.PriceDenom, pair.AssetDenom, sdk.NewDec(1), sdk.NewDec(100), sdk.NewDec(100), types.OrderType_LIMIT),
+		types.NewSettlementEntry(ctx, 2, "def", types.PositionDirection_LONG, pair.PriceDenom, pair.AssetDenom, sdk.NewDec(2), sdk.NewDec(100), sdk.NewDec(100), types.OrderType_LIMIT),
+		types.NewSettlementEntry(ctx, 3, "ghi", types.PositionDirection_SHORT, pair.PriceDenom, pair.AssetDenom, sdk.NewDec(3), sdk.NewDec(100), sdk.NewDec(100), types.OrderType_MARKET),
+		types.NewSettlementEntry(ctx, 4, "ghi", types.PositionDirection_SHORT, pair.PriceDenom, pair.AssetDenom, sdk.NewDec(1), sdk.NewDec(100), sdk.NewDec(100), types.OrderType_MARKET),
+	}
+	contract.EmitOrderFillEvents(ctx, dexkeeper, TEST_CONTRACT, settlements)
+
+	events := ctx.EventManager().ABCIEvents()
+	require.Equal(t, 4, len(events))
+	expected := []struct {
+		filled   bool
+		orderID  uint64
+		quantity sdk.Dec
+	}{
+		{false, 1, sdk.NewDec(1)},
+		{true, 2, sdk.NewDec(3)},
+		{true, 3, sdk.NewDec(3)},
+		{false, 4, sdk.NewDec(1)},
+	}
+	for i, event := range events {
+		parsed, err := types.ParseTypedEvent(event)
+		require.Nil(t, err)
+		if expected[i].filled {
+			filled, ok := parsed.(*types.EventOrderFilled)
+			require.True(t, ok)
+			require.Equal(t, expected[i].orderID, filled.OrderId)
+			require.Equal(t, expected[i].quantity, filled.Quantity)
+		} else {
+			partial, ok := parsed.(*types.EventOrderPartiallyFilled)
+			require.True(t, ok)
+			require.Equal(t, expected[i].orderID, partial.OrderId)
+			require.Equal(t, expected[i].quantity, partial.Quantity)
+		}
+	}
+}
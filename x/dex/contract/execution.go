@@ -147,6 +147,7 @@ func ExecutePairsInParallel(ctx sdk.Context, contractAddr string, dexkeeper *kee
 				panic(fmt.Sprintf("Orderbook not found for %s", pairCopy.String()))
 			}
 			pairSettlements := ExecutePair(pairCtx, contractAddr, pair, dexkeeper, orderbook)
+			EmitOrderFillEvents(pairCtx, dexkeeper, contractAddr, pairSettlements)
 			orderIDToSettledQuantities := GetOrderIDToSettledQuantities(pairSettlements)
 			PrepareCancelUnfulfilledMarketOrders(pairCtx, typedContractAddr, pairCopy, orderIDToSettledQuantities)
 
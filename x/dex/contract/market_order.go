@@ -2,6 +2,7 @@ package contract
 
 import (
 	"context"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sei-protocol/sei-chain/x/dex/keeper"
@@ -19,11 +20,24 @@ func PrepareCancelUnfulfilledMarketOrders(
 	orderIDToSettledQuantities map[uint64]sdk.Dec,
 ) {
 	dexutils.GetMemState(ctx.Context()).ClearCancellationForPair(ctx, typedContractAddr, pair)
+	blockOrders := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair)
 	for _, marketOrderID := range getUnfulfilledPlacedMarketOrderIds(ctx, typedContractAddr, pair, orderIDToSettledQuantities) {
 		dexutils.GetMemState(ctx.Context()).GetBlockCancels(ctx, typedContractAddr, pair).Add(&types.Cancellation{
 			Id:        marketOrderID,
 			Initiator: types.CancellationInitiator_USER,
 		})
+		order := blockOrders.GetByID(marketOrderID)
+		if err := types.EmitTypedEvent(ctx, &types.EventOrderCancelled{
+			ContractAddr:      string(typedContractAddr),
+			OrderId:           marketOrderID,
+			Account:           order.Account,
+			PriceDenom:        pair.PriceDenom,
+			AssetDenom:        pair.AssetDenom,
+			PositionDirection: order.PositionDirection,
+			Reason:            types.CancelReasonUnfilledMarketOrder,
+		}); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to emit cancel event for order %d: %s", marketOrderID, err))
+		}
 	}
 }
 
@@ -0,0 +1,227 @@
+package contract
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sei-protocol/sei-chain/x/dex/exchange"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	dexkeeperutils "github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+	"github.com/sei-protocol/sei-chain/x/store"
+	otrace "go.opentelemetry.io/otel/trace"
+)
+
+type OrderBookGetter func(contractAddr string, pair types.Pair) (*types.OrderBook, bool)
+
+// handleRoutedOrders runs after all contracts have matched their own orders, so that
+// routed orders take liquidity from books that already reflect this block's limit orders.
+// Routed orders are executed sequentially in the order they were received.
+func handleRoutedOrders(ctx context.Context, sdkCtx sdk.Context, env *environment, keeper *keeper.Keeper, tracer *otrace.Tracer) {
+	_, span := (*tracer).Start(ctx, "DexEndBlockerHandleRoutedOrders")
+	defer span.End()
+	defer telemetry.MeasureSince(time.Now(), "dex", "handle_routed_orders")
+	for _, routedOrder := range dexutils.GetMemState(sdkCtx.Context()).GetBlockRoutedOrders(sdkCtx).Get() {
+		settlementsByContract, amountOut, err := ExecuteRoutedOrder(sdkCtx, keeper, routedOrder, env.getOrderBookForRouting)
+		if err != nil {
+			sdkCtx.Logger().Info(fmt.Sprintf("routed order from %s failed: %s", routedOrder.Account, err))
+			telemetry.IncrCounter(1, "dex", "routed_order_failed")
+			sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeRoutedOrderFailed,
+				sdk.NewAttribute(types.AttributeKeyAccount, routedOrder.Account),
+				sdk.NewAttribute(types.AttributeKeyAmountIn, routedOrder.AmountIn.String()),
+				sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+			))
+			continue
+		}
+		// iterate legs instead of the map for a deterministic order
+		for _, leg := range routedOrder.Legs {
+			settlements, ok := settlementsByContract[leg.ContractAddr]
+			if !ok {
+				continue
+			}
+			EmitOrderFillEvents(sdkCtx, keeper, leg.ContractAddr, settlements)
+			// the route's account has no balance in the contract, since the
+			// route's funds were moved by the module, so only the counterparties
+			// are settled by the contract
+			existing, _ := env.settlementsByContract.Load(leg.ContractAddr)
+			env.settlementsByContract.Store(leg.ContractAddr, append(existing, counterpartySettlements(routedOrder, leg.ContractAddr, settlements)...))
+			delete(settlementsByContract, leg.ContractAddr)
+		}
+		telemetry.IncrCounter(1, "dex", "routed_order_filled")
+		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeRoutedOrderFilled,
+			sdk.NewAttribute(types.AttributeKeyAccount, routedOrder.Account),
+			sdk.NewAttribute(types.AttributeKeyAmountIn, routedOrder.AmountIn.String()),
+			sdk.NewAttribute(types.AttributeKeyAmountOut, amountOut.String()),
+		))
+	}
+}
+
+// RefundRoutedOrders refunds the funds of the routed orders of the block, for when the
+// end blocker gives up before executing them.
+func RefundRoutedOrders(ctx sdk.Context, dexkeeper *keeper.Keeper) {
+	for _, routedOrder := range dexutils.GetMemState(ctx.Context()).GetBlockRoutedOrders(ctx).Get() {
+		if err := refundRoutedOrder(ctx, dexkeeper, routedOrder); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to refund routed order from %s: %s", routedOrder.Account, err))
+		}
+	}
+}
+
+// getOrderBookForRouting only exposes books of contracts that are still healthy in this
+// iteration of the end blocker, so that a route never settles against a rolled back contract.
+func (e *environment) getOrderBookForRouting(contractAddr string, pair types.Pair) (*types.OrderBook, bool) {
+	if _, failed := e.failedContractAddressesToErrors.Load(contractAddr); failed {
+		return nil, false
+	}
+	if e.outOfRentContractAddresses.Contains(contractAddr) {
+		return nil, false
+	}
+	needOrderMatching := false
+	for _, contract := range e.validContractsInfo {
+		if contract.ContractAddr == contractAddr {
+			needOrderMatching = contract.NeedOrderMatching
+			break
+		}
+	}
+	if !needOrderMatching {
+		return nil, false
+	}
+	return e.orderBooks.LoadNested(contractAddr, types.GetPairString(&pair))
+}
+
+// ExecuteRoutedOrder matches each leg of a routed order in sequence, feeding the output of a
+// leg as the input of the next one. Legs are matched fill-or-kill, and the whole route is
+// reverted and its funds refunded if any leg cannot be filled or if the final output is below
+// the route's minimum.
+//
+// The funds of the route are held by the module, and the input of each leg is sent to the
+// contract of the leg, which is where the counterparties of the leg hold the output. The output
+// of the last leg is paid to the route's account. On success, the settlements of every leg are
+// returned keyed by contract address.
+func ExecuteRoutedOrder(
+	ctx sdk.Context,
+	dexkeeper *keeper.Keeper,
+	routedOrder *types.RoutedOrder,
+	getOrderBook OrderBookGetter,
+) (map[string][]*types.SettlementEntry, sdk.Dec, error) {
+	routeCtx, routeStore := store.GetCachedContext(ctx)
+	touchedOrderBooks := []*types.OrderBook{}
+	fail := func(err error) (map[string][]*types.SettlementEntry, sdk.Dec, error) {
+		// order book caches may hold entries flushed to the discarded store
+		for _, orderbook := range touchedOrderBooks {
+			orderbook.Longs.Refresh(ctx)
+			orderbook.Shorts.Refresh(ctx)
+		}
+		if refundErr := refundRoutedOrder(ctx, dexkeeper, routedOrder); refundErr != nil {
+			return nil, sdk.ZeroDec(), sdkerrors.Wrapf(err, "failed to refund the route's funds (%s)", refundErr)
+		}
+		return nil, sdk.ZeroDec(), err
+	}
+
+	settlementsByContract := map[string][]*types.SettlementEntry{}
+	holder := dexkeeper.AccountKeeper.GetModuleAddress(types.ModuleName)
+	amount := routedOrder.AmountIn
+	for i, leg := range routedOrder.Legs {
+		pair := types.Pair{PriceDenom: leg.PriceDenom, AssetDenom: leg.AssetDenom}
+		orderbook, found := getOrderBook(leg.ContractAddr, pair)
+		if !found {
+			return fail(sdkerrors.Wrapf(types.ErrRouteNotFilled, "no order book for leg %d on %s", i, leg.ContractAddr))
+		}
+		touchedOrderBooks = append(touchedOrderBooks, orderbook)
+
+		// carry the input of the leg into its contract
+		contractAddr := sdk.MustAccAddressFromBech32(leg.ContractAddr)
+		if err := sendRouteFunds(routeCtx, dexkeeper, holder, contractAddr, routeInputDenom(leg), amount); err != nil {
+			return fail(sdkerrors.Wrapf(types.ErrRouteNotFilled, "failed to move the input of leg %d to %s: %s", i, leg.ContractAddr, err))
+		}
+		holder = contractAddr
+
+		order := *leg
+		var entries *types.CachedSortedOrderBookEntries
+		if leg.PositionDirection == types.PositionDirection_LONG {
+			// spend exactly `amount` of the price denom, without a cap on the quantity bought
+			order.OrderType = types.OrderType_FOKMARKETBYVALUE
+			order.Nominal = amount
+			order.Quantity = sdk.MaxSortableDec
+			entries = orderbook.Shorts
+		} else {
+			order.OrderType = types.OrderType_FOKMARKET
+			order.Quantity = amount
+			entries = orderbook.Longs
+		}
+		blockOrders := dexutils.GetMemState(routeCtx.Context()).GetBlockOrders(routeCtx, types.ContractAddress(leg.ContractAddr), pair)
+		blockOrders.Add(&order)
+		outcome := exchange.MatchMarketOrders(routeCtx, []*types.Order{&order}, entries, leg.PositionDirection, blockOrders)
+		if !outcome.TotalQuantity.IsPositive() {
+			return fail(sdkerrors.Wrapf(types.ErrRouteNotFilled, "leg %d on %s could not be filled", i, leg.ContractAddr))
+		}
+		dexkeeperutils.SetPriceStateFromExecutionOutcome(routeCtx, dexkeeper, types.ContractAddress(leg.ContractAddr), pair, outcome)
+		settlementsByContract[leg.ContractAddr] = append(settlementsByContract[leg.ContractAddr], outcome.Settlements...)
+
+		// only whole coins of the output can be moved out of the contract
+		if leg.PositionDirection == types.PositionDirection_LONG {
+			amount = outcome.TotalQuantity.TruncateDec()
+		} else {
+			amount = outcome.TotalNotional.TruncateDec()
+		}
+	}
+
+	if amount.LT(routedOrder.MinAmountOut) {
+		return fail(sdkerrors.Wrapf(types.ErrRouteNotFilled, "route yields %s, less than the minimum of %s", amount, routedOrder.MinAmountOut))
+	}
+	lastLeg := routedOrder.Legs[len(routedOrder.Legs)-1]
+	if err := sendRouteFunds(routeCtx, dexkeeper, holder, sdk.MustAccAddressFromBech32(routedOrder.Account), routeOutputDenom(lastLeg), amount); err != nil {
+		return fail(sdkerrors.Wrapf(types.ErrRouteNotFilled, "failed to pay the output of the route from %s: %s", lastLeg.ContractAddr, err))
+	}
+	routeStore.Write()
+	return settlementsByContract, amount, nil
+}
+
+func sendRouteFunds(ctx sdk.Context, dexkeeper *keeper.Keeper, from sdk.AccAddress, to sdk.AccAddress, denom string, amount sdk.Dec) error {
+	if from.Equals(to) || !amount.IsPositive() {
+		return nil
+	}
+	return dexkeeper.BankKeeper.SendCoins(ctx, from, to, sdk.NewCoins(sdk.NewCoin(denom, amount.TruncateInt())))
+}
+
+func refundRoutedOrder(ctx sdk.Context, dexkeeper *keeper.Keeper, routedOrder *types.RoutedOrder) error {
+	refund := sdk.NewCoins(sdk.NewCoin(routeInputDenom(routedOrder.Legs[0]), routedOrder.AmountIn.TruncateInt()))
+	return dexkeeper.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.MustAccAddressFromBech32(routedOrder.Account), refund)
+}
+
+// counterpartySettlements filters out the settlements of the route's own legs on the contract.
+func counterpartySettlements(routedOrder *types.RoutedOrder, contractAddr string, settlements []*types.SettlementEntry) []*types.SettlementEntry {
+	legIDs := map[uint64]struct{}{}
+	for _, leg := range routedOrder.Legs {
+		if leg.ContractAddr == contractAddr {
+			legIDs[leg.Id] = struct{}{}
+		}
+	}
+	filtered := []*types.SettlementEntry{}
+	for _, settlement := range settlements {
+		if _, ok := legIDs[settlement.OrderId]; !ok {
+			filtered = append(filtered, settlement)
+		}
+	}
+	return filtered
+}
+
+func routeInputDenom(leg *types.Order) string {
+	if leg.PositionDirection == types.PositionDirection_LONG {
+		return leg.PriceDenom
+	}
+	return leg.AssetDenom
+}
+
+func routeOutputDenom(leg *types.Order) string {
+	if leg.PositionDirection == types.PositionDirection_LONG {
+		return leg.AssetDenom
+	}
+	return leg.PriceDenom
+}
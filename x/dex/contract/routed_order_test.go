@@ -0,0 +1,205 @@
+package contract_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/contract"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	keeperutil "github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	minttypes "github.com/sei-protocol/sei-chain/x/mint/types"
+	"github.com/stretchr/testify/require"
+)
+
+const OtherContract = "sei14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sh9m79m"
+
+// setUpRoutedOrderBooks lists ATOM/USDC on the test contract and OSMO/USDC on
+// osmoContract, and funds the contracts with what their makers sell.
+func setUpRoutedOrderBooks(t *testing.T, ctx sdk.Context, dexkeeper *keeper.Keeper, osmoContract string) contract.OrderBookGetter {
+	// ATOM can be sold for USDC
+	dexkeeper.SetLongOrderBookEntry(ctx, keepertest.TestContract, &types.LongBook{
+		Price: sdk.NewDec(100),
+		Entry: &types.OrderEntry{
+			Price:    sdk.NewDec(100),
+			Quantity: sdk.NewDec(5),
+			Allocations: []*types.Allocation{{
+				OrderId:  1,
+				Account:  "abc",
+				Quantity: sdk.NewDec(5),
+			}},
+			PriceDenom: "USDC",
+			AssetDenom: "ATOM",
+		},
+	})
+	// USDC can buy OSMO
+	dexkeeper.SetShortOrderBookEntry(ctx, osmoContract, &types.ShortBook{
+		Price: sdk.NewDec(10),
+		Entry: &types.OrderEntry{
+			Price:    sdk.NewDec(10),
+			Quantity: sdk.NewDec(30),
+			Allocations: []*types.Allocation{{
+				OrderId:  1,
+				Account:  "def",
+				Quantity: sdk.NewDec(30),
+			}},
+			PriceDenom: "USDC",
+			AssetDenom: "OSMO",
+		},
+	})
+	fundAccount(t, ctx, dexkeeper, sdk.MustAccAddressFromBech32(keepertest.TestContract), sdk.NewCoins(sdk.NewCoin("USDC", sdk.NewInt(500))))
+	fundAccount(t, ctx, dexkeeper, sdk.MustAccAddressFromBech32(osmoContract), sdk.NewCoins(sdk.NewCoin("OSMO", sdk.NewInt(30))))
+
+	atomBook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(keepertest.TestContract), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
+	osmoBook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(osmoContract), types.Pair{PriceDenom: "USDC", AssetDenom: "OSMO"})
+	return func(contractAddr string, pair types.Pair) (*types.OrderBook, bool) {
+		if contractAddr == keepertest.TestContract && pair.AssetDenom == "ATOM" {
+			return atomBook, true
+		}
+		if contractAddr == osmoContract && pair.AssetDenom == "OSMO" {
+			return osmoBook, true
+		}
+		return nil, false
+	}
+}
+
+func fundAccount(t *testing.T, ctx sdk.Context, dexkeeper *keeper.Keeper, addr sdk.AccAddress, amounts sdk.Coins) {
+	require.NoError(t, dexkeeper.BankKeeper.MintCoins(ctx, minttypes.ModuleName, amounts))
+	require.NoError(t, dexkeeper.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, amounts))
+}
+
+// routedOrder sells ATOM for USDC on the test contract, then buys OSMO with the
+// USDC on osmoContract. Its funds are held by the module.
+func routedOrder(t *testing.T, ctx sdk.Context, dexkeeper *keeper.Keeper, osmoContract string, amountIn int64, minAmountOut sdk.Dec) *types.RoutedOrder {
+	dexkeeper.CreateModuleAccount(ctx)
+	escrow := sdk.NewCoins(sdk.NewCoin("ATOM", sdk.NewInt(amountIn)))
+	require.NoError(t, dexkeeper.BankKeeper.MintCoins(ctx, minttypes.ModuleName, escrow))
+	require.NoError(t, dexkeeper.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, types.ModuleName, escrow))
+	return &types.RoutedOrder{
+		Account: keepertest.TestAccount,
+		Legs: []*types.Order{
+			{
+				Id:                10,
+				Account:           keepertest.TestAccount,
+				ContractAddr:      keepertest.TestContract,
+				Price:             sdk.ZeroDec(),
+				PriceDenom:        "USDC",
+				AssetDenom:        "ATOM",
+				PositionDirection: types.PositionDirection_SHORT,
+			},
+			{
+				Id:                20,
+				Account:           keepertest.TestAccount,
+				ContractAddr:      osmoContract,
+				Price:             sdk.ZeroDec(),
+				PriceDenom:        "USDC",
+				AssetDenom:        "OSMO",
+				PositionDirection: types.PositionDirection_LONG,
+			},
+		},
+		AmountIn:     sdk.NewDec(amountIn),
+		MinAmountOut: minAmountOut,
+	}
+}
+
+func TestExecuteRoutedOrder(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	getOrderBook := setUpRoutedOrderBooks(t, ctx, dexkeeper, keepertest.TestContract)
+
+	settlements, amountOut, err := contract.ExecuteRoutedOrder(ctx, dexkeeper, routedOrder(t, ctx, dexkeeper, keepertest.TestContract, 2, sdk.NewDec(20)), getOrderBook)
+	require.Nil(t, err)
+	// 2 ATOM -> 200 USDC -> 20 OSMO
+	require.Equal(t, sdk.NewDec(20), amountOut)
+	require.Equal(t, 4, len(settlements[keepertest.TestContract]))
+
+	atomLong, found := dexkeeper.GetLongBookByPrice(ctx, keepertest.TestContract, sdk.NewDec(100), "USDC", "ATOM")
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(3), atomLong.Entry.Quantity)
+	osmoShort, found := dexkeeper.GetShortBookByPrice(ctx, keepertest.TestContract, sdk.NewDec(10), "USDC", "OSMO")
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(10), osmoShort.Entry.Quantity)
+
+	// the output is paid to the account
+	account := sdk.MustAccAddressFromBech32(keepertest.TestAccount)
+	require.Equal(t, sdk.NewInt(20), dexkeeper.BankKeeper.GetBalance(ctx, account, "OSMO").Amount)
+	contractAddr := sdk.MustAccAddressFromBech32(keepertest.TestContract)
+	require.Equal(t, sdk.NewInt(2), dexkeeper.BankKeeper.GetBalance(ctx, contractAddr, "ATOM").Amount)
+}
+
+func TestExecuteRoutedOrderAcrossContracts(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	getOrderBook := setUpRoutedOrderBooks(t, ctx, dexkeeper, OtherContract)
+
+	settlements, amountOut, err := contract.ExecuteRoutedOrder(ctx, dexkeeper, routedOrder(t, ctx, dexkeeper, OtherContract, 2, sdk.NewDec(20)), getOrderBook)
+	require.Nil(t, err)
+	// 2 ATOM -> 200 USDC -> 20 OSMO
+	require.Equal(t, sdk.NewDec(20), amountOut)
+	require.Equal(t, 2, len(settlements[keepertest.TestContract]))
+	require.Equal(t, 2, len(settlements[OtherContract]))
+
+	atomLong, found := dexkeeper.GetLongBookByPrice(ctx, keepertest.TestContract, sdk.NewDec(100), "USDC", "ATOM")
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(3), atomLong.Entry.Quantity)
+	osmoShort, found := dexkeeper.GetShortBookByPrice(ctx, OtherContract, sdk.NewDec(10), "USDC", "OSMO")
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(10), osmoShort.Entry.Quantity)
+
+	// the ATOM went to the first contract, the USDC it yielded to the second
+	// one, and the OSMO bought with it to the account
+	account := sdk.MustAccAddressFromBech32(keepertest.TestAccount)
+	atomContract := sdk.MustAccAddressFromBech32(keepertest.TestContract)
+	osmoContract := sdk.MustAccAddressFromBech32(OtherContract)
+	require.Equal(t, sdk.NewInt(2), dexkeeper.BankKeeper.GetBalance(ctx, atomContract, "ATOM").Amount)
+	require.Equal(t, sdk.NewInt(300), dexkeeper.BankKeeper.GetBalance(ctx, atomContract, "USDC").Amount)
+	require.Equal(t, sdk.NewInt(200), dexkeeper.BankKeeper.GetBalance(ctx, osmoContract, "USDC").Amount)
+	require.Equal(t, sdk.NewInt(10), dexkeeper.BankKeeper.GetBalance(ctx, osmoContract, "OSMO").Amount)
+	require.Equal(t, sdk.NewInt(20), dexkeeper.BankKeeper.GetBalance(ctx, account, "OSMO").Amount)
+	require.True(t, dexkeeper.BankKeeper.GetAllBalances(ctx, dexkeeper.AccountKeeper.GetModuleAddress(types.ModuleName)).IsZero())
+}
+
+func TestExecuteRoutedOrderBelowMinAmountOut(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	getOrderBook := setUpRoutedOrderBooks(t, ctx, dexkeeper, OtherContract)
+
+	_, _, err := contract.ExecuteRoutedOrder(ctx, dexkeeper, routedOrder(t, ctx, dexkeeper, OtherContract, 2, sdk.NewDec(21)), getOrderBook)
+	require.NotNil(t, err)
+
+	// both legs are reverted
+	atomLong, found := dexkeeper.GetLongBookByPrice(ctx, keepertest.TestContract, sdk.NewDec(100), "USDC", "ATOM")
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(5), atomLong.Entry.Quantity)
+	osmoShort, found := dexkeeper.GetShortBookByPrice(ctx, OtherContract, sdk.NewDec(10), "USDC", "OSMO")
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(30), osmoShort.Entry.Quantity)
+
+	// in-memory order books are consistent with the reverted state
+	book, _ := getOrderBook(keepertest.TestContract, types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
+	require.Equal(t, sdk.NewDec(5), book.Longs.Next(ctx).GetOrderEntry().Quantity)
+
+	// the funds moved between the contracts are reverted, and the input refunded
+	account := sdk.MustAccAddressFromBech32(keepertest.TestAccount)
+	require.Equal(t, sdk.NewInt(2), dexkeeper.BankKeeper.GetBalance(ctx, account, "ATOM").Amount)
+	require.True(t, dexkeeper.BankKeeper.GetBalance(ctx, account, "OSMO").IsZero())
+	require.Equal(t, sdk.NewInt(500), dexkeeper.BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(keepertest.TestContract), "USDC").Amount)
+	require.True(t, dexkeeper.BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(OtherContract), "USDC").IsZero())
+}
+
+func TestExecuteRoutedOrderLegNotFilled(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	getOrderBook := setUpRoutedOrderBooks(t, ctx, dexkeeper, OtherContract)
+
+	// 4 ATOM -> 400 USDC, but the OSMO book only has 300 USDC worth of depth
+	_, _, err := contract.ExecuteRoutedOrder(ctx, dexkeeper, routedOrder(t, ctx, dexkeeper, OtherContract, 4, sdk.ZeroDec()), getOrderBook)
+	require.NotNil(t, err)
+
+	atomLong, found := dexkeeper.GetLongBookByPrice(ctx, keepertest.TestContract, sdk.NewDec(100), "USDC", "ATOM")
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(5), atomLong.Entry.Quantity)
+	require.Equal(t, sdk.NewInt(4), dexkeeper.BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(keepertest.TestAccount), "ATOM").Amount)
+}
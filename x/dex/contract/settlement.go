@@ -1,6 +1,9 @@
 package contract
 
 import (
+	"fmt"
+	"sort"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sei-protocol/sei-chain/x/dex/keeper"
 	dexkeeperutils "github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
@@ -32,8 +35,32 @@ func callSettlementHook(
 			Entries: settlementEntries,
 		},
 	}
-	if _, err := dexkeeperutils.CallContractSudo(ctx, dexkeeper, contractAddr, nativeSettlementMsg, dexkeeper.GetSettlementGasAllowance(ctx, len(settlementEntries))); err != nil {
+	if _, err := dexkeeperutils.CallContractSudo(ctx, dexkeeper, contractAddr, nativeSettlementMsg, dexkeeper.GetSettlementGasAllowance(ctx, contractAddr, len(settlementEntries))); err != nil {
 		return err
 	}
+	if err := types.EmitTypedEvent(ctx, &types.EventSettlement{
+		ContractAddr: contractAddr,
+		Epoch:        int64(currentEpoch),
+		Entries:      settlementEntries,
+	}); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("failed to emit settlement event for %s: %s", contractAddr, err))
+	}
 	return nil
 }
+
+// RecordFills counts settlement entries towards each account's fills for the
+// purpose of the order-to-trade ratio limit.
+func RecordFills(ctx sdk.Context, contractAddr string, dexkeeper *keeper.Keeper, settlementEntries []*types.SettlementEntry) {
+	fillsByAccount := map[string]uint64{}
+	for _, entry := range settlementEntries {
+		fillsByAccount[entry.Account]++
+	}
+	accounts := make([]string, 0, len(fillsByAccount))
+	for account := range fillsByAccount {
+		accounts = append(accounts, account)
+	}
+	sort.Strings(accounts)
+	for _, account := range accounts {
+		dexkeeper.IncreaseAccountOrdersFilled(ctx, contractAddr, account, fillsByAccount[account])
+	}
+}
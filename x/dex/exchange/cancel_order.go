@@ -33,6 +33,18 @@ func cancelOrder(ctx sdk.Context, keeper *keeper.Keeper, cancellation *types.Can
 		if allocation.OrderId != cancellation.Id {
 			newAllocations = append(newAllocations, allocation)
 			newQuantity = newQuantity.Add(allocation.Quantity)
+			continue
+		}
+		if err := types.EmitTypedEvent(ctx, &types.EventOrderCancelled{
+			ContractAddr:      string(contract),
+			OrderId:           allocation.OrderId,
+			Account:           allocation.Account,
+			PriceDenom:        pair.PriceDenom,
+			AssetDenom:        pair.AssetDenom,
+			PositionDirection: cancellation.PositionDirection,
+			Reason:            types.CancelReasonFromInitiator(cancellation.Initiator),
+		}); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("error emitting cancel event: %s", err))
 		}
 	}
 	numAllocationsRemoved := len(newEntry.Allocations) - len(newAllocations)
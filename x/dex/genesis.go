@@ -40,12 +40,26 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 		k.SetNextOrderID(ctx, contractState.ContractInfo.ContractAddr, contractState.NextOrderId)
 
+		if contractState.ParamsOverride != nil {
+			k.SetContractParamsOverride(ctx, *contractState.ParamsOverride)
+		}
+
 	}
 
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
 
 	k.SetEpoch(ctx, genState.LastEpoch)
+
+	for _, campaign := range genState.IncentiveCampaigns {
+		k.SetIncentiveCampaign(ctx, campaign)
+	}
+	for _, score := range genState.IncentiveScores {
+		k.SetIncentiveScore(ctx, score)
+	}
+	if genState.NextIncentiveCampaignId != 0 {
+		k.SetNextIncentiveCampaignID(ctx, genState.NextIncentiveCampaignId)
+	}
 }
 
 // ExportGenesis returns the dex module's exported genesis.
@@ -67,13 +81,18 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 				Prices:    pairPrices,
 			})
 		}
+		var paramsOverride *types.ContractParamsOverride
+		if override, found := k.GetContractParamsOverride(ctx, contractAddr); found {
+			paramsOverride = &override
+		}
 		contractStates[i] = types.ContractState{
-			ContractInfo:  contractInfo,
-			LongBookList:  k.GetAllLongBook(ctx, contractAddr),
-			ShortBookList: k.GetAllShortBook(ctx, contractAddr),
-			PairList:      registeredPairs,
-			PriceList:     contractPrices,
-			NextOrderId:   k.GetNextOrderID(ctx, contractAddr),
+			ContractInfo:   contractInfo,
+			LongBookList:   k.GetAllLongBook(ctx, contractAddr),
+			ShortBookList:  k.GetAllShortBook(ctx, contractAddr),
+			PairList:       registeredPairs,
+			PriceList:      contractPrices,
+			NextOrderId:    k.GetNextOrderID(ctx, contractAddr),
+			ParamsOverride: paramsOverride,
 		}
 	}
 	genesis.ContractState = contractStates
@@ -81,5 +100,11 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	_, currentEpoch := k.IsNewEpoch(ctx)
 	genesis.LastEpoch = currentEpoch
 
+	genesis.IncentiveCampaigns = k.GetAllIncentiveCampaigns(ctx)
+	for _, campaign := range genesis.IncentiveCampaigns {
+		genesis.IncentiveScores = append(genesis.IncentiveScores, k.GetIncentiveScores(ctx, campaign.Id)...)
+	}
+	genesis.NextIncentiveCampaignId = k.GetNextIncentiveCampaignID(ctx)
+
 	return genesis
 }
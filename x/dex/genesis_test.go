@@ -78,6 +78,10 @@ func TestGenesis(t *testing.T) {
 		PairList:     pairList,
 		PriceList:    priceList,
 		NextOrderId:  10,
+		ParamsOverride: &types.ContractParamsOverride{
+			ContractAddr:     contractInfo.ContractAddr,
+			MaxOrderPerPrice: 100,
+		},
 	}
 	contractList = append(contractList, contractState)
 
@@ -101,5 +105,6 @@ func TestGenesis(t *testing.T) {
 	require.ElementsMatch(t, genesisState.ContractState[0].ContractInfo.Dependencies, got.ContractState[0].ContractInfo.Dependencies)
 	require.ElementsMatch(t, genesisState.ContractState[0].PriceList, got.ContractState[0].PriceList)
 	require.Equal(t, genesisState.ContractState[0].NextOrderId, got.ContractState[0].NextOrderId)
+	require.Equal(t, genesisState.ContractState[0].ParamsOverride, got.ContractState[0].ParamsOverride)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -1,6 +1,8 @@
 package dex
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sei-protocol/sei-chain/x/dex/keeper"
 	"github.com/sei-protocol/sei-chain/x/dex/types"
@@ -12,3 +14,28 @@ func HandleAddAssetMetadataProposal(ctx sdk.Context, k *keeper.Keeper, p *types.
 	}
 	return nil
 }
+
+func HandleUpdateParamsProposal(ctx sdk.Context, k *keeper.Keeper, p *types.UpdateParamsProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	for _, override := range p.ContractOverrides {
+		if override.IsEmpty() {
+			continue
+		}
+		if _, err := k.GetContract(ctx, override.ContractAddr); err != nil {
+			return fmt.Errorf("cannot override the params of contract %s: %w", override.ContractAddr, err)
+		}
+	}
+	if p.Params != nil {
+		k.SetParams(ctx, *p.Params)
+	}
+	for _, override := range p.ContractOverrides {
+		if override.IsEmpty() {
+			k.DeleteContractParamsOverride(ctx, override.ContractAddr)
+			continue
+		}
+		k.SetContractParamsOverride(ctx, override)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package dex_test
+
+import (
+	"testing"
+
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleUpdateParamsProposal(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	k.SetParams(ctx, types.DefaultParams())
+	require.NoError(t, k.SetContract(ctx, &types.ContractInfoV2{ContractAddr: keepertest.TestContract}))
+	k.SetContractParamsOverride(ctx, types.ContractParamsOverride{ContractAddr: keepertest.TestContract2, MaxOrderPerPrice: 1})
+
+	params := types.DefaultParams()
+	params.MaxPairsPerContract = 5
+	proposal := types.UpdateParamsProposal{
+		Title:       "title",
+		Description: "description",
+		Params:      &params,
+		ContractOverrides: []types.ContractParamsOverride{
+			{ContractAddr: keepertest.TestContract, MaxOrderPerPrice: 10},
+			// an empty override removes the existing one
+			{ContractAddr: keepertest.TestContract2},
+		},
+	}
+	require.NoError(t, dex.HandleUpdateParamsProposal(ctx, k, &proposal))
+	require.Equal(t, params, k.GetParams(ctx))
+	require.Equal(t, uint64(10), k.GetMaxOrderPerPrice(ctx, keepertest.TestContract))
+	_, found := k.GetContractParamsOverride(ctx, keepertest.TestContract2)
+	require.False(t, found)
+
+	invalidParams := types.DefaultParams()
+	invalidParams.EndBlockGasLimit = 0
+	proposal.Params = &invalidParams
+	require.Error(t, dex.HandleUpdateParamsProposal(ctx, k, &proposal))
+	require.Equal(t, params, k.GetParams(ctx))
+
+	// overrides can't be set for contracts that aren't registered
+	proposal.Params = nil
+	proposal.ContractOverrides = []types.ContractParamsOverride{
+		{ContractAddr: keepertest.TestContract, MaxOrderPerPrice: 20},
+		{ContractAddr: keepertest.TestContract2, MaxOrderPerPrice: 20},
+	}
+	require.ErrorIs(t, dex.HandleUpdateParamsProposal(ctx, k, &proposal), types.ErrContractNotExists)
+	require.Equal(t, uint64(10), k.GetMaxOrderPerPrice(ctx, keepertest.TestContract))
+	_, found = k.GetContractParamsOverride(ctx, keepertest.TestContract2)
+	require.False(t, found)
+}
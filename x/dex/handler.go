@@ -47,6 +47,12 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgUnsuspendContract:
 			res, err := msgServer.UnsuspendContract(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgPlaceRoutedMarketOrder:
+			res, err := msgServer.PlaceRoutedMarketOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCreateIncentiveCampaign:
+			res, err := msgServer.CreateIncentiveCampaign(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 			// this line is used by starport scaffolding # 1
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
@@ -60,6 +66,8 @@ func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
 		switch c := content.(type) {
 		case *types.AddAssetMetadataProposal:
 			return HandleAddAssetMetadataProposal(ctx, &k, c)
+		case *types.UpdateParamsProposal:
+			return HandleUpdateParamsProposal(ctx, &k, c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized dex proposal content type: %T", c)
 		}
@@ -28,6 +28,16 @@ func (w KeeperWrapper) HandleEBDeposit(ctx context.Context, sdkCtx sdk.Context,
 		sdkCtx.Logger().Error(fmt.Sprintf("Error during deposit: %s", err.Error()))
 		return err
 	}
+	for _, deposit := range msg.OrderPlacements.Deposits {
+		if err := types.EmitTypedEvent(sdkCtx, &types.EventDeposit{
+			ContractAddr: contractAddr,
+			Account:      deposit.Account,
+			Denom:        deposit.Denom,
+			Amount:       deposit.Amount,
+		}); err != nil {
+			sdkCtx.Logger().Error(fmt.Sprintf("Failed to emit deposit event: %s", err.Error()))
+		}
+	}
 
 	return nil
 }
@@ -31,7 +31,7 @@ func (w KeeperWrapper) HandleEBPlaceOrders(ctx context.Context, sdkCtx sdk.Conte
 		if msg.IsEmpty() {
 			continue
 		}
-		userProvidedGas := w.GetParams(sdkCtx).DefaultGasPerOrder * uint64(len(msg.OrderPlacements.Orders))
+		userProvidedGas := w.GetEffectiveParams(sdkCtx, contractAddr).DefaultGasPerOrder * uint64(len(msg.OrderPlacements.Orders))
 		data, err := utils.CallContractSudo(sdkCtx, w.Keeper, contractAddr, msg, userProvidedGas)
 		if err != nil {
 			sdkCtx.Logger().Error(fmt.Sprintf("Error during order placement: %s", err.Error()))
@@ -49,8 +49,24 @@ func (w KeeperWrapper) HandleEBPlaceOrders(ctx context.Context, sdkCtx sdk.Conte
 	}
 
 	for _, pair := range registeredPairs {
+		blockOrders := dexutils.GetMemState(sdkCtx.Context()).GetBlockOrders(sdkCtx, typedContractAddr, pair)
 		for _, response := range responses {
-			dexutils.GetMemState(sdkCtx.Context()).GetBlockOrders(sdkCtx, typedContractAddr, pair).MarkFailedToPlace(response.UnsuccessfulOrders)
+			blockOrders.MarkFailedToPlace(response.UnsuccessfulOrders)
+		}
+		for _, order := range blockOrders.Get() {
+			if order.Status != types.OrderStatus_FAILED_TO_PLACE {
+				continue
+			}
+			if err := types.EmitTypedEvent(sdkCtx, &types.EventOrderRejected{
+				ContractAddr: contractAddr,
+				OrderId:      order.Id,
+				Account:      order.Account,
+				PriceDenom:   order.PriceDenom,
+				AssetDenom:   order.AssetDenom,
+				Reason:       order.StatusDescription,
+			}); err != nil {
+				sdkCtx.Logger().Error(fmt.Sprintf("Failed to emit rejection event for order %d: %s", order.Id, err))
+			}
 		}
 	}
 	return nil
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// GetAccountActiveOrderCount returns the number of orders the account has
+// resting on the order book of the given pair.
+func (k Keeper) GetAccountActiveOrderCount(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string, account string) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountActiveOrdersPrefix(contractAddr, priceDenom, assetDenom))
+	value := store.Get([]byte(account))
+	if value == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(value)
+}
+
+func (k Keeper) setAccountActiveOrderCount(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string, account string, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountActiveOrdersPrefix(contractAddr, priceDenom, assetDenom))
+	if count == 0 {
+		store.Delete([]byte(account))
+		return
+	}
+	value := make([]byte, 8)
+	binary.BigEndian.PutUint64(value, count)
+	store.Set([]byte(account), value)
+}
+
+// updateAccountActiveOrderCounts adjusts the active order count of every account
+// whose allocations differ between the old and new version of an order book entry.
+// Either entry can be nil, if the price level is being created or removed.
+func (k Keeper) updateAccountActiveOrderCounts(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string, oldEntry *types.OrderEntry, newEntry *types.OrderEntry) {
+	deltas := map[string]int64{}
+	if oldEntry != nil {
+		for _, allocation := range oldEntry.Allocations {
+			deltas[allocation.Account]--
+		}
+	}
+	if newEntry != nil {
+		for _, allocation := range newEntry.Allocations {
+			deltas[allocation.Account]++
+		}
+	}
+	accounts := []string{}
+	for account, delta := range deltas {
+		if delta != 0 {
+			accounts = append(accounts, account)
+		}
+	}
+	sort.Strings(accounts)
+	for _, account := range accounts {
+		count := int64(k.GetAccountActiveOrderCount(ctx, contractAddr, priceDenom, assetDenom, account)) + deltas[account]
+		if count < 0 {
+			count = 0
+		}
+		k.setAccountActiveOrderCount(ctx, contractAddr, priceDenom, assetDenom, account, uint64(count))
+	}
+}
+
+func (k Keeper) RemoveAllAccountActiveOrderCountsForContract(ctx sdk.Context, contractAddr string) {
+	k.removeAllForPrefix(ctx, types.AccountActiveOrdersContractPrefix(contractAddr))
+}
+
+// GetAccountOrderCounter returns the order-to-trade counter of the account,
+// rolled forward to the current block.
+func (k Keeper) GetAccountOrderCounter(ctx sdk.Context, contractAddr string, account string) types.AccountOrderCounter {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountOrderCounterPrefix(contractAddr))
+	bz := store.Get([]byte(account))
+	if bz == nil {
+		return types.NewAccountOrderCounter(ctx.BlockHeight())
+	}
+	counter := types.AccountOrderCounter{}
+	k.Cdc.MustUnmarshal(bz, &counter)
+	counter.Roll(ctx.BlockHeight(), k.GetParams(ctx).OrderToTradeRatioWindow)
+	return counter
+}
+
+func (k Keeper) SetAccountOrderCounter(ctx sdk.Context, contractAddr string, account string, counter types.AccountOrderCounter) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountOrderCounterPrefix(contractAddr))
+	store.Set([]byte(account), k.Cdc.MustMarshal(&counter))
+}
+
+func (k Keeper) IncreaseAccountOrdersPlaced(ctx sdk.Context, contractAddr string, account string, count uint64) {
+	counter := k.GetAccountOrderCounter(ctx, contractAddr, account)
+	counter.OrdersPlaced += count
+	k.SetAccountOrderCounter(ctx, contractAddr, account, counter)
+}
+
+func (k Keeper) IncreaseAccountOrdersFilled(ctx sdk.Context, contractAddr string, account string, count uint64) {
+	counter := k.GetAccountOrderCounter(ctx, contractAddr, account)
+	counter.OrdersFilled += count
+	k.SetAccountOrderCounter(ctx, contractAddr, account, counter)
+}
+
+func (k Keeper) RemoveAllAccountOrderCountersForContract(ctx sdk.Context, contractAddr string) {
+	k.removeAllForPrefix(ctx, types.AccountOrderCounterPrefix(contractAddr))
+}
+
+// RebuildAccountActiveOrderCountsForContract recomputes the active order counts
+// of every account from the order books currently stored for the contract.
+func (k Keeper) RebuildAccountActiveOrderCountsForContract(ctx sdk.Context, contractAddr string) {
+	k.RemoveAllAccountActiveOrderCountsForContract(ctx, contractAddr)
+	for _, longBook := range k.GetAllLongBook(ctx, contractAddr) {
+		k.updateAccountActiveOrderCounts(ctx, contractAddr, longBook.Entry.PriceDenom, longBook.Entry.AssetDenom, nil, longBook.Entry)
+	}
+	for _, shortBook := range k.GetAllShortBook(ctx, contractAddr) {
+		k.updateAccountActiveOrderCounts(ctx, contractAddr, shortBook.Entry.PriceDenom, shortBook.Entry.AssetDenom, nil, shortBook.Entry)
+	}
+}
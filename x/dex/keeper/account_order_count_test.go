@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func longBookWithAllocations(price int64, accounts ...string) types.LongBook {
+	allocations := []*types.Allocation{}
+	for i, account := range accounts {
+		allocations = append(allocations, &types.Allocation{OrderId: uint64(i), Account: account, Quantity: sdk.OneDec()})
+	}
+	return types.LongBook{
+		Price: sdk.NewDec(price),
+		Entry: &types.OrderEntry{
+			Price:       sdk.NewDec(price),
+			Quantity:    sdk.NewDec(int64(len(accounts))),
+			PriceDenom:  keepertest.TestPriceDenom,
+			AssetDenom:  keepertest.TestAssetDenom,
+			Allocations: allocations,
+		},
+	}
+}
+
+func TestAccountActiveOrderCount(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	getCount := func(account string) uint64 {
+		return keeper.GetAccountActiveOrderCount(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, account)
+	}
+
+	keeper.SetLongBook(ctx, keepertest.TestContract, longBookWithAllocations(1, keepertest.TestAccount, keepertest.TestAccount, keepertest.TestAccount2))
+	keeper.SetLongBook(ctx, keepertest.TestContract, longBookWithAllocations(2, keepertest.TestAccount))
+	keeper.SetShortBook(ctx, keepertest.TestContract, types.ShortBook{
+		Price: sdk.NewDec(3),
+		Entry: longBookWithAllocations(3, keepertest.TestAccount2).Entry,
+	})
+	require.Equal(t, uint64(3), getCount(keepertest.TestAccount))
+	require.Equal(t, uint64(2), getCount(keepertest.TestAccount2))
+
+	// partially filled price level
+	keeper.SetLongBook(ctx, keepertest.TestContract, longBookWithAllocations(1, keepertest.TestAccount2))
+	require.Equal(t, uint64(1), getCount(keepertest.TestAccount))
+	require.Equal(t, uint64(2), getCount(keepertest.TestAccount2))
+
+	keeper.RemoveLongBookByPrice(ctx, keepertest.TestContract, sdk.NewDec(2), keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	keeper.RemoveShortBookByPrice(ctx, keepertest.TestContract, sdk.NewDec(3), keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	require.Equal(t, uint64(0), getCount(keepertest.TestAccount))
+	require.Equal(t, uint64(1), getCount(keepertest.TestAccount2))
+
+	// counts are scoped to the pair
+	require.Equal(t, uint64(0), keeper.GetAccountActiveOrderCount(ctx, keepertest.TestContract, "other", keepertest.TestAssetDenom, keepertest.TestAccount2))
+}
+
+func TestRebuildAccountActiveOrderCounts(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	keeper.SetLongBook(ctx, keepertest.TestContract, longBookWithAllocations(1, keepertest.TestAccount, keepertest.TestAccount2))
+	keeper.RemoveAllAccountActiveOrderCountsForContract(ctx, keepertest.TestContract)
+	require.Equal(t, uint64(0), keeper.GetAccountActiveOrderCount(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, keepertest.TestAccount))
+
+	keeper.RebuildAccountActiveOrderCountsForContract(ctx, keepertest.TestContract)
+	require.Equal(t, uint64(1), keeper.GetAccountActiveOrderCount(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, keepertest.TestAccount))
+	require.Equal(t, uint64(1), keeper.GetAccountActiveOrderCount(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, keepertest.TestAccount2))
+}
+
+func TestAccountOrderCounter(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	params := keeper.GetParams(ctx)
+	params.OrderToTradeRatioWindow = 10
+	keeper.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(100)
+	keeper.IncreaseAccountOrdersPlaced(ctx, keepertest.TestContract, keepertest.TestAccount, 5)
+	keeper.IncreaseAccountOrdersFilled(ctx, keepertest.TestContract, keepertest.TestAccount, 2)
+	counter := keeper.GetAccountOrderCounter(ctx, keepertest.TestContract, keepertest.TestAccount)
+	require.Equal(t, types.AccountOrderCounter{WindowStartHeight: 100, OrdersPlaced: 5, OrdersFilled: 2}, counter)
+
+	// next window keeps the previous counts around for the sliding estimate
+	ctx = ctx.WithBlockHeight(112)
+	keeper.IncreaseAccountOrdersPlaced(ctx, keepertest.TestContract, keepertest.TestAccount, 1)
+	counter = keeper.GetAccountOrderCounter(ctx, keepertest.TestContract, keepertest.TestAccount)
+	require.Equal(t, types.AccountOrderCounter{WindowStartHeight: 110, OrdersPlaced: 1, PrevOrdersPlaced: 5, PrevOrdersFilled: 2}, counter)
+
+	keeper.RemoveAllAccountOrderCountersForContract(ctx, keepertest.TestContract)
+	counter = keeper.GetAccountOrderCounter(ctx, keepertest.TestContract, keepertest.TestAccount)
+	require.Equal(t, types.NewAccountOrderCounter(112), counter)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"errors"
+	"fmt"
 	"math"
 	"math/big"
 	"time"
@@ -111,14 +112,31 @@ func (k Keeper) ChargeRentForGas(ctx sdk.Context, contractAddr string, gasUsed u
 	}
 	gasFee := gasFeeDec.RoundInt().Uint64()
 	if gasFee > contract.RentBalance {
+		charged := contract.RentBalance
 		contract.RentBalance = 0
 		if err := k.SetContract(ctx, &contract); err != nil {
 			return err
 		}
+		k.emitRentChargedEvent(ctx, contractAddr, gasUsed, charged, 0)
 		return types.ErrInsufficientRent
 	}
 	contract.RentBalance -= gasFee
-	return k.SetContract(ctx, &contract)
+	if err := k.SetContract(ctx, &contract); err != nil {
+		return err
+	}
+	k.emitRentChargedEvent(ctx, contractAddr, gasUsed, gasFee, contract.RentBalance)
+	return nil
+}
+
+func (k Keeper) emitRentChargedEvent(ctx sdk.Context, contractAddr string, gasUsed uint64, amount uint64, rentBalance uint64) {
+	if err := types.EmitTypedEvent(ctx, &types.EventRentCharged{
+		ContractAddr: contractAddr,
+		GasUsed:      gasUsed,
+		Amount:       amount,
+		RentBalance:  rentBalance,
+	}); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("failed to emit rent charge event for %s: %s", contractAddr, err))
+	}
 }
 
 func (k Keeper) GetRentsForContracts(ctx sdk.Context, contractAddrs []string) map[string]uint64 {
@@ -148,6 +166,9 @@ func (k Keeper) DoUnregisterContract(ctx sdk.Context, contract types.ContractInf
 	k.DeleteMatchResultState(ctx, contract.ContractAddr)
 	k.DeleteNextOrderID(ctx, contract.ContractAddr)
 	k.DeleteAllRegisteredPairsForContract(ctx, contract.ContractAddr)
+	k.DeleteContractParamsOverride(ctx, contract.ContractAddr)
+	k.RemoveAllAccountActiveOrderCountsForContract(ctx, contract.ContractAddr)
+	k.RemoveAllAccountOrderCountersForContract(ctx, contract.ContractAddr)
 }
 
 func (k Keeper) SuspendContract(ctx sdk.Context, contractAddress string, reason string) error {
@@ -157,7 +178,13 @@ func (k Keeper) SuspendContract(ctx sdk.Context, contractAddress string, reason
 	}
 	contract.Suspended = true
 	contract.SuspensionReason = reason
-	return k.SetContract(ctx, &contract)
+	if err := k.SetContract(ctx, &contract); err != nil {
+		return err
+	}
+	return types.EmitTypedEvent(ctx, &types.EventContractSuspended{
+		ContractAddr: contractAddress,
+		Reason:       reason,
+	})
 }
 
 func (k Keeper) ClearDependenciesForContract(ctx sdk.Context, removedContract types.ContractInfoV2) {
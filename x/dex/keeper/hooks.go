@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochtypes "github.com/sei-protocol/sei-chain/x/epoch/types"
+)
+
+func (k Keeper) BeforeEpochStart(_ sdk.Context, _ epochtypes.Epoch) {}
+
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, epoch epochtypes.Epoch) {
+	k.ProcessIncentiveCampaigns(ctx, epoch)
+}
+
+type Hooks struct {
+	k Keeper
+}
+
+var _ epochtypes.EpochHooks = Hooks{}
+
+// Return the wrapper struct.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// epochs hooks.
+func (h Hooks) BeforeEpochStart(ctx sdk.Context, epoch epochtypes.Epoch) {
+	h.k.BeforeEpochStart(ctx, epoch)
+}
+
+func (h Hooks) AfterEpochEnd(ctx sdk.Context, epoch epochtypes.Epoch) {
+	h.k.AfterEpochEnd(ctx, epoch)
+}
@@ -0,0 +1,333 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	epochtypes "github.com/sei-protocol/sei-chain/x/epoch/types"
+)
+
+// GetNextIncentiveCampaignID returns the id the next created campaign will get.
+// Campaign ids start at 1.
+func (k Keeper) GetNextIncentiveCampaignID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(types.NextIncentiveCampaignIDKey))
+	if bz == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) SetNextIncentiveCampaignID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set([]byte(types.NextIncentiveCampaignIDKey), sdk.Uint64ToBigEndian(id))
+}
+
+// SetIncentiveCampaign stores the campaign and indexes it by end epoch and by
+// pair. Neither can change once a campaign is created.
+func (k Keeper) SetIncentiveCampaign(ctx sdk.Context, campaign types.IncentiveCampaign) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.IncentiveCampaignKey))
+	store.Set(sdk.Uint64ToBigEndian(campaign.Id), k.Cdc.MustMarshal(&campaign))
+
+	endEpochStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.IncentiveCampaignByEndEpochKey))
+	endEpochStore.Set(types.IncentiveCampaignEndEpochIndexKey(campaign.EndEpoch, campaign.Id), []byte{})
+	pairStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.IncentiveCampaignByPairPrefix(campaign.ContractAddr, campaign.PriceDenom, campaign.AssetDenom))
+	pairStore.Set(sdk.Uint64ToBigEndian(campaign.Id), []byte{})
+}
+
+func (k Keeper) GetIncentiveCampaign(ctx sdk.Context, id uint64) (types.IncentiveCampaign, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.IncentiveCampaignKey))
+	bz := store.Get(sdk.Uint64ToBigEndian(id))
+	if bz == nil {
+		return types.IncentiveCampaign{}, false
+	}
+	campaign := types.IncentiveCampaign{}
+	k.Cdc.MustUnmarshal(bz, &campaign)
+	return campaign, true
+}
+
+// GetAllIncentiveCampaigns returns all campaigns that have not been paid out
+// yet, ordered by id.
+func (k Keeper) GetAllIncentiveCampaigns(ctx sdk.Context) (list []types.IncentiveCampaign) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.IncentiveCampaignKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		campaign := types.IncentiveCampaign{}
+		k.Cdc.MustUnmarshal(iterator.Value(), &campaign)
+		list = append(list, campaign)
+	}
+	return
+}
+
+// GetIncentiveCampaignsByEndEpoch returns all campaigns that have not been
+// paid out yet, ordered by end epoch and then by id.
+func (k Keeper) GetIncentiveCampaignsByEndEpoch(ctx sdk.Context) (list []types.IncentiveCampaign) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.IncentiveCampaignByEndEpochKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// the key is the end epoch followed by the id
+		id := binary.BigEndian.Uint64(iterator.Key()[8:])
+		campaign, found := k.GetIncentiveCampaign(ctx, id)
+		if !found {
+			panic(fmt.Sprintf("incentive campaign %d is indexed but not stored", id))
+		}
+		list = append(list, campaign)
+	}
+	return
+}
+
+// GetIncentiveCampaignCountForPair returns the number of campaigns of the pair
+// that have not been paid out yet.
+func (k Keeper) GetIncentiveCampaignCountForPair(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.IncentiveCampaignByPairPrefix(contractAddr, priceDenom, assetDenom))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	count := uint64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+	return count
+}
+
+func (k Keeper) DeleteIncentiveCampaign(ctx sdk.Context, id uint64) {
+	campaign, found := k.GetIncentiveCampaign(ctx, id)
+	if !found {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.IncentiveCampaignKey))
+	store.Delete(sdk.Uint64ToBigEndian(id))
+	endEpochStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.IncentiveCampaignByEndEpochKey))
+	endEpochStore.Delete(types.IncentiveCampaignEndEpochIndexKey(campaign.EndEpoch, id))
+	pairStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.IncentiveCampaignByPairPrefix(campaign.ContractAddr, campaign.PriceDenom, campaign.AssetDenom))
+	pairStore.Delete(sdk.Uint64ToBigEndian(id))
+	k.removeAllForPrefix(ctx, types.IncentiveScorePrefix(id))
+}
+
+func (k Keeper) SetIncentiveScore(ctx sdk.Context, score types.IncentiveScore) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.IncentiveScorePrefix(score.CampaignId))
+	store.Set([]byte(score.Account), k.Cdc.MustMarshal(&score))
+}
+
+func (k Keeper) GetIncentiveScore(ctx sdk.Context, campaignID uint64, account string) types.IncentiveScore {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.IncentiveScorePrefix(campaignID))
+	bz := store.Get([]byte(account))
+	if bz == nil {
+		return types.IncentiveScore{CampaignId: campaignID, Account: account, Score: sdk.ZeroDec()}
+	}
+	score := types.IncentiveScore{}
+	k.Cdc.MustUnmarshal(bz, &score)
+	return score
+}
+
+// GetIncentiveScores returns the scores of a campaign, ordered by account.
+func (k Keeper) GetIncentiveScores(ctx sdk.Context, campaignID uint64) (list []types.IncentiveScore) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.IncentiveScorePrefix(campaignID))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		score := types.IncentiveScore{}
+		k.Cdc.MustUnmarshal(iterator.Value(), &score)
+		list = append(list, score)
+	}
+	return
+}
+
+// CreateIncentiveCampaign moves the reward pool from the creator into the
+// incentive pool module account and stores the campaign. The campaign starts
+// with the epoch after the current one. The reward pool must be at least the
+// min campaign reward pool, and the pair must have room for another campaign.
+func (k Keeper) CreateIncentiveCampaign(ctx sdk.Context, msg *types.MsgCreateIncentiveCampaign) (types.IncentiveCampaign, error) {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return types.IncentiveCampaign{}, err
+	}
+	params := k.GetParams(ctx)
+	if !msg.RewardPool.IsAllGTE(params.MinCampaignRewardPool) {
+		return types.IncentiveCampaign{}, sdkerrors.Wrapf(types.ErrInvalidIncentiveCampaign, "reward pool %s is below the minimum of %s", msg.RewardPool, params.MinCampaignRewardPool)
+	}
+	if params.MaxActiveCampaignsPerPair > 0 && k.GetIncentiveCampaignCountForPair(ctx, msg.ContractAddr, msg.PriceDenom, msg.AssetDenom) >= params.MaxActiveCampaignsPerPair {
+		return types.IncentiveCampaign{}, sdkerrors.Wrapf(types.ErrInvalidIncentiveCampaign, "pair already has the maximum of %d active campaigns", params.MaxActiveCampaignsPerPair)
+	}
+	if err := k.BankKeeper.SendCoinsFromAccountToModule(ctx, creator, types.IncentivePoolName, msg.RewardPool); err != nil {
+		return types.IncentiveCampaign{}, err
+	}
+	startEpoch := k.EpochKeeper.GetEpoch(ctx).CurrentEpoch + 1
+	campaign := types.IncentiveCampaign{
+		Id:           k.GetNextIncentiveCampaignID(ctx),
+		Creator:      msg.Creator,
+		ContractAddr: msg.ContractAddr,
+		PriceDenom:   msg.PriceDenom,
+		AssetDenom:   msg.AssetDenom,
+		RewardPool:   msg.RewardPool,
+		StartEpoch:   startEpoch,
+		EndEpoch:     startEpoch + msg.NumEpochs - 1,
+		MaxSpread:    msg.MaxSpread,
+		MaxLevels:    msg.MaxLevels,
+	}
+	k.SetIncentiveCampaign(ctx, campaign)
+	k.SetNextIncentiveCampaignID(ctx, campaign.Id+1)
+	return campaign, nil
+}
+
+// ProcessIncentiveCampaigns samples the order books of every campaign running in
+// the epoch that just ended, and pays out the campaigns that ended with it. Paid
+// out campaigns are removed from the end epoch index, so only campaigns that
+// are running or about to start are visited.
+func (k Keeper) ProcessIncentiveCampaigns(ctx sdk.Context, epoch epochtypes.Epoch) {
+	elapsed := ctx.BlockTime().Sub(epoch.CurrentEpochStartTime)
+	for _, campaign := range k.GetIncentiveCampaignsByEndEpoch(ctx) {
+		if epoch.CurrentEpoch < campaign.StartEpoch {
+			continue
+		}
+		if epoch.CurrentEpoch <= campaign.EndEpoch && elapsed > 0 {
+			k.SampleIncentiveCampaign(ctx, campaign, sdk.NewDec(int64(elapsed.Seconds())))
+		}
+		if epoch.CurrentEpoch >= campaign.EndEpoch {
+			if err := k.DistributeIncentiveCampaign(ctx, campaign); err != nil {
+				ctx.Logger().Error("failed to distribute incentive campaign", "campaign", campaign.Id, "error", err)
+			}
+		}
+	}
+}
+
+// SampleIncentiveCampaign scores the resting liquidity of the campaign's pair.
+// Each price level within the campaign's max spread of the mid price counts
+// with a weight that decreases linearly from 1 at the mid to 0 at the max
+// spread. An account scores the smaller of its weighted bid and ask depth, so
+// only two-sided quotes are rewarded, multiplied by the sampled duration.
+func (k Keeper) SampleIncentiveCampaign(ctx sdk.Context, campaign types.IncentiveCampaign, duration sdk.Dec) {
+	longs := k.GetTopNLongBooksForPair(ctx, campaign.ContractAddr, campaign.PriceDenom, campaign.AssetDenom, int(campaign.MaxLevels))
+	shorts := k.GetTopNShortBooksForPair(ctx, campaign.ContractAddr, campaign.PriceDenom, campaign.AssetDenom, int(campaign.MaxLevels))
+	if len(longs) == 0 || len(shorts) == 0 {
+		return
+	}
+	mid := longs[0].GetPrice().Add(shorts[0].GetPrice()).QuoInt64(2)
+	if !mid.IsPositive() {
+		return
+	}
+	bidDepths := weightedDepthByAccount(longs, mid, campaign.MaxSpread)
+	askDepths := weightedDepthByAccount(shorts, mid, campaign.MaxSpread)
+
+	accounts := []string{}
+	for account := range bidDepths {
+		if _, ok := askDepths[account]; ok {
+			accounts = append(accounts, account)
+		}
+	}
+	sort.Strings(accounts)
+	for _, account := range accounts {
+		depth := sdk.MinDec(bidDepths[account], askDepths[account])
+		if !depth.IsPositive() {
+			continue
+		}
+		score := k.GetIncentiveScore(ctx, campaign.Id, account)
+		score.Score = score.Score.Add(depth.Mul(duration))
+		k.SetIncentiveScore(ctx, score)
+	}
+}
+
+func weightedDepthByAccount(levels []types.OrderBookEntry, mid sdk.Dec, maxSpread sdk.Dec) map[string]sdk.Dec {
+	depths := map[string]sdk.Dec{}
+	for _, level := range levels {
+		spread := level.GetPrice().Sub(mid).Abs().Quo(mid)
+		if spread.GTE(maxSpread) {
+			// levels are sorted from the mid outwards
+			break
+		}
+		weight := sdk.OneDec().Sub(spread.Quo(maxSpread))
+		for _, allocation := range level.GetOrderEntry().Allocations {
+			depth, ok := depths[allocation.Account]
+			if !ok {
+				depth = sdk.ZeroDec()
+			}
+			depths[allocation.Account] = depth.Add(allocation.Quantity.Mul(weight))
+		}
+	}
+	return depths
+}
+
+// DistributeIncentiveCampaign pays the reward pool out pro rata to the scores,
+// returns whatever is left after truncation (or the whole pool if nobody
+// scored) to the creator, and deletes the campaign. The payouts are all or
+// nothing: if any of them fails, none is made and the whole pool is refunded
+// to the creator instead. The campaign is deleted either way so that it is
+// never paid out twice.
+func (k Keeper) DistributeIncentiveCampaign(ctx sdk.Context, campaign types.IncentiveCampaign) error {
+	defer k.DeleteIncentiveCampaign(ctx, campaign.Id)
+
+	cacheCtx, write := ctx.CacheContext()
+	err := k.payIncentiveCampaign(cacheCtx, campaign)
+	if err == nil {
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		return nil
+	}
+
+	creator, addrErr := sdk.AccAddressFromBech32(campaign.Creator)
+	if addrErr != nil {
+		return fmt.Errorf("%w; failed to refund creator: %s", err, addrErr)
+	}
+	if refundErr := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.IncentivePoolName, creator, campaign.RewardPool); refundErr != nil {
+		return fmt.Errorf("%w; failed to refund creator: %s", err, refundErr)
+	}
+	return fmt.Errorf("%w; refunded the reward pool to the creator", err)
+}
+
+func (k Keeper) payIncentiveCampaign(ctx sdk.Context, campaign types.IncentiveCampaign) error {
+	scores := k.GetIncentiveScores(ctx, campaign.Id)
+	total := sdk.ZeroDec()
+	for _, score := range scores {
+		total = total.Add(score.Score)
+	}
+	remaining := campaign.RewardPool
+	if total.IsPositive() {
+		for _, score := range scores {
+			share := score.Score.Quo(total)
+			reward := sdk.NewCoins()
+			for _, coin := range campaign.RewardPool {
+				reward = reward.Add(sdk.NewCoin(coin.Denom, coin.Amount.ToDec().Mul(share).TruncateInt()))
+			}
+			if reward.IsZero() {
+				continue
+			}
+			account, err := sdk.AccAddressFromBech32(score.Account)
+			if err != nil {
+				return err
+			}
+			if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.IncentivePoolName, account, reward); err != nil {
+				return err
+			}
+			remaining = remaining.Sub(reward)
+			if err := types.EmitTypedEvent(ctx, &types.EventIncentiveRewardPaid{
+				CampaignId: campaign.Id,
+				Account:    score.Account,
+				Amount:     reward,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	if !remaining.IsZero() {
+		creator, err := sdk.AccAddressFromBech32(campaign.Creator)
+		if err != nil {
+			return err
+		}
+		if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.IncentivePoolName, creator, remaining); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,216 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	epochtypes "github.com/sei-protocol/sei-chain/x/epoch/types"
+	minttypes "github.com/sei-protocol/sei-chain/x/mint/types"
+	"github.com/stretchr/testify/require"
+)
+
+func incentiveBookEntry(price int64, quantities map[string]int64) *types.OrderEntry {
+	entry := &types.OrderEntry{
+		Price:      sdk.NewDec(price),
+		Quantity:   sdk.ZeroDec(),
+		PriceDenom: keepertest.TestPriceDenom,
+		AssetDenom: keepertest.TestAssetDenom,
+	}
+	for _, account := range []string{keepertest.TestAccount, keepertest.TestAccount2} {
+		quantity, ok := quantities[account]
+		if !ok {
+			continue
+		}
+		entry.Allocations = append(entry.Allocations, &types.Allocation{Account: account, Quantity: sdk.NewDec(quantity)})
+		entry.Quantity = entry.Quantity.Add(sdk.NewDec(quantity))
+	}
+	return entry
+}
+
+func TestIncentiveCampaignLifecycle(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+	creator, _ := sdk.AccAddressFromBech32(keepertest.TestContract2)
+	pool := sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(1000)))
+	require.NoError(t, keeper.BankKeeper.MintCoins(ctx, minttypes.ModuleName, pool))
+	require.NoError(t, keeper.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, creator, pool))
+	// a small pool makes the truncation below visible
+	params := keeper.GetParams(ctx)
+	params.MinCampaignRewardPool = pool
+	keeper.SetParams(ctx, params)
+
+	campaign, err := keeper.CreateIncentiveCampaign(ctx, types.NewMsgCreateIncentiveCampaign(
+		keepertest.TestContract2, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom,
+		pool, 1, sdk.MustNewDecFromStr("0.05"), 10,
+	))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), campaign.Id)
+	require.Equal(t, uint64(1), campaign.StartEpoch)
+	require.Equal(t, uint64(1), campaign.EndEpoch)
+	require.Equal(t, uint64(2), keeper.GetNextIncentiveCampaignID(ctx))
+	require.True(t, keeper.BankKeeper.GetAllBalances(ctx, creator).IsZero())
+
+	// mid is 100. TestAccount quotes 1% away on both sides (weight 0.8),
+	// TestAccount2 bids 1% away and asks 2% away (weight 0.6).
+	keeper.SetLongBook(ctx, keepertest.TestContract, types.LongBook{
+		Price: sdk.NewDec(99),
+		Entry: incentiveBookEntry(99, map[string]int64{keepertest.TestAccount: 10, keepertest.TestAccount2: 10}),
+	})
+	keeper.SetShortBook(ctx, keepertest.TestContract, types.ShortBook{
+		Price: sdk.NewDec(101),
+		Entry: incentiveBookEntry(101, map[string]int64{keepertest.TestAccount: 10}),
+	})
+	keeper.SetShortBook(ctx, keepertest.TestContract, types.ShortBook{
+		Price: sdk.NewDec(102),
+		Entry: incentiveBookEntry(102, map[string]int64{keepertest.TestAccount2: 10}),
+	})
+	// outside of the max spread
+	keeper.SetShortBook(ctx, keepertest.TestContract, types.ShortBook{
+		Price: sdk.NewDec(110),
+		Entry: incentiveBookEntry(110, map[string]int64{keepertest.TestAccount: 100}),
+	})
+
+	// the epoch before the campaign starts is not sampled
+	keeper.AfterEpochEnd(ctx, epochtypes.Epoch{CurrentEpoch: 0, CurrentEpochStartTime: time.Unix(940, 0)})
+	require.Empty(t, keeper.GetIncentiveScores(ctx, campaign.Id))
+
+	keeper.SampleIncentiveCampaign(ctx, campaign, sdk.NewDec(60))
+	scores := keeper.GetIncentiveScores(ctx, campaign.Id)
+	require.Equal(t, 2, len(scores))
+	require.Equal(t, sdk.NewDec(480), keeper.GetIncentiveScore(ctx, campaign.Id, keepertest.TestAccount).Score)
+	require.Equal(t, sdk.NewDec(360), keeper.GetIncentiveScore(ctx, campaign.Id, keepertest.TestAccount2).Score)
+
+	account, _ := sdk.AccAddressFromBech32(keepertest.TestAccount)
+	account2, _ := sdk.AccAddressFromBech32(keepertest.TestAccount2)
+	require.NoError(t, keeper.DistributeIncentiveCampaign(ctx, campaign))
+	require.Equal(t, sdk.NewInt(571), keeper.BankKeeper.GetBalance(ctx, account, "usei").Amount)
+	require.Equal(t, sdk.NewInt(428), keeper.BankKeeper.GetBalance(ctx, account2, "usei").Amount)
+	// truncated remainder goes back to the creator
+	require.Equal(t, sdk.NewInt(1), keeper.BankKeeper.GetBalance(ctx, creator, "usei").Amount)
+	_, found := keeper.GetIncentiveCampaign(ctx, campaign.Id)
+	require.False(t, found)
+	require.Empty(t, keeper.GetIncentiveScores(ctx, campaign.Id))
+}
+
+func TestIncentiveCampaignWithoutScoresRefundsCreator(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+	creator, _ := sdk.AccAddressFromBech32(keepertest.TestContract2)
+	pool := types.DefaultMinCampaignRewardPool
+	require.NoError(t, keeper.BankKeeper.MintCoins(ctx, minttypes.ModuleName, pool))
+	require.NoError(t, keeper.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, creator, pool))
+
+	campaign, err := keeper.CreateIncentiveCampaign(ctx, types.NewMsgCreateIncentiveCampaign(
+		keepertest.TestContract2, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom,
+		pool, 2, sdk.MustNewDecFromStr("0.05"), 10,
+	))
+	require.NoError(t, err)
+
+	// one-sided book is not sampled
+	keeper.SetLongBook(ctx, keepertest.TestContract, types.LongBook{
+		Price: sdk.NewDec(99),
+		Entry: incentiveBookEntry(99, map[string]int64{keepertest.TestAccount: 10}),
+	})
+	keeper.AfterEpochEnd(ctx, epochtypes.Epoch{CurrentEpoch: 1, CurrentEpochStartTime: time.Unix(940, 0)})
+	_, found := keeper.GetIncentiveCampaign(ctx, campaign.Id)
+	require.True(t, found)
+	require.True(t, keeper.BankKeeper.GetAllBalances(ctx, creator).IsZero())
+
+	keeper.AfterEpochEnd(ctx, epochtypes.Epoch{CurrentEpoch: 2, CurrentEpochStartTime: time.Unix(940, 0)})
+	_, found = keeper.GetIncentiveCampaign(ctx, campaign.Id)
+	require.False(t, found)
+	require.Equal(t, pool, keeper.BankKeeper.GetAllBalances(ctx, creator))
+}
+
+func TestIncentiveCampaignFailedPayoutRefundsCreator(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+	creator, _ := sdk.AccAddressFromBech32(keepertest.TestContract2)
+	account, _ := sdk.AccAddressFromBech32(keepertest.TestAccount)
+	pool := types.DefaultMinCampaignRewardPool
+	require.NoError(t, keeper.BankKeeper.MintCoins(ctx, minttypes.ModuleName, pool))
+	require.NoError(t, keeper.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, creator, pool))
+
+	campaign, err := keeper.CreateIncentiveCampaign(ctx, types.NewMsgCreateIncentiveCampaign(
+		keepertest.TestContract2, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom,
+		pool, 1, sdk.MustNewDecFromStr("0.05"), 10,
+	))
+	require.NoError(t, err)
+
+	// TestAccount is paid first, then the payout to the invalid account fails
+	keeper.SetIncentiveScore(ctx, types.IncentiveScore{CampaignId: campaign.Id, Account: keepertest.TestAccount, Score: sdk.NewDec(1)})
+	keeper.SetIncentiveScore(ctx, types.IncentiveScore{CampaignId: campaign.Id, Account: "zzz", Score: sdk.NewDec(1)})
+
+	keeper.AfterEpochEnd(ctx, epochtypes.Epoch{CurrentEpoch: 1, CurrentEpochStartTime: time.Unix(940, 0)})
+	require.True(t, keeper.BankKeeper.GetAllBalances(ctx, account).IsZero())
+	require.Equal(t, pool, keeper.BankKeeper.GetAllBalances(ctx, creator))
+	_, found := keeper.GetIncentiveCampaign(ctx, campaign.Id)
+	require.False(t, found)
+	require.Empty(t, keeper.GetIncentiveScores(ctx, campaign.Id))
+
+	// later epochs don't pay the campaign out again
+	keeper.AfterEpochEnd(ctx, epochtypes.Epoch{CurrentEpoch: 2, CurrentEpochStartTime: time.Unix(940, 0)})
+	require.True(t, keeper.BankKeeper.GetAllBalances(ctx, account).IsZero())
+	require.Equal(t, pool, keeper.BankKeeper.GetAllBalances(ctx, creator))
+	require.True(t, keeper.BankKeeper.GetAllBalances(ctx, keeper.AccountKeeper.GetModuleAddress(types.IncentivePoolName)).IsZero())
+}
+
+func TestIncentiveCampaignsIndexedByEndEpoch(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	for _, campaign := range []types.IncentiveCampaign{
+		{Id: 1, ContractAddr: keepertest.TestContract, PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom, EndEpoch: 5},
+		{Id: 2, ContractAddr: keepertest.TestContract, PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom, EndEpoch: 3},
+		{Id: 3, ContractAddr: keepertest.TestContract, PriceDenom: keepertest.TestPriceDenom, AssetDenom: "ETH", EndEpoch: 3},
+	} {
+		keeper.SetIncentiveCampaign(ctx, campaign)
+	}
+	ids := func() (ids []uint64) {
+		for _, campaign := range keeper.GetIncentiveCampaignsByEndEpoch(ctx) {
+			ids = append(ids, campaign.Id)
+		}
+		return
+	}
+	require.Equal(t, []uint64{2, 3, 1}, ids())
+	require.Equal(t, uint64(2), keeper.GetIncentiveCampaignCountForPair(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom))
+
+	keeper.DeleteIncentiveCampaign(ctx, 2)
+	require.Equal(t, []uint64{3, 1}, ids())
+	require.Equal(t, uint64(1), keeper.GetIncentiveCampaignCountForPair(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom))
+}
+
+func TestCreateIncentiveCampaignLimits(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	creator, _ := sdk.AccAddressFromBech32(keepertest.TestContract2)
+	pool := types.DefaultMinCampaignRewardPool
+	funds := pool.Add(pool...)
+	require.NoError(t, keeper.BankKeeper.MintCoins(ctx, minttypes.ModuleName, funds))
+	require.NoError(t, keeper.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, creator, funds))
+	params := keeper.GetParams(ctx)
+	params.MaxActiveCampaignsPerPair = 1
+	keeper.SetParams(ctx, params)
+	msg := func(pool sdk.Coins) *types.MsgCreateIncentiveCampaign {
+		return types.NewMsgCreateIncentiveCampaign(
+			keepertest.TestContract2, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom,
+			pool, 1, sdk.MustNewDecFromStr("0.05"), 10,
+		)
+	}
+
+	// below the min reward pool, or in another denom
+	_, err := keeper.CreateIncentiveCampaign(ctx, msg(pool.Sub(sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(1))))))
+	require.ErrorIs(t, err, types.ErrInvalidIncentiveCampaign)
+	_, err = keeper.CreateIncentiveCampaign(ctx, msg(sdk.NewCoins(sdk.NewCoin("uatom", pool.AmountOf("usei")))))
+	require.ErrorIs(t, err, types.ErrInvalidIncentiveCampaign)
+
+	campaign, err := keeper.CreateIncentiveCampaign(ctx, msg(pool))
+	require.NoError(t, err)
+
+	// the pair is at the cap until the campaign is paid out
+	_, err = keeper.CreateIncentiveCampaign(ctx, msg(pool))
+	require.ErrorIs(t, err, types.ErrInvalidIncentiveCampaign)
+	require.NoError(t, keeper.DistributeIncentiveCampaign(ctx, campaign))
+	_, err = keeper.CreateIncentiveCampaign(ctx, msg(pool))
+	require.NoError(t, err)
+}
@@ -16,8 +16,16 @@ func (k Keeper) SetLongBook(ctx sdk.Context, contractAddr string, longBook types
 			true, contractAddr, longBook.Entry.PriceDenom, longBook.Entry.AssetDenom,
 		),
 	)
+	key := GetKeyForLongBook(longBook)
+	var oldEntry *types.OrderEntry
+	if old := store.Get(key); old != nil {
+		var oldBook types.LongBook
+		k.Cdc.MustUnmarshal(old, &oldBook)
+		oldEntry = oldBook.Entry
+	}
+	k.updateAccountActiveOrderCounts(ctx, contractAddr, longBook.Entry.PriceDenom, longBook.Entry.AssetDenom, oldEntry, longBook.Entry)
 	b := k.Cdc.MustMarshal(&longBook)
-	store.Set(GetKeyForLongBook(longBook), b)
+	store.Set(key, b)
 }
 
 func (k Keeper) SetLongOrderBookEntry(ctx sdk.Context, contractAddr string, longBook types.OrderBookEntry) {
@@ -51,7 +59,13 @@ func (k Keeper) RemoveLongBookByPrice(ctx sdk.Context, contractAddr string, pric
 			true, contractAddr, priceDenom, assetDenom,
 		),
 	)
-	store.Delete(GetKeyForPrice(price))
+	key := GetKeyForPrice(price)
+	if old := store.Get(key); old != nil {
+		var oldBook types.LongBook
+		k.Cdc.MustUnmarshal(old, &oldBook)
+		k.updateAccountActiveOrderCounts(ctx, contractAddr, priceDenom, assetDenom, oldBook.Entry, nil)
+	}
+	store.Delete(key)
 }
 
 // GetAllLongBook returns all longBook
@@ -52,5 +52,12 @@ func (k msgServer) ContractDepositRent(goCtx context.Context, msg *types.MsgCont
 		sdk.NewAttribute(types.AttributeKeyContractAddress, msg.ContractAddr),
 		sdk.NewAttribute(types.AttributeKeyRentBalance, fmt.Sprint(contract.RentBalance)),
 	))
+	if err := types.EmitTypedEvent(ctx, &types.EventRentDeposited{
+		ContractAddr: msg.ContractAddr,
+		Amount:       msg.Amount,
+		RentBalance:  contract.RentBalance,
+	}); err != nil {
+		return nil, err
+	}
 	return &types.MsgContractDepositRentResponse{}, nil
 }
@@ -0,0 +1,33 @@
+package msgserver
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+func (k msgServer) CreateIncentiveCampaign(goCtx context.Context, msg *types.MsgCreateIncentiveCampaign) (*types.MsgCreateIncentiveCampaignResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("request invalid: %s", err))
+		return nil, err
+	}
+
+	if _, found := k.GetRegisteredPair(ctx, msg.ContractAddr, msg.PriceDenom, msg.AssetDenom); !found {
+		return nil, sdkerrors.Wrapf(types.ErrPairNotRegistered, "pair {price:%s,asset:%s} of contract %s", msg.PriceDenom, msg.AssetDenom, msg.ContractAddr)
+	}
+
+	campaign, err := k.Keeper.CreateIncentiveCampaign(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := types.EmitTypedEvent(ctx, &types.EventIncentiveCampaignCreated{Campaign: campaign}); err != nil {
+		return nil, err
+	}
+	return &types.MsgCreateIncentiveCampaignResponse{CampaignId: campaign.Id}, nil
+}
@@ -0,0 +1,43 @@
+package msgserver_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/msgserver"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	minttypes "github.com/sei-protocol/sei-chain/x/mint/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateIncentiveCampaign(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	creator, _ := sdk.AccAddressFromBech32(keepertest.TestAccount)
+	pool := types.DefaultMinCampaignRewardPool
+	require.NoError(t, keeper.BankKeeper.MintCoins(ctx, minttypes.ModuleName, pool))
+	require.NoError(t, keeper.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, creator, pool))
+	server := msgserver.NewMsgServerImpl(*keeper)
+	msg := types.NewMsgCreateIncentiveCampaign(
+		keepertest.TestAccount, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom,
+		pool, 5, sdk.MustNewDecFromStr("0.01"), 5,
+	)
+
+	// pair not registered
+	_, err := server.CreateIncentiveCampaign(wctx, msg)
+	require.ErrorIs(t, err, types.ErrPairNotRegistered)
+
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, keepertest.TestPair)
+	res, err := server.CreateIncentiveCampaign(wctx, msg)
+	require.NoError(t, err)
+	campaign, found := keeper.GetIncentiveCampaign(ctx, res.CampaignId)
+	require.True(t, found)
+	require.Equal(t, uint64(1), campaign.StartEpoch)
+	require.Equal(t, uint64(5), campaign.EndEpoch)
+	require.Equal(t, pool, keeper.BankKeeper.GetAllBalances(ctx, keeper.AccountKeeper.GetModuleAddress(types.IncentivePoolName)))
+
+	// creator can no longer fund a second campaign
+	_, err = server.CreateIncentiveCampaign(wctx, msg)
+	require.Error(t, err)
+}
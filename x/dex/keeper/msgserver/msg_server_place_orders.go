@@ -12,31 +12,36 @@ import (
 )
 
 func (k msgServer) transferFunds(goCtx context.Context, msg *types.MsgPlaceOrders) error {
-	if len(msg.Funds) == 0 {
+	return k.depositFunds(sdk.UnwrapSDKContext(goCtx), msg.Creator, msg.GetContractAddr(), msg.Funds)
+}
+
+// depositFunds moves funds from the creator to the dex module and records them as a
+// deposit to be credited to the creator by the given contract at end block.
+func (k msgServer) depositFunds(ctx sdk.Context, creator string, contractAddress string, funds sdk.Coins) error {
+	if len(funds) == 0 {
 		return nil
 	}
 
-	ctx := sdk.UnwrapSDKContext(goCtx)
-	contractAddr := sdk.MustAccAddressFromBech32(msg.ContractAddr)
-	if err := k.BankKeeper.IsSendEnabledCoins(ctx, msg.Funds...); err != nil {
+	contractAddr := sdk.MustAccAddressFromBech32(contractAddress)
+	if err := k.BankKeeper.IsSendEnabledCoins(ctx, funds...); err != nil {
 		return err
 	}
 	if k.BankKeeper.BlockedAddr(contractAddr) {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", contractAddr.String())
 	}
 
-	sender := sdk.MustAccAddressFromBech32(msg.Creator)
-	for _, fund := range msg.Funds {
+	sender := sdk.MustAccAddressFromBech32(creator)
+	for _, fund := range funds {
 		if fund.Amount.IsNil() || fund.IsNegative() {
 			return errors.New("fund deposits cannot be nil or negative")
 		}
-		utils.GetMemState(ctx.Context()).GetDepositInfo(ctx, types.ContractAddress(msg.GetContractAddr())).Add(&types.DepositInfoEntry{
-			Creator: msg.Creator,
+		utils.GetMemState(ctx.Context()).GetDepositInfo(ctx, types.ContractAddress(contractAddress)).Add(&types.DepositInfoEntry{
+			Creator: creator,
 			Denom:   fund.Denom,
 			Amount:  sdk.NewDec(fund.Amount.Int64()),
 		})
 	}
-	if err := k.BankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, msg.Funds); err != nil {
+	if err := k.BankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, funds); err != nil {
 		return fmt.Errorf("error sending coins to contract: %s", err)
 	}
 	return nil
@@ -50,13 +55,24 @@ func (k msgServer) PlaceOrders(goCtx context.Context, msg *types.MsgPlaceOrders)
 		return nil, err
 	}
 
+	params := k.GetParams(ctx)
+	if err := k.ValidateMinOrderNotional(params, msg.Orders); err != nil {
+		return nil, err
+	}
+	if err := k.ValidateAccountActiveOrders(ctx, params, msg.ContractAddr, msg.Creator, msg.Orders); err != nil {
+		return nil, err
+	}
+	if err := k.ValidateOrderToTradeRatio(ctx, params, msg.ContractAddr, msg.Creator, len(msg.Orders)); err != nil {
+		return nil, err
+	}
+
 	if err := k.transferFunds(goCtx, msg); err != nil {
 		return nil, err
 	}
 	events := []sdk.Event{}
 	nextID := k.GetNextOrderID(ctx, msg.ContractAddr)
 	idsInResp := []uint64{}
-	maxOrderPerPrice := k.GetMaxOrderPerPrice(ctx)
+	maxOrderPerPrice := k.GetMaxOrderPerPrice(ctx, msg.GetContractAddr())
 	for _, order := range msg.GetOrders() {
 		if k.GetOrderCountState(ctx, msg.GetContractAddr(), order.PriceDenom, order.AssetDenom, order.PositionDirection, order.Price) >= maxOrderPerPrice {
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order book already has more than %d orders for %s-%s-%s %s at %s", maxOrderPerPrice, msg.GetContractAddr(), order.PriceDenom, order.AssetDenom, order.PositionDirection, order.Price)
@@ -79,9 +95,27 @@ func (k msgServer) PlaceOrders(goCtx context.Context, msg *types.MsgPlaceOrders)
 			types.EventTypePlaceOrder,
 			sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprint(nextID)),
 		))
+		typedEvent, err := types.NewTypedEvent(&types.EventOrderPlaced{
+			ContractAddr:      msg.ContractAddr,
+			OrderId:           nextID,
+			Account:           msg.Creator,
+			PriceDenom:        order.PriceDenom,
+			AssetDenom:        order.AssetDenom,
+			PositionDirection: order.PositionDirection,
+			OrderType:         order.OrderType,
+			Price:             order.Price,
+			Quantity:          order.Quantity,
+		})
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, typedEvent)
 		nextID++
 	}
 	k.SetNextOrderID(ctx, msg.ContractAddr, nextID)
+	if params.MaxOrderToTradeRatio > 0 {
+		k.IncreaseAccountOrdersPlaced(ctx, msg.ContractAddr, msg.Creator, uint64(len(msg.Orders)))
+	}
 	ctx.EventManager().EmitEvents(events)
 
 	utils.GetMemState(ctx.Context()).SetDownstreamsToProcess(ctx, msg.ContractAddr, k.GetContractWithoutGasCharge)
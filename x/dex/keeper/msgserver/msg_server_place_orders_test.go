@@ -334,3 +334,181 @@ func TestPlaceOrderExceedingLimit(t *testing.T) {
 	_, err := server.PlaceOrders(wctx, msg)
 	require.NotNil(t, err)
 }
+
+func TestPlaceOrderExceedingAccountActiveOrders(t *testing.T) {
+	order := func() *types.Order {
+		return &types.Order{
+			Price:             sdk.MustNewDecFromStr("10"),
+			Quantity:          sdk.MustNewDecFromStr("10"),
+			Data:              "",
+			PositionDirection: types.PositionDirection_LONG,
+			OrderType:         types.OrderType_LIMIT,
+			PriceDenom:        keepertest.TestPriceDenom,
+			AssetDenom:        keepertest.TestAssetDenom,
+		}
+	}
+	keeper, ctx := keepertest.DexKeeper(t)
+	params := keeper.GetParams(ctx)
+	params.MaxOpenOrdersPerAccountPerPair = 2
+	keeper.SetParams(ctx, params)
+	keeper.AddRegisteredPair(ctx, TestContract, keepertest.TestPair)
+	keeper.SetPriceTickSizeForPair(ctx, TestContract, keepertest.TestPair, *keepertest.TestPair.PriceTicksize)
+	keeper.SetQuantityTickSizeForPair(ctx, TestContract, keepertest.TestPair, *keepertest.TestPair.QuantityTicksize)
+	keeper.SetLongBook(ctx, TestContract, types.LongBook{
+		Price: sdk.NewDec(5),
+		Entry: &types.OrderEntry{
+			Price:       sdk.NewDec(5),
+			Quantity:    sdk.NewDec(1),
+			PriceDenom:  keepertest.TestPriceDenom,
+			AssetDenom:  keepertest.TestAssetDenom,
+			Allocations: []*types.Allocation{{OrderId: 100, Account: TestCreator, Quantity: sdk.NewDec(1)}},
+		},
+	})
+	wctx := sdk.WrapSDKContext(ctx)
+	server := msgserver.NewMsgServerImpl(*keeper)
+	_, err := server.PlaceOrders(wctx, &types.MsgPlaceOrders{
+		Creator:      TestCreator,
+		ContractAddr: TestContract,
+		Orders:       []*types.Order{order()},
+	})
+	require.Nil(t, err)
+	// one order on the book and one placed earlier in the block
+	_, err = server.PlaceOrders(wctx, &types.MsgPlaceOrders{
+		Creator:      TestCreator,
+		ContractAddr: TestContract,
+		Orders:       []*types.Order{order()},
+	})
+	require.ErrorIs(t, err, types.ErrTooManyActiveOrders)
+	// other accounts are not affected
+	_, err = server.PlaceOrders(wctx, &types.MsgPlaceOrders{
+		Creator:      keepertest.TestAccount,
+		ContractAddr: TestContract,
+		Orders:       []*types.Order{order(), order()},
+	})
+	require.Nil(t, err)
+}
+
+func TestPlaceOrderBelowMinNotional(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	params := keeper.GetParams(ctx)
+	params.MinOrderNotional = sdk.NewDec(100)
+	keeper.SetParams(ctx, params)
+	keeper.AddRegisteredPair(ctx, TestContract, keepertest.TestPair)
+	keeper.SetPriceTickSizeForPair(ctx, TestContract, keepertest.TestPair, *keepertest.TestPair.PriceTicksize)
+	keeper.SetQuantityTickSizeForPair(ctx, TestContract, keepertest.TestPair, *keepertest.TestPair.QuantityTicksize)
+	wctx := sdk.WrapSDKContext(ctx)
+	server := msgserver.NewMsgServerImpl(*keeper)
+	msg := &types.MsgPlaceOrders{
+		Creator:      TestCreator,
+		ContractAddr: TestContract,
+		Orders: []*types.Order{
+			{
+				Price:             sdk.MustNewDecFromStr("10"),
+				Quantity:          sdk.MustNewDecFromStr("9"),
+				Data:              "",
+				PositionDirection: types.PositionDirection_LONG,
+				OrderType:         types.OrderType_LIMIT,
+				PriceDenom:        keepertest.TestPriceDenom,
+				AssetDenom:        keepertest.TestAssetDenom,
+			},
+		},
+	}
+	_, err := server.PlaceOrders(wctx, msg)
+	require.ErrorIs(t, err, types.ErrOrderNotionalTooSmall)
+
+	msg.Orders[0].Quantity = sdk.MustNewDecFromStr("10")
+	_, err = server.PlaceOrders(wctx, msg)
+	require.Nil(t, err)
+}
+
+func TestPlaceOrderExceedingOrderToTradeRatio(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	params := keeper.GetParams(ctx)
+	params.MaxOrderToTradeRatio = 2
+	params.OrderToTradeRatioWindow = 100
+	keeper.SetParams(ctx, params)
+	keeper.AddRegisteredPair(ctx, TestContract, keepertest.TestPair)
+	keeper.SetPriceTickSizeForPair(ctx, TestContract, keepertest.TestPair, *keepertest.TestPair.PriceTicksize)
+	keeper.SetQuantityTickSizeForPair(ctx, TestContract, keepertest.TestPair, *keepertest.TestPair.QuantityTicksize)
+	wctx := sdk.WrapSDKContext(ctx)
+	server := msgserver.NewMsgServerImpl(*keeper)
+	msg := func() *types.MsgPlaceOrders {
+		return &types.MsgPlaceOrders{
+			Creator:      TestCreator,
+			ContractAddr: TestContract,
+			Orders: []*types.Order{
+				{
+					Price:             sdk.MustNewDecFromStr("10"),
+					Quantity:          sdk.MustNewDecFromStr("10"),
+					Data:              "",
+					PositionDirection: types.PositionDirection_LONG,
+					OrderType:         types.OrderType_LIMIT,
+					PriceDenom:        keepertest.TestPriceDenom,
+					AssetDenom:        keepertest.TestAssetDenom,
+				},
+			},
+		}
+	}
+	_, err := server.PlaceOrders(wctx, msg())
+	require.Nil(t, err)
+	_, err = server.PlaceOrders(wctx, msg())
+	require.Nil(t, err)
+	_, err = server.PlaceOrders(wctx, msg())
+	require.ErrorIs(t, err, types.ErrOrderToTradeRatioExceeded)
+
+	// fills raise the allowance
+	keeper.IncreaseAccountOrdersFilled(ctx, TestContract, TestCreator, 2)
+	_, err = server.PlaceOrders(wctx, msg())
+	require.Nil(t, err)
+}
+
+func TestPlaceOrderEmitsTypedEvent(t *testing.T) {
+	msg := &types.MsgPlaceOrders{
+		Creator:      TestCreator,
+		ContractAddr: TestContract,
+		Orders: []*types.Order{
+			{
+				Price:             sdk.MustNewDecFromStr("10"),
+				Quantity:          sdk.MustNewDecFromStr("10"),
+				Data:              "",
+				PositionDirection: types.PositionDirection_LONG,
+				OrderType:         types.OrderType_LIMIT,
+				PriceDenom:        keepertest.TestPriceDenom,
+				AssetDenom:        keepertest.TestAssetDenom,
+			},
+		},
+	}
+	keeper, ctx := keepertest.DexKeeper(t)
+	keeper.AddRegisteredPair(ctx, TestContract, keepertest.TestPair)
+	keeper.SetPriceTickSizeForPair(ctx, TestContract, keepertest.TestPair, *keepertest.TestPair.PriceTicksize)
+	keeper.SetQuantityTickSizeForPair(ctx, TestContract, keepertest.TestPair, *keepertest.TestPair.QuantityTicksize)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	wctx := sdk.WrapSDKContext(ctx)
+	server := msgserver.NewMsgServerImpl(*keeper)
+	_, err := server.PlaceOrders(wctx, msg)
+	require.Nil(t, err)
+
+	var placed *types.EventOrderPlaced
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		if event.Type == types.EventTypePlaceOrder {
+			continue
+		}
+		parsed, err := types.ParseTypedEvent(event)
+		require.Nil(t, err)
+		if e, ok := parsed.(*types.EventOrderPlaced); ok {
+			placed = e
+		}
+	}
+	require.NotNil(t, placed)
+	require.Equal(t, &types.EventOrderPlaced{
+		ContractAddr:      TestContract,
+		OrderId:           0,
+		Account:           TestCreator,
+		PriceDenom:        keepertest.TestPriceDenom,
+		AssetDenom:        keepertest.TestAssetDenom,
+		PositionDirection: types.PositionDirection_LONG,
+		OrderType:         types.OrderType_LIMIT,
+		Price:             sdk.MustNewDecFromStr("10"),
+		Quantity:          sdk.MustNewDecFromStr("10"),
+	}, placed)
+}
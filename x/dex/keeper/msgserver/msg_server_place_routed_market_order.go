@@ -0,0 +1,108 @@
+package msgserver
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/sei-protocol/sei-chain/x/dex/utils"
+)
+
+// PlaceRoutedMarketOrder queues a multi-hop market order. Every leg gets an order ID
+// from its own contract, but none of the legs is matched here: the whole route is
+// executed atomically at end block, after regular order matching. The funds of the
+// route are held by the module until then.
+func (k msgServer) PlaceRoutedMarketOrder(goCtx context.Context, msg *types.MsgPlaceRoutedMarketOrder) (*types.MsgPlaceRoutedMarketOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("request invalid: %s", err))
+		return nil, err
+	}
+
+	// funds aren't deposited into a contract, since the route's intermediate
+	// assets are moved between the contracts of its legs
+	if err := k.BankKeeper.IsSendEnabledCoins(ctx, msg.Funds...); err != nil {
+		return nil, err
+	}
+	if err := k.BankKeeper.SendCoinsFromAccountToModule(ctx, sdk.MustAccAddressFromBech32(msg.Creator), types.ModuleName, msg.Funds); err != nil {
+		return nil, fmt.Errorf("error escrowing the route's funds: %s", err)
+	}
+
+	routedOrder := types.RoutedOrder{
+		Account:      msg.Creator,
+		Legs:         []*types.Order{},
+		AmountIn:     msg.AmountIn,
+		MinAmountOut: msg.MinAmountOut,
+	}
+	idsInResp := []uint64{}
+	events := []sdk.Event{}
+	for _, leg := range msg.Legs {
+		pair := types.Pair{PriceDenom: leg.PriceDenom, AssetDenom: leg.AssetDenom}
+		if _, found := k.GetPriceTickSizeForPair(ctx, leg.ContractAddr, pair); !found {
+			return nil, sdkerrors.Wrapf(types.ErrPairNotRegistered, "the pair {price:%s,asset:%s} is not registered for %s", leg.PriceDenom, leg.AssetDenom, leg.ContractAddr)
+		}
+		contract, err := k.GetContract(ctx, leg.ContractAddr)
+		if err != nil {
+			return nil, err
+		}
+		if contract.Suspended {
+			return nil, types.ErrContractSuspended
+		}
+		nextID := k.GetNextOrderID(ctx, leg.ContractAddr)
+		routedOrder.Legs = append(routedOrder.Legs, &types.Order{
+			Id:                nextID,
+			Account:           msg.Creator,
+			ContractAddr:      leg.ContractAddr,
+			Price:             sdk.ZeroDec(),
+			Quantity:          sdk.ZeroDec(),
+			Nominal:           sdk.ZeroDec(),
+			TriggerPrice:      sdk.ZeroDec(),
+			PriceDenom:        leg.PriceDenom,
+			AssetDenom:        leg.AssetDenom,
+			PositionDirection: leg.PositionDirection,
+			Data:              leg.Data,
+		})
+		k.SetNextOrderID(ctx, leg.ContractAddr, nextID+1)
+		idsInResp = append(idsInResp, nextID)
+		events = append(events, sdk.NewEvent(
+			types.EventTypePlaceOrder,
+			sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprint(nextID)),
+			sdk.NewAttribute(types.AttributeKeyContractAddress, leg.ContractAddr),
+		))
+		// legs are matched as fill-or-kill market orders, by value when buying
+		orderType := types.OrderType_FOKMARKET
+		if leg.PositionDirection == types.PositionDirection_LONG {
+			orderType = types.OrderType_FOKMARKETBYVALUE
+		}
+		typedEvent, err := types.NewTypedEvent(&types.EventOrderPlaced{
+			ContractAddr:      leg.ContractAddr,
+			OrderId:           nextID,
+			Account:           msg.Creator,
+			PriceDenom:        leg.PriceDenom,
+			AssetDenom:        leg.AssetDenom,
+			PositionDirection: leg.PositionDirection,
+			OrderType:         orderType,
+			Price:             sdk.ZeroDec(),
+			Quantity:          sdk.ZeroDec(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, typedEvent)
+		utils.GetMemState(ctx.Context()).SetDownstreamsToProcess(ctx, leg.ContractAddr, k.GetContractWithoutGasCharge)
+	}
+	utils.GetMemState(ctx.Context()).GetBlockRoutedOrders(ctx).Add(&routedOrder)
+	events = append(events, sdk.NewEvent(
+		types.EventTypePlaceRoutedOrder,
+		sdk.NewAttribute(types.AttributeKeyAccount, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyAmountIn, msg.AmountIn.String()),
+	))
+	ctx.EventManager().EmitEvents(events)
+
+	return &types.MsgPlaceRoutedMarketOrderResponse{
+		OrderIds: idsInResp,
+	}, nil
+}
@@ -0,0 +1,115 @@
+package msgserver_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/msgserver"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+	minttypes "github.com/sei-protocol/sei-chain/x/mint/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlaceRoutedMarketOrder(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	keeper.SetContract(ctx, &types.ContractInfoV2{ContractAddr: keepertest.TestContract, Creator: keepertest.TestAccount})
+	otherPair := types.Pair{PriceDenom: keepertest.TestPriceDenom, AssetDenom: "osmo", PriceTicksize: keepertest.TestPair.PriceTicksize, QuantityTicksize: keepertest.TestPair.QuantityTicksize}
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, keepertest.TestPair)
+	keeper.SetPriceTickSizeForPair(ctx, keepertest.TestContract, keepertest.TestPair, *keepertest.TestPair.PriceTicksize)
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, otherPair)
+	keeper.SetPriceTickSizeForPair(ctx, keepertest.TestContract, otherPair, *otherPair.PriceTicksize)
+	keeper.SetNextOrderID(ctx, keepertest.TestContract, 5)
+	funds := fundRoute(t, ctx, keeper)
+	msg := types.NewMsgPlaceRoutedMarketOrder(
+		TestCreator,
+		[]*types.RouteLeg{
+			{ContractAddr: keepertest.TestContract, PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom, PositionDirection: types.PositionDirection_SHORT},
+			{ContractAddr: keepertest.TestContract, PriceDenom: keepertest.TestPriceDenom, AssetDenom: "osmo", PositionDirection: types.PositionDirection_LONG},
+		},
+		sdk.NewDec(10),
+		sdk.NewDec(1),
+		funds,
+	)
+	server := msgserver.NewMsgServerImpl(*keeper)
+	res, err := server.PlaceRoutedMarketOrder(sdk.WrapSDKContext(ctx), msg)
+	require.Nil(t, err)
+	require.Equal(t, []uint64{5, 6}, res.OrderIds)
+	require.Equal(t, uint64(7), keeper.GetNextOrderID(ctx, keepertest.TestContract))
+
+	routedOrders := dexutils.GetMemState(ctx.Context()).GetBlockRoutedOrders(ctx).Get()
+	require.Equal(t, 1, len(routedOrders))
+	require.Equal(t, TestCreator, routedOrders[0].Account)
+	require.Equal(t, 2, len(routedOrders[0].Legs))
+	require.Equal(t, "osmo", routedOrders[0].Legs[1].AssetDenom)
+	require.Equal(t, uint64(6), routedOrders[0].Legs[1].Id)
+	require.True(t, dexutils.GetMemState(ctx.Context()).GetContractToProcess().Contains(keepertest.TestContract))
+
+	// the funds are held by the module rather than deposited into the contract
+	require.Equal(t, funds, keeper.BankKeeper.GetAllBalances(ctx, keeper.AccountKeeper.GetModuleAddress(types.ModuleName)))
+	require.Empty(t, dexutils.GetMemState(ctx.Context()).GetDepositInfo(ctx, types.ContractAddress(keepertest.TestContract)).Get())
+}
+
+func fundRoute(t *testing.T, ctx sdk.Context, keeper *keeper.Keeper) sdk.Coins {
+	keeper.CreateModuleAccount(ctx)
+	funds := sdk.NewCoins(sdk.NewCoin(keepertest.TestAssetDenom, sdk.NewInt(10)))
+	require.NoError(t, keeper.BankKeeper.MintCoins(ctx, minttypes.ModuleName, funds))
+	require.NoError(t, keeper.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sdk.MustAccAddressFromBech32(TestCreator), funds))
+	return funds
+}
+
+func TestPlaceRoutedMarketOrderAcrossContracts(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	keeper.SetContract(ctx, &types.ContractInfoV2{ContractAddr: keepertest.TestContract, Creator: keepertest.TestAccount})
+	keeper.SetContract(ctx, &types.ContractInfoV2{ContractAddr: TestContract, Creator: keepertest.TestAccount})
+	otherPair := types.Pair{PriceDenom: keepertest.TestPriceDenom, AssetDenom: "osmo", PriceTicksize: keepertest.TestPair.PriceTicksize, QuantityTicksize: keepertest.TestPair.QuantityTicksize}
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, keepertest.TestPair)
+	keeper.SetPriceTickSizeForPair(ctx, keepertest.TestContract, keepertest.TestPair, *keepertest.TestPair.PriceTicksize)
+	keeper.AddRegisteredPair(ctx, TestContract, otherPair)
+	keeper.SetPriceTickSizeForPair(ctx, TestContract, otherPair, *otherPair.PriceTicksize)
+	funds := fundRoute(t, ctx, keeper)
+	msg := types.NewMsgPlaceRoutedMarketOrder(
+		TestCreator,
+		[]*types.RouteLeg{
+			{ContractAddr: keepertest.TestContract, PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom, PositionDirection: types.PositionDirection_SHORT},
+			{ContractAddr: TestContract, PriceDenom: keepertest.TestPriceDenom, AssetDenom: "osmo", PositionDirection: types.PositionDirection_LONG},
+		},
+		sdk.NewDec(10),
+		sdk.NewDec(1),
+		funds,
+	)
+	server := msgserver.NewMsgServerImpl(*keeper)
+	res, err := server.PlaceRoutedMarketOrder(sdk.WrapSDKContext(ctx), msg)
+	require.Nil(t, err)
+	// each leg gets an order ID from its own contract
+	require.Equal(t, []uint64{0, 0}, res.OrderIds)
+	require.Equal(t, uint64(1), keeper.GetNextOrderID(ctx, keepertest.TestContract))
+	require.Equal(t, uint64(1), keeper.GetNextOrderID(ctx, TestContract))
+
+	routedOrders := dexutils.GetMemState(ctx.Context()).GetBlockRoutedOrders(ctx).Get()
+	require.Equal(t, 1, len(routedOrders))
+	require.Equal(t, TestContract, routedOrders[0].Legs[1].ContractAddr)
+	require.True(t, dexutils.GetMemState(ctx.Context()).GetContractToProcess().Contains(keepertest.TestContract))
+	require.True(t, dexutils.GetMemState(ctx.Context()).GetContractToProcess().Contains(TestContract))
+}
+
+func TestPlaceRoutedMarketOrderUnregisteredPair(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	keeper.SetContract(ctx, &types.ContractInfoV2{ContractAddr: keepertest.TestContract, Creator: keepertest.TestAccount})
+	funds := fundRoute(t, ctx, keeper)
+	msg := types.NewMsgPlaceRoutedMarketOrder(
+		TestCreator,
+		[]*types.RouteLeg{
+			{ContractAddr: keepertest.TestContract, PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom, PositionDirection: types.PositionDirection_SHORT},
+		},
+		sdk.NewDec(10),
+		sdk.NewDec(1),
+		funds,
+	)
+	server := msgserver.NewMsgServerImpl(*keeper)
+	_, err := server.PlaceRoutedMarketOrder(sdk.WrapSDKContext(ctx), msg)
+	require.NotNil(t, err)
+	require.Equal(t, 0, len(dexutils.GetMemState(ctx.Context()).GetBlockRoutedOrders(ctx).Get()))
+}
@@ -52,6 +52,14 @@ func (k msgServer) RegisterPairs(goCtx context.Context, msg *types.MsgRegisterPa
 				sdk.NewAttribute(types.AttributeKeyPriceDenom, pair.PriceDenom),
 				sdk.NewAttribute(types.AttributeKeyAssetDenom, pair.AssetDenom),
 			))
+			typedEvent, err := types.NewTypedEvent(&types.EventPairRegistered{
+				ContractAddr: contractAddr,
+				Pair:         *pair,
+			})
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, typedEvent)
 		}
 	}
 
@@ -38,6 +38,10 @@ func (k msgServer) UnsuspendContract(goCtx context.Context, msg *types.MsgUnsusp
 		return &types.MsgUnsuspendContractResponse{}, err
 	}
 
+	if err := types.EmitTypedEvent(ctx, &types.EventContractUnsuspended{ContractAddr: msg.ContractAddr}); err != nil {
+		return &types.MsgUnsuspendContractResponse{}, err
+	}
+
 	// suspension changes will also affect dependency traversal since suspended contracts are skipped
 	dexutils.GetMemState(ctx.Context()).ClearContractToDependencies()
 
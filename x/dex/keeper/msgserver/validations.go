@@ -3,10 +3,13 @@ package msgserver
 import (
 	"fmt"
 	"math"
+	"sort"
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/sei-protocol/sei-chain/x/dex/utils"
 )
 
 // Since cosmwasm would amplify gas limit by a multiplier for its internal gas metering,
@@ -29,6 +32,81 @@ func (k msgServer) ValidateSuspension(ctx sdk.Context, contractAddress string) e
 	return nil
 }
 
+func (k msgServer) ValidateMinOrderNotional(params types.Params, orders []*types.Order) error {
+	if params.MinOrderNotional.IsNil() || !params.MinOrderNotional.IsPositive() {
+		return nil
+	}
+	for _, order := range orders {
+		var notional sdk.Dec
+		switch {
+		case order.OrderType == types.OrderType_FOKMARKETBYVALUE:
+			notional = order.Nominal
+		case order.Price.IsPositive():
+			notional = order.Price.Mul(order.Quantity)
+		default:
+			// market orders without a worst price have no notional to check against
+			continue
+		}
+		if notional.LT(params.MinOrderNotional) {
+			return sdkerrors.Wrapf(types.ErrOrderNotionalTooSmall, "order notional %s is smaller than %s", notional, params.MinOrderNotional)
+		}
+	}
+	return nil
+}
+
+// ValidateAccountActiveOrders checks that the limit orders being placed would not
+// take the account over the active order cap of any pair, counting both orders
+// resting on the book and limit orders placed earlier in the same block.
+func (k msgServer) ValidateAccountActiveOrders(ctx sdk.Context, params types.Params, contractAddr string, account string, orders []*types.Order) error {
+	if params.MaxOpenOrdersPerAccountPerPair == 0 {
+		return nil
+	}
+	newOrdersByPair := map[types.PairString]uint64{}
+	pairs := map[types.PairString]types.Pair{}
+	for _, order := range orders {
+		if order.OrderType != types.OrderType_LIMIT {
+			continue
+		}
+		pair := types.Pair{PriceDenom: order.PriceDenom, AssetDenom: order.AssetDenom}
+		pairStr := types.GetPairString(&pair)
+		newOrdersByPair[pairStr]++
+		pairs[pairStr] = pair
+	}
+	pairStrs := []types.PairString{}
+	for pairStr := range pairs {
+		pairStrs = append(pairStrs, pairStr)
+	}
+	sort.Slice(pairStrs, func(i, j int) bool { return pairStrs[i] < pairStrs[j] })
+	for _, pairStr := range pairStrs {
+		pair := pairs[pairStr]
+		count := k.GetAccountActiveOrderCount(ctx, contractAddr, pair.PriceDenom, pair.AssetDenom, account) + newOrdersByPair[pairStr]
+		blockOrders := utils.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(contractAddr), pair)
+		pendingOrders := append(blockOrders.GetLimitOrders(types.PositionDirection_LONG), blockOrders.GetLimitOrders(types.PositionDirection_SHORT)...)
+		for _, pending := range pendingOrders {
+			if pending.Account == account {
+				count++
+			}
+		}
+		if count > params.MaxOpenOrdersPerAccountPerPair {
+			return sdkerrors.Wrapf(types.ErrTooManyActiveOrders, "account %s would have %d active orders for %s-%s-%s, above the limit of %d", account, count, contractAddr, pair.PriceDenom, pair.AssetDenom, params.MaxOpenOrdersPerAccountPerPair)
+		}
+	}
+	return nil
+}
+
+func (k msgServer) ValidateOrderToTradeRatio(ctx sdk.Context, params types.Params, contractAddr string, account string, numOrders int) error {
+	if params.MaxOrderToTradeRatio == 0 {
+		return nil
+	}
+	counter := k.GetAccountOrderCounter(ctx, contractAddr, account)
+	if counter.ExceedsOrderToTradeRatio(uint64(numOrders), params.MaxOrderToTradeRatio, ctx.BlockHeight(), params.OrderToTradeRatioWindow) {
+		return sdkerrors.Wrapf(types.ErrOrderToTradeRatioExceeded, "account %s has placed %d orders with %d fills in the last %d blocks, above the ratio of %d",
+			account, counter.SlidingOrdersPlaced(ctx.BlockHeight(), params.OrderToTradeRatioWindow), counter.SlidingOrdersFilled(ctx.BlockHeight(), params.OrderToTradeRatioWindow),
+			params.OrderToTradeRatioWindow, params.MaxOrderToTradeRatio)
+	}
+	return nil
+}
+
 func (k msgServer) maxAllowedRentBalance() uint64 {
 	// TODO: replace with a wasm keeper query once its gas registry is made public
 	return uint64(math.MaxUint64) / wasmkeeper.DefaultGasMultiplier
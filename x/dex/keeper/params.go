@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sei-protocol/sei-chain/x/dex/types"
 )
@@ -12,8 +13,18 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return params
 }
 
-func (k Keeper) GetSettlementGasAllowance(ctx sdk.Context, numSettlements int) uint64 {
-	return k.GetParams(ctx).GasAllowancePerSettlement * uint64(numSettlements)
+// GetEffectiveParams returns the module params with the overrides of the given
+// contract, if any, applied on top.
+func (k Keeper) GetEffectiveParams(ctx sdk.Context, contractAddr string) types.Params {
+	params := k.GetParams(ctx)
+	if override, found := k.GetContractParamsOverride(ctx, contractAddr); found {
+		return params.ApplyOverride(&override)
+	}
+	return params
+}
+
+func (k Keeper) GetSettlementGasAllowance(ctx sdk.Context, contractAddr string, numSettlements int) uint64 {
+	return k.GetEffectiveParams(ctx, contractAddr).GasAllowancePerSettlement * uint64(numSettlements)
 }
 
 func (k Keeper) GetMinProcessableRent(ctx sdk.Context) uint64 {
@@ -28,8 +39,8 @@ func (k Keeper) GetContractUnsuspendCost(ctx sdk.Context) uint64 {
 	return k.GetParams(ctx).ContractUnsuspendCost
 }
 
-func (k Keeper) GetMaxOrderPerPrice(ctx sdk.Context) uint64 {
-	return k.GetParams(ctx).MaxOrderPerPrice
+func (k Keeper) GetMaxOrderPerPrice(ctx sdk.Context, contractAddr string) uint64 {
+	return k.GetEffectiveParams(ctx, contractAddr).MaxOrderPerPrice
 }
 
 func (k Keeper) GetMaxPairsPerContract(ctx sdk.Context) uint64 {
@@ -40,3 +51,39 @@ func (k Keeper) GetMaxPairsPerContract(ctx sdk.Context) uint64 {
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.Paramstore.SetParamSet(ctx, &params)
 }
+
+func (k Keeper) SetContractParamsOverride(ctx sdk.Context, override types.ContractParamsOverride) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ContractParamsOverridePrefix(override.ContractAddr), k.Cdc.MustMarshal(&override))
+}
+
+func (k Keeper) GetContractParamsOverride(ctx sdk.Context, contractAddr string) (types.ContractParamsOverride, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ContractParamsOverridePrefix(contractAddr))
+	if bz == nil {
+		return types.ContractParamsOverride{}, false
+	}
+	override := types.ContractParamsOverride{}
+	k.Cdc.MustUnmarshal(bz, &override)
+	return override, true
+}
+
+func (k Keeper) DeleteContractParamsOverride(ctx sdk.Context, contractAddr string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ContractParamsOverridePrefix(contractAddr))
+}
+
+func (k Keeper) GetAllContractParamsOverrides(ctx sdk.Context) (list []types.ContractParamsOverride) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ContractParamsOverrideKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ContractParamsOverride
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
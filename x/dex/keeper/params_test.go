@@ -19,6 +19,35 @@ func TestGetParams(t *testing.T) {
 
 func TestGetSettlementGasAllowance(t *testing.T) {
 	k, ctx := testkeeper.DexKeeper(t)
-	gasAllowance := k.GetSettlementGasAllowance(ctx, 10)
+	gasAllowance := k.GetSettlementGasAllowance(ctx, testkeeper.TestContract, 10)
 	require.Equal(t, uint64(10)*types.DefaultGasAllowancePerSettlement, gasAllowance)
 }
+
+func TestContractParamsOverride(t *testing.T) {
+	k, ctx := testkeeper.DexKeeper(t)
+	k.SetParams(ctx, types.DefaultParams())
+	_, found := k.GetContractParamsOverride(ctx, testkeeper.TestContract)
+	require.False(t, found)
+	require.Equal(t, types.DefaultParams(), k.GetEffectiveParams(ctx, testkeeper.TestContract))
+
+	override := types.ContractParamsOverride{
+		ContractAddr:              testkeeper.TestContract,
+		MaxOrderPerPrice:          5,
+		GasAllowancePerSettlement: 20000,
+	}
+	k.SetContractParamsOverride(ctx, override)
+	loaded, found := k.GetContractParamsOverride(ctx, testkeeper.TestContract)
+	require.True(t, found)
+	require.Equal(t, override, loaded)
+	require.Equal(t, []types.ContractParamsOverride{override}, k.GetAllContractParamsOverrides(ctx))
+
+	require.Equal(t, uint64(5), k.GetMaxOrderPerPrice(ctx, testkeeper.TestContract))
+	require.Equal(t, uint64(10)*20000, k.GetSettlementGasAllowance(ctx, testkeeper.TestContract, 10))
+	require.Equal(t, uint64(types.DefaultDefaultGasPerOrder), k.GetEffectiveParams(ctx, testkeeper.TestContract).DefaultGasPerOrder)
+	// other contracts are not affected
+	require.Equal(t, uint64(types.DefaultMaxOrderPerPrice), k.GetMaxOrderPerPrice(ctx, testkeeper.TestContract2))
+
+	k.DeleteContractParamsOverride(ctx, testkeeper.TestContract)
+	_, found = k.GetContractParamsOverride(ctx, testkeeper.TestContract)
+	require.False(t, found)
+}
@@ -0,0 +1,27 @@
+package query
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k KeeperWrapper) EffectiveParams(c context.Context, req *types.QueryEffectiveParamsRequest) (*types.QueryEffectiveParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.ContractAddr); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid contract address")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	resp := &types.QueryEffectiveParamsResponse{Params: k.GetParams(ctx)}
+	if override, found := k.GetContractParamsOverride(ctx, req.ContractAddr); found {
+		resp.Params = resp.Params.ApplyOverride(&override)
+		resp.Override = &override
+	}
+	return resp, nil
+}
@@ -0,0 +1,34 @@
+package query_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEffectiveParamsQuery(t *testing.T) {
+	keeper, ctx := testkeeper.DexKeeper(t)
+	wrapper := query.KeeperWrapper{Keeper: keeper}
+	wctx := sdk.WrapSDKContext(ctx)
+	params := types.DefaultParams()
+	keeper.SetParams(ctx, params)
+
+	response, err := wrapper.EffectiveParams(wctx, &types.QueryEffectiveParamsRequest{ContractAddr: testkeeper.TestContract})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryEffectiveParamsResponse{Params: params}, response)
+
+	override := types.ContractParamsOverride{ContractAddr: testkeeper.TestContract, DefaultGasPerOrder: 1000}
+	keeper.SetContractParamsOverride(ctx, override)
+	response, err = wrapper.EffectiveParams(wctx, &types.QueryEffectiveParamsRequest{ContractAddr: testkeeper.TestContract})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), response.Params.DefaultGasPerOrder)
+	require.Equal(t, params.MaxOrderPerPrice, response.Params.MaxOrderPerPrice)
+	require.Equal(t, &override, response.Override)
+
+	_, err = wrapper.EffectiveParams(wctx, &types.QueryEffectiveParamsRequest{ContractAddr: "invalid"})
+	require.Error(t, err)
+}
@@ -0,0 +1,41 @@
+package query
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k KeeperWrapper) IncentiveCampaign(c context.Context, req *types.QueryIncentiveCampaignRequest) (*types.QueryIncentiveCampaignResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	campaign, found := k.GetIncentiveCampaign(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, types.ErrIncentiveCampaignNotFound.Error())
+	}
+	return &types.QueryIncentiveCampaignResponse{Campaign: campaign}, nil
+}
+
+func (k KeeperWrapper) IncentiveCampaigns(c context.Context, req *types.QueryIncentiveCampaignsRequest) (*types.QueryIncentiveCampaignsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryIncentiveCampaignsResponse{Campaigns: k.GetAllIncentiveCampaigns(ctx)}, nil
+}
+
+func (k KeeperWrapper) IncentiveScores(c context.Context, req *types.QueryIncentiveScoresRequest) (*types.QueryIncentiveScoresResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryIncentiveScoresResponse{Scores: k.GetIncentiveScores(ctx, req.CampaignId)}, nil
+}
@@ -11,8 +11,16 @@ import (
 // SetShortBook set a specific shortBook in the store
 func (k Keeper) SetShortBook(ctx sdk.Context, contractAddr string, shortBook types.ShortBook) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OrderBookPrefix(false, contractAddr, shortBook.Entry.PriceDenom, shortBook.Entry.AssetDenom))
+	key := GetKeyForShortBook(shortBook)
+	var oldEntry *types.OrderEntry
+	if old := store.Get(key); old != nil {
+		var oldBook types.ShortBook
+		k.Cdc.MustUnmarshal(old, &oldBook)
+		oldEntry = oldBook.Entry
+	}
+	k.updateAccountActiveOrderCounts(ctx, contractAddr, shortBook.Entry.PriceDenom, shortBook.Entry.AssetDenom, oldEntry, shortBook.Entry)
 	b := k.Cdc.MustMarshal(&shortBook)
-	store.Set(GetKeyForShortBook(shortBook), b)
+	store.Set(key, b)
 }
 
 func (k Keeper) SetShortOrderBookEntry(ctx sdk.Context, contractAddr string, shortBook types.OrderBookEntry) {
@@ -36,7 +44,13 @@ func (k Keeper) GetShortOrderBookEntryByPrice(ctx sdk.Context, contractAddr stri
 
 func (k Keeper) RemoveShortBookByPrice(ctx sdk.Context, contractAddr string, price sdk.Dec, priceDenom string, assetDenom string) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OrderBookPrefix(false, contractAddr, priceDenom, assetDenom))
-	store.Delete(GetKeyForPrice(price))
+	key := GetKeyForPrice(price)
+	if old := store.Get(key); old != nil {
+		var oldBook types.ShortBook
+		k.Cdc.MustUnmarshal(old, &oldBook)
+		k.updateAccountActiveOrderCounts(ctx, contractAddr, priceDenom, assetDenom, oldBook.Entry, nil)
+	}
+	store.Delete(key)
 }
 
 // GetAllShortBook returns all shortBook
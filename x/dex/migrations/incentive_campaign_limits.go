@@ -0,0 +1,21 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// IncentiveCampaignLimitsUpdate adds the incentive campaign limit params without
+// touching existing params, and indexes the campaigns that have not been paid
+// out yet by end epoch and by pair. Campaigns above the per pair cap are kept
+// until they end; the cap only applies to new campaigns.
+func IncentiveCampaignLimitsUpdate(ctx sdk.Context, dexkeeper keeper.Keeper) error {
+	dexkeeper.Paramstore.Set(ctx, types.KeyMinCampaignRewardPool, types.DefaultMinCampaignRewardPool)
+	dexkeeper.Paramstore.Set(ctx, types.KeyMaxActiveCampaignsPerPair, uint64(types.DefaultMaxActiveCampaignsPerPair))
+
+	for _, campaign := range dexkeeper.GetAllIncentiveCampaigns(ctx) {
+		dexkeeper.SetIncentiveCampaign(ctx, campaign)
+	}
+	return nil
+}
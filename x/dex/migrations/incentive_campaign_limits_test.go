@@ -0,0 +1,40 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/migrations"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIncentiveCampaignLimitsUpdate(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	params := dexkeeper.GetParams(ctx)
+	params.MaxOrderPerPrice = 5
+	dexkeeper.SetParams(ctx, params)
+	// campaigns written before the upgrade aren't indexed
+	campaign := types.IncentiveCampaign{
+		Id:           1,
+		ContractAddr: keepertest.TestContract,
+		PriceDenom:   keepertest.TestPriceDenom,
+		AssetDenom:   keepertest.TestAssetDenom,
+		StartEpoch:   1,
+		EndEpoch:     3,
+	}
+	store := prefix.NewStore(ctx.KVStore(dexkeeper.GetStoreKey()), types.KeyPrefix(types.IncentiveCampaignKey))
+	store.Set(sdk.Uint64ToBigEndian(campaign.Id), dexkeeper.Cdc.MustMarshal(&campaign))
+	require.Empty(t, dexkeeper.GetIncentiveCampaignsByEndEpoch(ctx))
+
+	err := migrations.IncentiveCampaignLimitsUpdate(ctx, *dexkeeper)
+	require.NoError(t, err)
+	params = dexkeeper.GetParams(ctx)
+	require.Equal(t, uint64(5), params.MaxOrderPerPrice)
+	require.Equal(t, types.DefaultMinCampaignRewardPool, params.MinCampaignRewardPool)
+	require.Equal(t, uint64(types.DefaultMaxActiveCampaignsPerPair), params.MaxActiveCampaignsPerPair)
+	require.Equal(t, []types.IncentiveCampaign{campaign}, dexkeeper.GetIncentiveCampaignsByEndEpoch(ctx))
+	require.Equal(t, uint64(1), dexkeeper.GetIncentiveCampaignCountForPair(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom))
+}
@@ -0,0 +1,24 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// OrderLimitsUpdate adds the account-level order limit params without touching
+// existing params, and backfills the active order count of every account from
+// the current order books. The open order cap is disabled on existing chains,
+// where accounts may already rest more orders than the default cap, until it's
+// set by governance.
+func OrderLimitsUpdate(ctx sdk.Context, dexkeeper keeper.Keeper) error {
+	dexkeeper.Paramstore.Set(ctx, types.KeyMaxOpenOrdersPerAccount, uint64(0))
+	dexkeeper.Paramstore.Set(ctx, types.KeyMinOrderNotional, types.DefaultMinOrderNotional)
+	dexkeeper.Paramstore.Set(ctx, types.KeyMaxOrderToTradeRatio, uint64(types.DefaultMaxOrderToTradeRatio))
+	dexkeeper.Paramstore.Set(ctx, types.KeyOrderToTradeRatioWindow, uint64(types.DefaultOrderToTradeRatioWindow))
+
+	for _, contractAddr := range getAllContractAddresses(ctx, dexkeeper) {
+		dexkeeper.RebuildAccountActiveOrderCountsForContract(ctx, contractAddr)
+	}
+	return nil
+}
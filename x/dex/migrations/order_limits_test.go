@@ -0,0 +1,43 @@
+package migrations_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/migrations"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderLimitsUpdate(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	params := dexkeeper.GetParams(ctx)
+	params.MaxOrderPerPrice = 5
+	dexkeeper.SetParams(ctx, params)
+	dexkeeper.SetContract(ctx, &types.ContractInfoV2{ContractAddr: keepertest.TestContract})
+	dexkeeper.SetLongBook(ctx, keepertest.TestContract, types.LongBook{
+		Price: sdk.NewDec(1),
+		Entry: &types.OrderEntry{
+			Price:      sdk.NewDec(1),
+			Quantity:   sdk.NewDec(2),
+			PriceDenom: keepertest.TestPriceDenom,
+			AssetDenom: keepertest.TestAssetDenom,
+			Allocations: []*types.Allocation{
+				{OrderId: 1, Account: keepertest.TestAccount, Quantity: sdk.NewDec(1)},
+				{OrderId: 2, Account: keepertest.TestAccount, Quantity: sdk.NewDec(1)},
+			},
+		},
+	})
+	// counts written before the upgrade don't exist
+	dexkeeper.RemoveAllAccountActiveOrderCountsForContract(ctx, keepertest.TestContract)
+
+	err := migrations.OrderLimitsUpdate(ctx, *dexkeeper)
+	require.NoError(t, err)
+	params = dexkeeper.GetParams(ctx)
+	require.Equal(t, uint64(5), params.MaxOrderPerPrice)
+	require.Equal(t, uint64(0), params.MaxOpenOrdersPerAccountPerPair)
+	require.Equal(t, uint64(types.DefaultOrderToTradeRatioWindow), params.OrderToTradeRatioWindow)
+	require.True(t, params.MinOrderNotional.IsZero())
+	require.Equal(t, uint64(2), dexkeeper.GetAccountActiveOrderCount(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, keepertest.TestAccount))
+}
@@ -209,6 +209,12 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	_ = cfg.RegisterMigration(types.ModuleName, 15, func(ctx sdk.Context) error {
 		return migrations.V15ToV16(ctx, am.keeper)
 	})
+	_ = cfg.RegisterMigration(types.ModuleName, 16, func(ctx sdk.Context) error {
+		return migrations.OrderLimitsUpdate(ctx, am.keeper)
+	})
+	_ = cfg.RegisterMigration(types.ModuleName, 17, func(ctx sdk.Context) error {
+		return migrations.IncentiveCampaignLimitsUpdate(ctx, am.keeper)
+	})
 }
 
 // RegisterInvariants registers the capability module's invariants.
@@ -233,7 +239,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 16 }
+func (AppModule) ConsensusVersion() uint64 { return 18 }
 
 // BeginBlock executes all ABCI BeginBlock logic respective to the capability module.
 func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
@@ -321,9 +327,11 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) (ret []abc
 	// `validContractAddresses` will always decrease in size every iteration.
 	iterCounter := len(validContractsInfo)
 	endBlockerStartTime := time.Now()
+	routedOrdersHandled := false
 	for len(validContractsInfo) > 0 {
 		newValidContractsInfo, newOutOfRentContractsInfo, failedContractToReasons, ctx, ok := contract.EndBlockerAtomic(ctx, &am.keeper, validContractsInfo, am.tracingInfo)
 		if ok {
+			routedOrdersHandled = true
 			break
 		}
 		telemetry.IncrCounter(float32(len(newOutOfRentContractsInfo)), am.Name(), "total_out_of_rent_contracts")
@@ -350,6 +358,9 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) (ret []abc
 			break
 		}
 	}
+	if !routedOrdersHandled {
+		contract.RefundRoutedOrders(ctx, &am.keeper)
+	}
 	telemetry.MeasureSince(endBlockerStartTime, am.Name(), "total_end_blocker_atomic")
 
 	return []abci.ValidatorUpdate{}
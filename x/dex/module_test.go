@@ -14,6 +14,7 @@ import (
 	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
 	dexcache "github.com/sei-protocol/sei-chain/x/dex/cache"
 	"github.com/sei-protocol/sei-chain/x/dex/contract"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/msgserver"
 	"github.com/sei-protocol/sei-chain/x/dex/types"
 	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
 	minttypes "github.com/sei-protocol/sei-chain/x/mint/types"
@@ -833,3 +834,121 @@ func TestOrderCountUpdate(t *testing.T) {
 	require.Equal(t, uint64(0), dexkeeper.GetOrderCountState(ctx, contractAddr.String(), pair.PriceDenom, pair.AssetDenom, types.PositionDirection_LONG, sdk.NewDec(3)))
 	require.Equal(t, uint64(1), dexkeeper.GetOrderCountState(ctx, contractAddr.String(), pair.PriceDenom, pair.AssetDenom, types.PositionDirection_SHORT, sdk.NewDec(3)))
 }
+
+func TestEndBlockRoutedOrderAcrossContracts(t *testing.T) {
+	testApp := keepertest.TestApp()
+	dexkeeper := testApp.DexKeeper
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), dexutils.DexMemStateContextKey, dexcache.NewMemState(dexkeeper.GetMemStoreKey())))
+	atomPair := types.Pair{PriceDenom: "SEI", AssetDenom: "ATOM"}
+	osmoPair := types.Pair{PriceDenom: "SEI", AssetDenom: "OSMO"}
+
+	testAccount, _ := sdk.AccAddressFromBech32("sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx")
+	amounts := sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(10000000)), sdk.NewCoin("uusdc", sdk.NewInt(10000000)), sdk.NewCoin("ATOM", sdk.NewInt(2)))
+	bankkeeper := testApp.BankKeeper
+	bankkeeper.MintCoins(ctx, minttypes.ModuleName, amounts)
+	bankkeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, testAccount, amounts)
+	dexAmounts := sdk.NewCoins(sdk.NewCoin("uusdc", sdk.NewInt(4000000)))
+	bankkeeper.SendCoinsFromAccountToModule(ctx, testAccount, types.ModuleName, dexAmounts)
+	wasm, err := ioutil.ReadFile("./testdata/mars.wasm")
+	if err != nil {
+		panic(err)
+	}
+	wasmKeeper := testApp.WasmKeeper
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(&wasmKeeper)
+	var perm *wasmtypes.AccessConfig
+	codeId, err := contractKeeper.Create(ctx, testAccount, wasm, perm)
+	if err != nil {
+		panic(err)
+	}
+	instantiate := func(pair types.Pair, maker types.PositionDirection) sdk.AccAddress {
+		contractAddr, _, err := contractKeeper.Instantiate(ctx, codeId, testAccount, testAccount, []byte(GOOD_CONTRACT_INSTANTIATE), "test",
+			sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(100000))))
+		if err != nil {
+			panic(err)
+		}
+		err = dexkeeper.SetContract(ctx, &types.ContractInfoV2{CodeId: 123, ContractAddr: contractAddr.String(), NeedHook: false, NeedOrderMatching: true, RentBalance: 100000000})
+		if err != nil {
+			panic(err)
+		}
+		dexkeeper.AddRegisteredPair(ctx, contractAddr.String(), pair)
+		dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(contractAddr.String()), pair).Add(
+			&types.Order{
+				Id:                1,
+				Account:           testAccount.String(),
+				ContractAddr:      contractAddr.String(),
+				Price:             sdk.MustNewDecFromStr("1"),
+				Quantity:          sdk.MustNewDecFromStr("5"),
+				PriceDenom:        pair.PriceDenom,
+				AssetDenom:        pair.AssetDenom,
+				OrderType:         types.OrderType_LIMIT,
+				PositionDirection: maker,
+				Data:              "{\"position_effect\":\"Open\",\"leverage\":\"1\"}",
+			},
+		)
+		dexutils.GetMemState(ctx.Context()).GetDepositInfo(ctx, types.ContractAddress(contractAddr.String())).Add(
+			&types.DepositInfoEntry{
+				Creator: testAccount.String(),
+				Denom:   "uusdc",
+				Amount:  sdk.MustNewDecFromStr("2000000"),
+			},
+		)
+		dexutils.GetMemState(ctx.Context()).SetDownstreamsToProcess(ctx, contractAddr.String(), dexkeeper.GetContractWithoutGasCharge)
+		return contractAddr
+	}
+	// ATOM can be sold for SEI on the first contract, and SEI can buy OSMO on the second one
+	atomContract := instantiate(atomPair, types.PositionDirection_LONG)
+	osmoContract := instantiate(osmoPair, types.PositionDirection_SHORT)
+	// what the makers trade is held by their contracts
+	contractFunds := sdk.NewCoins(sdk.NewCoin("SEI", sdk.NewInt(5)))
+	bankkeeper.MintCoins(ctx, minttypes.ModuleName, contractFunds)
+	bankkeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, atomContract, contractFunds)
+	contractFunds = sdk.NewCoins(sdk.NewCoin("OSMO", sdk.NewInt(5)))
+	bankkeeper.MintCoins(ctx, minttypes.ModuleName, contractFunds)
+	bankkeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, osmoContract, contractFunds)
+
+	ctx = ctx.WithBlockHeight(1)
+	testApp.EndBlocker(ctx, abci.RequestEndBlock{})
+
+	// 2 ATOM -> 2 SEI -> 2 OSMO
+	dexutils.GetMemState(ctx.Context()).Clear(ctx)
+	server := msgserver.NewMsgServerImpl(dexkeeper)
+	dexkeeper.SetPriceTickSizeForPair(ctx, atomContract.String(), atomPair, sdk.OneDec())
+	dexkeeper.SetPriceTickSizeForPair(ctx, osmoContract.String(), osmoPair, sdk.OneDec())
+	_, err = server.PlaceRoutedMarketOrder(sdk.WrapSDKContext(ctx), types.NewMsgPlaceRoutedMarketOrder(
+		testAccount.String(),
+		[]*types.RouteLeg{
+			{ContractAddr: atomContract.String(), PriceDenom: "SEI", AssetDenom: "ATOM", PositionDirection: types.PositionDirection_SHORT},
+			{ContractAddr: osmoContract.String(), PriceDenom: "SEI", AssetDenom: "OSMO", PositionDirection: types.PositionDirection_LONG},
+		},
+		sdk.NewDec(2),
+		sdk.NewDec(2),
+		sdk.NewCoins(sdk.NewCoin("ATOM", sdk.NewInt(2))),
+	))
+	require.Nil(t, err)
+
+	ctx = ctx.WithBlockHeight(2)
+	testApp.EndBlocker(ctx, abci.RequestEndBlock{})
+
+	// both contracts settled their makers
+	for _, contractAddr := range []sdk.AccAddress{atomContract, osmoContract} {
+		contract, err := dexkeeper.GetContract(ctx, contractAddr.String())
+		require.Nil(t, err)
+		require.False(t, contract.Suspended)
+	}
+	atomLong, found := dexkeeper.GetLongBookByPrice(ctx, atomContract.String(), sdk.OneDec(), atomPair.PriceDenom, atomPair.AssetDenom)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(3), atomLong.Entry.Quantity)
+	osmoShort, found := dexkeeper.GetShortBookByPrice(ctx, osmoContract.String(), sdk.OneDec(), osmoPair.PriceDenom, osmoPair.AssetDenom)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(3), osmoShort.Entry.Quantity)
+
+	// the ATOM went to the first contract, the SEI it yielded to the second one,
+	// and the OSMO bought with it to the account
+	require.Equal(t, sdk.NewInt(2), bankkeeper.GetBalance(ctx, atomContract, "ATOM").Amount)
+	require.Equal(t, sdk.NewInt(3), bankkeeper.GetBalance(ctx, atomContract, "SEI").Amount)
+	require.Equal(t, sdk.NewInt(2), bankkeeper.GetBalance(ctx, osmoContract, "SEI").Amount)
+	require.Equal(t, sdk.NewInt(3), bankkeeper.GetBalance(ctx, osmoContract, "OSMO").Amount)
+	require.Equal(t, sdk.NewInt(2), bankkeeper.GetBalance(ctx, testAccount, "OSMO").Amount)
+	require.True(t, bankkeeper.GetBalance(ctx, testAccount, "ATOM").IsZero())
+}
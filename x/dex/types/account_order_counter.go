@@ -0,0 +1,59 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewAccountOrderCounter(height int64) AccountOrderCounter {
+	return AccountOrderCounter{WindowStartHeight: height}
+}
+
+// Roll moves the counter forward to the window that contains `height`. Counts
+// of the window immediately before it are kept so that the ratio can be
+// measured over a sliding window; anything older is dropped.
+func (c *AccountOrderCounter) Roll(height int64, window uint64) {
+	if window == 0 || height < c.WindowStartHeight+int64(window) {
+		return
+	}
+	elapsedWindows := (height - c.WindowStartHeight) / int64(window)
+	if elapsedWindows == 1 {
+		c.PrevOrdersPlaced, c.PrevOrdersFilled = c.OrdersPlaced, c.OrdersFilled
+	} else {
+		c.PrevOrdersPlaced, c.PrevOrdersFilled = 0, 0
+	}
+	c.OrdersPlaced, c.OrdersFilled = 0, 0
+	c.WindowStartHeight += elapsedWindows * int64(window)
+}
+
+// SlidingOrdersPlaced returns the number of orders placed over the last `window`
+// blocks, approximated by weighing the previous window's count by how much of it
+// still overlaps with the sliding window. The counter must already be rolled to
+// `height`.
+func (c AccountOrderCounter) SlidingOrdersPlaced(height int64, window uint64) uint64 {
+	return slidingCount(c.PrevOrdersPlaced, c.OrdersPlaced, height-c.WindowStartHeight, window)
+}
+
+// SlidingOrdersFilled is the fill counterpart of SlidingOrdersPlaced.
+func (c AccountOrderCounter) SlidingOrdersFilled(height int64, window uint64) uint64 {
+	return slidingCount(c.PrevOrdersFilled, c.OrdersFilled, height-c.WindowStartHeight, window)
+}
+
+// ExceedsOrderToTradeRatio returns true if placing `newOrders` more orders would
+// put the account's order-to-trade ratio over `maxRatio`. An account with no
+// fills is treated as having one, so that it can place up to `maxRatio` orders.
+func (c AccountOrderCounter) ExceedsOrderToTradeRatio(newOrders uint64, maxRatio uint64, height int64, window uint64) bool {
+	placed := sdk.NewIntFromUint64(c.SlidingOrdersPlaced(height, window)).Add(sdk.NewIntFromUint64(newOrders))
+	filled := c.SlidingOrdersFilled(height, window)
+	if filled == 0 {
+		filled = 1
+	}
+	return placed.GT(sdk.NewIntFromUint64(maxRatio).Mul(sdk.NewIntFromUint64(filled)))
+}
+
+func slidingCount(prev uint64, current uint64, elapsed int64, window uint64) uint64 {
+	if window == 0 || elapsed < 0 || uint64(elapsed) >= window {
+		return current
+	}
+	prevWeighted := sdk.NewIntFromUint64(prev).Mul(sdk.NewIntFromUint64(window - uint64(elapsed))).Quo(sdk.NewIntFromUint64(window))
+	return current + prevWeighted.Uint64()
+}
@@ -0,0 +1,451 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/account_order_counter.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AccountOrderCounter tracks how many orders an account has placed against a
+// contract and how many fills it has received, over the current and previous
+// order-to-trade ratio windows.
+type AccountOrderCounter struct {
+	WindowStartHeight int64  `protobuf:"varint,1,opt,name=windowStartHeight,proto3" json:"window_start_height"`
+	OrdersPlaced      uint64 `protobuf:"varint,2,opt,name=ordersPlaced,proto3" json:"orders_placed"`
+	OrdersFilled      uint64 `protobuf:"varint,3,opt,name=ordersFilled,proto3" json:"orders_filled"`
+	PrevOrdersPlaced  uint64 `protobuf:"varint,4,opt,name=prevOrdersPlaced,proto3" json:"prev_orders_placed"`
+	PrevOrdersFilled  uint64 `protobuf:"varint,5,opt,name=prevOrdersFilled,proto3" json:"prev_orders_filled"`
+}
+
+func (m *AccountOrderCounter) Reset()         { *m = AccountOrderCounter{} }
+func (m *AccountOrderCounter) String() string { return proto.CompactTextString(m) }
+func (*AccountOrderCounter) ProtoMessage()    {}
+func (*AccountOrderCounter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef546193bf8afaa6, []int{0}
+}
+func (m *AccountOrderCounter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountOrderCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountOrderCounter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountOrderCounter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountOrderCounter.Merge(m, src)
+}
+func (m *AccountOrderCounter) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountOrderCounter) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountOrderCounter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountOrderCounter proto.InternalMessageInfo
+
+func (m *AccountOrderCounter) GetWindowStartHeight() int64 {
+	if m != nil {
+		return m.WindowStartHeight
+	}
+	return 0
+}
+
+func (m *AccountOrderCounter) GetOrdersPlaced() uint64 {
+	if m != nil {
+		return m.OrdersPlaced
+	}
+	return 0
+}
+
+func (m *AccountOrderCounter) GetOrdersFilled() uint64 {
+	if m != nil {
+		return m.OrdersFilled
+	}
+	return 0
+}
+
+func (m *AccountOrderCounter) GetPrevOrdersPlaced() uint64 {
+	if m != nil {
+		return m.PrevOrdersPlaced
+	}
+	return 0
+}
+
+func (m *AccountOrderCounter) GetPrevOrdersFilled() uint64 {
+	if m != nil {
+		return m.PrevOrdersFilled
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*AccountOrderCounter)(nil), "seiprotocol.seichain.dex.AccountOrderCounter")
+}
+
+func init() { proto.RegisterFile("dex/account_order_counter.proto", fileDescriptor_ef546193bf8afaa6) }
+
+var fileDescriptor_ef546193bf8afaa6 = []byte{
+	// 304 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0x49, 0xad, 0xd0,
+	0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x89, 0xcf, 0x2f, 0x4a, 0x49, 0x2d, 0x8a, 0x07, 0xb3,
+	0x53, 0x8b, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x24, 0x8a, 0x53, 0x33, 0xc1, 0xac, 0xe4,
+	0xfc, 0x1c, 0xbd, 0xe2, 0xd4, 0xcc, 0xe4, 0x8c, 0xc4, 0xcc, 0x3c, 0xbd, 0x94, 0xd4, 0x0a, 0x29,
+	0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0xb0, 0x94, 0x3e, 0x88, 0x05, 0x51, 0xaf, 0x74, 0x89, 0x89, 0x4b,
+	0xd8, 0x11, 0x62, 0x9e, 0x3f, 0xc8, 0x38, 0x67, 0x88, 0x69, 0x42, 0xae, 0x5c, 0x82, 0xe5, 0x99,
+	0x79, 0x29, 0xf9, 0xe5, 0xc1, 0x25, 0x89, 0x45, 0x25, 0x1e, 0xa9, 0x99, 0xe9, 0x19, 0x25, 0x12,
+	0x8c, 0x0a, 0x8c, 0x1a, 0xcc, 0x4e, 0xe2, 0xaf, 0xee, 0xc9, 0x0b, 0x43, 0x24, 0xe3, 0x8b, 0x41,
+	0xb2, 0xf1, 0x19, 0x60, 0xe9, 0x20, 0x4c, 0x1d, 0x42, 0xa6, 0x5c, 0x3c, 0x60, 0x57, 0x16, 0x07,
+	0xe4, 0x24, 0x26, 0xa7, 0xa6, 0x48, 0x30, 0x29, 0x30, 0x6a, 0xb0, 0x38, 0x09, 0xbe, 0xba, 0x27,
+	0xcf, 0x0b, 0x11, 0x8f, 0x2f, 0x00, 0x4b, 0x04, 0xa1, 0x28, 0x43, 0x68, 0x73, 0xcb, 0xcc, 0xc9,
+	0x49, 0x4d, 0x91, 0x60, 0xc6, 0xd0, 0x96, 0x06, 0x96, 0x08, 0x42, 0x51, 0x26, 0xe4, 0xc4, 0x25,
+	0x50, 0x50, 0x94, 0x5a, 0xe6, 0x8f, 0x6c, 0x23, 0x0b, 0x58, 0xab, 0xd8, 0xab, 0x7b, 0xf2, 0x42,
+	0x20, 0xb9, 0x78, 0x54, 0x6b, 0x31, 0xd4, 0xa3, 0x9a, 0x01, 0xb5, 0x9e, 0x15, 0xbb, 0x19, 0x50,
+	0x37, 0x60, 0xa8, 0x77, 0x72, 0x3f, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f,
+	0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28,
+	0xdd, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xe2, 0xd4, 0x4c, 0x5d,
+	0x58, 0x54, 0x81, 0x39, 0xe0, 0xb8, 0xd2, 0xaf, 0xd0, 0x07, 0xc5, 0x71, 0x49, 0x65, 0x41, 0x6a,
+	0x71, 0x12, 0x1b, 0x58, 0xde, 0x18, 0x30, 0x00, 0x14, 0x46, 0x8b, 0x63, 0xf7, 0x01, 0x00, 0x00,
+}
+
+func (m *AccountOrderCounter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountOrderCounter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountOrderCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PrevOrdersFilled != 0 {
+		i = encodeVarintAccountOrderCounter(dAtA, i, uint64(m.PrevOrdersFilled))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PrevOrdersPlaced != 0 {
+		i = encodeVarintAccountOrderCounter(dAtA, i, uint64(m.PrevOrdersPlaced))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.OrdersFilled != 0 {
+		i = encodeVarintAccountOrderCounter(dAtA, i, uint64(m.OrdersFilled))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OrdersPlaced != 0 {
+		i = encodeVarintAccountOrderCounter(dAtA, i, uint64(m.OrdersPlaced))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.WindowStartHeight != 0 {
+		i = encodeVarintAccountOrderCounter(dAtA, i, uint64(m.WindowStartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAccountOrderCounter(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAccountOrderCounter(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AccountOrderCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WindowStartHeight != 0 {
+		n += 1 + sovAccountOrderCounter(uint64(m.WindowStartHeight))
+	}
+	if m.OrdersPlaced != 0 {
+		n += 1 + sovAccountOrderCounter(uint64(m.OrdersPlaced))
+	}
+	if m.OrdersFilled != 0 {
+		n += 1 + sovAccountOrderCounter(uint64(m.OrdersFilled))
+	}
+	if m.PrevOrdersPlaced != 0 {
+		n += 1 + sovAccountOrderCounter(uint64(m.PrevOrdersPlaced))
+	}
+	if m.PrevOrdersFilled != 0 {
+		n += 1 + sovAccountOrderCounter(uint64(m.PrevOrdersFilled))
+	}
+	return n
+}
+
+func sovAccountOrderCounter(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAccountOrderCounter(x uint64) (n int) {
+	return sovAccountOrderCounter(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AccountOrderCounter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccountOrderCounter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountOrderCounter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountOrderCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStartHeight", wireType)
+			}
+			m.WindowStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountOrderCounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrdersPlaced", wireType)
+			}
+			m.OrdersPlaced = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountOrderCounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrdersPlaced |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrdersFilled", wireType)
+			}
+			m.OrdersFilled = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountOrderCounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrdersFilled |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevOrdersPlaced", wireType)
+			}
+			m.PrevOrdersPlaced = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountOrderCounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrevOrdersPlaced |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevOrdersFilled", wireType)
+			}
+			m.PrevOrdersFilled = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountOrderCounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrevOrdersFilled |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccountOrderCounter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccountOrderCounter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAccountOrderCounter(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAccountOrderCounter
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccountOrderCounter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccountOrderCounter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAccountOrderCounter
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAccountOrderCounter
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAccountOrderCounter
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAccountOrderCounter        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAccountOrderCounter          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAccountOrderCounter = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,45 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountOrderCounterRoll(t *testing.T) {
+	counter := types.AccountOrderCounter{WindowStartHeight: 10, OrdersPlaced: 8, OrdersFilled: 2}
+	// still in the same window
+	counter.Roll(19, 10)
+	require.Equal(t, types.AccountOrderCounter{WindowStartHeight: 10, OrdersPlaced: 8, OrdersFilled: 2}, counter)
+
+	counter.Roll(25, 10)
+	require.Equal(t, types.AccountOrderCounter{WindowStartHeight: 20, PrevOrdersPlaced: 8, PrevOrdersFilled: 2}, counter)
+
+	// skipping more than a full window drops everything
+	counter.OrdersPlaced = 3
+	counter.Roll(45, 10)
+	require.Equal(t, types.AccountOrderCounter{WindowStartHeight: 40}, counter)
+}
+
+func TestAccountOrderCounterSlidingCounts(t *testing.T) {
+	counter := types.AccountOrderCounter{WindowStartHeight: 20, OrdersPlaced: 4, OrdersFilled: 1, PrevOrdersPlaced: 10, PrevOrdersFilled: 4}
+	// at the start of the window the previous window fully counts
+	require.Equal(t, uint64(14), counter.SlidingOrdersPlaced(20, 10))
+	require.Equal(t, uint64(5), counter.SlidingOrdersFilled(20, 10))
+	// 3/10 of the window has elapsed, so 7/10 of the previous window is counted
+	require.Equal(t, uint64(11), counter.SlidingOrdersPlaced(23, 10))
+	require.Equal(t, uint64(3), counter.SlidingOrdersFilled(23, 10))
+}
+
+func TestExceedsOrderToTradeRatio(t *testing.T) {
+	counter := types.NewAccountOrderCounter(10)
+	// accounts without fills can place up to `ratio` orders
+	require.False(t, counter.ExceedsOrderToTradeRatio(5, 5, 10, 10))
+	require.True(t, counter.ExceedsOrderToTradeRatio(6, 5, 10, 10))
+
+	counter.OrdersPlaced = 10
+	counter.OrdersFilled = 2
+	require.False(t, counter.ExceedsOrderToTradeRatio(0, 5, 10, 10))
+	require.True(t, counter.ExceedsOrderToTradeRatio(1, 5, 10, 10))
+}
@@ -17,9 +17,12 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdatePriceTickSize{}, "dex/MsgUpdatePriceTickSize", nil)
 	cdc.RegisterConcrete(&MsgUpdateQuantityTickSize{}, "dex/MsgUpdateQuantityTickSize", nil)
 	cdc.RegisterConcrete(&AddAssetMetadataProposal{}, "dex/AddAssetMetadataProposal", nil)
+	cdc.RegisterConcrete(&UpdateParamsProposal{}, "dex/UpdateParamsProposal", nil)
 	cdc.RegisterConcrete(&MsgUnregisterContract{}, "dex/MsgUnregisterContract", nil)
 	cdc.RegisterConcrete(&MsgContractDepositRent{}, "dex/MsgContractDepositRent", nil)
 	cdc.RegisterConcrete(&MsgUnsuspendContract{}, "dex/MsgUnsuspendContract", nil)
+	cdc.RegisterConcrete(&MsgPlaceRoutedMarketOrder{}, "dex/MsgPlaceRoutedMarketOrder", nil)
+	cdc.RegisterConcrete(&MsgCreateIncentiveCampaign{}, "dex/MsgCreateIncentiveCampaign", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -45,6 +48,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*govtypes.Content)(nil),
 		&AddAssetMetadataProposal{},
 	)
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&UpdateParamsProposal{},
+	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUnregisterContract{},
 	)
@@ -54,6 +60,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUnsuspendContract{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgPlaceRoutedMarketOrder{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateIncentiveCampaign{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
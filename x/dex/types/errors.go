@@ -22,6 +22,13 @@ var (
 	ErrContractNotExists          = sdkerrors.Register(ModuleName, 17, "Error finding contract info")
 	ErrParsingContractInfo        = sdkerrors.Register(ModuleName, 18, "Error parsing contract info")
 	ErrInsufficientRent           = sdkerrors.Register(ModuleName, 19, "Error contract does not have sufficient fee")
+	ErrInvalidRoute               = sdkerrors.Register(ModuleName, 20, "invalid routed order")
+	ErrRouteNotFilled             = sdkerrors.Register(ModuleName, 21, "routed order could not be filled")
+	ErrTooManyActiveOrders        = sdkerrors.Register(ModuleName, 22, "account has too many active orders for pair")
+	ErrOrderNotionalTooSmall      = sdkerrors.Register(ModuleName, 23, "order notional is below the minimum")
+	ErrOrderToTradeRatioExceeded  = sdkerrors.Register(ModuleName, 24, "account order-to-trade ratio exceeded")
+	ErrInvalidIncentiveCampaign   = sdkerrors.Register(ModuleName, 25, "invalid incentive campaign")
+	ErrIncentiveCampaignNotFound  = sdkerrors.Register(ModuleName, 26, "incentive campaign not found")
 	ErrCircularContractDependency = sdkerrors.Register(ModuleName, 1103, "circular contract dependency detected")
 	ErrContractSuspended          = sdkerrors.Register(ModuleName, 1104, "contract suspended")
 	ErrContractNotSuspended       = sdkerrors.Register(ModuleName, 1105, "contract not suspended")
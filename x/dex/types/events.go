@@ -1,5 +1,11 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
 const (
 	EventTypePlaceOrder          = "place_order"
 	EventTypeCancelOrder         = "cancel_order"
@@ -9,6 +15,9 @@ const (
 	EventTypeRegisterPair        = "register_pair"
 	EventTypeSetQuantityTickSize = "set_quantity_tick_size"
 	EventTypeSetPriceTickSize    = "set_price_tick_size"
+	EventTypePlaceRoutedOrder    = "place_routed_order"
+	EventTypeRoutedOrderFilled   = "routed_order_filled"
+	EventTypeRoutedOrderFailed   = "routed_order_failed"
 
 	AttributeKeyOrderID         = "order_id"
 	AttributeKeyCancellationID  = "cancellation_id"
@@ -16,6 +25,57 @@ const (
 	AttributeKeyRentBalance     = "rent_balance"
 	AttributeKeyPriceDenom      = "price_denom"
 	AttributeKeyAssetDenom      = "asset_denom"
+	AttributeKeyAccount         = "account"
+	AttributeKeyAmountIn        = "amount_in"
+	AttributeKeyAmountOut       = "amount_out"
+	AttributeKeyReason          = "reason"
+	AttributeKeyVersion         = "version"
 
 	AttributeValueCategory = ModuleName
+
+	// TypedEventVersion is the schema version of the typed protobuf events
+	// defined in events.proto. Legacy string attribute events are version 1.
+	TypedEventVersion = "2"
+
+	CancelReasonUserRequested       = "user_requested"
+	CancelReasonLiquidated          = "liquidated"
+	CancelReasonUnfilledMarketOrder = "unfilled_market_order"
 )
+
+// NewTypedEvent converts a typed event into an sdk event tagged with
+// TypedEventVersion.
+func NewTypedEvent(tev proto.Message) (sdk.Event, error) {
+	event, err := sdk.TypedEventToEvent(tev)
+	if err != nil {
+		return sdk.Event{}, err
+	}
+	return event.AppendAttributes(sdk.NewAttribute(AttributeKeyVersion, TypedEventVersion)), nil
+}
+
+func EmitTypedEvent(ctx sdk.Context, tev proto.Message) error {
+	event, err := NewTypedEvent(tev)
+	if err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(event)
+	return nil
+}
+
+// ParseTypedEvent is the inverse of NewTypedEvent.
+func ParseTypedEvent(event abci.Event) (proto.Message, error) {
+	attributes := []abci.EventAttribute{}
+	for _, attribute := range event.Attributes {
+		if string(attribute.Key) != AttributeKeyVersion {
+			attributes = append(attributes, attribute)
+		}
+	}
+	event.Attributes = attributes
+	return sdk.ParseTypedEvent(event)
+}
+
+func CancelReasonFromInitiator(initiator CancellationInitiator) string {
+	if initiator == CancellationInitiator_LIQUIDATED {
+		return CancelReasonLiquidated
+	}
+	return CancelReasonUserRequested
+}
@@ -0,0 +1,43 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestTypedEventRoundTrip(t *testing.T) {
+	placed := &types.EventOrderPlaced{
+		ContractAddr:      "contract",
+		OrderId:           1,
+		Account:           "account",
+		PriceDenom:        "usdc",
+		AssetDenom:        "atom",
+		PositionDirection: types.PositionDirection_SHORT,
+		OrderType:         types.OrderType_LIMIT,
+		Price:             sdk.MustNewDecFromStr("1.5"),
+		Quantity:          sdk.NewDec(2),
+	}
+	event, err := types.NewTypedEvent(placed)
+	require.Nil(t, err)
+	require.Equal(t, "seiprotocol.seichain.dex.EventOrderPlaced", event.Type)
+	version := ""
+	for _, attribute := range event.Attributes {
+		if string(attribute.Key) == types.AttributeKeyVersion {
+			version = string(attribute.Value)
+		}
+	}
+	require.Equal(t, types.TypedEventVersion, version)
+
+	parsed, err := types.ParseTypedEvent(abci.Event(event))
+	require.Nil(t, err)
+	require.Equal(t, placed, parsed)
+}
+
+func TestCancelReasonFromInitiator(t *testing.T) {
+	require.Equal(t, types.CancelReasonUserRequested, types.CancelReasonFromInitiator(types.CancellationInitiator_USER))
+	require.Equal(t, types.CancelReasonLiquidated, types.CancelReasonFromInitiator(types.CancellationInitiator_LIQUIDATED))
+}
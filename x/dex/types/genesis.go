@@ -27,6 +27,24 @@ func (gs GenesisState) Validate() error {
 			return csErr
 		}
 	}
+	campaignIDs := make(map[uint64]struct{})
+	for _, campaign := range gs.IncentiveCampaigns {
+		if _, ok := campaignIDs[campaign.Id]; ok {
+			return fmt.Errorf("duplicated incentive campaign id %d", campaign.Id)
+		}
+		if campaign.Id >= gs.NextIncentiveCampaignId {
+			return fmt.Errorf("incentive campaign id %d is not below the next campaign id %d", campaign.Id, gs.NextIncentiveCampaignId)
+		}
+		if campaign.StartEpoch > campaign.EndEpoch {
+			return fmt.Errorf("incentive campaign %d ends before it starts", campaign.Id)
+		}
+		campaignIDs[campaign.Id] = struct{}{}
+	}
+	for _, score := range gs.IncentiveScores {
+		if _, ok := campaignIDs[score.CampaignId]; !ok {
+			return fmt.Errorf("incentive score for unknown campaign %d", score.CampaignId)
+		}
+	}
 	return nil
 }
 
@@ -37,6 +55,9 @@ func (cs ContractState) Validate() error {
 	if _, err := sdk.AccAddressFromBech32(cs.ContractInfo.ContractAddr); err != nil {
 		return fmt.Errorf("contract address is invalid")
 	}
+	if cs.ParamsOverride != nil && cs.ParamsOverride.ContractAddr != cs.ContractInfo.ContractAddr {
+		return fmt.Errorf("params override for %s does not match contract %s", cs.ParamsOverride.ContractAddr, cs.ContractInfo.ContractAddr)
+	}
 	// Check for duplicated price in a single market
 	// Can only be a one price per pair per contract
 	type MarketPrice struct {
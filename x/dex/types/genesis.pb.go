@@ -25,9 +25,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the dex module's genesis state.
 type GenesisState struct {
-	Params        Params          `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	ContractState []ContractState `protobuf:"bytes,2,rep,name=contractState,proto3" json:"contractState"`
-	LastEpoch     uint64          `protobuf:"varint,3,opt,name=lastEpoch,proto3" json:"lastEpoch,omitempty"`
+	Params                  Params              `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	ContractState           []ContractState     `protobuf:"bytes,2,rep,name=contractState,proto3" json:"contractState"`
+	LastEpoch               uint64              `protobuf:"varint,3,opt,name=lastEpoch,proto3" json:"lastEpoch,omitempty"`
+	IncentiveCampaigns      []IncentiveCampaign `protobuf:"bytes,4,rep,name=incentiveCampaigns,proto3" json:"incentiveCampaigns"`
+	IncentiveScores         []IncentiveScore    `protobuf:"bytes,5,rep,name=incentiveScores,proto3" json:"incentiveScores"`
+	NextIncentiveCampaignId uint64              `protobuf:"varint,6,opt,name=nextIncentiveCampaignId,proto3" json:"nextIncentiveCampaignId,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,14 +87,36 @@ func (m *GenesisState) GetLastEpoch() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetIncentiveCampaigns() []IncentiveCampaign {
+	if m != nil {
+		return m.IncentiveCampaigns
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIncentiveScores() []IncentiveScore {
+	if m != nil {
+		return m.IncentiveScores
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextIncentiveCampaignId() uint64 {
+	if m != nil {
+		return m.NextIncentiveCampaignId
+	}
+	return 0
+}
+
 type ContractState struct {
-	ContractInfo        ContractInfoV2       `protobuf:"bytes,1,opt,name=contractInfo,proto3" json:"contractInfo"`
-	LongBookList        []LongBook           `protobuf:"bytes,2,rep,name=longBookList,proto3" json:"longBookList"`
-	ShortBookList       []ShortBook          `protobuf:"bytes,3,rep,name=shortBookList,proto3" json:"shortBookList"`
-	TriggeredOrdersList []Order              `protobuf:"bytes,4,rep,name=triggeredOrdersList,proto3" json:"triggeredOrdersList"`
-	PairList            []Pair               `protobuf:"bytes,5,rep,name=pairList,proto3" json:"pairList"`
-	PriceList           []ContractPairPrices `protobuf:"bytes,6,rep,name=priceList,proto3" json:"priceList"`
-	NextOrderId         uint64               `protobuf:"varint,7,opt,name=nextOrderId,proto3" json:"nextOrderId,omitempty"`
+	ContractInfo        ContractInfoV2          `protobuf:"bytes,1,opt,name=contractInfo,proto3" json:"contractInfo"`
+	LongBookList        []LongBook              `protobuf:"bytes,2,rep,name=longBookList,proto3" json:"longBookList"`
+	ShortBookList       []ShortBook             `protobuf:"bytes,3,rep,name=shortBookList,proto3" json:"shortBookList"`
+	TriggeredOrdersList []Order                 `protobuf:"bytes,4,rep,name=triggeredOrdersList,proto3" json:"triggeredOrdersList"`
+	PairList            []Pair                  `protobuf:"bytes,5,rep,name=pairList,proto3" json:"pairList"`
+	PriceList           []ContractPairPrices    `protobuf:"bytes,6,rep,name=priceList,proto3" json:"priceList"`
+	NextOrderId         uint64                  `protobuf:"varint,7,opt,name=nextOrderId,proto3" json:"nextOrderId,omitempty"`
+	ParamsOverride      *ContractParamsOverride `protobuf:"bytes,8,opt,name=paramsOverride,proto3" json:"paramsOverride,omitempty"`
 }
 
 func (m *ContractState) Reset()         { *m = ContractState{} }
@@ -176,6 +201,13 @@ func (m *ContractState) GetNextOrderId() uint64 {
 	return 0
 }
 
+func (m *ContractState) GetParamsOverride() *ContractParamsOverride {
+	if m != nil {
+		return m.ParamsOverride
+	}
+	return nil
+}
+
 type ContractPairPrices struct {
 	PricePair Pair     `protobuf:"bytes,1,opt,name=pricePair,proto3" json:"pricePair"`
 	Prices    []*Price `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices,omitempty"`
@@ -237,38 +269,44 @@ func init() {
 func init() { proto.RegisterFile("dex/genesis.proto", fileDescriptor_a803aaabd08db59d) }
 
 var fileDescriptor_a803aaabd08db59d = []byte{
-	// 497 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x1b, 0x5a, 0x0a, 0x73, 0x5b, 0xfe, 0x78, 0x3b, 0x44, 0x15, 0xca, 0xa2, 0x72, 0xa0,
-	0x07, 0x96, 0x48, 0xe5, 0xc0, 0x0d, 0xa1, 0x22, 0x34, 0x4d, 0xaa, 0xb4, 0xaa, 0x95, 0x40, 0xe2,
-	0x82, 0xd2, 0xc4, 0xa4, 0xd6, 0xba, 0xbc, 0x91, 0x6d, 0xa4, 0xf2, 0x2d, 0xd8, 0x47, 0xe2, 0xb6,
-	0xe3, 0x8e, 0x9c, 0x10, 0x6a, 0xbf, 0x08, 0xf2, 0x1b, 0x7b, 0x4d, 0x04, 0x59, 0xb9, 0xc5, 0x8f,
-	0x9f, 0xf7, 0xe7, 0xf7, 0xb5, 0x9f, 0x90, 0xa7, 0x09, 0x5b, 0x87, 0x29, 0xcb, 0x98, 0xe4, 0x32,
-	0xc8, 0x05, 0x28, 0xa0, 0xae, 0x64, 0x1c, 0xbf, 0x62, 0x58, 0x05, 0x92, 0xf1, 0x78, 0x19, 0xf1,
-	0x2c, 0x48, 0xd8, 0xba, 0x7f, 0x94, 0x42, 0x0a, 0xb8, 0x15, 0xea, 0xaf, 0xc2, 0xdf, 0x7f, 0xa2,
-	0x11, 0x79, 0x24, 0xa2, 0x4b, 0x43, 0xe8, 0x1f, 0x6a, 0x65, 0x05, 0x59, 0xfa, 0x79, 0x01, 0x70,
-	0x61, 0xc4, 0x23, 0x2d, 0xca, 0x25, 0x08, 0x55, 0x56, 0x1f, 0x6b, 0x15, 0x44, 0xc2, 0x84, 0x11,
-	0xa8, 0x16, 0x62, 0xc8, 0x94, 0x88, 0x62, 0x65, 0xb4, 0x47, 0xc5, 0x09, 0x5c, 0x94, 0x8b, 0x72,
-	0xc1, 0x63, 0x56, 0x08, 0x83, 0x1f, 0x0e, 0xe9, 0x9e, 0x16, 0x43, 0xcc, 0x55, 0xa4, 0x18, 0x7d,
-	0x43, 0xda, 0x45, 0x47, 0xae, 0xe3, 0x3b, 0xc3, 0xce, 0xc8, 0x0f, 0xea, 0x86, 0x0a, 0xa6, 0xe8,
-	0x1b, 0xb7, 0xae, 0x7f, 0x1d, 0x37, 0x66, 0xa6, 0x8a, 0xce, 0x49, 0xcf, 0xf6, 0x80, 0x40, 0xf7,
-	0x9e, 0xdf, 0x1c, 0x76, 0x46, 0x2f, 0xea, 0x31, 0xef, 0xca, 0x76, 0x43, 0xab, 0x32, 0xe8, 0x33,
-	0x72, 0xb0, 0x8a, 0xa4, 0x7a, 0x9f, 0x43, 0xbc, 0x74, 0x9b, 0xbe, 0x33, 0x6c, 0xcd, 0x76, 0xc2,
-	0xe0, 0xaa, 0x45, 0x7a, 0x15, 0x08, 0x9d, 0x91, 0xae, 0x05, 0x9c, 0x65, 0x5f, 0xc0, 0x8c, 0x32,
-	0xdc, 0xdf, 0x83, 0x76, 0x7f, 0x18, 0x99, 0x26, 0x2a, 0x0c, 0x3a, 0x21, 0x5d, 0xfd, 0x30, 0x63,
-	0x80, 0x8b, 0x09, 0x97, 0xca, 0xcc, 0x35, 0xa8, 0x67, 0x4e, 0x8c, 0xdb, 0xd2, 0xca, 0xd5, 0xf4,
-	0x9c, 0xf4, 0xf0, 0x45, 0x6f, 0x71, 0x4d, 0xc4, 0x3d, 0xaf, 0xc7, 0xcd, 0xad, 0xdd, 0x5e, 0x51,
-	0xa5, 0x9e, 0x7e, 0x24, 0x87, 0x4a, 0xf0, 0x34, 0x65, 0x82, 0x25, 0xe7, 0x3a, 0x15, 0x12, 0xb1,
-	0x2d, 0xc4, 0x1e, 0xd7, 0x63, 0xd1, 0x6b, 0x90, 0xff, 0x22, 0xd0, 0xb7, 0xe4, 0xa1, 0x0e, 0x10,
-	0xd2, 0xee, 0x23, 0xcd, 0xbb, 0x2b, 0x12, 0xdc, 0xc2, 0x6e, 0xab, 0xe8, 0x94, 0x1c, 0x60, 0xe4,
-	0x10, 0xd1, 0x46, 0xc4, 0xcb, 0xfd, 0x4f, 0xa1, 0x51, 0x53, 0x5d, 0x66, 0x13, 0xb6, 0x83, 0x50,
-	0x9f, 0x74, 0x32, 0xb6, 0x56, 0xd8, 0xe5, 0x59, 0xe2, 0x3e, 0xc0, 0x44, 0x94, 0xa5, 0xc1, 0x95,
-	0x43, 0xe8, 0xdf, 0x24, 0x3a, 0x36, 0xad, 0x68, 0xc9, 0xa4, 0xe2, 0xff, 0xa6, 0xd9, 0x95, 0xd1,
-	0xd7, 0xa4, 0x8d, 0x0b, 0x69, 0x22, 0x70, 0xc7, 0xe5, 0xe2, 0xa9, 0x33, 0x63, 0x1f, 0x9f, 0x5e,
-	0x6f, 0x3c, 0xe7, 0x66, 0xe3, 0x39, 0xbf, 0x37, 0x9e, 0xf3, 0x7d, 0xeb, 0x35, 0x6e, 0xb6, 0x5e,
-	0xe3, 0xe7, 0xd6, 0x6b, 0x7c, 0x3a, 0x49, 0xb9, 0x5a, 0x7e, 0x5d, 0x04, 0x31, 0x5c, 0x86, 0x92,
-	0xf1, 0x13, 0x4b, 0xc3, 0x05, 0xe2, 0xc2, 0x75, 0xa8, 0x7f, 0x5d, 0xf5, 0x2d, 0x67, 0x72, 0xd1,
-	0xc6, 0xfd, 0x57, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x8f, 0x4d, 0x7c, 0x7f, 0x83, 0x04, 0x00,
+	// 593 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xd1, 0x6e, 0x12, 0x4d,
+	0x14, 0xc7, 0xd9, 0xc2, 0xc7, 0xd7, 0x0e, 0xd0, 0xea, 0xb4, 0x89, 0x13, 0x62, 0xb6, 0x04, 0x2f,
+	0x24, 0xd1, 0x2e, 0x06, 0x2f, 0xf4, 0xca, 0x18, 0x1a, 0xd3, 0x90, 0x90, 0x40, 0x20, 0xd1, 0xc6,
+	0x1b, 0xb3, 0xec, 0x8e, 0xcb, 0xa4, 0xb0, 0xb3, 0x99, 0x19, 0x1b, 0x7c, 0x0b, 0x7d, 0x1b, 0x1f,
+	0xa1, 0x97, 0xbd, 0xf4, 0xca, 0x18, 0xb8, 0xf4, 0x25, 0xcc, 0x9c, 0x9d, 0x85, 0x85, 0x76, 0x85,
+	0x3b, 0xf6, 0x3f, 0xe7, 0xff, 0x3b, 0x67, 0xe6, 0x9c, 0x03, 0x7a, 0xe8, 0xd3, 0x59, 0x33, 0xa0,
+	0x21, 0x95, 0x4c, 0x3a, 0x91, 0xe0, 0x8a, 0x63, 0x22, 0x29, 0x83, 0x5f, 0x1e, 0x9f, 0x38, 0x92,
+	0x32, 0x6f, 0xec, 0xb2, 0xd0, 0xf1, 0xe9, 0xac, 0x7a, 0x12, 0xf0, 0x80, 0xc3, 0x51, 0x53, 0xff,
+	0x8a, 0xe3, 0xab, 0x0f, 0x34, 0x22, 0x72, 0x85, 0x3b, 0x35, 0x84, 0xea, 0xb1, 0x56, 0x26, 0x3c,
+	0x0c, 0x3e, 0x8d, 0x38, 0xbf, 0x32, 0xe2, 0x89, 0x16, 0xe5, 0x98, 0x0b, 0x95, 0x56, 0x8f, 0xb4,
+	0xca, 0x85, 0x4f, 0x85, 0x11, 0xb0, 0x16, 0x3c, 0x1e, 0x2a, 0xe1, 0x7a, 0xca, 0x68, 0x87, 0x71,
+	0x06, 0x26, 0xd2, 0xa6, 0x48, 0x30, 0x8f, 0xa6, 0x13, 0xb2, 0xd0, 0xa3, 0xa1, 0x62, 0xd7, 0x46,
+	0xac, 0xff, 0xc8, 0xa3, 0xf2, 0x45, 0x7c, 0xb3, 0xa1, 0x72, 0x15, 0xc5, 0x6f, 0x50, 0x31, 0x2e,
+	0x93, 0x58, 0x35, 0xab, 0x51, 0x6a, 0xd5, 0x9c, 0xac, 0x9b, 0x3a, 0x7d, 0x88, 0x6b, 0x17, 0x6e,
+	0x7e, 0x9d, 0xe6, 0x06, 0xc6, 0x85, 0x87, 0xa8, 0x92, 0x14, 0x06, 0x40, 0xb2, 0x57, 0xcb, 0x37,
+	0x4a, 0xad, 0xa7, 0xd9, 0x98, 0xf3, 0x74, 0xb8, 0xa1, 0xad, 0x33, 0xf0, 0x63, 0x74, 0x30, 0x71,
+	0xa5, 0x7a, 0x17, 0x71, 0x6f, 0x4c, 0xf2, 0x35, 0xab, 0x51, 0x18, 0xac, 0x04, 0xec, 0x22, 0xbc,
+	0xbc, 0xd6, 0xb9, 0x3b, 0x8d, 0x5c, 0x16, 0x84, 0x92, 0x14, 0x20, 0xef, 0xb3, 0xec, 0xbc, 0x9d,
+	0x4d, 0x8f, 0xc9, 0x7d, 0x0f, 0x0c, 0x5f, 0xa2, 0xa3, 0xa5, 0x3a, 0xf4, 0xb8, 0xa0, 0x92, 0xfc,
+	0x07, 0xfc, 0xc6, 0x0e, 0x7c, 0x30, 0x18, 0xf8, 0x26, 0x06, 0xbf, 0x46, 0x8f, 0x42, 0x3a, 0x53,
+	0x77, 0x8a, 0xe9, 0xf8, 0xa4, 0x08, 0x17, 0xcd, 0x3a, 0xae, 0xff, 0x29, 0xa0, 0xca, 0xda, 0xdb,
+	0xe1, 0x01, 0x2a, 0x27, 0xef, 0xd6, 0x09, 0x3f, 0x73, 0xd3, 0xc1, 0xc6, 0xf6, 0xa7, 0xd7, 0xd1,
+	0xef, 0x5b, 0xa6, 0xc4, 0x35, 0x06, 0xee, 0xa2, 0xb2, 0x1e, 0xd2, 0x36, 0xe7, 0x57, 0x5d, 0x26,
+	0x95, 0x69, 0x67, 0x3d, 0x9b, 0xd9, 0x35, 0xd1, 0x09, 0x2d, 0xed, 0xc6, 0x3d, 0x54, 0x81, 0xe9,
+	0x5e, 0xe2, 0xf2, 0x80, 0x7b, 0x92, 0x8d, 0x1b, 0x26, 0xe1, 0xc9, 0x64, 0xac, 0xf9, 0xf1, 0x07,
+	0x74, 0xac, 0x04, 0x0b, 0x02, 0x2a, 0xa8, 0xdf, 0xd3, 0x1b, 0x22, 0x01, 0x1b, 0x37, 0xff, 0x34,
+	0x1b, 0x0b, 0xb1, 0x06, 0x79, 0x1f, 0x01, 0xbf, 0x45, 0xfb, 0x7a, 0x99, 0x80, 0x16, 0xb7, 0xda,
+	0xfe, 0xd7, 0x26, 0xb0, 0x04, 0xb6, 0x74, 0xe1, 0x3e, 0x3a, 0x80, 0xf5, 0x03, 0x44, 0x11, 0x10,
+	0xcf, 0xb7, 0xb7, 0x42, 0xa3, 0xfa, 0xda, 0x96, 0x2c, 0xd6, 0x0a, 0x82, 0x6b, 0xa8, 0xa4, 0x87,
+	0x01, 0xaa, 0xec, 0xf8, 0xe4, 0x7f, 0x98, 0x8f, 0xb4, 0x84, 0x2f, 0xd1, 0x61, 0xbc, 0x87, 0xbd,
+	0x6b, 0x2a, 0x04, 0xf3, 0x29, 0xd9, 0x87, 0x19, 0x78, 0xb1, 0x4b, 0xe2, 0xb4, 0x6f, 0xb0, 0xc1,
+	0xa9, 0x7f, 0xb7, 0x10, 0xbe, 0x5b, 0x23, 0x6e, 0x9b, 0x4b, 0x6a, 0xc9, 0xcc, 0xdb, 0x6e, 0xef,
+	0xb4, 0xb2, 0xe1, 0x57, 0xa8, 0x08, 0x1f, 0x92, 0xec, 0x6d, 0x6b, 0x1b, 0x64, 0x1d, 0x98, 0xf0,
+	0xf6, 0xc5, 0xcd, 0xdc, 0xb6, 0x6e, 0xe7, 0xb6, 0xf5, 0x7b, 0x6e, 0x5b, 0xdf, 0x16, 0x76, 0xee,
+	0x76, 0x61, 0xe7, 0x7e, 0x2e, 0xec, 0xdc, 0xc7, 0xb3, 0x80, 0xa9, 0xf1, 0x97, 0x91, 0xe3, 0xf1,
+	0x69, 0x53, 0x52, 0x76, 0x96, 0xd0, 0xe0, 0x03, 0x70, 0xcd, 0x59, 0x53, 0xff, 0x1f, 0xaa, 0xaf,
+	0x11, 0x95, 0xa3, 0x22, 0x9c, 0xbf, 0xfc, 0x3b, 0x00, 0xe1, 0x56, 0x7d, 0x74, 0xe9, 0x05, 0x00,
 	0x00,
 }
 
@@ -292,6 +330,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextIncentiveCampaignId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextIncentiveCampaignId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.IncentiveScores) > 0 {
+		for iNdEx := len(m.IncentiveScores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentiveScores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.IncentiveCampaigns) > 0 {
+		for iNdEx := len(m.IncentiveCampaigns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentiveCampaigns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.LastEpoch != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.LastEpoch))
 		i--
@@ -344,6 +415,18 @@ func (m *ContractState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ParamsOverride != nil {
+		{
+			size, err := m.ParamsOverride.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.NextOrderId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextOrderId))
 		i--
@@ -507,6 +590,21 @@ func (m *GenesisState) Size() (n int) {
 	if m.LastEpoch != 0 {
 		n += 1 + sovGenesis(uint64(m.LastEpoch))
 	}
+	if len(m.IncentiveCampaigns) > 0 {
+		for _, e := range m.IncentiveCampaigns {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IncentiveScores) > 0 {
+		for _, e := range m.IncentiveScores {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextIncentiveCampaignId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextIncentiveCampaignId))
+	}
 	return n
 }
 
@@ -551,6 +649,10 @@ func (m *ContractState) Size() (n int) {
 	if m.NextOrderId != 0 {
 		n += 1 + sovGenesis(uint64(m.NextOrderId))
 	}
+	if m.ParamsOverride != nil {
+		l = m.ParamsOverride.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -692,6 +794,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveCampaigns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentiveCampaigns = append(m.IncentiveCampaigns, IncentiveCampaign{})
+			if err := m.IncentiveCampaigns[len(m.IncentiveCampaigns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveScores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentiveScores = append(m.IncentiveScores, IncentiveScore{})
+			if err := m.IncentiveScores[len(m.IncentiveScores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextIncentiveCampaignId", wireType)
+			}
+			m.NextIncentiveCampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextIncentiveCampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -964,6 +1153,42 @@ func (m *ContractState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsOverride", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParamsOverride == nil {
+				m.ParamsOverride = &ContractParamsOverride{}
+			}
+			if err := m.ParamsOverride.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -214,6 +214,24 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "incentive campaign id not below next id",
+			genState: &types.GenesisState{
+				Params:             types.DefaultParams(),
+				IncentiveCampaigns: []types.IncentiveCampaign{{Id: 1}},
+			},
+			valid: false,
+		},
+		{
+			desc: "incentive score for unknown campaign",
+			genState: &types.GenesisState{
+				Params:                  types.DefaultParams(),
+				IncentiveCampaigns:      []types.IncentiveCampaign{{Id: 1}},
+				IncentiveScores:         []types.IncentiveScore{{CampaignId: 2}},
+				NextIncentiveCampaignId: 2,
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
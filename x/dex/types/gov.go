@@ -10,13 +10,16 @@ import (
 
 const (
 	ProposalTypeAddAssetMetadata = "AddAssetMetadata"
+	ProposalTypeUpdateParams     = "UpdateDexParams"
 )
 
 func init() {
 	// for routing
 	govtypes.RegisterProposalType(ProposalTypeAddAssetMetadata)
+	govtypes.RegisterProposalType(ProposalTypeUpdateParams)
 	// for marshal and unmarshal
 	govtypes.RegisterProposalTypeCodec(&AddAssetMetadataProposal{}, "dex/AddAssetMetadataProposal")
+	govtypes.RegisterProposalTypeCodec(&UpdateParamsProposal{}, "dex/UpdateParamsProposal")
 }
 
 func (p *AddAssetMetadataProposal) GetTitle() string { return p.Title }
@@ -55,3 +58,55 @@ func (p AddAssetMetadataProposal) String() string {
 `, p.Title, p.Description, assetRecords))
 	return b.String()
 }
+
+func (p *UpdateParamsProposal) GetTitle() string { return p.Title }
+
+func (p *UpdateParamsProposal) GetDescription() string { return p.Description }
+
+func (p *UpdateParamsProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UpdateParamsProposal) ProposalType() string {
+	return ProposalTypeUpdateParams
+}
+
+func (p *UpdateParamsProposal) ValidateBasic() error {
+	if p.Params == nil && len(p.ContractOverrides) == 0 {
+		return fmt.Errorf("proposal must update params or at least one contract override")
+	}
+	if p.Params != nil {
+		if err := p.Params.Validate(); err != nil {
+			return err
+		}
+	}
+	seen := map[string]struct{}{}
+	for _, override := range p.ContractOverrides {
+		if err := override.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seen[override.ContractAddr]; ok {
+			return fmt.Errorf("duplicated params override for contract %s", override.ContractAddr)
+		}
+		seen[override.ContractAddr] = struct{}{}
+	}
+
+	return govtypes.ValidateAbstract(p)
+}
+
+func (p UpdateParamsProposal) String() string {
+	params := "unchanged"
+	if p.Params != nil {
+		params = p.Params.String()
+	}
+	overrides := ""
+	for _, override := range p.ContractOverrides {
+		overrides += override.String()
+	}
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Update Dex Params Proposal:
+  Title:       %s
+  Description: %s
+  Params:      %s
+  Overrides:   %s
+`, p.Title, p.Description, params, overrides))
+	return b.String()
+}
@@ -63,34 +63,84 @@ func (m *AddAssetMetadataProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AddAssetMetadataProposal proto.InternalMessageInfo
 
+// UpdateParamsProposal is a gov Content type for updating the dex module's
+// params and per-contract param overrides. Params may be omitted if only
+// overrides are being changed. An override with every value set to zero
+// removes the existing override for that contract.
+type UpdateParamsProposal struct {
+	Title             string                   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description       string                   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Params            *Params                  `protobuf:"bytes,3,opt,name=params,proto3" json:"params,omitempty" yaml:"params"`
+	ContractOverrides []ContractParamsOverride `protobuf:"bytes,4,rep,name=contractOverrides,proto3" json:"contractOverrides" yaml:"contract_overrides"`
+}
+
+func (m *UpdateParamsProposal) Reset()      { *m = UpdateParamsProposal{} }
+func (*UpdateParamsProposal) ProtoMessage() {}
+func (*UpdateParamsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dab07ca1a96062d0, []int{1}
+}
+func (m *UpdateParamsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateParamsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateParamsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateParamsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateParamsProposal.Merge(m, src)
+}
+func (m *UpdateParamsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateParamsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateParamsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateParamsProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*AddAssetMetadataProposal)(nil), "seiprotocol.seichain.dex.AddAssetMetadataProposal")
+	proto.RegisterType((*UpdateParamsProposal)(nil), "seiprotocol.seichain.dex.UpdateParamsProposal")
 }
 
 func init() { proto.RegisterFile("dex/gov.proto", fileDescriptor_dab07ca1a96062d0) }
 
 var fileDescriptor_dab07ca1a96062d0 = []byte{
-	// 307 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4d, 0x49, 0xad, 0xd0,
-	0x4f, 0xcf, 0x2f, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x28, 0x4e, 0xcd, 0x04, 0xb3,
-	0x92, 0xf3, 0x73, 0xf4, 0x8a, 0x53, 0x33, 0x93, 0x33, 0x12, 0x33, 0xf3, 0xf4, 0x52, 0x52, 0x2b,
-	0xa4, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x52, 0xfa, 0x20, 0x16, 0x44, 0xbd, 0x94, 0x08, 0x48,
-	0x7b, 0x62, 0x71, 0x71, 0x6a, 0x49, 0x7c, 0x4e, 0x66, 0x71, 0x09, 0x44, 0x54, 0xe9, 0x0d, 0x23,
-	0x97, 0x84, 0x63, 0x4a, 0x8a, 0x23, 0x48, 0xdc, 0x37, 0xb5, 0x24, 0x31, 0x25, 0xb1, 0x24, 0x31,
-	0xa0, 0x28, 0xbf, 0x20, 0xbf, 0x38, 0x31, 0x47, 0x48, 0x8d, 0x8b, 0xb5, 0x24, 0xb3, 0x24, 0x27,
-	0x55, 0x82, 0x51, 0x81, 0x51, 0x83, 0xd3, 0x49, 0xe0, 0xd3, 0x3d, 0x79, 0x9e, 0xca, 0xc4, 0xdc,
-	0x1c, 0x2b, 0x25, 0xb0, 0xb0, 0x52, 0x10, 0x44, 0x5a, 0xc8, 0x82, 0x8b, 0x3b, 0x25, 0xb5, 0x38,
-	0xb9, 0x28, 0xb3, 0xa0, 0x24, 0x33, 0x3f, 0x4f, 0x82, 0x09, 0xac, 0x5a, 0xec, 0xd3, 0x3d, 0x79,
-	0x21, 0x88, 0x6a, 0x24, 0x49, 0xa5, 0x20, 0x64, 0xa5, 0x42, 0x09, 0x5c, 0x9c, 0x60, 0x27, 0xf9,
-	0x64, 0x16, 0x97, 0x48, 0x30, 0x2b, 0x30, 0x6b, 0x70, 0x1b, 0xa9, 0xeb, 0xe1, 0xf2, 0x98, 0x1e,
-	0x8a, 0x2b, 0x9d, 0x24, 0x4f, 0xdc, 0x93, 0x67, 0xf8, 0x74, 0x4f, 0x5e, 0x10, 0x62, 0x09, 0xc2,
-	0x6b, 0x4a, 0x41, 0x08, 0x43, 0xad, 0x78, 0x3a, 0x16, 0xc8, 0x33, 0xcc, 0x58, 0x20, 0xcf, 0xf0,
-	0x62, 0x81, 0x3c, 0x83, 0x93, 0xfb, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78,
-	0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44,
-	0xe9, 0xa6, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x17, 0xa7, 0x66, 0xea,
-	0xc2, 0x5c, 0x00, 0xe6, 0x80, 0x9d, 0xa0, 0x5f, 0xa1, 0x0f, 0x0a, 0xc2, 0x92, 0xca, 0x82, 0xd4,
-	0xe2, 0x24, 0x36, 0xb0, 0xbc, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xce, 0xe9, 0x08, 0x1a, 0x95,
-	0x01, 0x00, 0x00,
+	// 413 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x52, 0x3f, 0x8f, 0xd3, 0x30,
+	0x14, 0x8f, 0xaf, 0x70, 0xd2, 0xb9, 0x77, 0xd2, 0x35, 0xaa, 0x50, 0xee, 0x86, 0x38, 0x78, 0x80,
+	0x2e, 0x97, 0xa0, 0x63, 0x41, 0xdd, 0x1a, 0x06, 0x06, 0x40, 0x54, 0x91, 0x58, 0x58, 0x8a, 0x1b,
+	0x5b, 0xa9, 0xa5, 0xb4, 0x8e, 0x62, 0x53, 0xb5, 0x23, 0x1b, 0x23, 0x23, 0x63, 0x3f, 0x4e, 0xc7,
+	0x6e, 0x30, 0x45, 0xa8, 0x5d, 0x18, 0x98, 0xf2, 0x09, 0x50, 0xec, 0x44, 0x6d, 0x85, 0xba, 0xde,
+	0xe6, 0xbc, 0xf7, 0x7b, 0xbf, 0x7f, 0x0a, 0xbc, 0xa2, 0x6c, 0x11, 0x24, 0x62, 0xee, 0x67, 0xb9,
+	0x50, 0xc2, 0x76, 0x24, 0xe3, 0xfa, 0x15, 0x8b, 0xd4, 0x97, 0x8c, 0xc7, 0x13, 0xc2, 0x67, 0x3e,
+	0x65, 0x8b, 0xdb, 0x6e, 0x22, 0x12, 0xa1, 0x57, 0x41, 0xf5, 0x32, 0xf8, 0xdb, 0x6e, 0x75, 0x4e,
+	0xa4, 0x64, 0x6a, 0x94, 0x72, 0xa9, 0xea, 0xe9, 0x75, 0x35, 0xcd, 0x48, 0x4e, 0xa6, 0xd2, 0x4c,
+	0xf0, 0x5f, 0x00, 0x9d, 0x01, 0xa5, 0x83, 0x0a, 0xf9, 0x9e, 0x29, 0x42, 0x89, 0x22, 0xc3, 0x5c,
+	0x64, 0x42, 0x92, 0xd4, 0x7e, 0x06, 0x1f, 0x2b, 0xae, 0x52, 0xe6, 0x00, 0x0f, 0xf4, 0x2e, 0xc2,
+	0xeb, 0xb2, 0x40, 0x97, 0x4b, 0x32, 0x4d, 0xfb, 0x58, 0x8f, 0x71, 0x64, 0xd6, 0xf6, 0x2b, 0xd8,
+	0xa6, 0x4c, 0xc6, 0x39, 0xcf, 0x14, 0x17, 0x33, 0xe7, 0x4c, 0xa3, 0x9f, 0x94, 0x05, 0xb2, 0x0d,
+	0xfa, 0x60, 0x89, 0xa3, 0x43, 0xa8, 0xfd, 0x19, 0x5e, 0x68, 0x93, 0xef, 0xb8, 0x54, 0x4e, 0xcb,
+	0x6b, 0xf5, 0xda, 0xf7, 0xcf, 0xfd, 0x53, 0x51, 0xfd, 0x23, 0x97, 0xe1, 0xcd, 0xba, 0x40, 0x56,
+	0x59, 0xa0, 0x8e, 0x11, 0xd9, 0x87, 0xc5, 0xd1, 0x9e, 0xb4, 0x7f, 0xf9, 0x6d, 0x85, 0xac, 0x1f,
+	0x2b, 0x64, 0xfd, 0x59, 0x21, 0x0b, 0xff, 0x3c, 0x83, 0xdd, 0x8f, 0x19, 0x25, 0x8a, 0x0d, 0x75,
+	0x0b, 0x0f, 0x18, 0xf5, 0x2d, 0x3c, 0x37, 0xcd, 0x3b, 0x2d, 0x0f, 0xf4, 0xda, 0xf7, 0xde, 0xe9,
+	0x9c, 0xc6, 0x5b, 0xd8, 0x29, 0x0b, 0x74, 0x65, 0x68, 0xcd, 0x25, 0x8e, 0x6a, 0x0a, 0xfb, 0x2b,
+	0x80, 0x9d, 0x58, 0xcc, 0x54, 0x4e, 0x62, 0xf5, 0x61, 0xce, 0xf2, 0x9c, 0x53, 0x26, 0x9d, 0x47,
+	0xba, 0xc0, 0x17, 0xa7, 0x89, 0x5f, 0xd7, 0x27, 0x46, 0xa0, 0x39, 0x0c, 0x9f, 0xd6, 0x4d, 0xde,
+	0x18, 0xb1, 0x86, 0x78, 0x24, 0x1a, 0x66, 0x1c, 0xfd, 0xaf, 0x76, 0xdc, 0x6c, 0xf8, 0x66, 0xbd,
+	0x75, 0xc1, 0x66, 0xeb, 0x82, 0xdf, 0x5b, 0x17, 0x7c, 0xdf, 0xb9, 0xd6, 0x66, 0xe7, 0x5a, 0xbf,
+	0x76, 0xae, 0xf5, 0xe9, 0x2e, 0xe1, 0x6a, 0xf2, 0x65, 0xec, 0xc7, 0x62, 0x1a, 0x48, 0xc6, 0xef,
+	0x1a, 0x6b, 0xfa, 0x43, 0x7b, 0x0b, 0x16, 0x41, 0xf5, 0x63, 0xaa, 0x65, 0xc6, 0xe4, 0xf8, 0x5c,
+	0xef, 0x5f, 0xfe, 0x1b, 0x00, 0xfa, 0x78, 0xe6, 0x6c, 0x01, 0x03, 0x00, 0x00,
 }
 
 func (m *AddAssetMetadataProposal) Marshal() (dAtA []byte, err error) {
@@ -144,6 +194,69 @@ func (m *AddAssetMetadataProposal) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *UpdateParamsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateParamsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateParamsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractOverrides) > 0 {
+		for iNdEx := len(m.ContractOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -178,6 +291,33 @@ func (m *AddAssetMetadataProposal) Size() (n int) {
 	return n
 }
 
+func (m *UpdateParamsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.ContractOverrides) > 0 {
+		for _, e := range m.ContractOverrides {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGov(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -332,6 +472,190 @@ func (m *AddAssetMetadataProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UpdateParamsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateParamsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateParamsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractOverrides = append(m.ContractOverrides, ContractParamsOverride{})
+			if err := m.ContractOverrides[len(m.ContractOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGov(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,48 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateParamsProposalValidateBasic(t *testing.T) {
+	TEST_CONTRACT := "sei1ghd753shjuwexxywmgs4xz7x2q732vcnkm6h2pyv9s6ah3hylvrqladqwc"
+	params := types.DefaultParams()
+	proposal := types.UpdateParamsProposal{
+		Title:       "title",
+		Description: "description",
+		Params:      &params,
+		ContractOverrides: []types.ContractParamsOverride{
+			{ContractAddr: TEST_CONTRACT, MaxOrderPerPrice: 100},
+		},
+	}
+	require.NoError(t, proposal.ValidateBasic())
+
+	// overrides only
+	proposal.Params = nil
+	require.NoError(t, proposal.ValidateBasic())
+
+	// nothing to update
+	proposal.ContractOverrides = nil
+	require.Error(t, proposal.ValidateBasic())
+
+	// invalid params
+	invalidParams := types.DefaultParams()
+	invalidParams.DefaultGasPerOrder = 0
+	proposal.Params = &invalidParams
+	require.Error(t, proposal.ValidateBasic())
+
+	// invalid contract address
+	proposal.Params = nil
+	proposal.ContractOverrides = []types.ContractParamsOverride{{ContractAddr: "invalid", MaxOrderPerPrice: 100}}
+	require.Error(t, proposal.ValidateBasic())
+
+	// duplicated contract
+	proposal.ContractOverrides = []types.ContractParamsOverride{
+		{ContractAddr: TEST_CONTRACT, MaxOrderPerPrice: 100},
+		{ContractAddr: TEST_CONTRACT, DefaultGasPerOrder: 100},
+	}
+	require.Error(t, proposal.ValidateBasic())
+}
@@ -0,0 +1,1003 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/incentive.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// IncentiveCampaign rewards accounts that rest two-sided liquidity close to the
+// mid price of a pair. The books are sampled at the end of every epoch between
+// startEpoch and endEpoch, and the reward pool is distributed pro rata to the
+// accumulated scores once endEpoch ends.
+type IncentiveCampaign struct {
+	Id           uint64                                   `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	Creator      string                                   `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator"`
+	ContractAddr string                                   `protobuf:"bytes,3,opt,name=contractAddr,proto3" json:"contract_address"`
+	PriceDenom   string                                   `protobuf:"bytes,4,opt,name=priceDenom,proto3" json:"price_denom"`
+	AssetDenom   string                                   `protobuf:"bytes,5,opt,name=assetDenom,proto3" json:"asset_denom"`
+	RewardPool   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=rewardPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_pool"`
+	StartEpoch   uint64                                   `protobuf:"varint,7,opt,name=startEpoch,proto3" json:"start_epoch"`
+	EndEpoch     uint64                                   `protobuf:"varint,8,opt,name=endEpoch,proto3" json:"end_epoch"`
+	// orders further away from the mid price than this ratio are not scored
+	MaxSpread github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=maxSpread,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_spread" yaml:"max_spread"`
+	// number of price levels read on each side of the book per sample
+	MaxLevels uint64 `protobuf:"varint,10,opt,name=maxLevels,proto3" json:"max_levels"`
+}
+
+func (m *IncentiveCampaign) Reset()         { *m = IncentiveCampaign{} }
+func (m *IncentiveCampaign) String() string { return proto.CompactTextString(m) }
+func (*IncentiveCampaign) ProtoMessage()    {}
+func (*IncentiveCampaign) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8b0d4fd1300aff, []int{0}
+}
+func (m *IncentiveCampaign) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentiveCampaign) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentiveCampaign.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncentiveCampaign) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentiveCampaign.Merge(m, src)
+}
+func (m *IncentiveCampaign) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentiveCampaign) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentiveCampaign.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentiveCampaign proto.InternalMessageInfo
+
+func (m *IncentiveCampaign) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *IncentiveCampaign) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *IncentiveCampaign) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
+func (m *IncentiveCampaign) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
+}
+
+func (m *IncentiveCampaign) GetAssetDenom() string {
+	if m != nil {
+		return m.AssetDenom
+	}
+	return ""
+}
+
+func (m *IncentiveCampaign) GetRewardPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RewardPool
+	}
+	return nil
+}
+
+func (m *IncentiveCampaign) GetStartEpoch() uint64 {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
+}
+
+func (m *IncentiveCampaign) GetEndEpoch() uint64 {
+	if m != nil {
+		return m.EndEpoch
+	}
+	return 0
+}
+
+func (m *IncentiveCampaign) GetMaxLevels() uint64 {
+	if m != nil {
+		return m.MaxLevels
+	}
+	return 0
+}
+
+type IncentiveScore struct {
+	CampaignId uint64                                 `protobuf:"varint,1,opt,name=campaignId,proto3" json:"campaign_id"`
+	Account    string                                 `protobuf:"bytes,2,opt,name=account,proto3" json:"account"`
+	Score      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=score,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"score" yaml:"score"`
+}
+
+func (m *IncentiveScore) Reset()         { *m = IncentiveScore{} }
+func (m *IncentiveScore) String() string { return proto.CompactTextString(m) }
+func (*IncentiveScore) ProtoMessage()    {}
+func (*IncentiveScore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8b0d4fd1300aff, []int{1}
+}
+func (m *IncentiveScore) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentiveScore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentiveScore.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncentiveScore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentiveScore.Merge(m, src)
+}
+func (m *IncentiveScore) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentiveScore) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentiveScore.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentiveScore proto.InternalMessageInfo
+
+func (m *IncentiveScore) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
+	}
+	return 0
+}
+
+func (m *IncentiveScore) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*IncentiveCampaign)(nil), "seiprotocol.seichain.dex.IncentiveCampaign")
+	proto.RegisterType((*IncentiveScore)(nil), "seiprotocol.seichain.dex.IncentiveScore")
+}
+
+func init() { proto.RegisterFile("dex/incentive.proto", fileDescriptor_2f8b0d4fd1300aff) }
+
+var fileDescriptor_2f8b0d4fd1300aff = []byte{
+	// 565 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x14, 0x85, 0xe3, 0xb4, 0x4d, 0x9b, 0x49, 0xff, 0xfe, 0xd4, 0x54, 0xc8, 0x74, 0x61, 0x47, 0x91,
+	0x40, 0x41, 0x22, 0xb6, 0x0a, 0x1b, 0xc4, 0x06, 0x91, 0x16, 0x55, 0x95, 0x58, 0x80, 0xbb, 0x43,
+	0x42, 0xd6, 0x64, 0xe6, 0x2a, 0x19, 0x61, 0x7b, 0xac, 0x99, 0x69, 0x48, 0x79, 0x0a, 0x9e, 0x83,
+	0x17, 0xa1, 0xcb, 0x2e, 0x11, 0x0b, 0x83, 0x12, 0xb1, 0xf1, 0xb2, 0x4f, 0x80, 0x66, 0x6c, 0x37,
+	0x66, 0x07, 0x2b, 0x5f, 0x7f, 0x73, 0xce, 0xdc, 0xab, 0xa3, 0x3b, 0xe8, 0x2e, 0x85, 0x45, 0xc0,
+	0x52, 0x02, 0xa9, 0x62, 0x73, 0xf0, 0x33, 0xc1, 0x15, 0xb7, 0x1d, 0x09, 0xcc, 0x54, 0x84, 0xc7,
+	0xbe, 0x04, 0x46, 0x66, 0x98, 0xa5, 0x3e, 0x85, 0xc5, 0xa1, 0x4b, 0xb8, 0x4c, 0xb8, 0x0c, 0x26,
+	0x58, 0x42, 0x30, 0x3f, 0x9a, 0x80, 0xc2, 0x47, 0x01, 0xe1, 0x2c, 0x2d, 0x9d, 0x87, 0x07, 0x53,
+	0x3e, 0xe5, 0xa6, 0x0c, 0x74, 0x55, 0xd2, 0xc1, 0xaf, 0x4d, 0xb4, 0x7f, 0x56, 0xf7, 0x38, 0xc6,
+	0x49, 0x86, 0xd9, 0x34, 0xb5, 0xef, 0xa1, 0x36, 0xa3, 0x8e, 0xd5, 0xb7, 0x86, 0x9b, 0xe3, 0x4e,
+	0x91, 0x7b, 0x6d, 0x46, 0xc3, 0x36, 0xa3, 0xf6, 0x03, 0xb4, 0x4d, 0x04, 0x60, 0xc5, 0x85, 0xd3,
+	0xee, 0x5b, 0xc3, 0xee, 0xb8, 0x57, 0xe4, 0x5e, 0x8d, 0xc2, 0xba, 0xb0, 0x9f, 0xa1, 0x5d, 0xc2,
+	0x53, 0x25, 0x30, 0x51, 0x2f, 0x29, 0x15, 0xce, 0x86, 0xd1, 0x1e, 0x14, 0xb9, 0x77, 0xa7, 0xe6,
+	0x11, 0xa6, 0x54, 0x80, 0x94, 0xe1, 0x1f, 0x4a, 0x3b, 0x40, 0x28, 0x13, 0x8c, 0xc0, 0x09, 0xa4,
+	0x3c, 0x71, 0x36, 0x8d, 0xef, 0xff, 0x22, 0xf7, 0x7a, 0x86, 0x46, 0x54, 0xe3, 0xb0, 0x21, 0xd1,
+	0x06, 0x2c, 0x25, 0xa8, 0xd2, 0xb0, 0xb5, 0x36, 0x18, 0x5a, 0x1b, 0xd6, 0x12, 0xfb, 0x13, 0x42,
+	0x02, 0x3e, 0x62, 0x41, 0xdf, 0x70, 0x1e, 0x3b, 0x9d, 0xfe, 0xc6, 0xb0, 0xf7, 0xe4, 0xbe, 0x5f,
+	0x66, 0xe7, 0xeb, 0xec, 0xfc, 0x2a, 0x3b, 0xff, 0x98, 0xb3, 0x74, 0xfc, 0xe2, 0x2a, 0xf7, 0x5a,
+	0xfa, 0xbe, 0xd2, 0x14, 0x65, 0x9c, 0xc7, 0x5f, 0x7e, 0x78, 0xc3, 0x29, 0x53, 0xb3, 0x8b, 0x89,
+	0x4f, 0x78, 0x12, 0x54, 0xb9, 0x97, 0x9f, 0x91, 0xa4, 0x1f, 0x02, 0x75, 0x99, 0x81, 0x34, 0x7e,
+	0x19, 0x36, 0xba, 0xe9, 0x61, 0xa5, 0xc2, 0x42, 0xbd, 0xca, 0x38, 0x99, 0x39, 0xdb, 0x26, 0x5e,
+	0x33, 0xac, 0xa1, 0x11, 0x68, 0x1c, 0x36, 0x24, 0xf6, 0x23, 0xb4, 0x03, 0x29, 0x2d, 0xe5, 0x3b,
+	0x46, 0xfe, 0x5f, 0x91, 0x7b, 0x5d, 0x48, 0x69, 0x25, 0xbe, 0x3d, 0xb6, 0x39, 0xea, 0x26, 0x78,
+	0x71, 0x9e, 0x09, 0xc0, 0xd4, 0xe9, 0x9a, 0x1c, 0xde, 0xea, 0xd9, 0xbf, 0xe7, 0xde, 0xc3, 0xbf,
+	0x18, 0xf6, 0x04, 0x48, 0x91, 0x7b, 0x28, 0xc1, 0x8b, 0x48, 0x9a, 0x3b, 0x6e, 0x72, 0x6f, 0xff,
+	0x12, 0x27, 0xf1, 0xf3, 0xc1, 0x9a, 0x0d, 0xc2, 0x75, 0x0f, 0xfb, 0xb1, 0x69, 0xf8, 0x1a, 0xe6,
+	0x10, 0x4b, 0x07, 0x99, 0xe1, 0xf6, 0xea, 0x2b, 0x62, 0x43, 0xc3, 0xb5, 0x60, 0xf0, 0xd5, 0x42,
+	0x7b, 0xb7, 0x7b, 0x76, 0x4e, 0xb8, 0x00, 0x9d, 0x06, 0xa9, 0x16, 0xee, 0xac, 0x5e, 0x36, 0x93,
+	0x46, 0x4d, 0x23, 0x46, 0xc3, 0x86, 0x44, 0x6f, 0x1f, 0x26, 0x84, 0x5f, 0xa4, 0xaa, 0xb9, 0x7d,
+	0x15, 0x0a, 0xeb, 0xc2, 0x7e, 0x8f, 0xb6, 0xa4, 0x6e, 0x50, 0xad, 0xdd, 0xe9, 0x3f, 0xa7, 0x50,
+	0xda, 0x6f, 0x72, 0x6f, 0xb7, 0x0c, 0xc0, 0xfc, 0x0e, 0xc2, 0x12, 0x8f, 0x4f, 0xaf, 0x96, 0xae,
+	0x75, 0xbd, 0x74, 0xad, 0x9f, 0x4b, 0xd7, 0xfa, 0xbc, 0x72, 0x5b, 0xd7, 0x2b, 0xb7, 0xf5, 0x6d,
+	0xe5, 0xb6, 0xde, 0x8d, 0x1a, 0x1d, 0x24, 0xb0, 0x51, 0xfd, 0x4e, 0xcd, 0x8f, 0x79, 0xa8, 0xc1,
+	0x22, 0xd0, 0x8f, 0xda, 0x34, 0x9b, 0x74, 0xcc, 0xf9, 0xd3, 0xdf, 0x03, 0x00, 0xc7, 0x38, 0xab,
+	0x3a, 0xe8, 0x03, 0x00, 0x00,
+}
+
+func (m *IncentiveCampaign) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncentiveCampaign) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentiveCampaign) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxLevels != 0 {
+		i = encodeVarintIncentive(dAtA, i, uint64(m.MaxLevels))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.MaxSpread.Size()
+		i -= size
+		if _, err := m.MaxSpread.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIncentive(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.EndEpoch != 0 {
+		i = encodeVarintIncentive(dAtA, i, uint64(m.EndEpoch))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintIncentive(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RewardPool) > 0 {
+		for iNdEx := len(m.RewardPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AssetDenom) > 0 {
+		i -= len(m.AssetDenom)
+		copy(dAtA[i:], m.AssetDenom)
+		i = encodeVarintIncentive(dAtA, i, uint64(len(m.AssetDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintIncentive(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintIncentive(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintIncentive(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintIncentive(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IncentiveScore) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncentiveScore) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentiveScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Score.Size()
+		i -= size
+		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIncentive(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintIncentive(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CampaignId != 0 {
+		i = encodeVarintIncentive(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIncentive(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIncentive(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IncentiveCampaign) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovIncentive(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovIncentive(uint64(l))
+	}
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovIncentive(uint64(l))
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovIncentive(uint64(l))
+	}
+	l = len(m.AssetDenom)
+	if l > 0 {
+		n += 1 + l + sovIncentive(uint64(l))
+	}
+	if len(m.RewardPool) > 0 {
+		for _, e := range m.RewardPool {
+			l = e.Size()
+			n += 1 + l + sovIncentive(uint64(l))
+		}
+	}
+	if m.StartEpoch != 0 {
+		n += 1 + sovIncentive(uint64(m.StartEpoch))
+	}
+	if m.EndEpoch != 0 {
+		n += 1 + sovIncentive(uint64(m.EndEpoch))
+	}
+	l = m.MaxSpread.Size()
+	n += 1 + l + sovIncentive(uint64(l))
+	if m.MaxLevels != 0 {
+		n += 1 + sovIncentive(uint64(m.MaxLevels))
+	}
+	return n
+}
+
+func (m *IncentiveScore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		n += 1 + sovIncentive(uint64(m.CampaignId))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovIncentive(uint64(l))
+	}
+	l = m.Score.Size()
+	n += 1 + l + sovIncentive(uint64(l))
+	return n
+}
+
+func sovIncentive(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIncentive(x uint64) (n int) {
+	return sovIncentive(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IncentiveCampaign) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncentiveCampaign: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncentiveCampaign: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPool = append(m.RewardPool, types.Coin{})
+			if err := m.RewardPool[len(m.RewardPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEpoch", wireType)
+			}
+			m.EndEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpread", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSpread.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLevels", wireType)
+			}
+			m.MaxLevels = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLevels |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IncentiveScore) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncentiveScore: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncentiveScore: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIncentive(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIncentive
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIncentive
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIncentive
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIncentive
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIncentive        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIncentive          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIncentive = fmt.Errorf("proto: unexpected end of group")
+)
@@ -22,6 +22,10 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_dex"
+
+	// IncentivePoolName is the module account holding the reward pools of
+	// market-making incentive campaigns
+	IncentivePoolName = "dex_incentive_pool"
 )
 
 func KeyPrefix(p string) []byte {
@@ -140,6 +144,43 @@ func MemDepositSubprefix(creator, denom string) []byte {
 	return append([]byte(creator), DenomPrefix(denom)...)
 }
 
+func AccountActiveOrdersContractPrefix(contractAddr string) []byte {
+	return append(KeyPrefix(AccountActiveOrdersKey), AddressKeyPrefix(contractAddr)...)
+}
+
+// `AccountActiveOrders` constant + contract + price denom + asset denom
+func AccountActiveOrdersPrefix(contractAddr string, priceDenom string, assetDenom string) []byte {
+	return append(
+		AccountActiveOrdersContractPrefix(contractAddr),
+		PairPrefix(priceDenom, assetDenom)...,
+	)
+}
+
+func AccountOrderCounterPrefix(contractAddr string) []byte {
+	return append(KeyPrefix(AccountOrderCounterKey), AddressKeyPrefix(contractAddr)...)
+}
+
+func ContractParamsOverridePrefix(contractAddr string) []byte {
+	return append(KeyPrefix(ContractParamsOverrideKey), AddressKeyPrefix(contractAddr)...)
+}
+
+func IncentiveScorePrefix(campaignID uint64) []byte {
+	return append(KeyPrefix(IncentiveScoreKey), sdk.Uint64ToBigEndian(campaignID)...)
+}
+
+// end epoch + campaign id, so that campaigns are iterated in the order they end
+func IncentiveCampaignEndEpochIndexKey(endEpoch uint64, campaignID uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(endEpoch), sdk.Uint64ToBigEndian(campaignID)...)
+}
+
+// `IncentiveCampaignByPair` constant + contract + price denom + asset denom
+func IncentiveCampaignByPairPrefix(contractAddr string, priceDenom string, assetDenom string) []byte {
+	return append(
+		append(KeyPrefix(IncentiveCampaignByPairKey), AddressKeyPrefix(contractAddr)...),
+		PairPrefix(priceDenom, assetDenom)...,
+	)
+}
+
 func ContractKey(contractAddr string) []byte {
 	return AddressKeyPrefix(contractAddr)
 }
@@ -174,7 +215,20 @@ const (
 	LongOrderCountKey   = "loc-"
 	ShortOrderCountKey  = "soc-"
 
-	MemOrderKey   = "MemOrder-"
-	MemDepositKey = "MemDeposit-"
-	MemCancelKey  = "MemCancel-"
+	ContractParamsOverrideKey = "ContractParamsOverride-"
+	// nested under AccountActiveOrdersKey so that it falls under the same access
+	// control resource type as the active order counts
+	AccountOrderCounterKey = AccountActiveOrdersKey + "-counter"
+
+	IncentiveCampaignKey       = "IncentiveCampaign-"
+	IncentiveScoreKey          = "IncentiveScore-"
+	NextIncentiveCampaignIDKey = "NextIncentiveCampaignID"
+	// indexes of the campaigns that have not been paid out yet
+	IncentiveCampaignByEndEpochKey = "IncentiveCampaignByEndEpoch-"
+	IncentiveCampaignByPairKey     = "IncentiveCampaignByPair-"
+
+	MemOrderKey       = "MemOrder-"
+	MemDepositKey     = "MemDeposit-"
+	MemCancelKey      = "MemCancel-"
+	MemRoutedOrderKey = "MemRoutedOrder-"
 )
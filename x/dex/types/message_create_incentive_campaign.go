@@ -0,0 +1,82 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCreateIncentiveCampaign = "create_incentive_campaign"
+
+// MaxIncentiveCampaignLevels caps the number of price levels sampled on each
+// side of the book, since every level is read at each epoch boundary.
+const MaxIncentiveCampaignLevels = 50
+
+var _ sdk.Msg = &MsgCreateIncentiveCampaign{}
+
+func NewMsgCreateIncentiveCampaign(
+	creator string,
+	contractAddr string,
+	priceDenom string,
+	assetDenom string,
+	rewardPool sdk.Coins,
+	numEpochs uint64,
+	maxSpread sdk.Dec,
+	maxLevels uint64,
+) *MsgCreateIncentiveCampaign {
+	return &MsgCreateIncentiveCampaign{
+		Creator:      creator,
+		ContractAddr: contractAddr,
+		PriceDenom:   priceDenom,
+		AssetDenom:   assetDenom,
+		RewardPool:   rewardPool,
+		NumEpochs:    numEpochs,
+		MaxSpread:    maxSpread,
+		MaxLevels:    maxLevels,
+	}
+}
+
+func (msg *MsgCreateIncentiveCampaign) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateIncentiveCampaign) Type() string {
+	return TypeMsgCreateIncentiveCampaign
+}
+
+func (msg *MsgCreateIncentiveCampaign) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCreateIncentiveCampaign) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateIncentiveCampaign) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.ContractAddr); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address (%s)", err)
+	}
+	if msg.PriceDenom == "" || msg.AssetDenom == "" {
+		return sdkerrors.Wrapf(ErrInvalidIncentiveCampaign, "price and asset denoms must be set")
+	}
+	if !msg.RewardPool.IsValid() || msg.RewardPool.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidIncentiveCampaign, "invalid reward pool %s", msg.RewardPool)
+	}
+	if msg.NumEpochs == 0 {
+		return sdkerrors.Wrapf(ErrInvalidIncentiveCampaign, "campaign must last at least one epoch")
+	}
+	if msg.MaxSpread.IsNil() || !msg.MaxSpread.IsPositive() || msg.MaxSpread.GT(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidIncentiveCampaign, "max spread must be in (0, 1], got %s", msg.MaxSpread)
+	}
+	if msg.MaxLevels == 0 || msg.MaxLevels > MaxIncentiveCampaignLevels {
+		return sdkerrors.Wrapf(ErrInvalidIncentiveCampaign, "max levels must be between 1 and %d", MaxIncentiveCampaignLevels)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateMsgCreateIncentiveCampaign(t *testing.T) {
+	TEST_CONTRACT := "sei1ghd753shjuwexxywmgs4xz7x2q732vcnkm6h2pyv9s6ah3hylvrqladqwc"
+	TEST_CREATOR := "sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx"
+	pool := sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(1000)))
+	newMsg := func() *types.MsgCreateIncentiveCampaign {
+		return types.NewMsgCreateIncentiveCampaign(TEST_CREATOR, TEST_CONTRACT, "usdc", "atom", pool, 10, sdk.MustNewDecFromStr("0.05"), 10)
+	}
+	require.NoError(t, newMsg().ValidateBasic())
+
+	msg := newMsg()
+	msg.ContractAddr = "invalid"
+	require.Error(t, msg.ValidateBasic())
+
+	msg = newMsg()
+	msg.AssetDenom = ""
+	require.Error(t, msg.ValidateBasic())
+
+	msg = newMsg()
+	msg.RewardPool = sdk.NewCoins()
+	require.Error(t, msg.ValidateBasic())
+
+	msg = newMsg()
+	msg.NumEpochs = 0
+	require.Error(t, msg.ValidateBasic())
+
+	msg = newMsg()
+	msg.MaxSpread = sdk.ZeroDec()
+	require.Error(t, msg.ValidateBasic())
+
+	msg = newMsg()
+	msg.MaxSpread = sdk.NewDec(2)
+	require.Error(t, msg.ValidateBasic())
+
+	msg = newMsg()
+	msg.MaxLevels = types.MaxIncentiveCampaignLevels + 1
+	require.Error(t, msg.ValidateBasic())
+}
@@ -0,0 +1,109 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgPlaceRoutedMarketOrder = "place_routed_market_order"
+
+// MaxRouteLegs caps the number of hops a single routed market order may take.
+const MaxRouteLegs = 4
+
+var _ sdk.Msg = &MsgPlaceRoutedMarketOrder{}
+
+func NewMsgPlaceRoutedMarketOrder(
+	creator string,
+	legs []*RouteLeg,
+	amountIn sdk.Dec,
+	minAmountOut sdk.Dec,
+	fund sdk.Coins,
+) *MsgPlaceRoutedMarketOrder {
+	return &MsgPlaceRoutedMarketOrder{
+		Creator:      creator,
+		Legs:         legs,
+		AmountIn:     amountIn,
+		MinAmountOut: minAmountOut,
+		Funds:        fund,
+	}
+}
+
+func (msg *MsgPlaceRoutedMarketOrder) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgPlaceRoutedMarketOrder) Type() string {
+	return TypeMsgPlaceRoutedMarketOrder
+}
+
+func (msg *MsgPlaceRoutedMarketOrder) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgPlaceRoutedMarketOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgPlaceRoutedMarketOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if len(msg.Legs) == 0 || len(msg.Legs) > MaxRouteLegs {
+		return sdkerrors.Wrapf(ErrInvalidRoute, "a route must have between 1 and %d legs", MaxRouteLegs)
+	}
+
+	if msg.AmountIn.IsNil() || !msg.AmountIn.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidRoute, "amount in must be positive")
+	}
+
+	if msg.MinAmountOut.IsNil() || msg.MinAmountOut.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidRoute, "min amount out must not be negative")
+	}
+
+	for i, leg := range msg.Legs {
+		if _, err := sdk.AccAddressFromBech32(leg.ContractAddr); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address for leg %d (%s)", i, err)
+		}
+		if len(leg.AssetDenom) == 0 || sdk.ValidateDenom(leg.AssetDenom) != nil {
+			return sdkerrors.Wrapf(ErrInvalidRoute, "leg %d asset denom is empty or invalid", i)
+		}
+		if len(leg.PriceDenom) == 0 || sdk.ValidateDenom(leg.PriceDenom) != nil {
+			return sdkerrors.Wrapf(ErrInvalidRoute, "leg %d price denom is empty or invalid", i)
+		}
+		if i > 0 && msg.Legs[i-1].OutputDenom() != leg.InputDenom() {
+			return sdkerrors.Wrapf(ErrInvalidRoute, "leg %d spends %s but leg %d yields %s", i, leg.InputDenom(), i-1, msg.Legs[i-1].OutputDenom())
+		}
+	}
+
+	// the funds are held until the route is executed, and are exactly what its
+	// first leg spends
+	amountIn := sdk.NewCoin(msg.Legs[0].InputDenom(), msg.AmountIn.TruncateInt())
+	if !msg.AmountIn.IsInteger() || len(msg.Funds) != 1 || msg.Funds[0].Denom != amountIn.Denom || !msg.Funds[0].Amount.Equal(amountIn.Amount) {
+		return sdkerrors.Wrapf(ErrInvalidRoute, "funds must be exactly the amount in of %s%s", msg.AmountIn, amountIn.Denom)
+	}
+
+	return nil
+}
+
+// InputDenom is the denom a leg spends.
+func (leg *RouteLeg) InputDenom() string {
+	if leg.PositionDirection == PositionDirection_LONG {
+		return leg.PriceDenom
+	}
+	return leg.AssetDenom
+}
+
+// OutputDenom is the denom a leg yields.
+func (leg *RouteLeg) OutputDenom() string {
+	if leg.PositionDirection == PositionDirection_LONG {
+		return leg.AssetDenom
+	}
+	return leg.PriceDenom
+}
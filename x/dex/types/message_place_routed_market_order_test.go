@@ -0,0 +1,69 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateMsgPlaceRoutedMarketOrder(t *testing.T) {
+	TEST_CONTRACT := "sei1ghd753shjuwexxywmgs4xz7x2q732vcnkm6h2pyv9s6ah3hylvrqladqwc"
+	OTHER_CONTRACT := "sei14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sh9m79m"
+	validLegs := func() []*types.RouteLeg {
+		return []*types.RouteLeg{
+			{ContractAddr: TEST_CONTRACT, PriceDenom: "usdc", AssetDenom: "atom", PositionDirection: types.PositionDirection_SHORT},
+			{ContractAddr: TEST_CONTRACT, PriceDenom: "usdc", AssetDenom: "osmo", PositionDirection: types.PositionDirection_LONG},
+		}
+	}
+	funds := sdk.NewCoins(sdk.NewCoin("atom", sdk.OneInt()))
+	msg := types.NewMsgPlaceRoutedMarketOrder("sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx", validLegs(), sdk.OneDec(), sdk.ZeroDec(), funds)
+	require.NoError(t, msg.ValidateBasic())
+
+	// legs that don't chain
+	legs := validLegs()
+	legs[1].PositionDirection = types.PositionDirection_SHORT
+	msg = types.NewMsgPlaceRoutedMarketOrder("sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx", legs, sdk.OneDec(), sdk.ZeroDec(), funds)
+	require.Error(t, msg.ValidateBasic())
+
+	// no legs
+	msg = types.NewMsgPlaceRoutedMarketOrder("sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx", []*types.RouteLeg{}, sdk.OneDec(), sdk.ZeroDec(), funds)
+	require.Error(t, msg.ValidateBasic())
+
+	// too many legs
+	legs = []*types.RouteLeg{}
+	for i := 0; i <= types.MaxRouteLegs; i++ {
+		legs = append(legs, validLegs()...)
+	}
+	msg = types.NewMsgPlaceRoutedMarketOrder("sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx", legs, sdk.OneDec(), sdk.ZeroDec(), funds)
+	require.Error(t, msg.ValidateBasic())
+
+	// non-positive amount in
+	msg = types.NewMsgPlaceRoutedMarketOrder("sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx", validLegs(), sdk.ZeroDec(), sdk.ZeroDec(), funds)
+	require.Error(t, msg.ValidateBasic())
+
+	// negative min amount out
+	msg = types.NewMsgPlaceRoutedMarketOrder("sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx", validLegs(), sdk.OneDec(), sdk.OneDec().Neg(), funds)
+	require.Error(t, msg.ValidateBasic())
+
+	// legs on different contracts
+	legs = validLegs()
+	legs[1].ContractAddr = OTHER_CONTRACT
+	msg = types.NewMsgPlaceRoutedMarketOrder("sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx", legs, sdk.OneDec(), sdk.ZeroDec(), funds)
+	require.NoError(t, msg.ValidateBasic())
+
+	// funds other than the amount in
+	msg = types.NewMsgPlaceRoutedMarketOrder("sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx", validLegs(), sdk.NewDec(2), sdk.ZeroDec(), funds)
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidRoute)
+	msg = types.NewMsgPlaceRoutedMarketOrder("sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx", validLegs(), sdk.OneDec(), sdk.ZeroDec(), sdk.NewCoins(sdk.NewCoin("usdc", sdk.OneInt())))
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidRoute)
+	msg = types.NewMsgPlaceRoutedMarketOrder("sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx", validLegs(), sdk.MustNewDecFromStr("0.5"), sdk.ZeroDec(), funds)
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidRoute)
+
+	// invalid leg contract
+	legs = validLegs()
+	legs[0].ContractAddr = "invalid"
+	msg = types.NewMsgPlaceRoutedMarketOrder("sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx", legs, sdk.OneDec(), sdk.ZeroDec(), funds)
+	require.Error(t, msg.ValidateBasic())
+}
@@ -23,6 +23,12 @@ var (
 	KeyMaxOrderPerPrice           = []byte("KeyMaxOrderPerPrice")
 	KeyMaxPairsPerContract        = []byte("KeyMaxPairsPerContract")
 	KeyDefaultGasPerOrderDataByte = []byte("KeyDefaultGasPerOrderDataByte")
+	KeyMaxOpenOrdersPerAccount    = []byte("KeyMaxOpenOrdersPerAccount")
+	KeyMinOrderNotional           = []byte("KeyMinOrderNotional")
+	KeyMaxOrderToTradeRatio       = []byte("KeyMaxOrderToTradeRatio")
+	KeyOrderToTradeRatioWindow    = []byte("KeyOrderToTradeRatioWindow")
+	KeyMinCampaignRewardPool      = []byte("KeyMinCampaignRewardPool")
+	KeyMaxActiveCampaignsPerPair  = []byte("KeyMaxActiveCampaignsPerPair")
 )
 
 const (
@@ -39,9 +45,17 @@ const (
 	DefaultMaxOrderPerPrice           = 10000
 	DefaultMaxPairsPerContract        = 100
 	DefaultDefaultGasPerOrderDataByte = 30
+	DefaultMaxOpenOrdersPerAccount    = 1000
+	DefaultMaxOrderToTradeRatio       = 0 // disabled
+	DefaultOrderToTradeRatioWindow    = 1000
+	DefaultMaxActiveCampaignsPerPair  = 10
 )
 
-var DefaultSudoCallGasPrice = sdk.NewDecWithPrec(1, 1) // 0.1
+var (
+	DefaultSudoCallGasPrice      = sdk.NewDecWithPrec(1, 1) // 0.1
+	DefaultMinOrderNotional      = sdk.ZeroDec()
+	DefaultMinCampaignRewardPool = sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(1000000))) // 1 sei
+)
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
@@ -53,20 +67,26 @@ func ParamKeyTable() paramtypes.KeyTable {
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
 	return Params{
-		PriceSnapshotRetention:     DefaultPriceSnapshotRetention,
-		SudoCallGasPrice:           DefaultSudoCallGasPrice,
-		BeginBlockGasLimit:         DefaultBeginBlockGasLimit,
-		EndBlockGasLimit:           DefaultEndBlockGasLimit,
-		DefaultGasPerOrder:         DefaultDefaultGasPerOrder,
-		DefaultGasPerCancel:        DefaultDefaultGasPerCancel,
-		MinRentDeposit:             DefaultMinRentDeposit,
-		GasAllowancePerSettlement:  DefaultGasAllowancePerSettlement,
-		MinProcessableRent:         DefaultMinProcessableRent,
-		OrderBookEntriesPerLoad:    DefaultOrderBookEntriesPerLoad,
-		ContractUnsuspendCost:      DefaultContractUnsuspendCost,
-		MaxOrderPerPrice:           DefaultMaxOrderPerPrice,
-		MaxPairsPerContract:        DefaultMaxPairsPerContract,
-		DefaultGasPerOrderDataByte: DefaultDefaultGasPerOrderDataByte,
+		PriceSnapshotRetention:         DefaultPriceSnapshotRetention,
+		SudoCallGasPrice:               DefaultSudoCallGasPrice,
+		BeginBlockGasLimit:             DefaultBeginBlockGasLimit,
+		EndBlockGasLimit:               DefaultEndBlockGasLimit,
+		DefaultGasPerOrder:             DefaultDefaultGasPerOrder,
+		DefaultGasPerCancel:            DefaultDefaultGasPerCancel,
+		MinRentDeposit:                 DefaultMinRentDeposit,
+		GasAllowancePerSettlement:      DefaultGasAllowancePerSettlement,
+		MinProcessableRent:             DefaultMinProcessableRent,
+		OrderBookEntriesPerLoad:        DefaultOrderBookEntriesPerLoad,
+		ContractUnsuspendCost:          DefaultContractUnsuspendCost,
+		MaxOrderPerPrice:               DefaultMaxOrderPerPrice,
+		MaxPairsPerContract:            DefaultMaxPairsPerContract,
+		DefaultGasPerOrderDataByte:     DefaultDefaultGasPerOrderDataByte,
+		MaxOpenOrdersPerAccountPerPair: DefaultMaxOpenOrdersPerAccount,
+		MinOrderNotional:               DefaultMinOrderNotional,
+		MaxOrderToTradeRatio:           DefaultMaxOrderToTradeRatio,
+		OrderToTradeRatioWindow:        DefaultOrderToTradeRatioWindow,
+		MinCampaignRewardPool:          DefaultMinCampaignRewardPool,
+		MaxActiveCampaignsPerPair:      DefaultMaxActiveCampaignsPerPair,
 	}
 }
 
@@ -87,6 +107,12 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyMaxOrderPerPrice, &p.MaxOrderPerPrice, validateUint64Param),
 		paramtypes.NewParamSetPair(KeyMaxPairsPerContract, &p.MaxPairsPerContract, validateUint64Param),
 		paramtypes.NewParamSetPair(KeyDefaultGasPerOrderDataByte, &p.DefaultGasPerOrderDataByte, validateUint64Param),
+		paramtypes.NewParamSetPair(KeyMaxOpenOrdersPerAccount, &p.MaxOpenOrdersPerAccountPerPair, validateUint64Param),
+		paramtypes.NewParamSetPair(KeyMinOrderNotional, &p.MinOrderNotional, validateMinOrderNotional),
+		paramtypes.NewParamSetPair(KeyMaxOrderToTradeRatio, &p.MaxOrderToTradeRatio, validateUint64Param),
+		paramtypes.NewParamSetPair(KeyOrderToTradeRatioWindow, &p.OrderToTradeRatioWindow, validateUint64Param),
+		paramtypes.NewParamSetPair(KeyMinCampaignRewardPool, &p.MinCampaignRewardPool, validateMinCampaignRewardPool),
+		paramtypes.NewParamSetPair(KeyMaxActiveCampaignsPerPair, &p.MaxActiveCampaignsPerPair, validateUint64Param),
 	}
 }
 
@@ -98,8 +124,66 @@ func (p Params) Validate() error {
 	if err := validateSudoCallGasPrice(p.SudoCallGasPrice); err != nil {
 		return err
 	}
-	// it's not possible for other params to fail validation if they've already
-	// made it into Params' fields.
+	// the per-key validators used by the param store only check types so that
+	// partially populated params can still be written by migrations and tests.
+	// Params coming from genesis or governance must set every limit below.
+	for _, pp := range []struct {
+		name  string
+		value uint64
+	}{
+		{"begin block gas limit", p.BeginBlockGasLimit},
+		{"end block gas limit", p.EndBlockGasLimit},
+		{"default gas per order", p.DefaultGasPerOrder},
+		{"default gas per cancel", p.DefaultGasPerCancel},
+		{"gas allowance per settlement", p.GasAllowancePerSettlement},
+		{"order book entries per load", p.OrderBookEntriesPerLoad},
+		{"max order per price", p.MaxOrderPerPrice},
+		{"max pairs per contract", p.MaxPairsPerContract},
+	} {
+		if pp.value == 0 {
+			return fmt.Errorf("%s must be a positive integer", pp.name)
+		}
+	}
+	if err := validateMinOrderNotional(p.MinOrderNotional); err != nil {
+		return err
+	}
+	if p.MaxOrderToTradeRatio > 0 && p.OrderToTradeRatioWindow == 0 {
+		return fmt.Errorf("order to trade ratio window must be a positive integer when the ratio is enforced")
+	}
+	if err := validateMinCampaignRewardPool(p.MinCampaignRewardPool); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ApplyOverride returns a copy of the params with any non-zero values from the
+// contract override taking precedence.
+func (p Params) ApplyOverride(override *ContractParamsOverride) Params {
+	if override == nil {
+		return p
+	}
+	if override.MaxOrderPerPrice > 0 {
+		p.MaxOrderPerPrice = override.MaxOrderPerPrice
+	}
+	if override.DefaultGasPerOrder > 0 {
+		p.DefaultGasPerOrder = override.DefaultGasPerOrder
+	}
+	if override.GasAllowancePerSettlement > 0 {
+		p.GasAllowancePerSettlement = override.GasAllowancePerSettlement
+	}
+	return p
+}
+
+// IsEmpty returns true if the override does not change any param, in which
+// case it should be removed rather than stored.
+func (o ContractParamsOverride) IsEmpty() bool {
+	return o.MaxOrderPerPrice == 0 && o.DefaultGasPerOrder == 0 && o.GasAllowancePerSettlement == 0
+}
+
+func (o ContractParamsOverride) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(o.ContractAddr); err != nil {
+		return fmt.Errorf("invalid contract address %s in params override: %w", o.ContractAddr, err)
+	}
 	return nil
 }
 
@@ -141,3 +225,27 @@ func validateUint64Param(i interface{}) error {
 
 	return nil
 }
+
+func validateMinOrderNotional(i interface{}) error {
+	notional, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// an unset notional is treated as zero
+	if !notional.IsNil() && notional.IsNegative() {
+		return fmt.Errorf("min order notional must be non-negative")
+	}
+	return nil
+}
+
+func validateMinCampaignRewardPool(i interface{}) error {
+	pool, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// an empty pool means there is no minimum
+	if !pool.IsValid() {
+		return fmt.Errorf("invalid min campaign reward pool %s", pool)
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -40,6 +41,17 @@ type Params struct {
 	MaxOrderPerPrice           uint64                                 `protobuf:"varint,12,opt,name=max_order_per_price,json=maxOrderPerPrice,proto3" json:"max_order_per_price" yaml:"max_order_per_price"`
 	MaxPairsPerContract        uint64                                 `protobuf:"varint,13,opt,name=max_pairs_per_contract,json=maxPairsPerContract,proto3" json:"max_pairs_per_contract" yaml:"max_pairs_per_contract"`
 	DefaultGasPerOrderDataByte uint64                                 `protobuf:"varint,14,opt,name=default_gas_per_order_data_byte,json=defaultGasPerOrderDataByte,proto3" json:"default_gas_per_order_data_byte" yaml:"default_gas_per_order_data_byte"`
+	// 0 means no cap
+	MaxOpenOrdersPerAccountPerPair uint64                                 `protobuf:"varint,15,opt,name=max_open_orders_per_account_per_pair,json=maxOpenOrdersPerAccountPerPair,proto3" json:"max_open_orders_per_account_per_pair" yaml:"max_open_orders_per_account_per_pair"`
+	MinOrderNotional               github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=min_order_notional,json=minOrderNotional,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_order_notional" yaml:"min_order_notional"`
+	// 0 means the order-to-trade ratio is not enforced
+	MaxOrderToTradeRatio uint64 `protobuf:"varint,17,opt,name=max_order_to_trade_ratio,json=maxOrderToTradeRatio,proto3" json:"max_order_to_trade_ratio" yaml:"max_order_to_trade_ratio"`
+	// length of the sliding window, in blocks, over which the order-to-trade ratio is measured
+	OrderToTradeRatioWindow uint64 `protobuf:"varint,18,opt,name=order_to_trade_ratio_window,json=orderToTradeRatioWindow,proto3" json:"order_to_trade_ratio_window" yaml:"order_to_trade_ratio_window"`
+	// an incentive campaign's reward pool must hold at least these coins
+	MinCampaignRewardPool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,19,rep,name=min_campaign_reward_pool,json=minCampaignRewardPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_campaign_reward_pool" yaml:"min_campaign_reward_pool"`
+	// 0 means no cap
+	MaxActiveCampaignsPerPair uint64 `protobuf:"varint,20,opt,name=max_active_campaigns_per_pair,json=maxActiveCampaignsPerPair,proto3" json:"max_active_campaigns_per_pair" yaml:"max_active_campaigns_per_pair"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -165,64 +177,195 @@ func (m *Params) GetDefaultGasPerOrderDataByte() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxOpenOrdersPerAccountPerPair() uint64 {
+	if m != nil {
+		return m.MaxOpenOrdersPerAccountPerPair
+	}
+	return 0
+}
+
+func (m *Params) GetMaxOrderToTradeRatio() uint64 {
+	if m != nil {
+		return m.MaxOrderToTradeRatio
+	}
+	return 0
+}
+
+func (m *Params) GetOrderToTradeRatioWindow() uint64 {
+	if m != nil {
+		return m.OrderToTradeRatioWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMinCampaignRewardPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinCampaignRewardPool
+	}
+	return nil
+}
+
+func (m *Params) GetMaxActiveCampaignsPerPair() uint64 {
+	if m != nil {
+		return m.MaxActiveCampaignsPerPair
+	}
+	return 0
+}
+
+// ContractParamsOverride holds per-contract values that take precedence over
+// the module-wide Params for a single contract. A zero value means the field
+// is not overridden and the module-wide value applies.
+type ContractParamsOverride struct {
+	ContractAddr              string `protobuf:"bytes,1,opt,name=contract_addr,json=contractAddr,proto3" json:"contract_addr" yaml:"contract_addr"`
+	MaxOrderPerPrice          uint64 `protobuf:"varint,2,opt,name=max_order_per_price,json=maxOrderPerPrice,proto3" json:"max_order_per_price" yaml:"max_order_per_price"`
+	DefaultGasPerOrder        uint64 `protobuf:"varint,3,opt,name=default_gas_per_order,json=defaultGasPerOrder,proto3" json:"default_gas_per_order" yaml:"default_gas_per_order"`
+	GasAllowancePerSettlement uint64 `protobuf:"varint,4,opt,name=gas_allowance_per_settlement,json=gasAllowancePerSettlement,proto3" json:"gas_allowance_per_settlement" yaml:"gas_allowance_per_settlement"`
+}
+
+func (m *ContractParamsOverride) Reset()         { *m = ContractParamsOverride{} }
+func (m *ContractParamsOverride) String() string { return proto.CompactTextString(m) }
+func (*ContractParamsOverride) ProtoMessage()    {}
+func (*ContractParamsOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e49286500ccff43e, []int{1}
+}
+func (m *ContractParamsOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractParamsOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractParamsOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractParamsOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractParamsOverride.Merge(m, src)
+}
+func (m *ContractParamsOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractParamsOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractParamsOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractParamsOverride proto.InternalMessageInfo
+
+func (m *ContractParamsOverride) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
+func (m *ContractParamsOverride) GetMaxOrderPerPrice() uint64 {
+	if m != nil {
+		return m.MaxOrderPerPrice
+	}
+	return 0
+}
+
+func (m *ContractParamsOverride) GetDefaultGasPerOrder() uint64 {
+	if m != nil {
+		return m.DefaultGasPerOrder
+	}
+	return 0
+}
+
+func (m *ContractParamsOverride) GetGasAllowancePerSettlement() uint64 {
+	if m != nil {
+		return m.GasAllowancePerSettlement
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "seiprotocol.seichain.dex.Params")
+	proto.RegisterType((*ContractParamsOverride)(nil), "seiprotocol.seichain.dex.ContractParamsOverride")
 }
 
 func init() { proto.RegisterFile("dex/params.proto", fileDescriptor_e49286500ccff43e) }
 
 var fileDescriptor_e49286500ccff43e = []byte{
-	// 787 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x95, 0xc1, 0x6f, 0x1b, 0x45,
-	0x14, 0xc6, 0xbd, 0x50, 0x42, 0x3b, 0x94, 0xca, 0xda, 0x34, 0xc9, 0x92, 0x16, 0x4f, 0x35, 0x48,
-	0x55, 0x2f, 0xb1, 0x0f, 0x08, 0x21, 0x8a, 0x10, 0xc2, 0x49, 0x94, 0x4b, 0x11, 0xd6, 0x54, 0x1c,
-	0xe0, 0xb2, 0x1a, 0xef, 0x3e, 0x9c, 0x51, 0x66, 0x67, 0x56, 0x33, 0x63, 0x61, 0x9f, 0xb9, 0x70,
-	0x44, 0x9c, 0x38, 0xf6, 0xcf, 0xe9, 0xb1, 0x47, 0xc4, 0x61, 0x84, 0x92, 0x0b, 0xda, 0xe3, 0xfe,
-	0x05, 0x68, 0x66, 0x6d, 0x96, 0x24, 0x6b, 0xf7, 0x94, 0xcd, 0xf7, 0xfb, 0xb4, 0xdf, 0x7b, 0xde,
-	0x79, 0x6f, 0x50, 0x3f, 0x87, 0xc5, 0xa8, 0x64, 0x9a, 0x15, 0x66, 0x58, 0x6a, 0x65, 0x55, 0x9c,
-	0x18, 0xe0, 0xe1, 0x29, 0x53, 0x62, 0x68, 0x80, 0x67, 0xe7, 0x8c, 0xcb, 0x61, 0x0e, 0x8b, 0xc3,
-	0x87, 0x33, 0x35, 0x53, 0x01, 0x8d, 0xfc, 0x53, 0xe3, 0x27, 0xf5, 0x7d, 0xb4, 0x33, 0x09, 0x2f,
-	0x88, 0x97, 0x28, 0x29, 0x35, 0xcf, 0x20, 0x35, 0x92, 0x95, 0xe6, 0x5c, 0xd9, 0x54, 0x83, 0x05,
-	0x69, 0xb9, 0x92, 0x49, 0xf4, 0x24, 0x7a, 0x76, 0x67, 0xfc, 0x75, 0xe5, 0xf0, 0x46, 0x4f, 0xed,
-	0x30, 0x5e, 0xb2, 0x42, 0x3c, 0x27, 0x9b, 0x1c, 0x84, 0xee, 0x07, 0xf4, 0x72, 0x45, 0xe8, 0x1a,
-	0xc4, 0x16, 0xed, 0x9a, 0x79, 0xae, 0xd2, 0x8c, 0x09, 0x91, 0xce, 0x98, 0x49, 0x83, 0x2f, 0x79,
-	0xe7, 0x49, 0xf4, 0xec, 0xde, 0xf8, 0xf4, 0xb5, 0xc3, 0xbd, 0xbf, 0x1c, 0x7e, 0x3a, 0xe3, 0xf6,
-	0x7c, 0x3e, 0x1d, 0x66, 0xaa, 0x18, 0x65, 0xca, 0x14, 0xca, 0xac, 0xfe, 0x1c, 0x99, 0xfc, 0x62,
-	0x64, 0x97, 0x25, 0x98, 0xe1, 0x09, 0x64, 0x95, 0xc3, 0x5d, 0x2f, 0xa3, 0x7d, 0x2f, 0x1e, 0x33,
-	0x21, 0xce, 0x98, 0x99, 0x78, 0x25, 0x16, 0x68, 0x6f, 0x0a, 0x33, 0x2e, 0xd3, 0xa9, 0x50, 0xd9,
-	0x45, 0xb0, 0x0a, 0x5e, 0x70, 0x9b, 0xbc, 0x1b, 0xba, 0xfd, 0xa2, 0x72, 0xb8, 0xdb, 0x50, 0x3b,
-	0xfc, 0xb8, 0x69, 0xb5, 0x13, 0x13, 0x1a, 0x07, 0x7d, 0xec, 0xe5, 0x33, 0x66, 0x5e, 0x78, 0x31,
-	0xce, 0xd1, 0x2e, 0xc8, 0xfc, 0x56, 0xd6, 0x9d, 0x90, 0xf5, 0x99, 0xaf, 0xba, 0x03, 0xd7, 0x0e,
-	0x1f, 0x36, 0x49, 0x1d, 0x90, 0xd0, 0x3e, 0xc8, 0xfc, 0x7a, 0x8a, 0x40, 0x7b, 0x39, 0xfc, 0xc4,
-	0xe6, 0xc2, 0x36, 0xad, 0x83, 0x4e, 0x95, 0xce, 0x41, 0x27, 0xef, 0xb5, 0x3d, 0x75, 0x1a, 0xda,
-	0x9e, 0x3a, 0x31, 0xa1, 0xf1, 0x4a, 0xf7, 0x3f, 0x1f, 0xe8, 0xef, 0xbc, 0x18, 0x97, 0x68, 0xff,
-	0xa6, 0x3b, 0x63, 0x32, 0x03, 0x91, 0xec, 0x84, 0xb8, 0x2f, 0x2b, 0x87, 0x37, 0x38, 0x6a, 0x87,
-	0x3f, 0xee, 0xce, 0x6b, 0x38, 0xa1, 0xbb, 0xd7, 0x02, 0x8f, 0x83, 0x1a, 0xff, 0x80, 0xfa, 0x05,
-	0x97, 0xa9, 0x06, 0x69, 0xd3, 0x1c, 0x4a, 0x65, 0xb8, 0x4d, 0xde, 0x0f, 0x59, 0xa3, 0xca, 0xe1,
-	0x5b, 0xac, 0x76, 0xf8, 0xa0, 0x49, 0xb9, 0x49, 0x08, 0x7d, 0x50, 0x70, 0x49, 0x41, 0xda, 0x93,
-	0x46, 0x88, 0x7f, 0x8d, 0xd0, 0x63, 0x5f, 0x03, 0x13, 0x42, 0xfd, 0xec, 0xd3, 0x42, 0x35, 0x06,
-	0xac, 0x15, 0x50, 0x80, 0xb4, 0xc9, 0xdd, 0x90, 0x73, 0x56, 0x39, 0xbc, 0xd5, 0x57, 0x3b, 0xfc,
-	0x49, 0x93, 0xb9, 0xcd, 0x45, 0xe8, 0x47, 0x33, 0x66, 0xbe, 0x59, 0xd3, 0x09, 0xe8, 0x97, 0xff,
-	0xb1, 0x98, 0xa3, 0x87, 0xbe, 0xde, 0x52, 0xab, 0x0c, 0x8c, 0x61, 0x53, 0x01, 0xa1, 0xf6, 0xe4,
-	0x5e, 0xa8, 0xe0, 0xf3, 0xca, 0xe1, 0x4e, 0x5e, 0x3b, 0xfc, 0xa8, 0xed, 0xf6, 0x26, 0x25, 0x34,
-	0x2e, 0xb8, 0x9c, 0xb4, 0xaa, 0x6f, 0x3e, 0xfe, 0x25, 0x42, 0x8f, 0xc2, 0x17, 0x4e, 0xa7, 0x4a,
-	0x5d, 0xa4, 0x20, 0xad, 0xe6, 0xd0, 0x7c, 0x08, 0xa1, 0x58, 0x9e, 0xa0, 0x10, 0x79, 0x5a, 0x39,
-	0xbc, 0xcd, 0x56, 0x3b, 0x4c, 0x9a, 0xe4, 0x2d, 0x26, 0x42, 0x0f, 0x02, 0x1d, 0x2b, 0x75, 0x71,
-	0xda, 0xb0, 0x09, 0xe8, 0x17, 0x8a, 0xe5, 0xf1, 0x1c, 0x1d, 0x64, 0x4a, 0x5a, 0xcd, 0x32, 0x9b,
-	0xce, 0xa5, 0x99, 0x9b, 0xd2, 0x9f, 0xf7, 0x4c, 0x19, 0x9b, 0x7c, 0x10, 0x0a, 0xf8, 0xaa, 0x72,
-	0x78, 0x93, 0xa5, 0x76, 0x78, 0xd0, 0x84, 0x6f, 0x30, 0x10, 0xba, 0xb7, 0x26, 0xdf, 0xaf, 0xc1,
-	0xb1, 0x32, 0x61, 0x26, 0x0b, 0xb6, 0x68, 0x4e, 0x78, 0x28, 0xb3, 0xd9, 0x3b, 0xf7, 0xdb, 0x99,
-	0xec, 0xc0, 0xed, 0x4c, 0x76, 0x40, 0x42, 0xfb, 0x05, 0x5b, 0x84, 0xe9, 0x98, 0x80, 0x6e, 0xf6,
-	0x4c, 0x89, 0xf6, 0xbd, 0xb3, 0x64, 0x5c, 0xaf, 0x4e, 0xf8, 0xaa, 0x98, 0xe4, 0xc3, 0x76, 0x4a,
-	0xba, 0x1d, 0xed, 0x94, 0x74, 0x73, 0x42, 0x7d, 0x85, 0x13, 0xaf, 0xfb, 0x19, 0x59, 0xa9, 0xf1,
-	0xef, 0x11, 0xc2, 0x9d, 0x63, 0x9c, 0xe6, 0xcc, 0xb2, 0x74, 0xba, 0xb4, 0x90, 0x3c, 0x08, 0xd9,
-	0xdf, 0x56, 0x0e, 0xbf, 0xcd, 0x5a, 0x3b, 0xfc, 0x74, 0xcb, 0x6a, 0x68, 0x8d, 0x84, 0x1e, 0xde,
-	0x5e, 0x12, 0x27, 0xcc, 0xb2, 0xf1, 0xd2, 0xc2, 0xf3, 0xbb, 0x7f, 0xbc, 0xc2, 0xbd, 0x7f, 0x5e,
-	0xe1, 0x68, 0x7c, 0xf6, 0xfa, 0x72, 0x10, 0xbd, 0xb9, 0x1c, 0x44, 0x7f, 0x5f, 0x0e, 0xa2, 0xdf,
-	0xae, 0x06, 0xbd, 0x37, 0x57, 0x83, 0xde, 0x9f, 0x57, 0x83, 0xde, 0x8f, 0x47, 0xff, 0xdb, 0xf1,
-	0x06, 0xf8, 0xd1, 0xfa, 0x2a, 0x0b, 0xff, 0x84, 0xbb, 0x6c, 0xb4, 0x18, 0xf9, 0x4b, 0x2f, 0xac,
-	0xfb, 0xe9, 0x4e, 0xe0, 0x9f, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xff, 0x27, 0x82, 0x3c, 0x08,
-	0x07, 0x00, 0x00,
+	// 1174 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0xdc, 0xc4,
+	0x17, 0x8f, 0x9b, 0x7e, 0xfb, 0x6d, 0xdd, 0x1f, 0x2c, 0xce, 0x8f, 0x3a, 0x69, 0xbb, 0x13, 0x99,
+	0xaa, 0x0a, 0x42, 0xd9, 0x55, 0x41, 0x08, 0x51, 0x84, 0x50, 0x36, 0x89, 0x22, 0xa4, 0xd2, 0xae,
+	0xa6, 0x45, 0x15, 0x5c, 0xac, 0x59, 0x7b, 0xd8, 0x8c, 0x62, 0xcf, 0x58, 0x33, 0xb3, 0xc9, 0xe6,
+	0xcc, 0x05, 0x89, 0x03, 0x88, 0x13, 0xc7, 0x5e, 0xb8, 0xf0, 0x6f, 0x70, 0xa0, 0xc7, 0x1e, 0x11,
+	0x87, 0x01, 0x25, 0x17, 0xe4, 0xa3, 0xff, 0x01, 0xd0, 0xcc, 0xec, 0xc6, 0xc9, 0xc6, 0xde, 0x14,
+	0x09, 0x38, 0xad, 0xf7, 0x7d, 0x3e, 0x9e, 0xcf, 0x7b, 0x33, 0xef, 0xbd, 0x79, 0x76, 0x1b, 0x31,
+	0x1e, 0xb6, 0x33, 0xc4, 0x51, 0x2a, 0x5a, 0x19, 0x67, 0x92, 0x79, 0xbe, 0xc0, 0xc4, 0x3c, 0x45,
+	0x2c, 0x69, 0x09, 0x4c, 0xa2, 0x1d, 0x44, 0x68, 0x2b, 0xc6, 0xc3, 0xe5, 0x66, 0xc4, 0x44, 0xca,
+	0x44, 0xbb, 0x87, 0x04, 0x6e, 0xef, 0xdd, 0xef, 0x61, 0x89, 0xee, 0xb7, 0x23, 0x46, 0xa8, 0x7d,
+	0x73, 0x79, 0xbe, 0xcf, 0xfa, 0xcc, 0x3c, 0xb6, 0xf5, 0x93, 0xb5, 0x06, 0x7f, 0xce, 0xb9, 0x97,
+	0xba, 0x46, 0xc0, 0x3b, 0x70, 0xfd, 0x8c, 0x93, 0x08, 0x87, 0x82, 0xa2, 0x4c, 0xec, 0x30, 0x19,
+	0x72, 0x2c, 0x31, 0x95, 0x84, 0x51, 0xdf, 0x59, 0x71, 0x56, 0x2f, 0x76, 0x3e, 0xca, 0x15, 0xa8,
+	0xe5, 0x14, 0x0a, 0x80, 0x03, 0x94, 0x26, 0x0f, 0x82, 0x3a, 0x46, 0x00, 0x17, 0x0d, 0xf4, 0x64,
+	0x84, 0xc0, 0x31, 0xe0, 0x49, 0x77, 0x4e, 0x0c, 0x62, 0x16, 0x46, 0x28, 0x49, 0xc2, 0x3e, 0x12,
+	0xa1, 0xe1, 0xf9, 0x17, 0x56, 0x9c, 0xd5, 0x2b, 0x9d, 0xad, 0x17, 0x0a, 0xcc, 0xfc, 0xaa, 0xc0,
+	0xbd, 0x3e, 0x91, 0x3b, 0x83, 0x5e, 0x2b, 0x62, 0x69, 0x7b, 0x14, 0xab, 0xfd, 0x59, 0x13, 0xf1,
+	0x6e, 0x5b, 0x1e, 0x64, 0x58, 0xb4, 0x36, 0x71, 0x94, 0x2b, 0x50, 0xb5, 0x18, 0x6c, 0x68, 0xe3,
+	0x06, 0x4a, 0x92, 0x6d, 0x24, 0xba, 0xda, 0xe2, 0x25, 0xee, 0x42, 0x0f, 0xf7, 0x09, 0x0d, 0x7b,
+	0x09, 0x8b, 0x76, 0x0d, 0x35, 0x21, 0x29, 0x91, 0xfe, 0xac, 0x89, 0xf6, 0xfd, 0x5c, 0x81, 0x6a,
+	0x42, 0xa1, 0xc0, 0x6d, 0x1b, 0x6a, 0x25, 0x1c, 0x40, 0xcf, 0xd8, 0x3b, 0xda, 0xbc, 0x8d, 0xc4,
+	0x43, 0x6d, 0xf4, 0x62, 0x77, 0x0e, 0xd3, 0xf8, 0x8c, 0xd6, 0x45, 0xa3, 0xf5, 0xae, 0xf6, 0xba,
+	0x02, 0x2e, 0x14, 0x58, 0xb6, 0x4a, 0x15, 0x60, 0x00, 0x1b, 0x98, 0xc6, 0xa7, 0x55, 0x12, 0x77,
+	0x21, 0xc6, 0x5f, 0xa0, 0x41, 0x22, 0x6d, 0xe8, 0x98, 0x87, 0x8c, 0xc7, 0x98, 0xfb, 0xff, 0x2b,
+	0x63, 0xaa, 0x24, 0x94, 0x31, 0x55, 0xc2, 0x01, 0xf4, 0x46, 0x76, 0xbd, 0x7d, 0x98, 0x3f, 0xd6,
+	0x46, 0x2f, 0x73, 0x17, 0x27, 0xd9, 0x11, 0xa2, 0x11, 0x4e, 0xfc, 0x4b, 0x46, 0xee, 0x83, 0x5c,
+	0x81, 0x1a, 0x46, 0xa1, 0xc0, 0x9d, 0x6a, 0x3d, 0x8b, 0x07, 0x70, 0xee, 0x94, 0xe0, 0x86, 0xb1,
+	0x7a, 0x9f, 0xb9, 0x8d, 0x94, 0xd0, 0x90, 0x63, 0x2a, 0xc3, 0x18, 0x67, 0x4c, 0x10, 0xe9, 0xff,
+	0xdf, 0x68, 0xb5, 0x73, 0x05, 0xce, 0x60, 0x85, 0x02, 0x37, 0xad, 0xca, 0x24, 0x12, 0xc0, 0x1b,
+	0x29, 0xa1, 0x10, 0x53, 0xb9, 0x69, 0x0d, 0xde, 0x57, 0x8e, 0x7b, 0x5b, 0xfb, 0x80, 0x92, 0x84,
+	0xed, 0x6b, 0x35, 0xe3, 0x8d, 0xc0, 0x52, 0x26, 0x38, 0xc5, 0x54, 0xfa, 0x97, 0x8d, 0xce, 0x76,
+	0xae, 0xc0, 0x54, 0x5e, 0xa1, 0xc0, 0x1b, 0x56, 0x73, 0x1a, 0x2b, 0x80, 0x4b, 0x7d, 0x24, 0xd6,
+	0xc7, 0x68, 0x17, 0xf3, 0x27, 0xc7, 0x98, 0x47, 0xdc, 0x79, 0xed, 0x6f, 0xc6, 0x59, 0x84, 0x85,
+	0x40, 0xbd, 0x04, 0x1b, 0xdf, 0xfd, 0x2b, 0xc6, 0x83, 0xf7, 0x72, 0x05, 0x2a, 0xf1, 0x42, 0x81,
+	0x5b, 0x65, 0xb4, 0x93, 0x68, 0x00, 0xbd, 0x94, 0xd0, 0x6e, 0x69, 0xd5, 0xc1, 0x7b, 0x5f, 0x3a,
+	0xee, 0x2d, 0x73, 0xc2, 0x61, 0x8f, 0xb1, 0xdd, 0x10, 0x53, 0xc9, 0x09, 0xb6, 0x07, 0x91, 0x30,
+	0x14, 0xfb, 0xae, 0x91, 0xdc, 0xca, 0x15, 0x98, 0x46, 0x2b, 0x14, 0x08, 0xac, 0xf2, 0x14, 0x52,
+	0x00, 0x6f, 0x1a, 0xb4, 0xc3, 0xd8, 0xee, 0x96, 0xc5, 0xba, 0x98, 0x3f, 0x64, 0x28, 0xf6, 0x06,
+	0xee, 0xcd, 0x88, 0x51, 0xc9, 0x51, 0x24, 0xc3, 0x01, 0x15, 0x03, 0x91, 0xe9, 0x7c, 0x8f, 0x98,
+	0x90, 0xfe, 0x55, 0xe3, 0xc0, 0x87, 0xb9, 0x02, 0x75, 0x94, 0x42, 0x81, 0xa6, 0x15, 0xaf, 0x21,
+	0x04, 0x70, 0x61, 0x8c, 0x7c, 0x3a, 0x06, 0x36, 0x98, 0x30, 0x35, 0x99, 0xa2, 0xa1, 0xcd, 0x70,
+	0xe3, 0xa6, 0xed, 0x3b, 0xd7, 0xca, 0x9a, 0xac, 0x80, 0xcb, 0x9a, 0xac, 0x00, 0x03, 0xd8, 0x48,
+	0xd1, 0xd0, 0x54, 0x47, 0x17, 0x73, 0xdb, 0x67, 0x32, 0x77, 0x51, 0x33, 0x33, 0x44, 0xf8, 0x28,
+	0xc3, 0x47, 0xce, 0xf8, 0xd7, 0xcb, 0x2a, 0xa9, 0x66, 0x94, 0x55, 0x52, 0x8d, 0x07, 0x50, 0x7b,
+	0xd8, 0xd5, 0x76, 0x5d, 0x23, 0x23, 0xab, 0xf7, 0x9d, 0xe3, 0x82, 0xca, 0x32, 0x0e, 0x63, 0x24,
+	0x51, 0xd8, 0x3b, 0x90, 0xd8, 0xbf, 0x61, 0xb4, 0x3f, 0xc9, 0x15, 0x38, 0x8f, 0x5a, 0x28, 0x70,
+	0x6f, 0x4a, 0x6b, 0x28, 0x89, 0x01, 0x5c, 0x3e, 0xdb, 0x24, 0x36, 0x91, 0x44, 0x9d, 0x03, 0x89,
+	0xbd, 0x1f, 0x1c, 0xf7, 0xae, 0xd9, 0xb1, 0x0c, 0x53, 0xfb, 0xa6, 0x5d, 0x04, 0x45, 0x11, 0x1b,
+	0x50, 0x69, 0x9e, 0x75, 0x84, 0xfe, 0x6b, 0xc6, 0xb3, 0x67, 0xb9, 0x02, 0xaf, 0xc4, 0x2f, 0x14,
+	0x78, 0xeb, 0xc4, 0x79, 0x9c, 0xc3, 0x0e, 0x60, 0x53, 0x1f, 0x50, 0x86, 0xa9, 0xf1, 0x4e, 0xbb,
+	0xb9, 0x6e, 0x29, 0xfa, 0xbc, 0x10, 0xe1, 0xde, 0x37, 0x8e, 0xab, 0x0b, 0x65, 0x14, 0x1c, 0x65,
+	0xfa, 0x86, 0x42, 0x89, 0xdf, 0x30, 0x97, 0x11, 0xfa, 0xdb, 0x97, 0x51, 0xc5, 0x5a, 0x85, 0x02,
+	0x4b, 0x65, 0x9d, 0x9e, 0xc6, 0x74, 0x02, 0x11, 0xeb, 0xdb, 0xa3, 0x91, 0xc9, 0xdb, 0x77, 0xfd,
+	0x32, 0xd5, 0x24, 0x0b, 0x25, 0x47, 0x31, 0x0e, 0x39, 0x92, 0x84, 0xf9, 0xaf, 0x97, 0x37, 0x73,
+	0x1d, 0xa7, 0xbc, 0x99, 0xeb, 0x18, 0x01, 0x9c, 0x1f, 0x67, 0xed, 0x53, 0xf6, 0x54, 0xdb, 0xa1,
+	0x36, 0x9f, 0x68, 0x0e, 0xa7, 0xf9, 0xe1, 0x3e, 0xa1, 0x31, 0xdb, 0xf7, 0xbd, 0xc9, 0xe6, 0x50,
+	0x49, 0x9b, 0x6c, 0x0e, 0x95, 0xa4, 0x71, 0x73, 0x38, 0xa9, 0xff, 0xcc, 0x20, 0xde, 0x4f, 0x8e,
+	0xeb, 0xeb, 0x8d, 0x8a, 0x50, 0x9a, 0x21, 0xd2, 0xd7, 0x7d, 0x7c, 0x1f, 0xf1, 0x38, 0xcc, 0x18,
+	0x4b, 0xfc, 0xb9, 0x95, 0xd9, 0xd5, 0xab, 0x6f, 0x2f, 0xb5, 0xec, 0xee, 0xb7, 0xf4, 0xf4, 0xd3,
+	0x1a, 0x4d, 0x3f, 0xad, 0x0d, 0x46, 0x68, 0x87, 0xe9, 0x13, 0x33, 0xdb, 0x53, 0xb3, 0xc4, 0x89,
+	0xed, 0xa9, 0x61, 0x04, 0x3f, 0xfe, 0x06, 0x56, 0x5f, 0xe1, 0xc0, 0xb5, 0x9e, 0x80, 0x0b, 0x29,
+	0xa1, 0x1b, 0xa3, 0x55, 0xa0, 0x59, 0xa4, 0xcb, 0x58, 0xe2, 0x7d, 0xed, 0xb8, 0x77, 0xf4, 0xfe,
+	0xa3, 0x48, 0x92, 0x3d, 0x7c, 0xac, 0x23, 0xca, 0xbc, 0x9f, 0x37, 0xbb, 0xf9, 0x71, 0xae, 0xc0,
+	0x74, 0x62, 0xa1, 0xc0, 0xdd, 0xf2, 0x3c, 0x6b, 0x69, 0x01, 0x5c, 0x4a, 0xd1, 0x70, 0xdd, 0xc0,
+	0x63, 0x7f, 0xc4, 0x28, 0xc9, 0x1f, 0x5c, 0xfe, 0xfe, 0x39, 0x98, 0xf9, 0xe3, 0x39, 0x70, 0x82,
+	0x9f, 0x67, 0xdd, 0xc5, 0x71, 0xe3, 0xb0, 0x93, 0xe0, 0xe3, 0x3d, 0xcc, 0x39, 0x89, 0xb1, 0xf7,
+	0xc8, 0xbd, 0x7e, 0xdc, 0x51, 0x51, 0x1c, 0x73, 0x33, 0x06, 0x5e, 0xe9, 0xbc, 0x99, 0x2b, 0x70,
+	0x1a, 0x28, 0x14, 0x98, 0x9f, 0xe8, 0xc0, 0xda, 0x1c, 0xc0, 0x6b, 0xe3, 0xff, 0xeb, 0x71, 0xcc,
+	0xeb, 0xda, 0xed, 0x85, 0x7f, 0xb6, 0xdd, 0xd6, 0x8e, 0x40, 0xb3, 0xff, 0xc6, 0x08, 0x74, 0xee,
+	0xd4, 0x70, 0xf1, 0xbf, 0x9a, 0x1a, 0x3a, 0xdb, 0x2f, 0x0e, 0x9b, 0xce, 0xcb, 0xc3, 0xa6, 0xf3,
+	0xfb, 0x61, 0xd3, 0xf9, 0xf6, 0xa8, 0x39, 0xf3, 0xf2, 0xa8, 0x39, 0xf3, 0xcb, 0x51, 0x73, 0xe6,
+	0xf3, 0xb5, 0x13, 0xc9, 0x2b, 0x30, 0x59, 0x1b, 0x7f, 0x41, 0x98, 0x3f, 0xe6, 0x13, 0xa2, 0x3d,
+	0x6c, 0xeb, 0x6f, 0x0d, 0x93, 0xc7, 0xbd, 0x4b, 0x06, 0x7f, 0xe7, 0xaf, 0x01, 0x00, 0x9c, 0x9d,
+	0x3c, 0xee, 0x7f, 0x0c, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -286,6 +429,29 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.DefaultGasPerOrderDataByte != that1.DefaultGasPerOrderDataByte {
 		return false
 	}
+	if this.MaxOpenOrdersPerAccountPerPair != that1.MaxOpenOrdersPerAccountPerPair {
+		return false
+	}
+	if !this.MinOrderNotional.Equal(that1.MinOrderNotional) {
+		return false
+	}
+	if this.MaxOrderToTradeRatio != that1.MaxOrderToTradeRatio {
+		return false
+	}
+	if this.OrderToTradeRatioWindow != that1.OrderToTradeRatioWindow {
+		return false
+	}
+	if len(this.MinCampaignRewardPool) != len(that1.MinCampaignRewardPool) {
+		return false
+	}
+	for i := range this.MinCampaignRewardPool {
+		if !this.MinCampaignRewardPool[i].Equal(&that1.MinCampaignRewardPool[i]) {
+			return false
+		}
+	}
+	if this.MaxActiveCampaignsPerPair != that1.MaxActiveCampaignsPerPair {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -308,6 +474,60 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxActiveCampaignsPerPair != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxActiveCampaignsPerPair))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.MinCampaignRewardPool) > 0 {
+		for iNdEx := len(m.MinCampaignRewardPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinCampaignRewardPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.OrderToTradeRatioWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OrderToTradeRatioWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MaxOrderToTradeRatio != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxOrderToTradeRatio))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	{
+		size := m.MinOrderNotional.Size()
+		i -= size
+		if _, err := m.MinOrderNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.MaxOpenOrdersPerAccountPerPair != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxOpenOrdersPerAccountPerPair))
+		i--
+		dAtA[i] = 0x78
+	}
 	if m.DefaultGasPerOrderDataByte != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.DefaultGasPerOrderDataByte))
 		i--
@@ -386,6 +606,51 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ContractParamsOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractParamsOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractParamsOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasAllowancePerSettlement != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GasAllowancePerSettlement))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DefaultGasPerOrder != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DefaultGasPerOrder))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxOrderPerPrice != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxOrderPerPrice))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -444,6 +709,48 @@ func (m *Params) Size() (n int) {
 	if m.DefaultGasPerOrderDataByte != 0 {
 		n += 1 + sovParams(uint64(m.DefaultGasPerOrderDataByte))
 	}
+	if m.MaxOpenOrdersPerAccountPerPair != 0 {
+		n += 1 + sovParams(uint64(m.MaxOpenOrdersPerAccountPerPair))
+	}
+	l = m.MinOrderNotional.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.MaxOrderToTradeRatio != 0 {
+		n += 2 + sovParams(uint64(m.MaxOrderToTradeRatio))
+	}
+	if m.OrderToTradeRatioWindow != 0 {
+		n += 2 + sovParams(uint64(m.OrderToTradeRatioWindow))
+	}
+	if len(m.MinCampaignRewardPool) > 0 {
+		for _, e := range m.MinCampaignRewardPool {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxActiveCampaignsPerPair != 0 {
+		n += 2 + sovParams(uint64(m.MaxActiveCampaignsPerPair))
+	}
+	return n
+}
+
+func (m *ContractParamsOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MaxOrderPerPrice != 0 {
+		n += 1 + sovParams(uint64(m.MaxOrderPerPrice))
+	}
+	if m.DefaultGasPerOrder != 0 {
+		n += 1 + sovParams(uint64(m.DefaultGasPerOrder))
+	}
+	if m.GasAllowancePerSettlement != 0 {
+		n += 1 + sovParams(uint64(m.GasAllowancePerSettlement))
+	}
 	return n
 }
 
@@ -763,6 +1070,289 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpenOrdersPerAccountPerPair", wireType)
+			}
+			m.MaxOpenOrdersPerAccountPerPair = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOpenOrdersPerAccountPerPair |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOrderNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinOrderNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrderToTradeRatio", wireType)
+			}
+			m.MaxOrderToTradeRatio = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOrderToTradeRatio |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderToTradeRatioWindow", wireType)
+			}
+			m.OrderToTradeRatioWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderToTradeRatioWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCampaignRewardPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinCampaignRewardPool = append(m.MinCampaignRewardPool, types.Coin{})
+			if err := m.MinCampaignRewardPool[len(m.MinCampaignRewardPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActiveCampaignsPerPair", wireType)
+			}
+			m.MaxActiveCampaignsPerPair = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActiveCampaignsPerPair |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractParamsOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractParamsOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractParamsOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrderPerPrice", wireType)
+			}
+			m.MaxOrderPerPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOrderPerPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultGasPerOrder", wireType)
+			}
+			m.DefaultGasPerOrder = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultGasPerOrder |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasAllowancePerSettlement", wireType)
+			}
+			m.GasAllowancePerSettlement = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasAllowancePerSettlement |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -14,4 +14,39 @@ func TestParamsValidate(t *testing.T) {
 
 	p = types.Params{SudoCallGasPrice: sdk.ZeroDec()}
 	require.Error(t, p.Validate())
+
+	require.NoError(t, types.DefaultParams().Validate())
+
+	p = types.DefaultParams()
+	p.MaxOrderPerPrice = 0
+	require.Error(t, p.Validate())
+
+	p = types.DefaultParams()
+	p.OrderBookEntriesPerLoad = 0
+	require.Error(t, p.Validate())
+
+	p = types.DefaultParams()
+	p.MinCampaignRewardPool = sdk.Coins{sdk.Coin{Denom: "usei", Amount: sdk.NewInt(-1)}}
+	require.Error(t, p.Validate())
+
+	// no min campaign reward pool
+	p = types.DefaultParams()
+	p.MinCampaignRewardPool = sdk.NewCoins()
+	require.NoError(t, p.Validate())
+}
+
+func TestParamsApplyOverride(t *testing.T) {
+	p := types.DefaultParams()
+	require.Equal(t, p, p.ApplyOverride(nil))
+
+	override := types.ContractParamsOverride{
+		ContractAddr:     "sei1ghd753shjuwexxywmgs4xz7x2q732vcnkm6h2pyv9s6ah3hylvrqladqwc",
+		MaxOrderPerPrice: 5,
+	}
+	effective := p.ApplyOverride(&override)
+	require.Equal(t, uint64(5), effective.MaxOrderPerPrice)
+	require.Equal(t, p.DefaultGasPerOrder, effective.DefaultGasPerOrder)
+	require.Equal(t, p.GasAllowancePerSettlement, effective.GasAllowancePerSettlement)
+	// the original params are left untouched
+	require.Equal(t, uint64(types.DefaultMaxOrderPerPrice), p.MaxOrderPerPrice)
 }
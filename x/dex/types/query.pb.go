@@ -2073,6 +2073,358 @@ func (m *QueryGetOrderCountResponse) GetCount() uint64 {
 	return 0
 }
 
+type QueryEffectiveParamsRequest struct {
+	ContractAddr string `protobuf:"bytes,1,opt,name=contractAddr,proto3" json:"contract_address"`
+}
+
+func (m *QueryEffectiveParamsRequest) Reset()         { *m = QueryEffectiveParamsRequest{} }
+func (m *QueryEffectiveParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveParamsRequest) ProtoMessage()    {}
+func (*QueryEffectiveParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8e98105e6e08a59, []int{40}
+}
+func (m *QueryEffectiveParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEffectiveParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveParamsRequest.Merge(m, src)
+}
+func (m *QueryEffectiveParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEffectiveParamsRequest proto.InternalMessageInfo
+
+func (m *QueryEffectiveParamsRequest) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
+type QueryEffectiveParamsResponse struct {
+	Params   Params                  `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Override *ContractParamsOverride `protobuf:"bytes,2,opt,name=override,proto3" json:"override"`
+}
+
+func (m *QueryEffectiveParamsResponse) Reset()         { *m = QueryEffectiveParamsResponse{} }
+func (m *QueryEffectiveParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveParamsResponse) ProtoMessage()    {}
+func (*QueryEffectiveParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8e98105e6e08a59, []int{41}
+}
+func (m *QueryEffectiveParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEffectiveParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveParamsResponse.Merge(m, src)
+}
+func (m *QueryEffectiveParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEffectiveParamsResponse proto.InternalMessageInfo
+
+func (m *QueryEffectiveParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *QueryEffectiveParamsResponse) GetOverride() *ContractParamsOverride {
+	if m != nil {
+		return m.Override
+	}
+	return nil
+}
+
+type QueryIncentiveCampaignRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+}
+
+func (m *QueryIncentiveCampaignRequest) Reset()         { *m = QueryIncentiveCampaignRequest{} }
+func (m *QueryIncentiveCampaignRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIncentiveCampaignRequest) ProtoMessage()    {}
+func (*QueryIncentiveCampaignRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8e98105e6e08a59, []int{42}
+}
+func (m *QueryIncentiveCampaignRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncentiveCampaignRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncentiveCampaignRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIncentiveCampaignRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncentiveCampaignRequest.Merge(m, src)
+}
+func (m *QueryIncentiveCampaignRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncentiveCampaignRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncentiveCampaignRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIncentiveCampaignRequest proto.InternalMessageInfo
+
+func (m *QueryIncentiveCampaignRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type QueryIncentiveCampaignResponse struct {
+	Campaign IncentiveCampaign `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign"`
+}
+
+func (m *QueryIncentiveCampaignResponse) Reset()         { *m = QueryIncentiveCampaignResponse{} }
+func (m *QueryIncentiveCampaignResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIncentiveCampaignResponse) ProtoMessage()    {}
+func (*QueryIncentiveCampaignResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8e98105e6e08a59, []int{43}
+}
+func (m *QueryIncentiveCampaignResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncentiveCampaignResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncentiveCampaignResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIncentiveCampaignResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncentiveCampaignResponse.Merge(m, src)
+}
+func (m *QueryIncentiveCampaignResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncentiveCampaignResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncentiveCampaignResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIncentiveCampaignResponse proto.InternalMessageInfo
+
+func (m *QueryIncentiveCampaignResponse) GetCampaign() IncentiveCampaign {
+	if m != nil {
+		return m.Campaign
+	}
+	return IncentiveCampaign{}
+}
+
+type QueryIncentiveCampaignsRequest struct {
+}
+
+func (m *QueryIncentiveCampaignsRequest) Reset()         { *m = QueryIncentiveCampaignsRequest{} }
+func (m *QueryIncentiveCampaignsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIncentiveCampaignsRequest) ProtoMessage()    {}
+func (*QueryIncentiveCampaignsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8e98105e6e08a59, []int{44}
+}
+func (m *QueryIncentiveCampaignsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncentiveCampaignsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncentiveCampaignsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIncentiveCampaignsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncentiveCampaignsRequest.Merge(m, src)
+}
+func (m *QueryIncentiveCampaignsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncentiveCampaignsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncentiveCampaignsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIncentiveCampaignsRequest proto.InternalMessageInfo
+
+type QueryIncentiveCampaignsResponse struct {
+	Campaigns []IncentiveCampaign `protobuf:"bytes,1,rep,name=campaigns,proto3" json:"campaigns"`
+}
+
+func (m *QueryIncentiveCampaignsResponse) Reset()         { *m = QueryIncentiveCampaignsResponse{} }
+func (m *QueryIncentiveCampaignsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIncentiveCampaignsResponse) ProtoMessage()    {}
+func (*QueryIncentiveCampaignsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8e98105e6e08a59, []int{45}
+}
+func (m *QueryIncentiveCampaignsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncentiveCampaignsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncentiveCampaignsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIncentiveCampaignsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncentiveCampaignsResponse.Merge(m, src)
+}
+func (m *QueryIncentiveCampaignsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncentiveCampaignsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncentiveCampaignsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIncentiveCampaignsResponse proto.InternalMessageInfo
+
+func (m *QueryIncentiveCampaignsResponse) GetCampaigns() []IncentiveCampaign {
+	if m != nil {
+		return m.Campaigns
+	}
+	return nil
+}
+
+type QueryIncentiveScoresRequest struct {
+	CampaignId uint64 `protobuf:"varint,1,opt,name=campaignId,proto3" json:"campaign_id"`
+}
+
+func (m *QueryIncentiveScoresRequest) Reset()         { *m = QueryIncentiveScoresRequest{} }
+func (m *QueryIncentiveScoresRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIncentiveScoresRequest) ProtoMessage()    {}
+func (*QueryIncentiveScoresRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8e98105e6e08a59, []int{46}
+}
+func (m *QueryIncentiveScoresRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncentiveScoresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncentiveScoresRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIncentiveScoresRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncentiveScoresRequest.Merge(m, src)
+}
+func (m *QueryIncentiveScoresRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncentiveScoresRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncentiveScoresRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIncentiveScoresRequest proto.InternalMessageInfo
+
+func (m *QueryIncentiveScoresRequest) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
+	}
+	return 0
+}
+
+type QueryIncentiveScoresResponse struct {
+	Scores []IncentiveScore `protobuf:"bytes,1,rep,name=scores,proto3" json:"scores"`
+}
+
+func (m *QueryIncentiveScoresResponse) Reset()         { *m = QueryIncentiveScoresResponse{} }
+func (m *QueryIncentiveScoresResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIncentiveScoresResponse) ProtoMessage()    {}
+func (*QueryIncentiveScoresResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8e98105e6e08a59, []int{47}
+}
+func (m *QueryIncentiveScoresResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncentiveScoresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncentiveScoresResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIncentiveScoresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncentiveScoresResponse.Merge(m, src)
+}
+func (m *QueryIncentiveScoresResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncentiveScoresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncentiveScoresResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIncentiveScoresResponse proto.InternalMessageInfo
+
+func (m *QueryIncentiveScoresResponse) GetScores() []IncentiveScore {
+	if m != nil {
+		return m.Scores
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "seiprotocol.seichain.dex.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "seiprotocol.seichain.dex.QueryParamsResponse")
@@ -2114,155 +2466,182 @@ func init() {
 	proto.RegisterType((*QueryGetMatchResultResponse)(nil), "seiprotocol.seichain.dex.QueryGetMatchResultResponse")
 	proto.RegisterType((*QueryGetOrderCountRequest)(nil), "seiprotocol.seichain.dex.QueryGetOrderCountRequest")
 	proto.RegisterType((*QueryGetOrderCountResponse)(nil), "seiprotocol.seichain.dex.QueryGetOrderCountResponse")
+	proto.RegisterType((*QueryEffectiveParamsRequest)(nil), "seiprotocol.seichain.dex.QueryEffectiveParamsRequest")
+	proto.RegisterType((*QueryEffectiveParamsResponse)(nil), "seiprotocol.seichain.dex.QueryEffectiveParamsResponse")
+	proto.RegisterType((*QueryIncentiveCampaignRequest)(nil), "seiprotocol.seichain.dex.QueryIncentiveCampaignRequest")
+	proto.RegisterType((*QueryIncentiveCampaignResponse)(nil), "seiprotocol.seichain.dex.QueryIncentiveCampaignResponse")
+	proto.RegisterType((*QueryIncentiveCampaignsRequest)(nil), "seiprotocol.seichain.dex.QueryIncentiveCampaignsRequest")
+	proto.RegisterType((*QueryIncentiveCampaignsResponse)(nil), "seiprotocol.seichain.dex.QueryIncentiveCampaignsResponse")
+	proto.RegisterType((*QueryIncentiveScoresRequest)(nil), "seiprotocol.seichain.dex.QueryIncentiveScoresRequest")
+	proto.RegisterType((*QueryIncentiveScoresResponse)(nil), "seiprotocol.seichain.dex.QueryIncentiveScoresResponse")
 }
 
 func init() { proto.RegisterFile("dex/query.proto", fileDescriptor_d8e98105e6e08a59) }
 
 var fileDescriptor_d8e98105e6e08a59 = []byte{
-	// 2281 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcb, 0x6f, 0x1c, 0x49,
-	0x1d, 0x76, 0x8f, 0x63, 0x63, 0x97, 0xf3, 0x2c, 0xdb, 0x89, 0x19, 0xc2, 0x4c, 0xa8, 0x55, 0x36,
-	0xcb, 0x2e, 0x9e, 0x26, 0x4e, 0xb2, 0x79, 0x48, 0x9b, 0x6c, 0xc6, 0xce, 0x1a, 0x8b, 0x38, 0x71,
-	0xda, 0x89, 0x37, 0x84, 0x0d, 0xbd, 0xe5, 0xe9, 0xf2, 0x4c, 0xe3, 0x9e, 0xae, 0x49, 0x77, 0xcf,
-	0xc6, 0x96, 0x19, 0xf1, 0x12, 0x17, 0xb8, 0x44, 0x5a, 0x0e, 0xec, 0x81, 0x3f, 0x00, 0x09, 0x0e,
-	0x5c, 0xd0, 0x8a, 0x3b, 0xab, 0x95, 0x40, 0x4b, 0xa4, 0x05, 0x09, 0x81, 0x34, 0x42, 0x09, 0xa7,
-	0xb9, 0x23, 0xc4, 0x6d, 0xd5, 0x55, 0xd5, 0xef, 0x19, 0x77, 0x77, 0x1c, 0xad, 0xb2, 0xa7, 0xf6,
-	0x54, 0xd7, 0xf7, 0xab, 0xdf, 0xf7, 0xd5, 0xaf, 0x1e, 0xfd, 0x19, 0x1c, 0xd2, 0xc8, 0x96, 0xfc,
-	0xa0, 0x4d, 0xac, 0xed, 0x4a, 0xcb, 0xa2, 0x0e, 0x85, 0x33, 0x36, 0xd1, 0xd9, 0x5f, 0x35, 0x6a,
-	0x54, 0x6c, 0xa2, 0xd7, 0x1a, 0x58, 0x37, 0x2b, 0x1a, 0xd9, 0x2a, 0x4e, 0xd5, 0x69, 0x9d, 0xb2,
-	0x57, 0xb2, 0xfb, 0x17, 0xef, 0x5f, 0x3c, 0x5e, 0xa7, 0xb4, 0x6e, 0x10, 0x19, 0xb7, 0x74, 0x19,
-	0x9b, 0x26, 0x75, 0xb0, 0xa3, 0x53, 0xd3, 0x16, 0x6f, 0x5f, 0xad, 0x51, 0xbb, 0x49, 0x6d, 0x79,
-	0x1d, 0xdb, 0x84, 0x0f, 0x23, 0xbf, 0x77, 0x7a, 0x9d, 0x38, 0xf8, 0xb4, 0xdc, 0xc2, 0x75, 0xdd,
-	0x64, 0x9d, 0x45, 0xdf, 0xc3, 0x6e, 0x2a, 0x2d, 0x6c, 0xe1, 0xa6, 0x87, 0x9e, 0x74, 0x5b, 0x0c,
-	0x6a, 0xd6, 0xd5, 0x75, 0x4a, 0x37, 0x45, 0xe3, 0x94, 0xdb, 0x68, 0x37, 0xa8, 0xe5, 0x84, 0x5b,
-	0x19, 0x8f, 0x96, 0xa5, 0xd7, 0x88, 0x68, 0x80, 0x6e, 0x43, 0x8d, 0x9a, 0x8e, 0x85, 0x6b, 0x8e,
-	0x68, 0x3b, 0xe8, 0xb6, 0x39, 0x0f, 0x71, 0x2b, 0x1c, 0x0a, 0xdb, 0x36, 0x71, 0x54, 0x43, 0xb7,
-	0x23, 0xbd, 0x5a, 0x58, 0xb7, 0xc2, 0xa1, 0xa9, 0xa5, 0x11, 0xaf, 0xe1, 0xa8, 0xdb, 0xd0, 0xc4,
-	0x4e, 0xad, 0xa1, 0x5a, 0xc4, 0x6e, 0x1b, 0x4e, 0xb8, 0x23, 0x31, 0xdb, 0x5e, 0xfe, 0x68, 0x0a,
-	0xc0, 0x5b, 0x2e, 0xe7, 0x15, 0x46, 0x4a, 0x21, 0x0f, 0xda, 0xc4, 0x76, 0xd0, 0x1d, 0x30, 0x19,
-	0x69, 0xb5, 0x5b, 0xd4, 0xb4, 0x09, 0xbc, 0x0c, 0x46, 0x39, 0xf9, 0x19, 0xe9, 0x84, 0xf4, 0xca,
-	0xc4, 0xdc, 0x89, 0xca, 0xa0, 0x99, 0xa8, 0x70, 0x64, 0x75, 0xdf, 0xc7, 0xdd, 0xf2, 0x90, 0x22,
-	0x50, 0xe8, 0x7d, 0x09, 0x1c, 0x63, 0x71, 0x17, 0x89, 0x73, 0x9d, 0x9a, 0xf5, 0x2a, 0xa5, 0x9b,
-	0x62, 0x48, 0x38, 0x05, 0x46, 0x98, 0x36, 0x2c, 0xf4, 0xb8, 0xc2, 0x7f, 0x40, 0x04, 0xf6, 0x7b,
-	0x02, 0x5d, 0xd5, 0x34, 0x6b, 0xa6, 0xc0, 0x5e, 0x46, 0xda, 0x60, 0x09, 0x00, 0xd6, 0x79, 0x81,
-	0x98, 0xb4, 0x39, 0x33, 0xcc, 0x7a, 0x84, 0x5a, 0xdc, 0xf7, 0x4c, 0x40, 0xfe, 0x7e, 0x1f, 0x7f,
-	0x1f, 0xb4, 0xa0, 0x77, 0xc1, 0x4c, 0x32, 0x29, 0xc1, 0x78, 0x01, 0x8c, 0x79, 0x6d, 0x82, 0x33,
-	0x1a, 0xcc, 0xd9, 0xeb, 0x29, 0x58, 0xfb, 0x48, 0xf4, 0x27, 0x8f, 0xf7, 0x55, 0xc3, 0x88, 0xf3,
-	0x7e, 0x0b, 0x80, 0xa0, 0xcc, 0xc4, 0x18, 0x2f, 0x57, 0x78, 0x4d, 0x56, 0xdc, 0x9a, 0xac, 0xf0,
-	0xd2, 0x17, 0x35, 0x59, 0x59, 0xc1, 0x75, 0x22, 0xb0, 0x4a, 0x08, 0xf9, 0xb9, 0x28, 0xf5, 0x1b,
-	0x49, 0x48, 0x15, 0xe1, 0xd1, 0x57, 0xaa, 0xe1, 0x67, 0x93, 0x0a, 0x2e, 0x46, 0xe4, 0x28, 0x30,
-	0x39, 0x4e, 0xa5, 0xca, 0xc1, 0x53, 0x08, 0xeb, 0x81, 0x7e, 0x29, 0x05, 0xd3, 0xba, 0xea, 0x2e,
-	0xc5, 0x17, 0xa3, 0xd8, 0x34, 0xf0, 0xe5, 0x3e, 0x59, 0x09, 0x09, 0x17, 0xc1, 0xb8, 0xdf, 0x28,
-	0x4a, 0xe1, 0xa5, 0xc1, 0x1a, 0xfa, 0x5d, 0x85, 0x88, 0x01, 0x16, 0x7d, 0x14, 0x9a, 0xa8, 0x04,
-	0xf9, 0x2f, 0x52, 0xc5, 0xfd, 0x4e, 0x12, 0x7a, 0x45, 0x89, 0xf4, 0xd7, 0x6b, 0xf8, 0x59, 0xf5,
-	0x7a, 0x7e, 0x55, 0xb7, 0x03, 0xa6, 0xbd, 0xe9, 0x5d, 0x71, 0x59, 0x7a, 0x3b, 0x6a, 0x4c, 0x08,
-	0x29, 0x45, 0x88, 0x42, 0x5c, 0x88, 0x84, 0xd8, 0xc3, 0x49, 0xb1, 0xd1, 0x2d, 0x70, 0x34, 0x3e,
-	0xb8, 0x10, 0xea, 0x3c, 0x18, 0x65, 0x63, 0xd9, 0x42, 0xa5, 0xf2, 0x2e, 0x1b, 0xb7, 0xdb, 0x4f,
-	0x11, 0xdd, 0xd1, 0xaf, 0x24, 0x30, 0x15, 0x89, 0xf9, 0x39, 0xf2, 0x81, 0xc7, 0xc1, 0xb8, 0xa3,
-	0x37, 0x89, 0xed, 0xe0, 0x66, 0x8b, 0xd5, 0xc6, 0x3e, 0x25, 0x68, 0x40, 0x5a, 0x4c, 0x6a, 0x9f,
-	0xec, 0xb9, 0xf0, 0xe2, 0xce, 0xc0, 0x55, 0xac, 0xfe, 0x29, 0x30, 0xb2, 0x41, 0xdb, 0xa6, 0xc6,
-	0x92, 0x1d, 0x53, 0xf8, 0x0f, 0xf4, 0xa1, 0x04, 0x8a, 0xfe, 0xe9, 0x80, 0x1d, 0x62, 0x47, 0x65,
-	0x90, 0x93, 0x32, 0x54, 0x0f, 0xf5, 0xba, 0xe5, 0x09, 0xd6, 0xaa, 0x6a, 0x6e, 0x73, 0x44, 0x17,
-	0x39, 0xa9, 0x0b, 0x07, 0xf0, 0x33, 0x5e, 0x00, 0x42, 0x42, 0x5d, 0xe8, 0x27, 0x54, 0x75, 0xaa,
-	0xd7, 0x2d, 0x1f, 0xf6, 0xda, 0x55, 0xac, 0x69, 0x16, 0xb1, 0xed, 0x58, 0x39, 0xdc, 0x06, 0x5f,
-	0xe9, 0x9b, 0xf9, 0x9e, 0x64, 0x42, 0x8f, 0x42, 0x15, 0x71, 0xfb, 0x21, 0x6e, 0xf9, 0x15, 0x1e,
-	0x4f, 0x54, 0xca, 0x9a, 0x28, 0xbc, 0x0c, 0x0e, 0x19, 0x94, 0x6e, 0xae, 0xe3, 0xda, 0xe6, 0x2a,
-	0xa9, 0x51, 0x53, 0xb3, 0x99, 0x30, 0xfb, 0x38, 0xd8, 0x7b, 0xa5, 0xda, 0xfc, 0x9d, 0x12, 0xef,
-	0x8c, 0xee, 0x06, 0x95, 0x20, 0x32, 0x12, 0x14, 0xaf, 0x80, 0x11, 0xf7, 0x2a, 0xe5, 0x55, 0x7d,
-	0x69, 0x30, 0x45, 0x17, 0x57, 0x1d, 0xef, 0x75, 0xcb, 0x1c, 0xa0, 0xf0, 0x07, 0x3a, 0x26, 0x22,
-	0x5f, 0x75, 0xe7, 0xe3, 0xba, 0x6e, 0x3b, 0xde, 0x05, 0x89, 0x88, 0xa5, 0x16, 0x7a, 0x21, 0xc6,
-	0xfc, 0x36, 0x18, 0xc7, 0x5e, 0xa3, 0x18, 0xf7, 0xd4, 0xe0, 0x71, 0x19, 0x7e, 0x99, 0x38, 0x58,
-	0xc3, 0x0e, 0xf6, 0xf6, 0x25, 0x1f, 0x8f, 0x4e, 0x7b, 0xbb, 0x5f, 0xb8, 0x5b, 0xe8, 0x10, 0xd3,
-	0x42, 0xab, 0x8f, 0xff, 0x40, 0x58, 0xd4, 0x6b, 0x0c, 0x22, 0xb2, 0x9b, 0x07, 0x63, 0x4d, 0xd1,
-	0x26, 0xe6, 0x3d, 0x6b, 0x72, 0x8a, 0x0f, 0x44, 0x6f, 0x8b, 0xc2, 0x52, 0x48, 0x5d, 0xb7, 0x1d,
-	0x62, 0x11, 0x6d, 0x05, 0xeb, 0xd6, 0xde, 0x0b, 0x01, 0xdd, 0x03, 0xc7, 0xfb, 0x07, 0x16, 0xd9,
-	0x5f, 0x02, 0x23, 0xee, 0xa5, 0x37, 0xc3, 0x7c, 0xba, 0x38, 0x21, 0x27, 0x87, 0xa0, 0x7b, 0xa0,
-	0x14, 0x8b, 0x3d, 0x2f, 0x86, 0xde, 0x7b, 0xde, 0x2d, 0x50, 0x1e, 0x18, 0x5b, 0xa4, 0xbe, 0x0c,
-	0x0e, 0xf8, 0x41, 0x74, 0x73, 0x83, 0x0a, 0xf5, 0x5f, 0x19, 0x4c, 0xc1, 0x0b, 0xb1, 0x64, 0x6e,
-	0xd0, 0xb5, 0xb9, 0x60, 0x44, 0xf7, 0x37, 0xda, 0x0a, 0x4a, 0xfe, 0xa6, 0x7b, 0xed, 0x7f, 0x0e,
-	0xab, 0xf0, 0x24, 0xf8, 0x12, 0xae, 0xd5, 0x68, 0xdb, 0x74, 0xc4, 0xb6, 0x34, 0xd1, 0xeb, 0x96,
-	0xbd, 0x26, 0xc5, 0xfb, 0x03, 0xdd, 0x0f, 0x0e, 0x19, 0x6f, 0x64, 0xbf, 0xb6, 0x46, 0xd9, 0x27,
-	0x48, 0x86, 0x43, 0x86, 0x21, 0xab, 0xa0, 0xd7, 0x2d, 0x0b, 0x88, 0x22, 0x9e, 0xe8, 0x93, 0xd0,
-	0xb5, 0x8d, 0xf7, 0xda, 0x5e, 0x5a, 0xd8, 0x3b, 0xb9, 0xe8, 0x3e, 0x5d, 0xc8, 0xbb, 0x4f, 0x0f,
-	0xa7, 0xef, 0xd3, 0x47, 0x41, 0x41, 0xd7, 0xf8, 0x29, 0x55, 0x1d, 0xed, 0x75, 0xcb, 0x05, 0x5d,
-	0x53, 0x0a, 0xba, 0x86, 0xee, 0x07, 0x17, 0xbe, 0x10, 0x1f, 0x21, 0xd9, 0x9b, 0x60, 0x84, 0xf1,
-	0x4e, 0xdf, 0x83, 0x39, 0x96, 0xed, 0x50, 0x0c, 0xa1, 0xf0, 0x07, 0xfa, 0x4b, 0x41, 0xd4, 0xde,
-	0x22, 0x71, 0xbe, 0xa5, 0xdb, 0x0e, 0xb5, 0xf4, 0x1a, 0x36, 0xa2, 0x77, 0x8f, 0x17, 0x59, 0x36,
-	0x05, 0x4c, 0xb7, 0x88, 0xa5, 0x53, 0xed, 0x3a, 0x31, 0xeb, 0x4e, 0x63, 0xc9, 0xf4, 0x4e, 0x00,
-	0xae, 0xe4, 0xf1, 0x5e, 0xb7, 0x3c, 0xc3, 0x3b, 0xa8, 0x06, 0xeb, 0xa1, 0xea, 0xa6, 0x7f, 0x12,
-	0xf4, 0x87, 0xc2, 0x8b, 0x60, 0xbf, 0xd9, 0x6e, 0xde, 0xdc, 0x58, 0x61, 0x6f, 0xed, 0x99, 0x11,
-	0x16, 0x6a, 0xba, 0xd7, 0x2d, 0x1f, 0x31, 0xdb, 0xcd, 0x75, 0x62, 0xa9, 0x74, 0x43, 0xe5, 0x50,
-	0x5b, 0x89, 0x74, 0x45, 0x16, 0x38, 0x31, 0x58, 0x4d, 0x31, 0x69, 0x37, 0x62, 0x97, 0xa9, 0x57,
-	0x53, 0x4e, 0xce, 0x79, 0x6c, 0x6a, 0x06, 0xb1, 0x1d, 0xbd, 0xb6, 0xc9, 0x4b, 0x9e, 0xa3, 0xfd,
-	0x3b, 0xd6, 0x8f, 0x0b, 0x62, 0xdb, 0x5b, 0x24, 0xce, 0x32, 0xb6, 0x36, 0x89, 0xb3, 0xda, 0x6e,
-	0x36, 0xb1, 0xbb, 0x9d, 0xbc, 0xf8, 0xf3, 0x77, 0x0d, 0x1c, 0xf1, 0x8e, 0xe3, 0xf8, 0xdc, 0x1d,
-	0xeb, 0x75, 0xcb, 0x93, 0xfe, 0xe9, 0x1d, 0x9a, 0xb6, 0x24, 0x02, 0xfd, 0x7f, 0x18, 0x7c, 0x75,
-	0x80, 0x06, 0x42, 0xf5, 0x77, 0xc0, 0x84, 0x43, 0x1d, 0x6c, 0xac, 0x51, 0xa3, 0xdd, 0x14, 0x1f,
-	0x6e, 0xd5, 0x4b, 0xff, 0xec, 0x96, 0x5f, 0xae, 0xeb, 0x4e, 0xa3, 0xbd, 0x5e, 0xa9, 0xd1, 0xa6,
-	0x2c, 0xac, 0x1c, 0xfe, 0x98, 0xb5, 0xb5, 0x4d, 0xd9, 0xd9, 0x6e, 0x11, 0xbb, 0xb2, 0x40, 0x6a,
-	0xbd, 0x6e, 0x79, 0x3f, 0x0b, 0xa0, 0xbe, 0xc7, 0x22, 0x28, 0xe1, 0x70, 0xb0, 0x0d, 0x26, 0x43,
-	0x3f, 0x6f, 0x50, 0xf7, 0x32, 0x8f, 0x0d, 0xa1, 0xd8, 0x7c, 0xae, 0x51, 0xa6, 0xc3, 0xa3, 0xa8,
-	0xa6, 0x08, 0xa5, 0xf4, 0x8b, 0x0f, 0xd7, 0xc0, 0x78, 0x43, 0xaf, 0x37, 0x58, 0x99, 0x08, 0xb5,
-	0x2f, 0xe4, 0x1a, 0x0c, 0xb8, 0x70, 0x95, 0x4d, 0xa0, 0x12, 0x84, 0x82, 0xab, 0x60, 0xcc, 0xa0,
-	0x0f, 0x79, 0x58, 0xf6, 0x51, 0x55, 0x3d, 0x9f, 0x2b, 0xec, 0xb8, 0x41, 0x1f, 0x8a, 0xa8, 0x7e,
-	0x20, 0x37, 0x59, 0x03, 0x8b, 0x5b, 0x24, 0x5b, 0x53, 0xb9, 0x93, 0x75, 0xe1, 0x5e, 0xb2, 0x7e,
-	0x28, 0xf4, 0x81, 0x24, 0xee, 0x13, 0x6c, 0x8f, 0x5b, 0xd5, 0x9b, 0x6d, 0x83, 0x7d, 0x4c, 0x79,
-	0xe5, 0xbf, 0xe7, 0x4d, 0x32, 0xb1, 0x80, 0x0a, 0x99, 0x4f, 0xf6, 0x5f, 0x48, 0x62, 0x6d, 0x26,
-	0x72, 0x13, 0x65, 0xb9, 0x09, 0x0e, 0x5f, 0xdb, 0x22, 0xb5, 0xb6, 0x43, 0xb4, 0x5b, 0x6d, 0x6c,
-	0x3a, 0xba, 0xb3, 0x2d, 0x6a, 0xf3, 0x4a, 0x2e, 0x6d, 0x8e, 0x10, 0x11, 0x45, 0x7d, 0x20, 0xc2,
-	0x28, 0x89, 0xc0, 0x68, 0x2d, 0xf8, 0x16, 0x59, 0xc6, 0x4e, 0xad, 0xa1, 0x30, 0x6b, 0x6f, 0xef,
-	0xf7, 0x97, 0x46, 0xf0, 0xa5, 0x10, 0x89, 0x2b, 0x38, 0x2e, 0x81, 0x51, 0x6e, 0x22, 0x8a, 0x19,
-	0x38, 0x39, 0x78, 0x06, 0x42, 0x70, 0xbe, 0xd7, 0x71, 0xa0, 0x22, 0x9e, 0xe8, 0xbf, 0x85, 0xd8,
-	0x71, 0x38, 0xcf, 0x6e, 0x17, 0x5f, 0x80, 0x8d, 0x6e, 0xc9, 0xfb, 0x5c, 0xe2, 0xeb, 0xe9, 0x4c,
-	0xae, 0xd9, 0xe5, 0x50, 0xef, 0x4b, 0xf3, 0x01, 0x38, 0xd2, 0xa2, 0xb6, 0xee, 0xd6, 0xd1, 0x82,
-	0x6e, 0x91, 0x1a, 0x33, 0x1d, 0xdc, 0x05, 0x75, 0x70, 0xee, 0xb5, 0x5d, 0xce, 0x92, 0x38, 0xa4,
-	0x7a, 0xb4, 0xd7, 0x2d, 0x43, 0x2f, 0x92, 0xaa, 0x79, 0xed, 0x4a, 0x32, 0x3a, 0x7a, 0x23, 0xa8,
-	0x9c, 0xb0, 0xec, 0x62, 0x82, 0xcb, 0x60, 0x84, 0x5f, 0xfc, 0x24, 0xb6, 0x71, 0xb3, 0x05, 0xc4,
-	0xaf, 0x7d, 0xfc, 0x31, 0xf7, 0xdb, 0x12, 0x18, 0x61, 0x78, 0xf8, 0x48, 0x02, 0xa3, 0xdc, 0xdb,
-	0x85, 0xdf, 0x18, 0x9c, 0x6b, 0xd2, 0x52, 0x2e, 0xce, 0x66, 0xec, 0xcd, 0x53, 0x42, 0x5f, 0xff,
-	0xc9, 0xa7, 0xff, 0x79, 0xbf, 0xf0, 0x12, 0xfc, 0x9a, 0x6c, 0x13, 0x7d, 0xd6, 0xc3, 0xc9, 0x1e,
-	0x4e, 0x0e, 0x8c, 0x78, 0xf8, 0x58, 0x0a, 0x9c, 0x47, 0x78, 0x3a, 0x65, 0x98, 0xa4, 0xf3, 0x5c,
-	0x9c, 0xcb, 0x03, 0x11, 0xe9, 0xdd, 0x67, 0xe9, 0xbd, 0x0d, 0xef, 0xec, 0x92, 0x9e, 0xff, 0x5f,
-	0x01, 0x79, 0x27, 0x5c, 0xab, 0x1d, 0x79, 0x27, 0xa8, 0xc3, 0x8e, 0xbc, 0x13, 0xd4, 0x98, 0xf7,
-	0xa6, 0x03, 0xff, 0x2c, 0x81, 0x09, 0x6f, 0xcc, 0xab, 0x86, 0x91, 0xca, 0x2a, 0xe9, 0x2b, 0xa7,
-	0xb2, 0xea, 0x63, 0xe1, 0xa2, 0x3b, 0x8c, 0xd5, 0x4d, 0xb8, 0xfc, 0x5c, 0x59, 0xc1, 0xbf, 0x49,
-	0x21, 0x9f, 0x0e, 0x66, 0x90, 0x3b, 0x6e, 0x59, 0x16, 0xcf, 0xe4, 0xc2, 0x08, 0x36, 0xdf, 0x63,
-	0x6c, 0xee, 0xc2, 0xb5, 0x5d, 0xd8, 0x04, 0xff, 0xa4, 0xc9, 0x3f, 0x49, 0x7f, 0x95, 0xc0, 0x7e,
-	0x7f, 0x54, 0x77, 0x96, 0x32, 0x48, 0x9e, 0x9b, 0x59, 0x3f, 0xdf, 0x13, 0xad, 0x31, 0x66, 0x2b,
-	0xf0, 0xc6, 0xf3, 0x65, 0x06, 0x3f, 0x91, 0xc0, 0x98, 0x67, 0xa7, 0xc1, 0x4a, 0xba, 0xe6, 0x61,
-	0x2b, 0xac, 0x28, 0x67, 0xee, 0x2f, 0x58, 0x60, 0xc6, 0xe2, 0xbb, 0xf0, 0x3b, 0xbb, 0xb0, 0xa8,
-	0x13, 0x71, 0x61, 0xc8, 0x31, 0x3d, 0xbe, 0x45, 0xd8, 0x81, 0xff, 0x92, 0xc0, 0xc1, 0xa8, 0xfd,
-	0x05, 0xcf, 0x66, 0x58, 0xed, 0x09, 0x9f, 0xaf, 0x78, 0x2e, 0x27, 0x4a, 0x50, 0x7c, 0x87, 0x51,
-	0x5c, 0x83, 0xb7, 0x53, 0x28, 0x1a, 0x0c, 0x9b, 0x93, 0x29, 0xfc, 0x48, 0x02, 0xe3, 0xbe, 0xd7,
-	0x0b, 0xb3, 0xea, 0xef, 0xef, 0xc8, 0xdf, 0xcc, 0x0e, 0xc8, 0x51, 0x77, 0xfe, 0x8c, 0xd9, 0xd9,
-	0x89, 0xfc, 0x91, 0xd7, 0x1d, 0x33, 0xef, 0xb2, 0xd4, 0x5d, 0xd8, 0x77, 0xcc, 0x52, 0x77, 0x11,
-	0x57, 0x10, 0x2d, 0x33, 0x16, 0x8b, 0xf0, 0x5a, 0x0a, 0x0b, 0x66, 0x01, 0x26, 0x48, 0xc4, 0xcc,
-	0xc7, 0x0e, 0xfc, 0xbd, 0x04, 0x0e, 0x44, 0x9c, 0x32, 0x98, 0xba, 0xa6, 0xfb, 0xb8, 0x79, 0xc5,
-	0xb3, 0xf9, 0x40, 0x82, 0xcb, 0x39, 0xc6, 0x45, 0x86, 0xb3, 0xbb, 0x70, 0x09, 0xfe, 0x7b, 0x2c,
-	0xef, 0x68, 0x5c, 0xf0, 0x5f, 0x4b, 0x60, 0xdc, 0xb7, 0x2e, 0x53, 0x2b, 0x27, 0xee, 0x7e, 0xa6,
-	0x56, 0x4e, 0xc2, 0x15, 0x45, 0xb3, 0x2c, 0xcf, 0x53, 0xf0, 0x64, 0xa6, 0x3c, 0xe1, 0x87, 0x12,
-	0x80, 0x8b, 0xc4, 0x89, 0xf9, 0x80, 0x30, 0x6d, 0x15, 0xf6, 0x37, 0x24, 0x8b, 0xaf, 0xe7, 0x85,
-	0x89, 0xa4, 0xcf, 0xb0, 0xa4, 0x67, 0xe1, 0x6b, 0xbb, 0x24, 0x6d, 0xf9, 0x58, 0x95, 0xf9, 0x8c,
-	0xf0, 0x53, 0x09, 0x4c, 0x47, 0x52, 0xf7, 0x7c, 0x3c, 0x78, 0x21, 0x73, 0x1a, 0x31, 0x67, 0xb2,
-	0x78, 0xf1, 0x19, 0x90, 0x82, 0xc3, 0x35, 0xc6, 0xe1, 0x0a, 0x7c, 0x23, 0x1b, 0x07, 0xaf, 0xd8,
-	0x63, 0x65, 0x0f, 0xff, 0xc0, 0xb7, 0x1a, 0xee, 0xf8, 0x65, 0xd9, 0x6a, 0x22, 0xae, 0x64, 0x96,
-	0xad, 0x26, 0x6a, 0x26, 0xa2, 0xb7, 0x58, 0xde, 0x6f, 0xc2, 0xcb, 0x29, 0x8b, 0x94, 0xdb, 0x86,
-	0x89, 0x55, 0x2a, 0xdc, 0xca, 0x0e, 0xfc, 0x3b, 0xdf, 0x5a, 0x58, 0xf4, 0x2c, 0x57, 0x8f, 0xb8,
-	0xe7, 0x98, 0xe5, 0xea, 0x91, 0xf0, 0xf5, 0xd0, 0xbb, 0x2c, 0xfb, 0x7b, 0xf0, 0x6e, 0x96, 0xec,
-	0xd5, 0xf5, 0x6d, 0x55, 0xd7, 0x72, 0x1c, 0x70, 0xba, 0xd6, 0x81, 0x1f, 0x14, 0xc0, 0x64, 0x1f,
-	0x93, 0x0a, 0x5e, 0x4c, 0x4f, 0x77, 0x80, 0x4d, 0x58, 0xbc, 0xf4, 0x2c, 0x50, 0x41, 0xf8, 0xe7,
-	0x12, 0x63, 0xfc, 0x53, 0x09, 0xfe, 0x48, 0x4a, 0xe1, 0xdc, 0xf0, 0x63, 0xe4, 0x3d, 0x27, 0xe4,
-	0x9d, 0xbe, 0x7e, 0x5f, 0x47, 0xde, 0x09, 0x7b, 0x78, 0x1d, 0xf8, 0x3f, 0x09, 0x1c, 0x8e, 0xfb,
-	0x48, 0xf0, 0xf5, 0x74, 0x76, 0xfd, 0xcc, 0xb7, 0xe2, 0xf9, 0xdc, 0x38, 0x21, 0x89, 0xc5, 0x14,
-	0x31, 0xe0, 0xf7, 0x53, 0xf4, 0x68, 0x32, 0xb4, 0x6a, 0x73, 0x78, 0x0e, 0x31, 0x12, 0x2e, 0x5a,
-	0x07, 0xfe, 0x8c, 0xef, 0x9b, 0x31, 0xb3, 0x22, 0x75, 0xdf, 0xec, 0x6f, 0xbc, 0xa4, 0xee, 0x9b,
-	0x03, 0x3c, 0x11, 0x34, 0x04, 0x7f, 0xc8, 0xae, 0x5d, 0x21, 0x33, 0x20, 0xcb, 0xb5, 0x2b, 0x69,
-	0x69, 0x64, 0xb9, 0x76, 0xf5, 0x31, 0x2c, 0xd0, 0x10, 0xfc, 0x01, 0x38, 0x10, 0xf9, 0xd4, 0x85,
-	0x59, 0x97, 0x71, 0xd8, 0x8f, 0x28, 0x9e, 0xcd, 0x07, 0xf2, 0x46, 0xaf, 0x2e, 0x7e, 0xfc, 0xa4,
-	0x24, 0x3d, 0x7e, 0x52, 0x92, 0xfe, 0xfd, 0xa4, 0x24, 0x3d, 0x7a, 0x5a, 0x1a, 0x7a, 0xfc, 0xb4,
-	0x34, 0xf4, 0x8f, 0xa7, 0xa5, 0xa1, 0x7b, 0xb3, 0x21, 0xcb, 0x20, 0x5e, 0x16, 0xb3, 0xbc, 0x2e,
-	0xb6, 0x58, 0x65, 0x30, 0xf7, 0x60, 0x7d, 0x94, 0xbd, 0x3f, 0xf3, 0x59, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xf0, 0x0a, 0x3f, 0x2b, 0x03, 0x27, 0x00, 0x00,
+	// 2583 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x5d, 0x6f, 0xd4, 0xd8,
+	0x19, 0xc6, 0x13, 0x92, 0x26, 0x07, 0x96, 0x8f, 0x43, 0x12, 0x82, 0x97, 0x66, 0xa8, 0xb7, 0x2c,
+	0x74, 0xb7, 0x19, 0x43, 0x80, 0x00, 0x51, 0x97, 0x8f, 0x09, 0x90, 0x46, 0x25, 0x10, 0x1c, 0xc8,
+	0x52, 0xba, 0xd4, 0xeb, 0x8c, 0x4f, 0x26, 0x6e, 0x3c, 0xf6, 0x60, 0x7b, 0x80, 0x28, 0x1d, 0xf5,
+	0x4b, 0xbd, 0x69, 0x6f, 0x90, 0xb6, 0x17, 0xdd, 0x8b, 0xfe, 0x80, 0x5e, 0xf4, 0xa2, 0x37, 0xd5,
+	0x6a, 0xef, 0xb6, 0x6a, 0x57, 0x2b, 0xb5, 0xda, 0x22, 0x6d, 0x2b, 0x55, 0xad, 0x34, 0xaa, 0xa0,
+	0x57, 0x73, 0x5f, 0x55, 0xbd, 0xab, 0x7c, 0xce, 0x7b, 0x3c, 0x1e, 0xdb, 0x13, 0xdb, 0x09, 0x5a,
+	0x2d, 0x57, 0x93, 0x39, 0x3e, 0xcf, 0x7b, 0xde, 0xe7, 0x39, 0xef, 0xf9, 0xf0, 0x33, 0x41, 0x7b,
+	0x75, 0xf2, 0x58, 0x7e, 0xd0, 0x20, 0xce, 0x7a, 0xa9, 0xee, 0xd8, 0x9e, 0x8d, 0xc7, 0x5c, 0x62,
+	0xd0, 0xbf, 0x2a, 0xb6, 0x59, 0x72, 0x89, 0x51, 0x59, 0xd5, 0x0c, 0xab, 0xa4, 0x93, 0xc7, 0xe2,
+	0x70, 0xd5, 0xae, 0xda, 0xf4, 0x91, 0xec, 0xff, 0xc5, 0xfa, 0x8b, 0x87, 0xab, 0xb6, 0x5d, 0x35,
+	0x89, 0xac, 0xd5, 0x0d, 0x59, 0xb3, 0x2c, 0xdb, 0xd3, 0x3c, 0xc3, 0xb6, 0x5c, 0x78, 0xfa, 0x46,
+	0xc5, 0x76, 0x6b, 0xb6, 0x2b, 0x2f, 0x6b, 0x2e, 0x61, 0xc3, 0xc8, 0x0f, 0x4f, 0x2e, 0x13, 0x4f,
+	0x3b, 0x29, 0xd7, 0xb5, 0xaa, 0x61, 0xd1, 0xce, 0xd0, 0x77, 0x9f, 0x9f, 0x4a, 0x5d, 0x73, 0xb4,
+	0x1a, 0x47, 0x1f, 0xf0, 0x5b, 0x4c, 0xdb, 0xaa, 0xaa, 0xcb, 0xb6, 0xbd, 0x06, 0x8d, 0xc3, 0x7e,
+	0xa3, 0xbb, 0x6a, 0x3b, 0x5e, 0xb8, 0x95, 0xf2, 0xa8, 0x3b, 0x46, 0x85, 0x40, 0x03, 0xf6, 0x1b,
+	0x2a, 0xb6, 0xe5, 0x39, 0x5a, 0xc5, 0x83, 0xb6, 0x3d, 0x7e, 0x9b, 0xf7, 0x48, 0xab, 0x87, 0x43,
+	0x69, 0xae, 0x4b, 0x3c, 0xd5, 0x34, 0xdc, 0xae, 0x5e, 0x75, 0xcd, 0x70, 0xc2, 0xa1, 0x6d, 0x47,
+	0x27, 0xbc, 0x61, 0xd4, 0x6f, 0xa8, 0x69, 0x5e, 0x65, 0x55, 0x75, 0x88, 0xdb, 0x30, 0xbd, 0x70,
+	0x47, 0x62, 0x35, 0xba, 0xf3, 0x37, 0xac, 0x0a, 0xb1, 0x3c, 0xe3, 0x21, 0x24, 0x26, 0x0d, 0x23,
+	0x7c, 0xcb, 0x17, 0x62, 0x81, 0x32, 0x55, 0xc8, 0x83, 0x06, 0x71, 0x3d, 0xe9, 0x0e, 0x3a, 0xd0,
+	0xd5, 0xea, 0xd6, 0x6d, 0xcb, 0x25, 0xf8, 0x02, 0x1a, 0x60, 0x8a, 0x8c, 0x09, 0x47, 0x84, 0xe3,
+	0xbb, 0x26, 0x8f, 0x94, 0x7a, 0x4d, 0x4f, 0x89, 0x21, 0xcb, 0x3b, 0x3f, 0x69, 0x15, 0x77, 0x28,
+	0x80, 0x92, 0xde, 0x13, 0xd0, 0x41, 0x1a, 0x77, 0x96, 0x78, 0xd7, 0x6d, 0xab, 0x5a, 0xb6, 0xed,
+	0x35, 0x18, 0x12, 0x0f, 0xa3, 0x7e, 0x2a, 0x18, 0x0d, 0x3d, 0xa4, 0xb0, 0x2f, 0x58, 0x42, 0xbb,
+	0xb9, 0x6a, 0x97, 0x75, 0xdd, 0x19, 0x2b, 0xd0, 0x87, 0x5d, 0x6d, 0x78, 0x1c, 0x21, 0xda, 0xf9,
+	0x0a, 0xb1, 0xec, 0xda, 0x58, 0x1f, 0xed, 0x11, 0x6a, 0xf1, 0x9f, 0x53, 0x55, 0xd9, 0xf3, 0x9d,
+	0xec, 0x79, 0xa7, 0x45, 0x7a, 0x17, 0x8d, 0xc5, 0x93, 0x02, 0xc6, 0x57, 0xd0, 0x20, 0x6f, 0x03,
+	0xce, 0x52, 0x6f, 0xce, 0xbc, 0x27, 0xb0, 0x0e, 0x90, 0xd2, 0x1f, 0x39, 0xef, 0xcb, 0xa6, 0x19,
+	0xe5, 0x7d, 0x0d, 0xa1, 0x4e, 0xed, 0xc1, 0x18, 0xaf, 0x97, 0x58, 0xa1, 0x96, 0xfc, 0x42, 0x2d,
+	0xb1, 0xf5, 0x00, 0x85, 0x5a, 0x5a, 0xd0, 0xaa, 0x04, 0xb0, 0x4a, 0x08, 0xf9, 0xb9, 0x28, 0xf5,
+	0x6b, 0x01, 0x8d, 0xc5, 0x79, 0x24, 0x4a, 0xd5, 0xb7, 0x35, 0xa9, 0xf0, 0x6c, 0x97, 0x1c, 0x05,
+	0x2a, 0xc7, 0xb1, 0x54, 0x39, 0x58, 0x0a, 0x61, 0x3d, 0xa4, 0x5f, 0x08, 0x9d, 0x69, 0x5d, 0xf4,
+	0xd7, 0xe7, 0x17, 0xa3, 0xd8, 0x74, 0x74, 0x28, 0x21, 0x2b, 0x90, 0x70, 0x16, 0x0d, 0x05, 0x8d,
+	0x50, 0x0a, 0xaf, 0xf5, 0xd6, 0x30, 0xe8, 0x0a, 0x22, 0x76, 0xb0, 0xd2, 0xc7, 0xa1, 0x89, 0x8a,
+	0x91, 0x7f, 0x99, 0x2a, 0xee, 0x37, 0x02, 0x3a, 0x94, 0x40, 0x24, 0x59, 0xaf, 0xbe, 0xad, 0xea,
+	0xf5, 0xe2, 0xaa, 0x6e, 0x03, 0x8d, 0xf0, 0xe9, 0x5d, 0xf0, 0x59, 0xf2, 0x1d, 0x35, 0x22, 0x84,
+	0x90, 0x22, 0x44, 0x21, 0x2a, 0x44, 0x4c, 0xec, 0xbe, 0xb8, 0xd8, 0xd2, 0x2d, 0x34, 0x1a, 0x1d,
+	0x1c, 0x84, 0x3a, 0x8b, 0x06, 0xe8, 0x58, 0x2e, 0xa8, 0x54, 0xdc, 0x64, 0xe3, 0xf6, 0xfb, 0x29,
+	0xd0, 0x5d, 0xfa, 0xa5, 0x80, 0x86, 0xbb, 0x62, 0x7e, 0x8e, 0x7c, 0xf0, 0x61, 0x34, 0xe4, 0x19,
+	0x35, 0xe2, 0x7a, 0x5a, 0xad, 0x4e, 0x6b, 0x63, 0xa7, 0xd2, 0x69, 0x90, 0xf4, 0x88, 0xd4, 0x01,
+	0xd9, 0x33, 0xe1, 0xc5, 0x9d, 0x81, 0x2b, 0xac, 0xfe, 0x61, 0xd4, 0xbf, 0x62, 0x37, 0x2c, 0x9d,
+	0x26, 0x3b, 0xa8, 0xb0, 0x2f, 0xd2, 0x07, 0x02, 0x12, 0x83, 0xd3, 0x41, 0xf3, 0x88, 0xdb, 0x2d,
+	0x83, 0x1c, 0x97, 0xa1, 0xbc, 0xb7, 0xdd, 0x2a, 0xee, 0xa2, 0xad, 0xaa, 0xee, 0x37, 0x77, 0xe9,
+	0x22, 0xc7, 0x75, 0x61, 0x00, 0x76, 0xf0, 0x03, 0x20, 0x24, 0xd4, 0xb9, 0x24, 0xa1, 0xca, 0xc3,
+	0xed, 0x56, 0x71, 0x1f, 0x6f, 0x57, 0x35, 0x5d, 0x77, 0x88, 0xeb, 0x46, 0xca, 0xe1, 0x36, 0x7a,
+	0x35, 0x31, 0xf3, 0x6d, 0xc9, 0x24, 0x3d, 0x09, 0x55, 0xc4, 0xed, 0x47, 0x5a, 0x3d, 0xa8, 0xf0,
+	0x68, 0xa2, 0x42, 0xd6, 0x44, 0xf1, 0x05, 0xb4, 0xd7, 0xb4, 0xed, 0xb5, 0x65, 0xad, 0xb2, 0xb6,
+	0x48, 0x2a, 0xb6, 0xa5, 0xbb, 0x54, 0x98, 0x9d, 0x0c, 0xcc, 0x1f, 0xa9, 0x2e, 0x7b, 0xa6, 0x44,
+	0x3b, 0x4b, 0x77, 0xd1, 0x48, 0x24, 0x23, 0xa0, 0x78, 0x11, 0xf5, 0xfb, 0xf7, 0x2b, 0x5e, 0xf5,
+	0xe3, 0xbd, 0x29, 0xfa, 0xb8, 0xf2, 0x50, 0xbb, 0x55, 0x64, 0x00, 0x85, 0x7d, 0x48, 0x07, 0x21,
+	0xf2, 0x65, 0x7f, 0x3e, 0xae, 0x1b, 0xae, 0xc7, 0x2f, 0x48, 0x04, 0x8d, 0x46, 0x1f, 0xc0, 0x98,
+	0xdf, 0x42, 0x43, 0x1a, 0x6f, 0x84, 0x71, 0x8f, 0xf5, 0x1e, 0x97, 0xe2, 0xe7, 0x89, 0xa7, 0xe9,
+	0x9a, 0xa7, 0xf1, 0x7d, 0x29, 0xc0, 0x4b, 0x27, 0xf9, 0xee, 0x17, 0xee, 0x16, 0x3a, 0xc4, 0xf4,
+	0xd0, 0xea, 0x63, 0x5f, 0x24, 0x0d, 0x89, 0x49, 0x10, 0xc8, 0x6e, 0x06, 0x0d, 0xd6, 0xa0, 0x0d,
+	0xe6, 0x3d, 0x6b, 0x72, 0x4a, 0x00, 0x94, 0xde, 0x86, 0xc2, 0x52, 0x48, 0xd5, 0x70, 0x3d, 0xe2,
+	0x10, 0x7d, 0x41, 0x33, 0x9c, 0xed, 0x17, 0x82, 0x74, 0x0f, 0x1d, 0x4e, 0x0e, 0x0c, 0xd9, 0x4f,
+	0xa3, 0x7e, 0xff, 0x26, 0x9c, 0x61, 0x3e, 0x7d, 0x1c, 0xc8, 0xc9, 0x20, 0xd2, 0x3d, 0x34, 0x1e,
+	0x89, 0x3d, 0x03, 0x43, 0x6f, 0x3f, 0xef, 0x3a, 0x2a, 0xf6, 0x8c, 0x0d, 0xa9, 0xcf, 0xa3, 0x57,
+	0x82, 0x20, 0x86, 0xb5, 0x62, 0x83, 0xfa, 0xc7, 0x7b, 0x53, 0xe0, 0x21, 0xe6, 0xac, 0x15, 0x7b,
+	0x69, 0xb2, 0x33, 0xa2, 0xff, 0x5d, 0x7a, 0xdc, 0x29, 0xf9, 0x9b, 0x8e, 0x4e, 0x5e, 0x80, 0xf8,
+	0xf8, 0x28, 0xfa, 0x92, 0x56, 0xa9, 0xd8, 0x0d, 0xcb, 0x83, 0x6d, 0x69, 0x57, 0xbb, 0x55, 0xe4,
+	0x4d, 0x0a, 0xff, 0x43, 0xba, 0x8f, 0x46, 0xa3, 0x23, 0x07, 0xb5, 0x35, 0x40, 0xdf, 0x4b, 0x32,
+	0x1c, 0x32, 0x14, 0x59, 0x46, 0xed, 0x56, 0x11, 0x20, 0x0a, 0x7c, 0x4a, 0x9f, 0x86, 0xae, 0x6d,
+	0xac, 0xd7, 0xfa, 0xdc, 0x95, 0xed, 0x93, 0xeb, 0xde, 0xa7, 0x0b, 0x79, 0xf7, 0xe9, 0xbe, 0xf4,
+	0x7d, 0x7a, 0x14, 0x15, 0x0c, 0x9d, 0x9d, 0x52, 0xe5, 0x81, 0x76, 0xab, 0x58, 0x30, 0x74, 0xa5,
+	0x60, 0xe8, 0xd2, 0x7d, 0x74, 0x28, 0x81, 0x0f, 0x48, 0x76, 0x09, 0xf5, 0x53, 0xde, 0xe9, 0x7b,
+	0x30, 0xc3, 0xd2, 0x1d, 0x8a, 0x22, 0x14, 0xf6, 0x21, 0xfd, 0xb9, 0x00, 0xb5, 0x37, 0x4b, 0xbc,
+	0x6f, 0x1a, 0xae, 0x67, 0x3b, 0x46, 0x45, 0x33, 0xbb, 0xef, 0x1e, 0x5f, 0x64, 0xd9, 0x14, 0x34,
+	0x52, 0x27, 0x8e, 0x61, 0xeb, 0xd7, 0x89, 0x55, 0xf5, 0x56, 0xe7, 0x2c, 0x7e, 0x02, 0x30, 0x25,
+	0x0f, 0xb7, 0x5b, 0xc5, 0x31, 0xd6, 0x41, 0x35, 0x69, 0x0f, 0xd5, 0xb0, 0x82, 0x93, 0x20, 0x19,
+	0x8a, 0xcf, 0xa3, 0xdd, 0x56, 0xa3, 0x76, 0x73, 0x65, 0x81, 0x3e, 0x75, 0xc7, 0xfa, 0x69, 0xa8,
+	0x91, 0x76, 0xab, 0xb8, 0xdf, 0x6a, 0xd4, 0x96, 0x89, 0xa3, 0xda, 0x2b, 0x2a, 0x83, 0xba, 0x4a,
+	0x57, 0x57, 0xc9, 0x41, 0x47, 0x7a, 0xab, 0x09, 0x93, 0x76, 0x23, 0x72, 0x99, 0x7a, 0x23, 0xe5,
+	0xe4, 0x9c, 0xd1, 0x2c, 0xdd, 0x24, 0xae, 0x67, 0x54, 0xd6, 0x58, 0xc9, 0x33, 0x74, 0x70, 0xc7,
+	0xfa, 0x51, 0x01, 0xb6, 0xbd, 0x59, 0xe2, 0xcd, 0x6b, 0xce, 0x1a, 0xf1, 0x16, 0x1b, 0xb5, 0x9a,
+	0xe6, 0xac, 0xbf, 0x0c, 0xf3, 0x77, 0x15, 0xed, 0xe7, 0xc7, 0x71, 0x74, 0xee, 0x0e, 0xb6, 0x5b,
+	0xc5, 0x03, 0xc1, 0xe9, 0x1d, 0x9a, 0xb6, 0x38, 0x42, 0xfa, 0x5f, 0x1f, 0xfa, 0x72, 0x0f, 0x0d,
+	0x40, 0xf5, 0x77, 0xd0, 0x2e, 0xcf, 0xf6, 0x34, 0x73, 0xc9, 0x36, 0x1b, 0x35, 0x78, 0x71, 0x2b,
+	0x4f, 0xff, 0xa3, 0x55, 0x7c, 0xbd, 0x6a, 0x78, 0xab, 0x8d, 0xe5, 0x52, 0xc5, 0xae, 0xc9, 0xe0,
+	0xef, 0xb0, 0x8f, 0x09, 0x57, 0x5f, 0x93, 0xbd, 0xf5, 0x3a, 0x71, 0x4b, 0x57, 0x48, 0xa5, 0xdd,
+	0x2a, 0xee, 0xa6, 0x01, 0xd4, 0x87, 0x34, 0x82, 0x12, 0x0e, 0x87, 0x1b, 0xe8, 0x40, 0xe8, 0xeb,
+	0x0d, 0xdb, 0x33, 0x6c, 0x4b, 0x33, 0x41, 0xb1, 0x99, 0x5c, 0xa3, 0x8c, 0x84, 0x47, 0x51, 0x2d,
+	0x08, 0xa5, 0x24, 0xc5, 0xc7, 0x4b, 0x68, 0x68, 0xd5, 0xa8, 0xae, 0xd2, 0x32, 0x01, 0xb5, 0xcf,
+	0xe5, 0x1a, 0x0c, 0xf9, 0x70, 0x95, 0x4e, 0xa0, 0xd2, 0x09, 0x85, 0x17, 0xd1, 0xa0, 0x69, 0x3f,
+	0x62, 0x61, 0xe9, 0x4b, 0x55, 0xf9, 0x6c, 0xae, 0xb0, 0x43, 0xa6, 0xfd, 0x08, 0xa2, 0x06, 0x81,
+	0xfc, 0x64, 0x4d, 0x0d, 0x6e, 0x91, 0x63, 0xfd, 0x5b, 0x49, 0xd6, 0x87, 0xf3, 0x64, 0x83, 0x50,
+	0xd2, 0xfb, 0x02, 0xdc, 0x27, 0xe8, 0x1e, 0xb7, 0x68, 0xd4, 0x1a, 0x26, 0x7d, 0x99, 0xe2, 0xe5,
+	0xbf, 0xed, 0x4d, 0x32, 0xb6, 0x80, 0x0a, 0x99, 0x4f, 0xf6, 0x9f, 0x0b, 0xb0, 0x36, 0x63, 0xb9,
+	0x41, 0x59, 0xae, 0xa1, 0x7d, 0x57, 0x1f, 0x93, 0x4a, 0xc3, 0x23, 0xfa, 0xad, 0x86, 0x66, 0x79,
+	0x86, 0xb7, 0x0e, 0xb5, 0x79, 0x31, 0x97, 0x36, 0xfb, 0x09, 0x44, 0x51, 0x1f, 0x40, 0x18, 0x25,
+	0x16, 0x58, 0x5a, 0xea, 0xbc, 0x8b, 0xcc, 0xfb, 0x86, 0x9f, 0x42, 0xfd, 0xbe, 0xed, 0xdf, 0x5f,
+	0x56, 0xd1, 0xab, 0x89, 0x71, 0x81, 0xe3, 0x1c, 0x1a, 0x60, 0xce, 0x22, 0xcc, 0xc0, 0xd1, 0xde,
+	0x33, 0x10, 0x82, 0xb3, 0xbd, 0x8e, 0x01, 0x15, 0xf8, 0x94, 0xfe, 0x53, 0x88, 0x1c, 0x87, 0x33,
+	0xf4, 0x76, 0xf1, 0x12, 0x6c, 0x74, 0x73, 0xfc, 0x75, 0x89, 0xad, 0xa7, 0x53, 0xb9, 0x66, 0xb7,
+	0xbf, 0x1e, 0x7a, 0x85, 0xc2, 0x0f, 0xd0, 0xfe, 0xba, 0xed, 0x1a, 0x7e, 0x1d, 0x5d, 0x31, 0x1c,
+	0x52, 0xf1, 0xff, 0xa0, 0x0b, 0x6a, 0xcf, 0xe4, 0x9b, 0x9b, 0x9c, 0x25, 0x51, 0x48, 0x79, 0xb4,
+	0xdd, 0x2a, 0x62, 0x1e, 0x49, 0xd5, 0x79, 0xbb, 0x12, 0x8f, 0x2e, 0xbd, 0x85, 0xc4, 0x24, 0xd9,
+	0x61, 0x82, 0x8b, 0xa8, 0x9f, 0x5d, 0xfc, 0x04, 0xba, 0x71, 0xd3, 0x05, 0x44, 0x1b, 0x14, 0xf6,
+	0x11, 0xdc, 0xf8, 0xaf, 0xae, 0xac, 0xf8, 0x01, 0x1f, 0x92, 0x2e, 0xbb, 0x78, 0x1b, 0x95, 0xf7,
+	0x21, 0x5f, 0x5f, 0xb1, 0xc8, 0x2f, 0xc6, 0x72, 0xc6, 0xf7, 0xd0, 0xa0, 0xfd, 0x90, 0x38, 0x8e,
+	0xa1, 0x13, 0xf0, 0x75, 0x4e, 0xa4, 0x5f, 0xb9, 0x59, 0xa4, 0x9b, 0x80, 0x2b, 0xef, 0x6e, 0xb7,
+	0x8a, 0x41, 0x14, 0x25, 0xf8, 0x4b, 0x3a, 0x0b, 0x67, 0xd6, 0x1c, 0xf7, 0xd4, 0x67, 0xb4, 0x5a,
+	0x5d, 0x33, 0xaa, 0xc1, 0xce, 0xc5, 0xee, 0x84, 0x42, 0xec, 0x4e, 0x68, 0xa3, 0xf1, 0x5e, 0xc0,
+	0xe0, 0x75, 0x61, 0xb0, 0x02, 0x6d, 0x40, 0x7c, 0x93, 0xca, 0x88, 0x85, 0xe1, 0xae, 0x2a, 0x0f,
+	0x21, 0x1d, 0xe9, 0x35, 0x60, 0xe0, 0xf8, 0x3b, 0xa8, 0xd8, 0xb3, 0x07, 0xe4, 0x74, 0x13, 0x0d,
+	0xf1, 0x80, 0xfc, 0xea, 0xb3, 0x85, 0xa4, 0x3a, 0x31, 0xa4, 0x1b, 0x50, 0x55, 0x41, 0xd7, 0xc5,
+	0x8a, 0xed, 0x74, 0xae, 0xad, 0x32, 0x42, 0xbc, 0xef, 0x1c, 0x57, 0x91, 0x2e, 0x51, 0xde, 0xaa,
+	0x1a, 0xba, 0x12, 0xea, 0x22, 0xad, 0xa0, 0xc3, 0xc9, 0xf1, 0x80, 0xc0, 0x35, 0x34, 0xe0, 0xd2,
+	0x16, 0xc8, 0xfe, 0x78, 0x86, 0xec, 0x69, 0x08, 0x5e, 0x53, 0x0c, 0x3d, 0xf9, 0xd1, 0x57, 0x51,
+	0x3f, 0x1d, 0x08, 0x3f, 0x11, 0xd0, 0x00, 0x2b, 0x16, 0xfc, 0xf5, 0xde, 0xc1, 0xe2, 0x3f, 0xb0,
+	0x88, 0x13, 0x19, 0x7b, 0xb3, 0xcc, 0xa5, 0xaf, 0xfd, 0xf8, 0xb3, 0x7f, 0xbf, 0x57, 0x78, 0x0d,
+	0x7f, 0x45, 0x76, 0x89, 0x31, 0xc1, 0x71, 0x32, 0xc7, 0xc9, 0x9d, 0xdf, 0xaa, 0xf0, 0x53, 0xa1,
+	0xe3, 0xc3, 0xe3, 0x93, 0x29, 0xc3, 0xc4, 0x7f, 0x87, 0x11, 0x27, 0xf3, 0x40, 0x20, 0xbd, 0xfb,
+	0x34, 0xbd, 0xb7, 0xf1, 0x9d, 0x4d, 0xd2, 0x0b, 0x7e, 0x38, 0x93, 0x37, 0xc2, 0x3b, 0x40, 0x53,
+	0xde, 0xe8, 0xec, 0xca, 0x4d, 0x79, 0xa3, 0xb3, 0xe3, 0xf2, 0x27, 0x4d, 0xfc, 0x27, 0x01, 0xed,
+	0xe2, 0x63, 0x5e, 0x36, 0xcd, 0x54, 0x56, 0xf1, 0x5f, 0x59, 0xc4, 0xc9, 0x3c, 0x10, 0x60, 0x75,
+	0x87, 0xb2, 0xba, 0x89, 0xe7, 0x5f, 0x28, 0x2b, 0xfc, 0x57, 0x21, 0xe4, 0x5a, 0xe3, 0x0c, 0x72,
+	0x47, 0x0d, 0x7c, 0xf1, 0x54, 0x2e, 0x0c, 0xb0, 0xf9, 0x2e, 0x65, 0x73, 0x17, 0x2f, 0x6d, 0xc2,
+	0xa6, 0xf3, 0x3b, 0x66, 0xfe, 0x49, 0xfa, 0x8b, 0x80, 0x76, 0x07, 0xa3, 0xfa, 0xb3, 0x94, 0x41,
+	0xf2, 0xdc, 0xcc, 0x92, 0x7e, 0x05, 0x90, 0x96, 0x28, 0xb3, 0x05, 0x7c, 0xe3, 0xc5, 0x32, 0xc3,
+	0x9f, 0x0a, 0x68, 0x90, 0x9b, 0xcb, 0xb8, 0x94, 0xae, 0x79, 0xd8, 0x18, 0x16, 0xe5, 0xcc, 0xfd,
+	0x81, 0x85, 0x46, 0x59, 0x7c, 0x07, 0x7f, 0x7b, 0x13, 0x16, 0x55, 0x02, 0xd7, 0xe7, 0x1c, 0xd3,
+	0x13, 0x18, 0xe6, 0x4d, 0xfc, 0x4f, 0x01, 0xed, 0xe9, 0x36, 0x83, 0xf1, 0xe9, 0x0c, 0xab, 0x3d,
+	0xe6, 0x7a, 0x8b, 0x67, 0x72, 0xa2, 0x80, 0xe2, 0x3b, 0x94, 0xe2, 0x12, 0xbe, 0x9d, 0x42, 0xd1,
+	0xa4, 0xd8, 0x9c, 0x4c, 0xf1, 0xc7, 0x02, 0x1a, 0xe2, 0xaa, 0xba, 0x38, 0xab, 0xfe, 0xc1, 0x8e,
+	0x7c, 0x22, 0x3b, 0x20, 0x47, 0xdd, 0x05, 0x33, 0xe6, 0x66, 0x27, 0xf2, 0x21, 0xab, 0x3b, 0x6a,
+	0x65, 0x67, 0xa9, 0xbb, 0xb0, 0x0b, 0x2f, 0xca, 0x99, 0xfb, 0x03, 0x8b, 0x79, 0xca, 0x62, 0x16,
+	0x5f, 0x4d, 0x61, 0x41, 0x0d, 0xf1, 0x18, 0x89, 0x88, 0x15, 0xdf, 0xc4, 0xbf, 0x15, 0xd0, 0x2b,
+	0x5d, 0xbe, 0x31, 0x4e, 0x5d, 0xd3, 0x09, 0xde, 0xb6, 0x78, 0x3a, 0x1f, 0x08, 0xb8, 0x9c, 0xa1,
+	0x5c, 0x64, 0x3c, 0xb1, 0x09, 0x97, 0xce, 0x3f, 0x58, 0xc8, 0x1b, 0x3a, 0x13, 0xfc, 0x57, 0x02,
+	0x1a, 0x0a, 0x8c, 0xfc, 0xd4, 0xca, 0x89, 0xfe, 0x16, 0x20, 0x9e, 0xc8, 0x0e, 0x80, 0x3c, 0x27,
+	0x68, 0x9e, 0xc7, 0xf0, 0xd1, 0x4c, 0x79, 0xe2, 0x0f, 0x04, 0x84, 0x67, 0x89, 0x17, 0x71, 0xc5,
+	0x71, 0xda, 0x2a, 0x4c, 0xb6, 0xe7, 0xc5, 0xa9, 0xbc, 0x30, 0x48, 0xfa, 0x14, 0x4d, 0x7a, 0x02,
+	0xbf, 0xb9, 0x49, 0xd2, 0x4e, 0x80, 0x55, 0xa9, 0xeb, 0x8e, 0x3f, 0x13, 0xd0, 0x48, 0x57, 0xea,
+	0xfc, 0x8a, 0x8d, 0xcf, 0x65, 0x4e, 0x23, 0xe2, 0xd3, 0x8b, 0xe7, 0xb7, 0x80, 0x04, 0x0e, 0x57,
+	0x29, 0x87, 0x8b, 0xf8, 0xad, 0x6c, 0x1c, 0x78, 0xb1, 0x47, 0xca, 0x1e, 0xff, 0x8e, 0x6d, 0x35,
+	0xcc, 0xff, 0xce, 0xb2, 0xd5, 0x74, 0x79, 0xf4, 0xe2, 0x89, 0xec, 0x00, 0xc8, 0xfb, 0x1a, 0xcd,
+	0xfb, 0x12, 0xbe, 0x90, 0xb2, 0x48, 0x99, 0x89, 0x1e, 0x5b, 0xa5, 0xe0, 0xdd, 0x37, 0xf1, 0xdf,
+	0xd8, 0xd6, 0x42, 0xa3, 0x67, 0xb9, 0x7a, 0x44, 0x1d, 0x78, 0xf1, 0x54, 0x2e, 0x0c, 0x64, 0xff,
+	0x2e, 0xcd, 0xfe, 0x1e, 0xbe, 0x9b, 0x25, 0x7b, 0x75, 0x79, 0x5d, 0x35, 0xf4, 0x1c, 0x07, 0x9c,
+	0xa1, 0x37, 0xf1, 0xfb, 0x05, 0x74, 0x20, 0xc1, 0xb2, 0xc5, 0xe7, 0xd3, 0xd3, 0xed, 0x61, 0x9a,
+	0x8b, 0xd3, 0x5b, 0x81, 0x02, 0xe1, 0x9f, 0x09, 0x94, 0xf1, 0x4f, 0x04, 0xfc, 0x43, 0x21, 0x85,
+	0xf3, 0x6a, 0x10, 0x23, 0xef, 0x39, 0x21, 0x6f, 0x24, 0xba, 0xdf, 0x4d, 0x79, 0x23, 0xec, 0x68,
+	0x37, 0xf1, 0x7f, 0x05, 0xb4, 0x2f, 0xea, 0xaa, 0xe2, 0xa9, 0x74, 0x76, 0x49, 0x56, 0xb4, 0x78,
+	0x36, 0x37, 0x0e, 0x24, 0x71, 0xa8, 0x22, 0x26, 0xfe, 0x5e, 0x8a, 0x1e, 0x35, 0x8a, 0x56, 0x5d,
+	0x06, 0xcf, 0x21, 0x46, 0xcc, 0x53, 0x6e, 0xe2, 0x9f, 0xb2, 0x7d, 0x33, 0x62, 0xdd, 0xa5, 0xee,
+	0x9b, 0xc9, 0x36, 0xa4, 0x38, 0x95, 0x17, 0x06, 0xcc, 0x77, 0xe0, 0x1f, 0xd0, 0x6b, 0x57, 0xc8,
+	0x1a, 0xcb, 0x72, 0xed, 0x8a, 0x1b, 0x7c, 0xe2, 0x99, 0x9c, 0xa8, 0x20, 0x81, 0xef, 0xa3, 0x57,
+	0xba, 0x8c, 0x1f, 0x9c, 0x75, 0x19, 0x87, 0xdd, 0x39, 0xf1, 0x74, 0x3e, 0x50, 0x30, 0xfa, 0x1f,
+	0x04, 0xb4, 0x37, 0x62, 0xef, 0xa4, 0xce, 0x41, 0xb2, 0xd1, 0x24, 0x4e, 0xe5, 0x85, 0x41, 0x12,
+	0x65, 0x5a, 0x7d, 0xdf, 0xc0, 0xd3, 0x9b, 0x54, 0x1f, 0xe1, 0x58, 0x95, 0xbd, 0x49, 0x47, 0x37,
+	0xfd, 0xdf, 0x0b, 0x68, 0x7f, 0xcc, 0xd4, 0xc0, 0x69, 0x0b, 0xa2, 0x97, 0x37, 0x24, 0x9e, 0xcb,
+	0x0f, 0x04, 0x32, 0xd3, 0x94, 0xcc, 0x69, 0x3c, 0xb9, 0x09, 0x99, 0xe0, 0xdf, 0x3c, 0x55, 0xee,
+	0x8f, 0xb0, 0x8d, 0xf2, 0x23, 0x01, 0xe1, 0x58, 0x64, 0x17, 0xe7, 0x4e, 0xc6, 0xcd, 0x7a, 0x18,
+	0xf7, 0xf6, 0x93, 0xa4, 0x29, 0xca, 0xe3, 0x04, 0x2e, 0xe5, 0xe2, 0xe1, 0xfa, 0x13, 0xb1, 0x37,
+	0x62, 0xf1, 0xa4, 0xd6, 0x53, 0xb2, 0xc5, 0x24, 0x4e, 0xe5, 0x85, 0x41, 0xea, 0x97, 0x68, 0xea,
+	0xd3, 0xf8, 0x5c, 0xa6, 0xd4, 0x99, 0x6d, 0x24, 0x6f, 0x74, 0xac, 0xaa, 0x66, 0x79, 0xf6, 0x93,
+	0x67, 0xe3, 0xc2, 0xd3, 0x67, 0xe3, 0xc2, 0xbf, 0x9e, 0x8d, 0x0b, 0x4f, 0x9e, 0x8f, 0xef, 0x78,
+	0xfa, 0x7c, 0x7c, 0xc7, 0xdf, 0x9f, 0x8f, 0xef, 0xb8, 0x37, 0x11, 0x72, 0x95, 0xa3, 0xd1, 0x27,
+	0x58, 0xf8, 0xc7, 0x74, 0x00, 0x6a, 0x30, 0x2f, 0x0f, 0xd0, 0xe7, 0xa7, 0xfe, 0x3f, 0x00, 0x9a,
+	0x14, 0x01, 0x57, 0x3b, 0x2d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2306,6 +2685,11 @@ type QueryClient interface {
 	GetOrderSimulation(ctx context.Context, in *QueryOrderSimulationRequest, opts ...grpc.CallOption) (*QueryOrderSimulationResponse, error)
 	GetMatchResult(ctx context.Context, in *QueryGetMatchResultRequest, opts ...grpc.CallOption) (*QueryGetMatchResultResponse, error)
 	GetOrderCount(ctx context.Context, in *QueryGetOrderCountRequest, opts ...grpc.CallOption) (*QueryGetOrderCountResponse, error)
+	// Queries the params in effect for a contract, with any per-contract overrides applied.
+	EffectiveParams(ctx context.Context, in *QueryEffectiveParamsRequest, opts ...grpc.CallOption) (*QueryEffectiveParamsResponse, error)
+	IncentiveCampaign(ctx context.Context, in *QueryIncentiveCampaignRequest, opts ...grpc.CallOption) (*QueryIncentiveCampaignResponse, error)
+	IncentiveCampaigns(ctx context.Context, in *QueryIncentiveCampaignsRequest, opts ...grpc.CallOption) (*QueryIncentiveCampaignsResponse, error)
+	IncentiveScores(ctx context.Context, in *QueryIncentiveScoresRequest, opts ...grpc.CallOption) (*QueryIncentiveScoresResponse, error)
 }
 
 type queryClient struct {
@@ -2496,6 +2880,42 @@ func (c *queryClient) GetOrderCount(ctx context.Context, in *QueryGetOrderCountR
 	return out, nil
 }
 
+func (c *queryClient) EffectiveParams(ctx context.Context, in *QueryEffectiveParamsRequest, opts ...grpc.CallOption) (*QueryEffectiveParamsResponse, error) {
+	out := new(QueryEffectiveParamsResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.dex.Query/EffectiveParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IncentiveCampaign(ctx context.Context, in *QueryIncentiveCampaignRequest, opts ...grpc.CallOption) (*QueryIncentiveCampaignResponse, error) {
+	out := new(QueryIncentiveCampaignResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.dex.Query/IncentiveCampaign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IncentiveCampaigns(ctx context.Context, in *QueryIncentiveCampaignsRequest, opts ...grpc.CallOption) (*QueryIncentiveCampaignsResponse, error) {
+	out := new(QueryIncentiveCampaignsResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.dex.Query/IncentiveCampaigns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IncentiveScores(ctx context.Context, in *QueryIncentiveScoresRequest, opts ...grpc.CallOption) (*QueryIncentiveScoresResponse, error) {
+	out := new(QueryIncentiveScoresResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.dex.Query/IncentiveScores", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -2527,6 +2947,11 @@ type QueryServer interface {
 	GetOrderSimulation(context.Context, *QueryOrderSimulationRequest) (*QueryOrderSimulationResponse, error)
 	GetMatchResult(context.Context, *QueryGetMatchResultRequest) (*QueryGetMatchResultResponse, error)
 	GetOrderCount(context.Context, *QueryGetOrderCountRequest) (*QueryGetOrderCountResponse, error)
+	// Queries the params in effect for a contract, with any per-contract overrides applied.
+	EffectiveParams(context.Context, *QueryEffectiveParamsRequest) (*QueryEffectiveParamsResponse, error)
+	IncentiveCampaign(context.Context, *QueryIncentiveCampaignRequest) (*QueryIncentiveCampaignResponse, error)
+	IncentiveCampaigns(context.Context, *QueryIncentiveCampaignsRequest) (*QueryIncentiveCampaignsResponse, error)
+	IncentiveScores(context.Context, *QueryIncentiveScoresRequest) (*QueryIncentiveScoresResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2593,6 +3018,18 @@ func (*UnimplementedQueryServer) GetMatchResult(ctx context.Context, req *QueryG
 func (*UnimplementedQueryServer) GetOrderCount(ctx context.Context, req *QueryGetOrderCountRequest) (*QueryGetOrderCountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOrderCount not implemented")
 }
+func (*UnimplementedQueryServer) EffectiveParams(ctx context.Context, req *QueryEffectiveParamsRequest) (*QueryEffectiveParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EffectiveParams not implemented")
+}
+func (*UnimplementedQueryServer) IncentiveCampaign(ctx context.Context, req *QueryIncentiveCampaignRequest) (*QueryIncentiveCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncentiveCampaign not implemented")
+}
+func (*UnimplementedQueryServer) IncentiveCampaigns(ctx context.Context, req *QueryIncentiveCampaignsRequest) (*QueryIncentiveCampaignsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncentiveCampaigns not implemented")
+}
+func (*UnimplementedQueryServer) IncentiveScores(ctx context.Context, req *QueryIncentiveScoresRequest) (*QueryIncentiveScoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncentiveScores not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2958,23 +3395,95 @@ func _Query_GetOrderCount_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "seiprotocol.seichain.dex.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "LongBook",
-			Handler:    _Query_LongBook_Handler,
-		},
-		{
-			MethodName: "LongBookAll",
-			Handler:    _Query_LongBookAll_Handler,
-		},
-		{
+func _Query_EffectiveParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEffectiveParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EffectiveParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.dex.Query/EffectiveParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EffectiveParams(ctx, req.(*QueryEffectiveParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IncentiveCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIncentiveCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IncentiveCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.dex.Query/IncentiveCampaign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IncentiveCampaign(ctx, req.(*QueryIncentiveCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IncentiveCampaigns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIncentiveCampaignsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IncentiveCampaigns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.dex.Query/IncentiveCampaigns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IncentiveCampaigns(ctx, req.(*QueryIncentiveCampaignsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IncentiveScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIncentiveScoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IncentiveScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.dex.Query/IncentiveScores",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IncentiveScores(ctx, req.(*QueryIncentiveScoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "seiprotocol.seichain.dex.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "LongBook",
+			Handler:    _Query_LongBook_Handler,
+		},
+		{
+			MethodName: "LongBookAll",
+			Handler:    _Query_LongBookAll_Handler,
+		},
+		{
 			MethodName: "ShortBook",
 			Handler:    _Query_ShortBook_Handler,
 		},
@@ -3042,6 +3551,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetOrderCount",
 			Handler:    _Query_GetOrderCount_Handler,
 		},
+		{
+			MethodName: "EffectiveParams",
+			Handler:    _Query_EffectiveParams_Handler,
+		},
+		{
+			MethodName: "IncentiveCampaign",
+			Handler:    _Query_IncentiveCampaign_Handler,
+		},
+		{
+			MethodName: "IncentiveCampaigns",
+			Handler:    _Query_IncentiveCampaigns_Handler,
+		},
+		{
+			MethodName: "IncentiveScores",
+			Handler:    _Query_IncentiveScores_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dex/query.proto",
@@ -4676,115 +5201,376 @@ func (m *QueryGetOrderCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryEffectiveParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryEffectiveParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryEffectiveParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetLongBookRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEffectiveParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Price)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ContractAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.PriceDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Override != nil {
+		{
+			size, err := m.Override.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.AssetDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetLongBookResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryIncentiveCampaignRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.LongBook.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllLongBookRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryIncentiveCampaignRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncentiveCampaignRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ContractAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.PriceDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.AssetDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllLongBookResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryIncentiveCampaignResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIncentiveCampaignResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncentiveCampaignResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.LongBook) > 0 {
-		for _, e := range m.LongBook {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Campaign.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIncentiveCampaignsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *QueryIncentiveCampaignsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncentiveCampaignsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIncentiveCampaignsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIncentiveCampaignsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncentiveCampaignsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Campaigns) > 0 {
+		for iNdEx := len(m.Campaigns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Campaigns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIncentiveScoresRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIncentiveScoresRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncentiveScoresRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIncentiveScoresResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIncentiveScoresResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncentiveScoresResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Scores) > 0 {
+		for iNdEx := len(m.Scores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Scores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetLongBookRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetLongBookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LongBook.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllLongBookRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllLongBookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LongBook) > 0 {
+		for _, e := range m.LongBook {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
 func (m *QueryGetShortBookRequest) Size() (n int) {
@@ -5372,36 +6158,138 @@ func (m *QueryGetOrderCountResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryEffectiveParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryEffectiveParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Override != nil {
+		l = m.Override.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+
+func (m *QueryIncentiveCampaignRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryIncentiveCampaignResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Campaign.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIncentiveCampaignsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryIncentiveCampaignsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Campaigns) > 0 {
+		for _, e := range m.Campaigns {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryIncentiveScoresRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		n += 1 + sovQuery(uint64(m.CampaignId))
+	}
+	return n
+}
+
+func (m *QueryIncentiveScoresResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Scores) > 0 {
+		for _, e := range m.Scores {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
@@ -9945,6 +10833,646 @@ func (m *QueryGetOrderCountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryEffectiveParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEffectiveParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEffectiveParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEffectiveParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEffectiveParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEffectiveParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Override", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Override == nil {
+				m.Override = &ContractParamsOverride{}
+			}
+			if err := m.Override.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentiveCampaignRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentiveCampaignRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentiveCampaignRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentiveCampaignResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentiveCampaignResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentiveCampaignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Campaign", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Campaign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentiveCampaignsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentiveCampaignsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentiveCampaignsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentiveCampaignsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentiveCampaignsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentiveCampaignsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Campaigns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Campaigns = append(m.Campaigns, IncentiveCampaign{})
+			if err := m.Campaigns[len(m.Campaigns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentiveScoresRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentiveScoresRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentiveScoresRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentiveScoresResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentiveScoresResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentiveScoresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scores = append(m.Scores, IncentiveScore{})
+			if err := m.Scores[len(m.Scores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,855 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/routed_order.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RouteLeg describes one hop of a routed market order. A LONG leg spends the
+// price denom to buy the asset denom; a SHORT leg sells the asset denom for
+// the price denom.
+type RouteLeg struct {
+	ContractAddr      string            `protobuf:"bytes,1,opt,name=contractAddr,proto3" json:"contract_address"`
+	PriceDenom        string            `protobuf:"bytes,2,opt,name=priceDenom,proto3" json:"price_denom"`
+	AssetDenom        string            `protobuf:"bytes,3,opt,name=assetDenom,proto3" json:"asset_denom"`
+	PositionDirection PositionDirection `protobuf:"varint,4,opt,name=positionDirection,proto3,enum=seiprotocol.seichain.dex.PositionDirection" json:"position_direction"`
+	Data              string            `protobuf:"bytes,5,opt,name=data,proto3" json:"data"`
+}
+
+func (m *RouteLeg) Reset()         { *m = RouteLeg{} }
+func (m *RouteLeg) String() string { return proto.CompactTextString(m) }
+func (*RouteLeg) ProtoMessage()    {}
+func (*RouteLeg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76e36aa004e107f2, []int{0}
+}
+func (m *RouteLeg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RouteLeg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RouteLeg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RouteLeg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteLeg.Merge(m, src)
+}
+func (m *RouteLeg) XXX_Size() int {
+	return m.Size()
+}
+func (m *RouteLeg) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteLeg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteLeg proto.InternalMessageInfo
+
+func (m *RouteLeg) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
+func (m *RouteLeg) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
+}
+
+func (m *RouteLeg) GetAssetDenom() string {
+	if m != nil {
+		return m.AssetDenom
+	}
+	return ""
+}
+
+func (m *RouteLeg) GetPositionDirection() PositionDirection {
+	if m != nil {
+		return m.PositionDirection
+	}
+	return PositionDirection_LONG
+}
+
+func (m *RouteLeg) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+// RoutedOrder is the in-block representation of a routed market order. Each
+// leg is materialized as an order with an ID allocated by its contract.
+type RoutedOrder struct {
+	Account      string                                 `protobuf:"bytes,1,opt,name=account,proto3" json:"account"`
+	Legs         []*Order                               `protobuf:"bytes,2,rep,name=legs,proto3" json:"legs"`
+	AmountIn     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=amountIn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"amount_in" yaml:"amount_in"`
+	MinAmountOut github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=minAmountOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_amount_out" yaml:"min_amount_out"`
+}
+
+func (m *RoutedOrder) Reset()         { *m = RoutedOrder{} }
+func (m *RoutedOrder) String() string { return proto.CompactTextString(m) }
+func (*RoutedOrder) ProtoMessage()    {}
+func (*RoutedOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76e36aa004e107f2, []int{1}
+}
+func (m *RoutedOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoutedOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoutedOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoutedOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoutedOrder.Merge(m, src)
+}
+func (m *RoutedOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoutedOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoutedOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoutedOrder proto.InternalMessageInfo
+
+func (m *RoutedOrder) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *RoutedOrder) GetLegs() []*Order {
+	if m != nil {
+		return m.Legs
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*RouteLeg)(nil), "seiprotocol.seichain.dex.RouteLeg")
+	proto.RegisterType((*RoutedOrder)(nil), "seiprotocol.seichain.dex.RoutedOrder")
+}
+
+func init() { proto.RegisterFile("dex/routed_order.proto", fileDescriptor_76e36aa004e107f2) }
+
+var fileDescriptor_76e36aa004e107f2 = []byte{
+	// 480 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x41, 0x6b, 0xdb, 0x30,
+	0x14, 0x8e, 0xd3, 0x6c, 0x4b, 0x94, 0xd2, 0x76, 0xa2, 0x2b, 0xa6, 0x0c, 0x2b, 0x18, 0x36, 0x02,
+	0x23, 0x36, 0x74, 0x97, 0x31, 0xd8, 0xa1, 0x21, 0x30, 0x06, 0x83, 0x0c, 0xdf, 0xb6, 0x8b, 0x71,
+	0x25, 0xe1, 0x8a, 0xc6, 0x52, 0x26, 0xc9, 0x90, 0x1e, 0xf6, 0x1f, 0xf6, 0x6f, 0xf6, 0x17, 0x7a,
+	0xec, 0x71, 0xec, 0xa0, 0x8d, 0xe4, 0xe6, 0x63, 0x7f, 0xc1, 0x90, 0x1c, 0xb7, 0xc9, 0x46, 0x0f,
+	0xbd, 0xc8, 0xef, 0x7d, 0xef, 0x7d, 0xdf, 0xf7, 0xf8, 0x30, 0x38, 0x22, 0x74, 0x11, 0x4b, 0x51,
+	0x6a, 0x4a, 0x52, 0x21, 0x09, 0x95, 0xd1, 0x5c, 0x0a, 0x2d, 0xa0, 0xaf, 0x28, 0x73, 0x15, 0x16,
+	0xb3, 0x48, 0x51, 0x86, 0xcf, 0x33, 0xc6, 0x23, 0x42, 0x17, 0xc7, 0x87, 0xb9, 0xc8, 0x85, 0x1b,
+	0xc5, 0xb6, 0xaa, 0xf7, 0x8f, 0xf7, 0xad, 0x0e, 0xe5, 0x65, 0xa1, 0x36, 0x81, 0x0d, 0xc5, 0xf0,
+	0x47, 0x1b, 0x74, 0x13, 0x6b, 0xf4, 0x91, 0xe6, 0xf0, 0x0d, 0xd8, 0xc5, 0x82, 0x6b, 0x99, 0x61,
+	0x7d, 0x4a, 0x88, 0xf4, 0xbd, 0x81, 0x37, 0xec, 0x8d, 0x0f, 0x2b, 0x83, 0x0e, 0x1a, 0x3c, 0xcd,
+	0x08, 0x91, 0x54, 0xa9, 0x64, 0x6b, 0x13, 0xc6, 0x00, 0xcc, 0x25, 0xc3, 0x74, 0x42, 0xb9, 0x28,
+	0xfc, 0xb6, 0xe3, 0xed, 0x57, 0x06, 0xf5, 0x1d, 0x9a, 0x12, 0x0b, 0x27, 0x1b, 0x2b, 0x96, 0x90,
+	0x29, 0x45, 0x75, 0x4d, 0xd8, 0xb9, 0x23, 0x38, 0xb4, 0x21, 0xdc, 0xad, 0xc0, 0xaf, 0xe0, 0xe9,
+	0x5c, 0x28, 0xa6, 0x99, 0xe0, 0x13, 0x26, 0x29, 0xb6, 0x85, 0xdf, 0x19, 0x78, 0xc3, 0xbd, 0x93,
+	0x57, 0xd1, 0x7d, 0xb1, 0x44, 0x9f, 0xfe, 0xa5, 0x8c, 0x8f, 0x2a, 0x83, 0x60, 0xa3, 0x94, 0x92,
+	0x06, 0x4f, 0xfe, 0x57, 0x87, 0xcf, 0x41, 0x87, 0x64, 0x3a, 0xf3, 0x1f, 0xb9, 0xeb, 0xba, 0x95,
+	0x41, 0xae, 0x4f, 0xdc, 0x1b, 0xfe, 0x6e, 0x83, 0xbe, 0x4b, 0x8e, 0x4c, 0x6d, 0x9e, 0xf0, 0x05,
+	0x78, 0x92, 0x61, 0x2c, 0x4a, 0xae, 0xd7, 0xb9, 0xf5, 0x2b, 0x83, 0x1a, 0x28, 0x69, 0x0a, 0xf8,
+	0x0e, 0x74, 0x66, 0x34, 0x57, 0x7e, 0x7b, 0xb0, 0x33, 0xec, 0x9f, 0xa0, 0xfb, 0x4f, 0x77, 0xaa,
+	0xb5, 0xab, 0x25, 0x24, 0xee, 0x85, 0x17, 0xa0, 0x9b, 0x15, 0x56, 0xe8, 0x03, 0x5f, 0xa7, 0x36,
+	0xbd, 0x32, 0xa8, 0xf5, 0xcb, 0xa0, 0x97, 0x39, 0xd3, 0xe7, 0xe5, 0x59, 0x84, 0x45, 0x11, 0x63,
+	0xa1, 0x0a, 0xa1, 0xd6, 0x9f, 0x91, 0x22, 0x17, 0xb1, 0xbe, 0x9c, 0x53, 0x15, 0x4d, 0x28, 0xae,
+	0x0c, 0xea, 0xd5, 0x0a, 0x29, 0xe3, 0x37, 0x06, 0x1d, 0x5c, 0x66, 0xc5, 0xec, 0x6d, 0x78, 0x0b,
+	0x85, 0xc9, 0xad, 0x01, 0xfc, 0x06, 0x76, 0x0b, 0xc6, 0x4f, 0x5d, 0x3b, 0x2d, 0xb5, 0x8b, 0xbb,
+	0x37, 0xfe, 0xfc, 0x60, 0xc3, 0xbd, 0x82, 0xf1, 0x74, 0xed, 0x20, 0x4a, 0x7d, 0x63, 0xd0, 0xb3,
+	0xda, 0x75, 0x1b, 0x0f, 0x93, 0x2d, 0xbb, 0xf1, 0xfb, 0xab, 0x65, 0xe0, 0x5d, 0x2f, 0x03, 0xef,
+	0xcf, 0x32, 0xf0, 0xbe, 0xaf, 0x82, 0xd6, 0xf5, 0x2a, 0x68, 0xfd, 0x5c, 0x05, 0xad, 0x2f, 0xa3,
+	0x0d, 0x6b, 0x45, 0xd9, 0xa8, 0x49, 0xd0, 0x35, 0x2e, 0xc2, 0x78, 0x11, 0xdb, 0x5f, 0xdd, 0x5d,
+	0x71, 0xf6, 0xd8, 0xcd, 0x5f, 0xff, 0x1d, 0x00, 0x99, 0xd7, 0x8c, 0x5c, 0x57, 0x03, 0x00, 0x00,
+}
+
+func (m *RouteLeg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouteLeg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RouteLeg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintRoutedOrder(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PositionDirection != 0 {
+		i = encodeVarintRoutedOrder(dAtA, i, uint64(m.PositionDirection))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AssetDenom) > 0 {
+		i -= len(m.AssetDenom)
+		copy(dAtA[i:], m.AssetDenom)
+		i = encodeVarintRoutedOrder(dAtA, i, uint64(len(m.AssetDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintRoutedOrder(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintRoutedOrder(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoutedOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoutedOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoutedOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinAmountOut.Size()
+		i -= size
+		if _, err := m.MinAmountOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRoutedOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.AmountIn.Size()
+		i -= size
+		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRoutedOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Legs) > 0 {
+		for iNdEx := len(m.Legs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Legs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRoutedOrder(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintRoutedOrder(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRoutedOrder(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRoutedOrder(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RouteLeg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovRoutedOrder(uint64(l))
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovRoutedOrder(uint64(l))
+	}
+	l = len(m.AssetDenom)
+	if l > 0 {
+		n += 1 + l + sovRoutedOrder(uint64(l))
+	}
+	if m.PositionDirection != 0 {
+		n += 1 + sovRoutedOrder(uint64(m.PositionDirection))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRoutedOrder(uint64(l))
+	}
+	return n
+}
+
+func (m *RoutedOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovRoutedOrder(uint64(l))
+	}
+	if len(m.Legs) > 0 {
+		for _, e := range m.Legs {
+			l = e.Size()
+			n += 1 + l + sovRoutedOrder(uint64(l))
+		}
+	}
+	l = m.AmountIn.Size()
+	n += 1 + l + sovRoutedOrder(uint64(l))
+	l = m.MinAmountOut.Size()
+	n += 1 + l + sovRoutedOrder(uint64(l))
+	return n
+}
+
+func sovRoutedOrder(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRoutedOrder(x uint64) (n int) {
+	return sovRoutedOrder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RouteLeg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRoutedOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RouteLeg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RouteLeg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoutedOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoutedOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoutedOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionDirection", wireType)
+			}
+			m.PositionDirection = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoutedOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionDirection |= PositionDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoutedOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRoutedOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoutedOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRoutedOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoutedOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoutedOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoutedOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Legs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoutedOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Legs = append(m.Legs, &Order{})
+			if err := m.Legs[len(m.Legs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoutedOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmountOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoutedOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinAmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRoutedOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRoutedOrder(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRoutedOrder
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRoutedOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRoutedOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRoutedOrder
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRoutedOrder
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRoutedOrder
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRoutedOrder        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRoutedOrder          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRoutedOrder = fmt.Errorf("proto: unexpected end of group")
+)
@@ -826,6 +826,249 @@ func (m *MsgUnsuspendContractResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUnsuspendContractResponse proto.InternalMessageInfo
 
+type MsgPlaceRoutedMarketOrder struct {
+	Creator      string                                   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator"`
+	Legs         []*RouteLeg                              `protobuf:"bytes,2,rep,name=legs,proto3" json:"legs"`
+	AmountIn     github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,3,opt,name=amountIn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"amount_in" yaml:"amount_in"`
+	MinAmountOut github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,4,opt,name=minAmountOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_amount_out" yaml:"min_amount_out"`
+	Funds        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=funds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"funds"`
+}
+
+func (m *MsgPlaceRoutedMarketOrder) Reset()         { *m = MsgPlaceRoutedMarketOrder{} }
+func (m *MsgPlaceRoutedMarketOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceRoutedMarketOrder) ProtoMessage()    {}
+func (*MsgPlaceRoutedMarketOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_463701e671e5a5e0, []int{17}
+}
+func (m *MsgPlaceRoutedMarketOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceRoutedMarketOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceRoutedMarketOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPlaceRoutedMarketOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceRoutedMarketOrder.Merge(m, src)
+}
+func (m *MsgPlaceRoutedMarketOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceRoutedMarketOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceRoutedMarketOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPlaceRoutedMarketOrder proto.InternalMessageInfo
+
+func (m *MsgPlaceRoutedMarketOrder) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgPlaceRoutedMarketOrder) GetLegs() []*RouteLeg {
+	if m != nil {
+		return m.Legs
+	}
+	return nil
+}
+
+func (m *MsgPlaceRoutedMarketOrder) GetFunds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Funds
+	}
+	return nil
+}
+
+type MsgPlaceRoutedMarketOrderResponse struct {
+	OrderIds []uint64 `protobuf:"varint,1,rep,packed,name=orderIds,proto3" json:"order_ids" yaml:"order_ids"`
+}
+
+func (m *MsgPlaceRoutedMarketOrderResponse) Reset()         { *m = MsgPlaceRoutedMarketOrderResponse{} }
+func (m *MsgPlaceRoutedMarketOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceRoutedMarketOrderResponse) ProtoMessage()    {}
+func (*MsgPlaceRoutedMarketOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_463701e671e5a5e0, []int{18}
+}
+func (m *MsgPlaceRoutedMarketOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceRoutedMarketOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceRoutedMarketOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPlaceRoutedMarketOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceRoutedMarketOrderResponse.Merge(m, src)
+}
+func (m *MsgPlaceRoutedMarketOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceRoutedMarketOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceRoutedMarketOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPlaceRoutedMarketOrderResponse proto.InternalMessageInfo
+
+func (m *MsgPlaceRoutedMarketOrderResponse) GetOrderIds() []uint64 {
+	if m != nil {
+		return m.OrderIds
+	}
+	return nil
+}
+
+type MsgCreateIncentiveCampaign struct {
+	Creator      string                                   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator"`
+	ContractAddr string                                   `protobuf:"bytes,2,opt,name=contractAddr,proto3" json:"contract_address"`
+	PriceDenom   string                                   `protobuf:"bytes,3,opt,name=priceDenom,proto3" json:"price_denom"`
+	AssetDenom   string                                   `protobuf:"bytes,4,opt,name=assetDenom,proto3" json:"asset_denom"`
+	RewardPool   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=rewardPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_pool"`
+	NumEpochs    uint64                                   `protobuf:"varint,6,opt,name=numEpochs,proto3" json:"num_epochs"`
+	MaxSpread    github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,7,opt,name=maxSpread,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_spread" yaml:"max_spread"`
+	MaxLevels    uint64                                   `protobuf:"varint,8,opt,name=maxLevels,proto3" json:"max_levels"`
+}
+
+func (m *MsgCreateIncentiveCampaign) Reset()         { *m = MsgCreateIncentiveCampaign{} }
+func (m *MsgCreateIncentiveCampaign) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateIncentiveCampaign) ProtoMessage()    {}
+func (*MsgCreateIncentiveCampaign) Descriptor() ([]byte, []int) {
+	return fileDescriptor_463701e671e5a5e0, []int{19}
+}
+func (m *MsgCreateIncentiveCampaign) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateIncentiveCampaign) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateIncentiveCampaign.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateIncentiveCampaign) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateIncentiveCampaign.Merge(m, src)
+}
+func (m *MsgCreateIncentiveCampaign) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateIncentiveCampaign) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateIncentiveCampaign.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateIncentiveCampaign proto.InternalMessageInfo
+
+func (m *MsgCreateIncentiveCampaign) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgCreateIncentiveCampaign) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
+func (m *MsgCreateIncentiveCampaign) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
+}
+
+func (m *MsgCreateIncentiveCampaign) GetAssetDenom() string {
+	if m != nil {
+		return m.AssetDenom
+	}
+	return ""
+}
+
+func (m *MsgCreateIncentiveCampaign) GetRewardPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RewardPool
+	}
+	return nil
+}
+
+func (m *MsgCreateIncentiveCampaign) GetNumEpochs() uint64 {
+	if m != nil {
+		return m.NumEpochs
+	}
+	return 0
+}
+
+func (m *MsgCreateIncentiveCampaign) GetMaxLevels() uint64 {
+	if m != nil {
+		return m.MaxLevels
+	}
+	return 0
+}
+
+type MsgCreateIncentiveCampaignResponse struct {
+	CampaignId uint64 `protobuf:"varint,1,opt,name=campaignId,proto3" json:"campaign_id"`
+}
+
+func (m *MsgCreateIncentiveCampaignResponse) Reset()         { *m = MsgCreateIncentiveCampaignResponse{} }
+func (m *MsgCreateIncentiveCampaignResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateIncentiveCampaignResponse) ProtoMessage()    {}
+func (*MsgCreateIncentiveCampaignResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_463701e671e5a5e0, []int{20}
+}
+func (m *MsgCreateIncentiveCampaignResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateIncentiveCampaignResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateIncentiveCampaignResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateIncentiveCampaignResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateIncentiveCampaignResponse.Merge(m, src)
+}
+func (m *MsgCreateIncentiveCampaignResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateIncentiveCampaignResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateIncentiveCampaignResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateIncentiveCampaignResponse proto.InternalMessageInfo
+
+func (m *MsgCreateIncentiveCampaignResponse) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgPlaceOrders)(nil), "seiprotocol.seichain.dex.MsgPlaceOrders")
 	proto.RegisterType((*MsgPlaceOrdersResponse)(nil), "seiprotocol.seichain.dex.MsgPlaceOrdersResponse")
@@ -844,73 +1087,101 @@ func init() {
 	proto.RegisterType((*MsgUpdateTickSizeResponse)(nil), "seiprotocol.seichain.dex.MsgUpdateTickSizeResponse")
 	proto.RegisterType((*MsgUnsuspendContract)(nil), "seiprotocol.seichain.dex.MsgUnsuspendContract")
 	proto.RegisterType((*MsgUnsuspendContractResponse)(nil), "seiprotocol.seichain.dex.MsgUnsuspendContractResponse")
+	proto.RegisterType((*MsgPlaceRoutedMarketOrder)(nil), "seiprotocol.seichain.dex.MsgPlaceRoutedMarketOrder")
+	proto.RegisterType((*MsgPlaceRoutedMarketOrderResponse)(nil), "seiprotocol.seichain.dex.MsgPlaceRoutedMarketOrderResponse")
+	proto.RegisterType((*MsgCreateIncentiveCampaign)(nil), "seiprotocol.seichain.dex.MsgCreateIncentiveCampaign")
+	proto.RegisterType((*MsgCreateIncentiveCampaignResponse)(nil), "seiprotocol.seichain.dex.MsgCreateIncentiveCampaignResponse")
 }
 
 func init() { proto.RegisterFile("dex/tx.proto", fileDescriptor_463701e671e5a5e0) }
 
 var fileDescriptor_463701e671e5a5e0 = []byte{
-	// 962 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0xae, 0x9b, 0x52, 0xba, 0xaf, 0xdd, 0x6e, 0xeb, 0x74, 0x17, 0xd7, 0xcb, 0xda, 0xc1, 0x12,
-	0x28, 0x80, 0x6a, 0xd3, 0xac, 0x80, 0x05, 0x89, 0x03, 0xc9, 0x4a, 0x68, 0x25, 0x22, 0x8a, 0x61,
-	0x41, 0xe2, 0x12, 0x39, 0xf6, 0xac, 0x3b, 0x34, 0xf5, 0x44, 0x9e, 0x09, 0x9b, 0x2e, 0x08, 0x09,
-	0x7e, 0x01, 0x07, 0x4e, 0x1c, 0x39, 0xf2, 0x0b, 0x90, 0x38, 0x72, 0xd9, 0x0b, 0xd2, 0x4a, 0x5c,
-	0x38, 0x19, 0xd4, 0xde, 0x72, 0xec, 0x2f, 0x40, 0x1e, 0xdb, 0x43, 0xec, 0x38, 0xc1, 0xd9, 0x03,
-	0x12, 0x27, 0x8f, 0x5f, 0xde, 0xf7, 0xbe, 0xf7, 0xbe, 0x37, 0xf3, 0xc6, 0x81, 0x2d, 0x0f, 0x8d,
-	0x2d, 0x36, 0x36, 0x87, 0x21, 0x61, 0x44, 0x56, 0x28, 0xc2, 0x7c, 0xe5, 0x92, 0x81, 0x49, 0x11,
-	0x76, 0x8f, 0x1d, 0x1c, 0x98, 0x1e, 0x1a, 0xab, 0x9a, 0x4b, 0xe8, 0x29, 0xa1, 0x56, 0xdf, 0xa1,
-	0xc8, 0xfa, 0xe2, 0xb0, 0x8f, 0x98, 0x73, 0x68, 0xb9, 0x04, 0x07, 0x09, 0x52, 0xdd, 0xf3, 0x89,
-	0x4f, 0xf8, 0xd2, 0x8a, 0x57, 0xa9, 0x55, 0x8e, 0xa3, 0xbb, 0x24, 0x60, 0xa1, 0xe3, 0xb2, 0xd4,
-	0x76, 0x2d, 0xb6, 0x91, 0xd0, 0x43, 0x61, 0x6a, 0xd8, 0x8e, 0x0d, 0x43, 0x07, 0x67, 0xef, 0x75,
-	0x9e, 0x12, 0x76, 0x4f, 0x7a, 0x14, 0x3f, 0x42, 0x89, 0xd1, 0xf8, 0x71, 0x15, 0xb6, 0xbb, 0xd4,
-	0x3f, 0x1a, 0x38, 0x2e, 0xfa, 0x20, 0x06, 0x53, 0xf9, 0x45, 0x78, 0xd6, 0x0d, 0x91, 0xc3, 0x48,
-	0xa8, 0x48, 0x0d, 0xa9, 0x79, 0xa5, 0xbd, 0x39, 0x89, 0xf4, 0xcc, 0x64, 0x67, 0x0b, 0xb9, 0x03,
-	0xeb, 0x9c, 0x8d, 0x2a, 0xab, 0x8d, 0x5a, 0x73, 0xb3, 0xa5, 0x9b, 0xf3, 0x8a, 0x34, 0x79, 0xe0,
-	0x36, 0x4c, 0x22, 0x3d, 0x85, 0xd8, 0xe9, 0x53, 0xbe, 0x03, 0x5b, 0x59, 0x19, 0xef, 0x7a, 0x5e,
-	0xa8, 0xd4, 0x38, 0xe1, 0xde, 0x24, 0xd2, 0x77, 0x32, 0x7b, 0xcf, 0xf1, 0xbc, 0x10, 0x51, 0x6a,
-	0xe7, 0x3c, 0xe5, 0xcf, 0xe1, 0x99, 0x07, 0xa3, 0xc0, 0xa3, 0xca, 0x1a, 0x67, 0xdf, 0x37, 0x13,
-	0x21, 0xcd, 0x58, 0x48, 0x33, 0x15, 0xd2, 0xec, 0x10, 0x1c, 0xb4, 0xdf, 0x7a, 0x1c, 0xe9, 0x2b,
-	0x93, 0x48, 0x4f, 0xfc, 0x7f, 0xfa, 0x53, 0x6f, 0xfa, 0x98, 0x1d, 0x8f, 0xfa, 0xa6, 0x4b, 0x4e,
-	0xad, 0x54, 0xfe, 0xe4, 0x71, 0x40, 0xbd, 0x13, 0x8b, 0x9d, 0x0d, 0x11, 0xe5, 0x48, 0x6a, 0x27,
-	0x10, 0xe3, 0x53, 0xb8, 0x91, 0xd7, 0xc8, 0x46, 0x74, 0x48, 0x02, 0x8a, 0xe4, 0x77, 0x60, 0x83,
-	0x57, 0x72, 0xcf, 0xa3, 0x8a, 0xd4, 0xa8, 0x35, 0xd7, 0xda, 0x2f, 0x4c, 0x22, 0xfd, 0x0a, 0xb7,
-	0xf5, 0xb0, 0x47, 0x2f, 0x23, 0x7d, 0xe7, 0xcc, 0x39, 0x1d, 0xbc, 0x6d, 0x08, 0x93, 0x61, 0x0b,
-	0x88, 0xf1, 0xbb, 0x04, 0xd7, 0xba, 0xd4, 0xef, 0x38, 0x81, 0x8b, 0x06, 0xcb, 0xc9, 0xdf, 0x83,
-	0xab, 0x2e, 0x87, 0x0d, 0x1c, 0x86, 0x49, 0x90, 0x75, 0xe1, 0xa5, 0xf9, 0x5d, 0xe8, 0x4c, 0xb9,
-	0xb7, 0x77, 0x27, 0x91, 0x9e, 0x0f, 0x60, 0xe7, 0x5f, 0x9f, 0xbe, 0x35, 0xc6, 0x3e, 0x3c, 0x57,
-	0x28, 0x2a, 0xd3, 0xcb, 0x18, 0x41, 0xbd, 0x4b, 0x7d, 0x1b, 0xf9, 0x98, 0x32, 0x14, 0x76, 0x52,
-	0x94, 0xac, 0x14, 0x6a, 0xfe, 0xa7, 0xcc, 0xbb, 0xb0, 0x91, 0xc5, 0x56, 0x56, 0x1b, 0x52, 0x73,
-	0xb3, 0xd5, 0x5c, 0x50, 0x61, 0xea, 0x79, 0x2f, 0x78, 0x40, 0x3e, 0x69, 0xd9, 0x02, 0x69, 0xdc,
-	0x82, 0x9b, 0x25, 0xb4, 0x22, 0xab, 0x1f, 0x24, 0xde, 0xe0, 0xcc, 0x7e, 0x17, 0x0d, 0x09, 0xc5,
-	0xcc, 0x46, 0x01, 0x9b, 0x51, 0x41, 0xaa, 0xbc, 0x41, 0x0d, 0x58, 0x77, 0x4e, 0xc9, 0x28, 0x48,
-	0xf2, 0x5e, 0x4b, 0xb6, 0x7f, 0x62, 0xb1, 0xd3, 0x67, 0xec, 0x43, 0x51, 0xe0, 0xa1, 0x4c, 0x5d,
-	0xee, 0x93, 0x58, 0xec, 0xf4, 0x69, 0x34, 0x40, 0x2b, 0xcf, 0x4d, 0xa4, 0x3f, 0x86, 0xeb, 0x5d,
-	0xea, 0xdf, 0x0f, 0xc2, 0xa2, 0xac, 0x15, 0xb7, 0x52, 0xb1, 0xc6, 0xd5, 0xca, 0x9d, 0xd6, 0xe1,
-	0x56, 0x29, 0xb3, 0x48, 0xed, 0x57, 0x09, 0x76, 0xa6, 0x94, 0x3f, 0x72, 0x70, 0x48, 0x17, 0x74,
-	0xfb, 0x7b, 0x09, 0x76, 0xfb, 0x0e, 0x73, 0x8f, 0x33, 0x96, 0x78, 0x7c, 0x29, 0x35, 0xbe, 0xb3,
-	0x5f, 0x9d, 0xdf, 0xf7, 0x76, 0x0c, 0xc9, 0xb8, 0x63, 0x0e, 0x71, 0xe6, 0xeb, 0x3c, 0x5a, 0x4f,
-	0x94, 0x11, 0xc7, 0xbb, 0x8c, 0x74, 0x35, 0x39, 0x93, 0x25, 0x3f, 0x1a, 0xf6, 0x6c, 0x02, 0x86,
-	0x0a, 0x4a, 0xb1, 0x08, 0x51, 0xe1, 0xcf, 0xc9, 0xde, 0xb9, 0x3f, 0xf4, 0x1c, 0x86, 0x8e, 0x42,
-	0xec, 0xa2, 0x8f, 0xb1, 0x7b, 0xf2, 0x11, 0x7e, 0x84, 0xaa, 0xca, 0xff, 0x10, 0xb6, 0x58, 0x0a,
-	0x79, 0x1f, 0x53, 0x96, 0x1e, 0x64, 0x63, 0x7e, 0xb9, 0x19, 0x41, 0xdb, 0x4a, 0xab, 0xdc, 0x16,
-	0x53, 0xbd, 0x37, 0xc0, 0x94, 0x5d, 0x46, 0xfa, 0xf5, 0xa4, 0xc0, 0xbc, 0xdd, 0xb0, 0x73, 0x44,
-	0xc6, 0x2f, 0x12, 0xec, 0x8b, 0xd4, 0x3f, 0x1c, 0x39, 0x01, 0xc3, 0xec, 0xec, 0x7f, 0x93, 0xfd,
-	0xcd, 0xa9, 0xe4, 0xb3, 0x98, 0xa2, 0x2b, 0x0f, 0x61, 0x8f, 0x6f, 0x4c, 0x3a, 0xa2, 0x43, 0x14,
-	0x78, 0xff, 0xdd, 0x89, 0xd0, 0xe0, 0xf9, 0x32, 0xe2, 0x2c, 0xb1, 0xd6, 0x6f, 0x1b, 0x50, 0xeb,
-	0x52, 0x5f, 0xc6, 0xb0, 0x39, 0x7d, 0xe7, 0x2e, 0x18, 0x6a, 0xf9, 0x9b, 0x47, 0x7d, 0xad, 0xaa,
-	0xa7, 0xb8, 0xa3, 0x06, 0xb0, 0x95, 0xbb, 0x60, 0x5e, 0x5e, 0x18, 0x61, 0xda, 0x55, 0x3d, 0xac,
-	0xec, 0x2a, 0xd8, 0xc6, 0xb0, 0x33, 0x33, 0xde, 0x0f, 0x16, 0x86, 0x29, 0xba, 0xab, 0xaf, 0x2f,
-	0xe5, 0x2e, 0x98, 0xbf, 0x91, 0xa0, 0x5e, 0x36, 0xc2, 0x17, 0x2b, 0x56, 0x82, 0x50, 0xef, 0x2c,
-	0x8b, 0x10, 0x39, 0x7c, 0x0d, 0x72, 0xc9, 0x1c, 0xb6, 0x16, 0xc6, 0x9b, 0x05, 0xa8, 0x6f, 0x2e,
-	0x09, 0x10, 0xfc, 0x04, 0xae, 0xe6, 0x67, 0xed, 0x2b, 0x95, 0xb4, 0xe4, 0xbe, 0x6a, 0xab, 0xba,
-	0xaf, 0x20, 0xfc, 0x0a, 0xea, 0x65, 0xa3, 0x6f, 0xb1, 0xe6, 0x25, 0x08, 0xf5, 0x76, 0x05, 0x44,
-	0xf1, 0x98, 0xcb, 0xdf, 0x4a, 0x70, 0x63, 0xce, 0xf8, 0xaa, 0x12, 0xaf, 0x08, 0x7a, 0xba, 0x24,
-	0xbe, 0x84, 0xdd, 0xd9, 0x41, 0x63, 0xfe, 0x4b, 0x07, 0x0b, 0xfe, 0xea, 0x1b, 0xcb, 0xf9, 0x67,
-	0xe4, 0xed, 0xf7, 0x1e, 0x9f, 0x6b, 0xd2, 0x93, 0x73, 0x4d, 0xfa, 0xeb, 0x5c, 0x93, 0xbe, 0xbb,
-	0xd0, 0x56, 0x9e, 0x5c, 0x68, 0x2b, 0x7f, 0x5c, 0x68, 0x2b, 0x9f, 0x1d, 0x4c, 0x7d, 0xe5, 0x52,
-	0x84, 0x0f, 0xb2, 0xe0, 0xfc, 0x85, 0x47, 0xb7, 0xc6, 0x16, 0xff, 0x4b, 0x10, 0x7f, 0xf0, 0xf6,
-	0xd7, 0xf9, 0xef, 0xb7, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x87, 0xb0, 0xd3, 0x48, 0xb9, 0x0c,
-	0x00, 0x00,
+	// 1345 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcb, 0x6f, 0xdc, 0xd4,
+	0x17, 0x8e, 0x93, 0x69, 0x9a, 0x9c, 0xa4, 0x69, 0xe2, 0xb4, 0xa9, 0xe3, 0xfe, 0x3a, 0x4e, 0x2d,
+	0xfd, 0xaa, 0xf0, 0xc8, 0x98, 0xa6, 0x3c, 0xca, 0x4b, 0xd0, 0x49, 0x10, 0x8a, 0xd4, 0xa8, 0xa9,
+	0x4b, 0x41, 0xb0, 0xb1, 0x6e, 0xec, 0xdb, 0xc9, 0x25, 0xe3, 0x7b, 0x47, 0xbe, 0x77, 0xda, 0x69,
+	0x79, 0x48, 0xb0, 0x44, 0x42, 0x62, 0xc1, 0x8a, 0x25, 0x2b, 0xc4, 0x5f, 0x80, 0xc4, 0x92, 0x4d,
+	0x97, 0x95, 0xd8, 0x20, 0x84, 0x0c, 0x6a, 0x77, 0xb3, 0xcc, 0x5f, 0x80, 0x7c, 0x6d, 0xdf, 0x79,
+	0x64, 0x66, 0x32, 0x13, 0x51, 0x24, 0x36, 0xb5, 0xfd, 0xcd, 0xf9, 0xce, 0x77, 0x5e, 0xf6, 0x3d,
+	0x0d, 0xcc, 0x06, 0xb8, 0xe1, 0x88, 0x46, 0xa9, 0x16, 0x31, 0xc1, 0x74, 0x83, 0x63, 0x22, 0xef,
+	0x7c, 0x56, 0x2d, 0x71, 0x4c, 0xfc, 0x3d, 0x44, 0x68, 0x29, 0xc0, 0x0d, 0xb3, 0xe8, 0x33, 0x1e,
+	0x32, 0xee, 0xec, 0x22, 0x8e, 0x9d, 0xbb, 0x97, 0x77, 0xb1, 0x40, 0x97, 0x1d, 0x9f, 0x11, 0x9a,
+	0x32, 0xcd, 0x33, 0x15, 0x56, 0x61, 0xf2, 0xd6, 0x49, 0xee, 0x32, 0x54, 0x4f, 0xbc, 0xfb, 0x8c,
+	0x8a, 0x08, 0xf9, 0x22, 0xc3, 0x16, 0x13, 0x8c, 0x50, 0x1f, 0x53, 0x41, 0xee, 0xe2, 0x0c, 0x3c,
+	0x9d, 0x80, 0x2c, 0x0a, 0x70, 0x94, 0x01, 0x73, 0x09, 0x50, 0x43, 0x24, 0x7f, 0x5e, 0x4a, 0x9e,
+	0x23, 0x56, 0x17, 0x38, 0xf0, 0xda, 0xed, 0xa4, 0x37, 0x41, 0xfc, 0x7d, 0x8f, 0x93, 0x07, 0x99,
+	0x37, 0xfb, 0xfb, 0x71, 0x98, 0xdb, 0xe6, 0x95, 0x9d, 0x2a, 0xf2, 0xf1, 0x8d, 0xc4, 0x98, 0xeb,
+	0xff, 0x87, 0x93, 0x7e, 0x84, 0x91, 0x60, 0x91, 0xa1, 0xad, 0x68, 0xab, 0xd3, 0xe5, 0x99, 0x66,
+	0x6c, 0xe5, 0x90, 0x9b, 0xdf, 0xe8, 0x1b, 0x30, 0x29, 0xbd, 0x73, 0x63, 0x7c, 0x65, 0x62, 0x75,
+	0x66, 0xdd, 0x2a, 0xf5, 0xab, 0x48, 0x49, 0x3a, 0x2e, 0x43, 0x33, 0xb6, 0x32, 0x8a, 0x9b, 0x5d,
+	0xf5, 0xab, 0x30, 0x9b, 0xe7, 0x7c, 0x2d, 0x08, 0x22, 0x63, 0x42, 0x0a, 0x9e, 0x69, 0xc6, 0xd6,
+	0x7c, 0x8e, 0x7b, 0x28, 0x08, 0x22, 0xcc, 0xb9, 0xdb, 0x61, 0xa9, 0x7f, 0x0c, 0x27, 0xee, 0xd4,
+	0x69, 0xc0, 0x8d, 0x82, 0x54, 0x5f, 0x2e, 0xa5, 0x55, 0x2f, 0x25, 0x55, 0x2f, 0x65, 0x55, 0x2f,
+	0x6d, 0x30, 0x42, 0xcb, 0xaf, 0x3e, 0x8c, 0xad, 0xb1, 0x66, 0x6c, 0xa5, 0xf6, 0x3f, 0xfe, 0x69,
+	0xad, 0x56, 0x88, 0xd8, 0xab, 0xef, 0x96, 0x7c, 0x16, 0x3a, 0x59, 0xaf, 0xd2, 0xcb, 0x1a, 0x0f,
+	0xf6, 0x1d, 0x71, 0xbf, 0x86, 0xb9, 0x64, 0x72, 0x37, 0xa5, 0xd8, 0x1f, 0xc0, 0x52, 0x67, 0x8d,
+	0x5c, 0xcc, 0x6b, 0x8c, 0x72, 0xac, 0xbf, 0x09, 0x53, 0x32, 0x93, 0xad, 0x80, 0x1b, 0xda, 0xca,
+	0xc4, 0x6a, 0xa1, 0x7c, 0xb1, 0x19, 0x5b, 0xd3, 0x12, 0xf3, 0x48, 0xc0, 0x0f, 0x62, 0x6b, 0xfe,
+	0x3e, 0x0a, 0xab, 0xaf, 0xd9, 0x0a, 0xb2, 0x5d, 0x45, 0xb1, 0x7f, 0xd5, 0xe0, 0xf4, 0x36, 0xaf,
+	0x6c, 0x20, 0xea, 0xe3, 0xea, 0x68, 0xe5, 0xf7, 0xe0, 0x94, 0x2f, 0x69, 0x55, 0x24, 0x08, 0xa3,
+	0x79, 0x17, 0x2e, 0xf5, 0xef, 0xc2, 0x46, 0x9b, 0x79, 0x79, 0xa1, 0x19, 0x5b, 0x9d, 0x0e, 0xdc,
+	0xce, 0xc7, 0xe3, 0xb7, 0xc6, 0x5e, 0x86, 0x73, 0x5d, 0x49, 0xe5, 0xf5, 0xb2, 0xeb, 0xb0, 0xb8,
+	0xcd, 0x2b, 0x2e, 0xae, 0x10, 0x2e, 0x70, 0xb4, 0x91, 0xb1, 0x74, 0xa3, 0x2b, 0xe7, 0x56, 0x9a,
+	0x9b, 0x30, 0x95, 0xfb, 0x36, 0xc6, 0x57, 0xb4, 0xd5, 0x99, 0xf5, 0xd5, 0x01, 0x19, 0x66, 0x96,
+	0x5b, 0xf4, 0x0e, 0x7b, 0x7f, 0xdd, 0x55, 0x4c, 0xfb, 0x02, 0x9c, 0xef, 0x21, 0xab, 0xa2, 0xfa,
+	0x4e, 0x93, 0x0d, 0xce, 0xf1, 0x4d, 0x5c, 0x63, 0x9c, 0x08, 0x17, 0x53, 0x71, 0xa8, 0x0a, 0xda,
+	0xd0, 0x03, 0x6a, 0xc3, 0x24, 0x0a, 0x59, 0x9d, 0xa6, 0x71, 0x17, 0xd2, 0xf1, 0x4f, 0x11, 0x37,
+	0xbb, 0x26, 0x36, 0x1c, 0xd3, 0x00, 0xe7, 0xd5, 0x95, 0x36, 0x29, 0xe2, 0x66, 0x57, 0x7b, 0x05,
+	0x8a, 0xbd, 0x63, 0x53, 0xe1, 0x37, 0xe0, 0xec, 0x36, 0xaf, 0xdc, 0xa6, 0x51, 0x77, 0x59, 0x87,
+	0x1c, 0xa5, 0xee, 0x1c, 0xc7, 0x87, 0xee, 0xb4, 0x05, 0x17, 0x7a, 0x2a, 0xab, 0xd0, 0x7e, 0xd1,
+	0x60, 0xbe, 0xad, 0xf2, 0x3b, 0x88, 0x44, 0x7c, 0x40, 0xb7, 0xbf, 0xd5, 0x60, 0x61, 0x17, 0x09,
+	0x7f, 0x2f, 0x57, 0x49, 0x3e, 0x6b, 0xc6, 0x84, 0x9c, 0xec, 0xe7, 0xfa, 0xf7, 0xbd, 0x9c, 0x50,
+	0x72, 0xed, 0x44, 0x43, 0xbd, 0xf3, 0x8b, 0xd2, 0x9b, 0xa7, 0xd2, 0x48, 0xfc, 0x1d, 0xc4, 0x96,
+	0x99, 0xbe, 0x93, 0x3d, 0x7e, 0xb4, 0xdd, 0xc3, 0x01, 0xd8, 0x26, 0x18, 0xdd, 0x49, 0xa8, 0x0c,
+	0x7f, 0x4a, 0x67, 0xe7, 0x76, 0x2d, 0x40, 0x02, 0xef, 0x44, 0xc4, 0xc7, 0xef, 0x11, 0x7f, 0xff,
+	0x16, 0x79, 0x80, 0x87, 0x2d, 0xff, 0x3d, 0x98, 0x15, 0x19, 0xe5, 0x3a, 0xe1, 0x22, 0x7b, 0x91,
+	0xed, 0xfe, 0xe9, 0xe6, 0x02, 0x65, 0x27, 0xcb, 0x72, 0x4e, 0x7d, 0xd5, 0xbd, 0x2a, 0xe1, 0xe2,
+	0x20, 0xb6, 0xce, 0xa6, 0x09, 0x76, 0xe2, 0xb6, 0xdb, 0x21, 0x64, 0xff, 0xac, 0xc1, 0xb2, 0x0a,
+	0xfd, 0x66, 0x1d, 0x51, 0x41, 0xc4, 0xfd, 0xff, 0x4c, 0xf4, 0xe7, 0xdb, 0x82, 0xcf, 0x7d, 0xaa,
+	0xae, 0xdc, 0x83, 0x33, 0xc9, 0x8f, 0x94, 0xd7, 0x79, 0x0d, 0xd3, 0xe0, 0xdf, 0x7b, 0x23, 0x8a,
+	0xf0, 0xbf, 0x5e, 0xc2, 0x2a, 0xb0, 0x3f, 0x26, 0x60, 0x39, 0x3f, 0x4b, 0x5c, 0x79, 0x46, 0x6f,
+	0xa3, 0x68, 0x1f, 0x0b, 0xf9, 0x9d, 0x1c, 0x36, 0xbc, 0xb7, 0xa1, 0x50, 0xc5, 0x15, 0x7e, 0x74,
+	0xad, 0xa5, 0xc2, 0x75, 0x5c, 0x29, 0x4f, 0x35, 0x63, 0x4b, 0x72, 0x5c, 0xf9, 0xaf, 0xbe, 0x0f,
+	0x53, 0xe9, 0x27, 0x68, 0x8b, 0x66, 0x9f, 0x9e, 0x1b, 0x49, 0x37, 0x7e, 0x8f, 0xad, 0x4b, 0x43,
+	0x1c, 0x8e, 0x9b, 0xd8, 0x4f, 0x4e, 0xb9, 0xd4, 0x83, 0x47, 0x68, 0xeb, 0x94, 0x53, 0x90, 0xed,
+	0x2a, 0x01, 0xfd, 0x33, 0x98, 0x0d, 0x09, 0xbd, 0x26, 0x1f, 0x6f, 0xd4, 0x85, 0x51, 0x90, 0x82,
+	0x1f, 0x8e, 0x2c, 0x38, 0x17, 0x12, 0xea, 0x65, 0x0a, 0xac, 0xde, 0x36, 0x28, 0x9d, 0xb8, 0xed,
+	0x76, 0xc8, 0xb5, 0x36, 0x85, 0x13, 0x4f, 0x7f, 0x53, 0xd8, 0x85, 0x8b, 0x7d, 0xbb, 0xfb, 0x4f,
+	0x2d, 0x0d, 0x3f, 0x14, 0xc0, 0x4c, 0x4e, 0x84, 0x64, 0x18, 0xf0, 0x56, 0xbe, 0x1d, 0x6e, 0xa0,
+	0xb0, 0x86, 0x48, 0x85, 0x3e, 0xf5, 0x11, 0xd7, 0x1d, 0x80, 0x5a, 0x44, 0x7c, 0xbc, 0x89, 0x29,
+	0x0b, 0xb3, 0xe9, 0x39, 0xdd, 0x8c, 0xad, 0x19, 0x89, 0x7a, 0x41, 0x02, 0xbb, 0x6d, 0x26, 0x09,
+	0x01, 0x71, 0x8e, 0x45, 0x4a, 0x28, 0xb4, 0x08, 0x12, 0xcd, 0x09, 0x2d, 0x13, 0xfd, 0x01, 0x40,
+	0x84, 0xef, 0xa1, 0x28, 0xd8, 0x61, 0xac, 0x7a, 0x74, 0xdb, 0xde, 0xca, 0xda, 0x36, 0x93, 0x92,
+	0xbc, 0x1a, 0x63, 0xd5, 0x91, 0x9a, 0xd7, 0xa6, 0xa6, 0x3f, 0x0f, 0xd3, 0xb4, 0x1e, 0xbe, 0x53,
+	0x63, 0xfe, 0x1e, 0x37, 0x26, 0xe5, 0xc9, 0x3d, 0xd7, 0x8c, 0x2d, 0xa0, 0xf5, 0xd0, 0xc3, 0x12,
+	0x75, 0x5b, 0x06, 0x3a, 0x83, 0xe9, 0x10, 0x35, 0x6e, 0xd5, 0x22, 0x8c, 0x02, 0xe3, 0xa4, 0xcc,
+	0xec, 0xe6, 0xc8, 0x73, 0x0d, 0x21, 0x6a, 0x78, 0x5c, 0xfa, 0x38, 0x88, 0xad, 0x85, 0x6c, 0xa6,
+	0x15, 0x66, 0xbb, 0x2d, 0x8d, 0x24, 0xbc, 0x10, 0x35, 0xae, 0xe3, 0xbb, 0xb8, 0xca, 0x8d, 0xa9,
+	0x56, 0x78, 0x89, 0x79, 0x55, 0xa2, 0x6e, 0xcb, 0xc0, 0xbe, 0x0d, 0x76, 0xff, 0x49, 0x51, 0xf3,
+	0xe8, 0x00, 0xf8, 0x19, 0xb6, 0x15, 0xc8, 0xa1, 0x29, 0xa4, 0xfd, 0xc9, 0x51, 0x8f, 0x04, 0x6e,
+	0x9b, 0xc9, 0x7a, 0x13, 0x60, 0x62, 0x9b, 0x57, 0x74, 0x02, 0x33, 0xed, 0xff, 0x71, 0x18, 0xb0,
+	0x99, 0x75, 0xae, 0xcf, 0xe6, 0x0b, 0xc3, 0x5a, 0xaa, 0x18, 0xab, 0x30, 0xdb, 0xb1, 0x25, 0x3f,
+	0x33, 0xd0, 0x43, 0xbb, 0xa9, 0x79, 0x79, 0x68, 0x53, 0xa5, 0xd6, 0x80, 0xf9, 0x43, 0x3b, 0xea,
+	0xda, 0x40, 0x37, 0xdd, 0xe6, 0xe6, 0x4b, 0x23, 0x99, 0x2b, 0xe5, 0x2f, 0x34, 0x58, 0xec, 0xb5,
+	0x87, 0x0e, 0xae, 0x58, 0x0f, 0x86, 0x79, 0x75, 0x54, 0x86, 0x8a, 0xe1, 0x73, 0xd0, 0x7b, 0x2c,
+	0x93, 0xce, 0x40, 0x7f, 0x87, 0x09, 0xe6, 0x2b, 0x23, 0x12, 0x94, 0x3e, 0x83, 0x53, 0x9d, 0x0b,
+	0xe3, 0xb3, 0x43, 0xd5, 0x52, 0xda, 0x9a, 0xeb, 0xc3, 0xdb, 0x2a, 0xc1, 0x4f, 0x61, 0xb1, 0xd7,
+	0xfe, 0x36, 0xb8, 0xe6, 0x3d, 0x18, 0xe6, 0x95, 0x21, 0x18, 0xdd, 0xbb, 0x8a, 0xfe, 0xa5, 0x06,
+	0x4b, 0x7d, 0x76, 0xb0, 0x61, 0xfc, 0x75, 0x93, 0x8e, 0x17, 0xc4, 0x27, 0xb0, 0x70, 0x78, 0x5b,
+	0x2a, 0x1d, 0xd1, 0xc1, 0x2e, 0x7b, 0xf3, 0xe5, 0xd1, 0xec, 0x95, 0xf8, 0x57, 0x1a, 0x2c, 0xf5,
+	0xd9, 0x88, 0xae, 0x1c, 0xfd, 0xa5, 0x38, 0x44, 0x32, 0x5f, 0x3f, 0x06, 0x49, 0x05, 0xf3, 0xb5,
+	0x06, 0xe7, 0xfa, 0x9d, 0xad, 0x2f, 0x0e, 0x7e, 0xa7, 0x7a, 0xb3, 0xcc, 0x37, 0x8e, 0xc3, 0xca,
+	0xe3, 0x29, 0xbf, 0xfb, 0xf0, 0x71, 0x51, 0x7b, 0xf4, 0xb8, 0xa8, 0xfd, 0xf5, 0xb8, 0xa8, 0x7d,
+	0xf3, 0xa4, 0x38, 0xf6, 0xe8, 0x49, 0x71, 0xec, 0xb7, 0x27, 0xc5, 0xb1, 0x8f, 0xd6, 0xda, 0x4e,
+	0x18, 0x8e, 0xc9, 0x5a, 0x2e, 0x21, 0x1f, 0xa4, 0x86, 0xd3, 0x70, 0xe4, 0x1f, 0x7d, 0x92, 0xc3,
+	0x66, 0x77, 0x52, 0xfe, 0x7e, 0xe5, 0xef, 0x01, 0x00, 0xeb, 0x31, 0x75, 0xb9, 0xc8, 0x12, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -934,6 +1205,8 @@ type MsgClient interface {
 	UpdatePriceTickSize(ctx context.Context, in *MsgUpdatePriceTickSize, opts ...grpc.CallOption) (*MsgUpdateTickSizeResponse, error)
 	UpdateQuantityTickSize(ctx context.Context, in *MsgUpdateQuantityTickSize, opts ...grpc.CallOption) (*MsgUpdateTickSizeResponse, error)
 	UnsuspendContract(ctx context.Context, in *MsgUnsuspendContract, opts ...grpc.CallOption) (*MsgUnsuspendContractResponse, error)
+	PlaceRoutedMarketOrder(ctx context.Context, in *MsgPlaceRoutedMarketOrder, opts ...grpc.CallOption) (*MsgPlaceRoutedMarketOrderResponse, error)
+	CreateIncentiveCampaign(ctx context.Context, in *MsgCreateIncentiveCampaign, opts ...grpc.CallOption) (*MsgCreateIncentiveCampaignResponse, error)
 }
 
 type msgClient struct {
@@ -1025,6 +1298,24 @@ func (c *msgClient) UnsuspendContract(ctx context.Context, in *MsgUnsuspendContr
 	return out, nil
 }
 
+func (c *msgClient) PlaceRoutedMarketOrder(ctx context.Context, in *MsgPlaceRoutedMarketOrder, opts ...grpc.CallOption) (*MsgPlaceRoutedMarketOrderResponse, error) {
+	out := new(MsgPlaceRoutedMarketOrderResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.dex.Msg/PlaceRoutedMarketOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateIncentiveCampaign(ctx context.Context, in *MsgCreateIncentiveCampaign, opts ...grpc.CallOption) (*MsgCreateIncentiveCampaignResponse, error) {
+	out := new(MsgCreateIncentiveCampaignResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.dex.Msg/CreateIncentiveCampaign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	PlaceOrders(context.Context, *MsgPlaceOrders) (*MsgPlaceOrdersResponse, error)
@@ -1036,6 +1327,8 @@ type MsgServer interface {
 	UpdatePriceTickSize(context.Context, *MsgUpdatePriceTickSize) (*MsgUpdateTickSizeResponse, error)
 	UpdateQuantityTickSize(context.Context, *MsgUpdateQuantityTickSize) (*MsgUpdateTickSizeResponse, error)
 	UnsuspendContract(context.Context, *MsgUnsuspendContract) (*MsgUnsuspendContractResponse, error)
+	PlaceRoutedMarketOrder(context.Context, *MsgPlaceRoutedMarketOrder) (*MsgPlaceRoutedMarketOrderResponse, error)
+	CreateIncentiveCampaign(context.Context, *MsgCreateIncentiveCampaign) (*MsgCreateIncentiveCampaignResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1069,6 +1362,12 @@ func (*UnimplementedMsgServer) UpdateQuantityTickSize(ctx context.Context, req *
 func (*UnimplementedMsgServer) UnsuspendContract(ctx context.Context, req *MsgUnsuspendContract) (*MsgUnsuspendContractResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnsuspendContract not implemented")
 }
+func (*UnimplementedMsgServer) PlaceRoutedMarketOrder(ctx context.Context, req *MsgPlaceRoutedMarketOrder) (*MsgPlaceRoutedMarketOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceRoutedMarketOrder not implemented")
+}
+func (*UnimplementedMsgServer) CreateIncentiveCampaign(ctx context.Context, req *MsgCreateIncentiveCampaign) (*MsgCreateIncentiveCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateIncentiveCampaign not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1236,6 +1535,42 @@ func _Msg_UnsuspendContract_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PlaceRoutedMarketOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceRoutedMarketOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceRoutedMarketOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.dex.Msg/PlaceRoutedMarketOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceRoutedMarketOrder(ctx, req.(*MsgPlaceRoutedMarketOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateIncentiveCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateIncentiveCampaign)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateIncentiveCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.dex.Msg/CreateIncentiveCampaign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateIncentiveCampaign(ctx, req.(*MsgCreateIncentiveCampaign))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "seiprotocol.seichain.dex.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1276,6 +1611,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UnsuspendContract",
 			Handler:    _Msg_UnsuspendContract_Handler,
 		},
+		{
+			MethodName: "PlaceRoutedMarketOrder",
+			Handler:    _Msg_PlaceRoutedMarketOrder_Handler,
+		},
+		{
+			MethodName: "CreateIncentiveCampaign",
+			Handler:    _Msg_CreateIncentiveCampaign_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dex/tx.proto",
@@ -1889,41 +2232,273 @@ func (m *MsgUnsuspendContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgPlaceRoutedMarketOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgPlaceOrders) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgPlaceRoutedMarketOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceRoutedMarketOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Orders) > 0 {
-		for _, e := range m.Orders {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.Funds) > 0 {
+		for iNdEx := len(m.Funds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Funds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	l = len(m.ContractAddr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.MinAmountOut.Size()
+		i -= size
+		if _, err := m.MinAmountOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.AmountIn.Size()
+		i -= size
+		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if len(m.Funds) > 0 {
-		for _, e := range m.Funds {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Legs) > 0 {
+		for iNdEx := len(m.Legs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Legs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceRoutedMarketOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceRoutedMarketOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceRoutedMarketOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrderIds) > 0 {
+		dAtA5 := make([]byte, len(m.OrderIds)*10)
+		var j4 int
+		for _, num := range m.OrderIds {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintTx(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateIncentiveCampaign) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateIncentiveCampaign) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateIncentiveCampaign) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxLevels != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxLevels))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.MaxSpread.Size()
+		i -= size
+		if _, err := m.MaxSpread.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.NumEpochs != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NumEpochs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.RewardPool) > 0 {
+		for iNdEx := len(m.RewardPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AssetDenom) > 0 {
+		i -= len(m.AssetDenom)
+		copy(dAtA[i:], m.AssetDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateIncentiveCampaignResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateIncentiveCampaignResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateIncentiveCampaignResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgPlaceOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Funds) > 0 {
+		for _, e := range m.Funds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
 		}
 	}
 	return n
@@ -2159,6 +2734,102 @@ func (m *MsgUnsuspendContractResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgPlaceRoutedMarketOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Legs) > 0 {
+		for _, e := range m.Legs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.AmountIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinAmountOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Funds) > 0 {
+		for _, e := range m.Funds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgPlaceRoutedMarketOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OrderIds) > 0 {
+		l = 0
+		for _, e := range m.OrderIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgCreateIncentiveCampaign) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AssetDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RewardPool) > 0 {
+		for _, e := range m.RewardPool {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.NumEpochs != 0 {
+		n += 1 + sovTx(uint64(m.NumEpochs))
+	}
+	l = m.MaxSpread.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.MaxLevels != 0 {
+		n += 1 + sovTx(uint64(m.MaxLevels))
+	}
+	return n
+}
+
+func (m *MsgCreateIncentiveCampaignResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		n += 1 + sovTx(uint64(m.CampaignId))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3798,6 +4469,703 @@ func (m *MsgUnsuspendContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgPlaceRoutedMarketOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceRoutedMarketOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceRoutedMarketOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Legs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Legs = append(m.Legs, &RouteLeg{})
+			if err := m.Legs[len(m.Legs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmountOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinAmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funds = append(m.Funds, types.Coin{})
+			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceRoutedMarketOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceRoutedMarketOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceRoutedMarketOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.OrderIds = append(m.OrderIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.OrderIds) == 0 {
+					m.OrderIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.OrderIds = append(m.OrderIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateIncentiveCampaign) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateIncentiveCampaign: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateIncentiveCampaign: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPool = append(m.RewardPool, types.Coin{})
+			if err := m.RewardPool[len(m.RewardPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochs", wireType)
+			}
+			m.NumEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpread", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSpread.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLevels", wireType)
+			}
+			m.MaxLevels = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLevels |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateIncentiveCampaignResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateIncentiveCampaignResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateIncentiveCampaignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -45,10 +45,20 @@ func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
 			}
 		}
 
+		totalBondedPower := sdk.TokensToConsensusPower(k.StakingKeeper.TotalBondedTokens(ctx), powerReduction)
+
 		voteTargets := make(map[string]types.Denom)
+		rewardBands := make(map[string]sdk.Dec)
+		// misses on the vote targets on probation aren't penalized
+		probationTargets := make(map[string]struct{})
 		totalTargets := 0
 		k.IterateVoteTargets(ctx, func(denom string, denomInfo types.Denom) bool {
 			voteTargets[denom] = denomInfo
+			rewardBands[denom] = denomInfo.GetRewardBand(params.RewardBand)
+			if k.IsVoteTargetOnProbation(ctx, denom) {
+				probationTargets[denom] = struct{}{}
+				return false
+			}
 			totalTargets++
 			return false
 		})
@@ -60,6 +70,7 @@ func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
 		// belowThresholdVoteMap has assets that failed to meet threshold
 		referenceDenom, belowThresholdVoteMap := pickReferenceDenom(ctx, k, voteTargets, voteMap)
 
+		medians := types.ExchangeRateTuples{}
 		if referenceDenom != "" {
 			// make voteMap of Reference denom to calculate cross exchange rates
 			ballotRD := voteMap[referenceDenom]
@@ -75,6 +86,7 @@ func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
 				j++
 			}
 			sort.Strings(keys)
+			exchangeRates := make(map[string]sdk.Dec, len(keys))
 			for _, denom := range keys {
 				ballot := voteMap[denom]
 				// Convert ballot to cross exchange rates
@@ -83,7 +95,7 @@ func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
 				}
 
 				// Get weighted median of cross exchange rates
-				exchangeRate := Tally(ctx, ballot, params.RewardBand, validatorClaimMap)
+				exchangeRate := Tally(ctx, ballot, rewardBands[denom], validatorClaimMap)
 
 				// Transform into the original form base/quote
 				if denom != referenceDenom {
@@ -93,7 +105,13 @@ func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
 				// Set the exchange rate, emit ABCI event
 				metrics.IncrPriceUpdateDenom(denom)
 				k.SetBaseExchangeRateWithEvent(ctx, denom, exchangeRate)
+				k.SetExchangeRateConfidence(ctx, denom, voteMap[denom].Confidence(exchangeRate, totalBondedPower))
+				medians = append(medians, types.NewExchangeRateTuple(denom, exchangeRate))
+				exchangeRates[denom] = exchangeRate
 			}
+
+			// Derive the exchange rates of denoms without ballots of their own
+			k.SetDerivedExchangeRates(ctx, params.DerivedDenoms, exchangeRates)
 		}
 
 		belowThresholdKeys := make([]string, len(belowThresholdVoteMap))
@@ -107,27 +125,55 @@ func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
 		for _, denom := range belowThresholdKeys {
 			ballot := belowThresholdVoteMap[denom]
 			// perform tally for below threshold assets to calculate total win count
-			Tally(ctx, ballot, params.RewardBand, validatorClaimMap)
+			Tally(ctx, ballot, rewardBands[denom], validatorClaimMap)
+		}
+
+		// validators that prevoted in the previous vote period but didn't reveal
+		unrevealedPrevoters := map[string]struct{}{}
+		if params.RequirePrevote {
+			unrevealedPrevoters = k.GetUnrevealedPrevoters(ctx, params.VotePeriod)
 		}
 
 		//---------------------------
 		// Do miss counting & slashing
-		for _, claim := range validatorClaimMap {
+		outcomes := make(map[string]types.VoteOutcome, len(validatorClaimMap))
+		for voter, claim := range validatorClaimMap {
 			// we require validator to have submitted in-range data
-			// for all assets to not be counted as a miss
-			if int(claim.WinCount) == totalTargets {
+			// for all assets not on probation to not be counted as a miss
+			winCount := 0
+			for _, denom := range claim.WinDenoms {
+				if _, ok := probationTargets[denom]; !ok {
+					winCount++
+				}
+			}
+			if winCount == totalTargets {
 				k.IncrementSuccessCount(ctx, claim.Recipient)
+				outcomes[voter] = types.VoteOutcome_VOTE_OUTCOME_SUCCESS
 				continue
 			}
 			if !claim.DidVote {
+				// committing to a vote without revealing it counts as a miss
+				if _, ok := unrevealedPrevoters[claim.Recipient.String()]; ok {
+					k.IncrementMissCount(ctx, claim.Recipient)
+					outcomes[voter] = types.VoteOutcome_VOTE_OUTCOME_MISS
+					continue
+				}
 				k.IncrementAbstainCount(ctx, claim.Recipient)
+				outcomes[voter] = types.VoteOutcome_VOTE_OUTCOME_ABSTAIN
 				continue
 			}
 
 			// Increase miss counter
 			k.IncrementMissCount(ctx, claim.Recipient)
+			outcomes[voter] = types.VoteOutcome_VOTE_OUTCOME_MISS
 		}
 
+		// Keep a summary of the ballot before the votes are cleared
+		k.AddVotePeriodBallot(ctx, k.BuildVotePeriodBallot(ctx, params.VotePeriod, medians, validatorClaimMap, outcomes))
+
+		// Distribute rewards to ballot winners
+		k.RewardBallotWinners(ctx, params.VotePeriod, params.RewardDistributionWindow, validatorClaimMap)
+
 		// Clear the ballot
 		k.ClearBallots(ctx, params.VotePeriod)
 
@@ -136,6 +182,11 @@ func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
 
 		priceSnapshotItems := []types.PriceSnapshotItem{}
 		k.IterateBaseExchangeRates(ctx, func(denom string, exchangeRate types.OracleExchangeRate) bool {
+			// the rates of removed vote targets are only kept until their sunset
+			// period ends, and aren't snapshotted
+			if k.IsVoteTargetInSunset(ctx, denom) {
+				return false
+			}
 			priceSnapshotItem := types.PriceSnapshotItem{
 				Denom:              denom,
 				OracleExchangeRate: exchangeRate,
@@ -165,4 +216,7 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 		// Compare vote targets and actives and remove excess feeds
 		k.RemoveExcessFeeds(ctx)
 	}
+
+	// End the probation and sunset periods of the vote targets added and removed through governance
+	k.EndVoteTargetTransitions(ctx)
 }
@@ -58,6 +58,14 @@ func TestOracleThreshold(t *testing.T) {
 	require.Equal(t, randomExchangeRate, rate)
 	require.Equal(t, int64(1), lastUpdate.Int64())
 
+	// all three validators voted for the same rate
+	confidence, found := input.OracleKeeper.GetExchangeRateConfidence(input.Ctx, utils.MicroAtomDenom)
+	require.True(t, found)
+	require.Equal(t, uint64(3), confidence.NumVoters)
+	require.Equal(t, sdk.OneDec(), confidence.VotingPowerShare)
+	require.True(t, confidence.StandardDeviation.IsZero())
+	require.True(t, confidence.Spread.IsZero())
+
 	// Case 3.
 	// Increase voting power of absent validator, exchange rate consensus fails
 	val, _ := input.StakingKeeper.GetValidator(input.Ctx, keeper.ValAddrs[2])
@@ -81,6 +89,39 @@ func TestOracleThreshold(t *testing.T) {
 	require.Equal(t, int64(1), lastUpdate.Int64())
 }
 
+func TestOracleThresholdPerDenom(t *testing.T) {
+	input, h := setup(t)
+	voteThreshold := sdk.NewDecWithPrec(30, 2)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: utils.MicroAtomDenom, VoteThreshold: &voteThreshold, MinVoters: 2}}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	input.OracleKeeper.ClearVoteTargets(input.Ctx)
+	input.OracleKeeper.SetVoteTarget(input.Ctx, params.Whitelist[0])
+
+	// Case 1.
+	// The lowered threshold is met, but not by enough voters
+	makeAggregateVote(t, input, h, 0, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate}}, 0)
+
+	oracle.MidBlocker(input.Ctx, input.OracleKeeper)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	_, _, _, err := input.OracleKeeper.GetBaseExchangeRate(input.Ctx, utils.MicroAtomDenom)
+	require.Error(t, err)
+
+	// Case 2.
+	// Two of three validators vote, which is below the global threshold
+	// but meets the denom's threshold and voter count
+	makeAggregateVote(t, input, h, 0, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate}}, 0)
+	makeAggregateVote(t, input, h, 0, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate}}, 1)
+
+	oracle.MidBlocker(input.Ctx, input.OracleKeeper)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	rate, _, _, err := input.OracleKeeper.GetBaseExchangeRate(input.Ctx, utils.MicroAtomDenom)
+	require.NoError(t, err)
+	require.Equal(t, randomExchangeRate, rate)
+}
+
 func TestOracleDrop(t *testing.T) {
 	input, h := setup(t)
 
@@ -168,6 +209,7 @@ func TestOracleTally(t *testing.T) {
 			!vote.ExchangeRate.IsPositive() {
 			claim.Weight += vote.Power
 			claim.WinCount++
+			claim.WinDenoms = append(claim.WinDenoms, vote.Denom)
 		}
 		claim.DidVote = true
 		expectedValidatorClaimMap[key] = claim
@@ -210,7 +252,7 @@ func TestInvalidVotesSlashing(t *testing.T) {
 	params := input.OracleKeeper.GetParams(input.Ctx)
 	params.Whitelist = types.DenomList{{Name: utils.MicroAtomDenom}}
 	input.OracleKeeper.SetParams(input.Ctx, params)
-	input.OracleKeeper.SetVoteTarget(input.Ctx, utils.MicroAtomDenom)
+	input.OracleKeeper.SetVoteTarget(input.Ctx, types.Denom{Name: utils.MicroAtomDenom})
 
 	votePeriodsPerWindow := sdk.NewDec(int64(input.OracleKeeper.SlashWindow(input.Ctx))).QuoInt64(int64(input.OracleKeeper.VotePeriod(input.Ctx))).TruncateInt64()
 	slashFraction := input.OracleKeeper.SlashFraction(input.Ctx)
@@ -300,7 +342,7 @@ func TestNotPassedBallotSlashing(t *testing.T) {
 	input.OracleKeeper.SetParams(input.Ctx, params)
 
 	input.OracleKeeper.ClearVoteTargets(input.Ctx)
-	input.OracleKeeper.SetVoteTarget(input.Ctx, utils.MicroAtomDenom)
+	input.OracleKeeper.SetVoteTarget(input.Ctx, types.Denom{Name: utils.MicroAtomDenom})
 
 	input.Ctx = input.Ctx.WithBlockHeight(input.Ctx.BlockHeight() + 1)
 
@@ -325,7 +367,7 @@ func TestNotPassedBallotSlashingInvalidVotes(t *testing.T) {
 	input.OracleKeeper.SetParams(input.Ctx, params)
 
 	input.OracleKeeper.ClearVoteTargets(input.Ctx)
-	input.OracleKeeper.SetVoteTarget(input.Ctx, utils.MicroAtomDenom)
+	input.OracleKeeper.SetVoteTarget(input.Ctx, types.Denom{Name: utils.MicroAtomDenom})
 
 	input.Ctx = input.Ctx.WithBlockHeight(input.Ctx.BlockHeight() + 1)
 
@@ -365,8 +407,8 @@ func TestInvalidVoteOnAssetUnderThresholdMisses(t *testing.T) {
 	input.OracleKeeper.SetParams(input.Ctx, params)
 
 	input.OracleKeeper.ClearVoteTargets(input.Ctx)
-	input.OracleKeeper.SetVoteTarget(input.Ctx, utils.MicroAtomDenom)
-	input.OracleKeeper.SetVoteTarget(input.Ctx, utils.MicroEthDenom)
+	input.OracleKeeper.SetVoteTarget(input.Ctx, types.Denom{Name: utils.MicroAtomDenom})
+	input.OracleKeeper.SetVoteTarget(input.Ctx, types.Denom{Name: utils.MicroEthDenom})
 
 	input.Ctx = input.Ctx.WithBlockHeight(input.Ctx.BlockHeight() + 1)
 
@@ -459,7 +501,7 @@ func TestAbstainSlashing(t *testing.T) {
 	input.OracleKeeper.SetParams(input.Ctx, params)
 
 	input.OracleKeeper.ClearVoteTargets(input.Ctx)
-	input.OracleKeeper.SetVoteTarget(input.Ctx, utils.MicroAtomDenom)
+	input.OracleKeeper.SetVoteTarget(input.Ctx, types.Denom{Name: utils.MicroAtomDenom})
 
 	votePeriodsPerWindow := sdk.NewDec(int64(input.OracleKeeper.SlashWindow(input.Ctx))).QuoInt64(int64(input.OracleKeeper.VotePeriod(input.Ctx))).TruncateInt64()
 	minValidPerWindow := input.OracleKeeper.MinValidPerWindow(input.Ctx)
@@ -493,6 +535,82 @@ func TestAbstainSlashing(t *testing.T) {
 	require.True(t, validator.IsJailed())
 }
 
+func TestUnrevealedPrevoteMisses(t *testing.T) {
+	input, h := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: utils.MicroAtomDenom}}
+	params.RequirePrevote = true
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	input.OracleKeeper.ClearVoteTargets(input.Ctx)
+	input.OracleKeeper.SetVoteTarget(input.Ctx, types.Denom{Name: utils.MicroAtomDenom})
+
+	salt := "1"
+	rates := sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate}}
+	for i := 0; i < 3; i++ {
+		hash := types.GetAggregateVoteHash(salt, rates.String(), keeper.ValAddrs[i])
+		_, err := h(input.Ctx.WithBlockHeight(1), types.NewMsgAggregateExchangeRatePrevote(hash, keeper.Addrs[i], keeper.ValAddrs[i]))
+		require.NoError(t, err)
+	}
+	oracle.MidBlocker(input.Ctx.WithBlockHeight(1), input.OracleKeeper)
+	oracle.EndBlocker(input.Ctx.WithBlockHeight(1), input.OracleKeeper)
+
+	// validators 0 and 1 reveal their prevotes, validator 2 does not
+	for i := 0; i < 2; i++ {
+		voteMsg := types.NewMsgAggregateExchangeRateVote(rates.String(), keeper.Addrs[i], keeper.ValAddrs[i])
+		voteMsg.Salt = salt
+		_, err := h(input.Ctx.WithBlockHeight(2), voteMsg)
+		require.NoError(t, err)
+	}
+	oracle.MidBlocker(input.Ctx.WithBlockHeight(2), input.OracleKeeper)
+	oracle.EndBlocker(input.Ctx.WithBlockHeight(2), input.OracleKeeper)
+
+	rate, _, _, err := input.OracleKeeper.GetBaseExchangeRate(input.Ctx.WithBlockHeight(2), utils.MicroAtomDenom)
+	require.NoError(t, err)
+	require.Equal(t, randomExchangeRate, rate)
+
+	require.Equal(t, uint64(0), input.OracleKeeper.GetMissCount(input.Ctx, keeper.ValAddrs[0]))
+	require.Equal(t, uint64(0), input.OracleKeeper.GetMissCount(input.Ctx, keeper.ValAddrs[1]))
+	// committing to a vote and not revealing it counts as a miss rather than an abstain,
+	// the only abstain is from the first vote period where nothing could be revealed yet
+	require.Equal(t, uint64(1), input.OracleKeeper.GetMissCount(input.Ctx, keeper.ValAddrs[2]))
+	require.Equal(t, uint64(1), input.OracleKeeper.GetAbstainCount(input.Ctx, keeper.ValAddrs[2]))
+
+	// the unrevealed prevote is cleared after the tally
+	_, err = input.OracleKeeper.GetAggregateExchangeRatePrevote(input.Ctx, keeper.ValAddrs[2])
+	require.Error(t, err)
+}
+
+func TestOracleRewardDistribution(t *testing.T) {
+	input, h := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: utils.MicroAtomDenom}}
+	params.RewardDistributionWindow = 100
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	input.OracleKeeper.ClearVoteTargets(input.Ctx)
+	input.OracleKeeper.SetVoteTarget(input.Ctx, types.Denom{Name: utils.MicroAtomDenom})
+
+	rewardPool := sdk.NewCoins(sdk.NewCoin(utils.MicroSeiDenom, sdk.NewInt(30_000_000)))
+	err := keeper.FundAccount(input, input.AccountKeeper.GetModuleAddress(types.ModuleName), rewardPool)
+	require.NoError(t, err)
+
+	// Account 1, 2 vote inside the reward band, account 3 outside of it
+	makeAggregateVote(t, input, h, 0, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate}}, 0)
+	makeAggregateVote(t, input, h, 0, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate}}, 1)
+	makeAggregateVote(t, input, h, 0, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate.MulInt64(2)}}, 2)
+
+	oracle.MidBlocker(input.Ctx, input.OracleKeeper)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	// 1 / 100 of the pool is split between the two winners
+	expectedReward := sdk.NewCoins(sdk.NewCoin(utils.MicroSeiDenom, sdk.NewInt(150_000)))
+	require.Equal(t, expectedReward, input.OracleKeeper.GetOracleRewards(input.Ctx, keeper.ValAddrs[0]))
+	require.Equal(t, expectedReward, input.OracleKeeper.GetOracleRewards(input.Ctx, keeper.ValAddrs[1]))
+	require.True(t, input.OracleKeeper.GetOracleRewards(input.Ctx, keeper.ValAddrs[2]).IsZero())
+	require.Equal(t, sdk.NewInt(29_700_000), input.OracleKeeper.GetRewardPool(input.Ctx, utils.MicroSeiDenom).Amount)
+}
+
 func TestVoteTargets(t *testing.T) {
 	input, h := setup(t)
 	params := input.OracleKeeper.GetParams(input.Ctx)
@@ -500,7 +618,7 @@ func TestVoteTargets(t *testing.T) {
 	input.OracleKeeper.SetParams(input.Ctx, params)
 
 	input.OracleKeeper.ClearVoteTargets(input.Ctx)
-	input.OracleKeeper.SetVoteTarget(input.Ctx, utils.MicroAtomDenom)
+	input.OracleKeeper.SetVoteTarget(input.Ctx, types.Denom{Name: utils.MicroAtomDenom})
 
 	// KRW
 	makeAggregateVote(t, input, h, 0, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate}}, 0)
@@ -566,7 +684,7 @@ func TestAbstainWithSmallStakingPower(t *testing.T) {
 	input, h := setupWithSmallVotingPower(t)
 
 	input.OracleKeeper.ClearVoteTargets(input.Ctx)
-	input.OracleKeeper.SetVoteTarget(input.Ctx, utils.MicroAtomDenom)
+	input.OracleKeeper.SetVoteTarget(input.Ctx, types.Denom{Name: utils.MicroAtomDenom})
 	makeAggregateVote(t, input, h, 0, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: sdk.ZeroDec()}}, 0)
 
 	oracle.MidBlocker(input.Ctx, input.OracleKeeper)
@@ -634,6 +752,177 @@ func TestOraclePriceSnapshot(t *testing.T) {
 	require.Equal(t, expected2, input.OracleKeeper.GetPriceSnapshot(input.Ctx, 200))
 }
 
+func TestOraclePriceSnapshotSkipsSunsetDenoms(t *testing.T) {
+	input, h := setup(t)
+	input.Ctx = input.Ctx.WithBlockTime(time.Unix(100, 0))
+
+	// ueth was removed from the vote targets and is in its sunset period
+	input.OracleKeeper.SetBaseExchangeRate(input.Ctx, utils.MicroEthDenom, randomExchangeRate)
+	input.OracleKeeper.SetVoteTargetSunset(input.Ctx, utils.MicroEthDenom, input.Ctx.BlockHeight()+10)
+
+	rates := sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate}}
+	makeAggregateVote(t, input, h, 0, rates, 0)
+	makeAggregateVote(t, input, h, 0, rates, 1)
+	makeAggregateVote(t, input, h, 0, rates, 2)
+
+	oracle.MidBlocker(input.Ctx, input.OracleKeeper)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	// the sunset rate is still served, but isn't snapshotted
+	rate, _, _, err := input.OracleKeeper.GetBaseExchangeRate(input.Ctx, utils.MicroEthDenom)
+	require.NoError(t, err)
+	require.Equal(t, randomExchangeRate, rate)
+	snapshot := input.OracleKeeper.GetPriceSnapshot(input.Ctx, 100)
+	require.Len(t, snapshot.PriceSnapshotItems, 1)
+	require.Equal(t, utils.MicroAtomDenom, snapshot.PriceSnapshotItems[0].Denom)
+}
+
+func TestVoteHistory(t *testing.T) {
+	input, h := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: utils.MicroAtomDenom}}
+	params.VoteHistoryRetention = 2
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	input.OracleKeeper.SetVoteTarget(input.Ctx, types.Denom{Name: utils.MicroAtomDenom})
+
+	for height := int64(1); height <= 3; height++ {
+		input.Ctx = input.Ctx.WithBlockHeight(height)
+
+		makeAggregateVote(t, input, h, height, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate}}, 0)
+		// far away from the median, counted as a miss
+		makeAggregateVote(t, input, h, height, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate.Add(sdk.NewDec(100000000000000))}}, 1)
+		makeAggregateVote(t, input, h, height, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate}}, 2)
+
+		oracle.MidBlocker(input.Ctx, input.OracleKeeper)
+		oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	}
+
+	// only the last two vote periods are retained
+	_, found := input.OracleKeeper.GetVotePeriodBallot(input.Ctx, 1)
+	require.False(t, found)
+
+	ballot, found := input.OracleKeeper.GetVotePeriodBallot(input.Ctx, 3)
+	require.True(t, found)
+	require.Equal(t, int64(3), ballot.BlockHeight)
+	require.Equal(t, types.ExchangeRateTuples{types.NewExchangeRateTuple(utils.MicroAtomDenom, randomExchangeRate)}, ballot.Medians)
+	require.Len(t, ballot.ValidatorBallots, 3)
+
+	outcomes := map[string]types.VoteOutcome{}
+	for _, validatorBallot := range ballot.ValidatorBallots {
+		outcomes[validatorBallot.Voter] = validatorBallot.Outcome
+		require.Len(t, validatorBallot.Votes, 1)
+		require.Equal(t, validatorBallot.Voter != keeper.ValAddrs[1].String(), validatorBallot.Votes[0].InBand)
+	}
+	require.Equal(t, types.VoteOutcome_VOTE_OUTCOME_SUCCESS, outcomes[keeper.ValAddrs[0].String()])
+	require.Equal(t, types.VoteOutcome_VOTE_OUTCOME_MISS, outcomes[keeper.ValAddrs[1].String()])
+	require.Equal(t, types.VoteOutcome_VOTE_OUTCOME_SUCCESS, outcomes[keeper.ValAddrs[2].String()])
+
+	// an abstaining validator is recorded without votes
+	input.Ctx = input.Ctx.WithBlockHeight(4)
+	makeAggregateVote(t, input, h, 4, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate}}, 0)
+	makeAggregateVote(t, input, h, 4, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate}}, 2)
+	oracle.MidBlocker(input.Ctx, input.OracleKeeper)
+
+	ballot, found = input.OracleKeeper.GetVotePeriodBallot(input.Ctx, 4)
+	require.True(t, found)
+	for _, validatorBallot := range ballot.ValidatorBallots {
+		if validatorBallot.Voter == keeper.ValAddrs[1].String() {
+			require.Equal(t, types.VoteOutcome_VOTE_OUTCOME_ABSTAIN, validatorBallot.Outcome)
+			require.Empty(t, validatorBallot.Votes)
+		}
+	}
+}
+
+func TestDerivedExchangeRates(t *testing.T) {
+	input, h := setup(t)
+	derivedDenom := "uatom/ueth"
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: utils.MicroAtomDenom}, {Name: utils.MicroEthDenom}}
+	params.DerivedDenoms = types.DerivedDenomList{
+		{Name: derivedDenom, Numerators: []string{utils.MicroAtomDenom}, Denominators: []string{utils.MicroEthDenom}},
+	}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	input.OracleKeeper.ClearVoteTargets(input.Ctx)
+	input.OracleKeeper.SetVoteTarget(input.Ctx, types.Denom{Name: utils.MicroAtomDenom})
+	input.OracleKeeper.SetVoteTarget(input.Ctx, types.Denom{Name: utils.MicroEthDenom})
+
+	rates := sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: sdk.NewDec(10)}, {Denom: utils.MicroEthDenom, Amount: sdk.NewDec(2000)}}
+	for height := int64(1); height <= 2; height++ {
+		input.Ctx = input.Ctx.WithBlockHeight(height).WithBlockTime(time.Unix(100*height, 0))
+		for idx := 0; idx < 3; idx++ {
+			makeAggregateVote(t, input, h, height, rates, idx)
+		}
+		oracle.MidBlocker(input.Ctx, input.OracleKeeper)
+		oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	}
+
+	rate, lastUpdate, _, err := input.OracleKeeper.GetBaseExchangeRate(input.Ctx, derivedDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(5, 3), rate)
+	require.Equal(t, int64(2), lastUpdate.Int64())
+
+	// the derived denom is snapshotted like the voted denoms so its TWAP is available
+	twaps, err := input.OracleKeeper.CalculateTwaps(input.Ctx, 100)
+	require.NoError(t, err)
+	require.Contains(t, twaps, types.OracleTwap{Denom: derivedDenom, Twap: sdk.NewDecWithPrec(5, 3), LookbackSeconds: 100})
+
+	// the derived exchange rate isn't updated when one of its terms fails its ballot
+	input.Ctx = input.Ctx.WithBlockHeight(3).WithBlockTime(time.Unix(300, 0))
+	for idx := 0; idx < 3; idx++ {
+		makeAggregateVote(t, input, h, 3, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: sdk.NewDec(20)}}, idx)
+	}
+	oracle.MidBlocker(input.Ctx, input.OracleKeeper)
+
+	rate, lastUpdate, _, err = input.OracleKeeper.GetBaseExchangeRate(input.Ctx, derivedDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(5, 3), rate)
+	require.Equal(t, int64(2), lastUpdate.Int64())
+
+	// derived denoms aren't cleared as excess feeds at the end of the slash window
+	input.Ctx = input.Ctx.WithBlockHeight(int64(params.SlashWindow) - 1)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	_, _, _, err = input.OracleKeeper.GetBaseExchangeRate(input.Ctx, derivedDenom)
+	require.NoError(t, err)
+}
+
+func TestVoteTargetProbation(t *testing.T) {
+	input, h := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: utils.MicroAtomDenom}, {Name: utils.MicroEthDenom}}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	input.OracleKeeper.ClearVoteTargets(input.Ctx)
+	input.OracleKeeper.SetVoteTarget(input.Ctx, types.Denom{Name: utils.MicroAtomDenom})
+	input.OracleKeeper.SetVoteTarget(input.Ctx, types.Denom{Name: utils.MicroEthDenom})
+	input.OracleKeeper.SetVoteTargetProbation(input.Ctx, utils.MicroEthDenom, 3)
+
+	atomRate := sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate}}
+	bothRates := sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate}, {Denom: utils.MicroEthDenom, Amount: randomExchangeRate}}
+
+	// missing the vote target on probation isn't counted as a miss
+	for height := int64(1); height <= 2; height++ {
+		input.Ctx = input.Ctx.WithBlockHeight(height)
+		makeAggregateVote(t, input, h, height, bothRates, 0)
+		makeAggregateVote(t, input, h, height, bothRates, 1)
+		makeAggregateVote(t, input, h, height, atomRate, 2)
+		oracle.MidBlocker(input.Ctx, input.OracleKeeper)
+		oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	}
+	require.Equal(t, uint64(0), input.OracleKeeper.GetMissCount(input.Ctx, keeper.ValAddrs[2]))
+	require.Equal(t, uint64(2), input.OracleKeeper.GetSuccessCount(input.Ctx, keeper.ValAddrs[2]))
+
+	// the probation period ends at height 3
+	input.Ctx = input.Ctx.WithBlockHeight(3)
+	makeAggregateVote(t, input, h, 3, bothRates, 0)
+	makeAggregateVote(t, input, h, 3, bothRates, 1)
+	makeAggregateVote(t, input, h, 3, atomRate, 2)
+	oracle.MidBlocker(input.Ctx, input.OracleKeeper)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	require.Equal(t, uint64(1), input.OracleKeeper.GetMissCount(input.Ctx, keeper.ValAddrs[2]))
+
+	_, found := input.OracleKeeper.GetVoteTargetProbation(input.Ctx, utils.MicroEthDenom)
+	require.False(t, found)
+}
+
 func makeAggregateVote(t *testing.T, input keeper.TestInput, h sdk.Handler, height int64, rates sdk.DecCoins, idx int) {
 	voteMsg := types.NewMsgAggregateExchangeRateVote(rates.String(), keeper.Addrs[idx], keeper.ValAddrs[idx])
 	_, err := h(input.Ctx.WithBlockHeight(height), voteMsg)
@@ -650,7 +939,7 @@ func TestEndWindowClearExcessFeeds(t *testing.T) {
 	input.OracleKeeper.SetBaseExchangeRate(input.Ctx, utils.MicroEthDenom, randomExchangeRate)
 
 	input.OracleKeeper.ClearVoteTargets(input.Ctx)
-	input.OracleKeeper.SetVoteTarget(input.Ctx, utils.MicroAtomDenom)
+	input.OracleKeeper.SetVoteTarget(input.Ctx, types.Denom{Name: utils.MicroAtomDenom})
 
 	earlyCtx := sdk.WrapSDKContext(input.Ctx)
 	earlyQuerier := keeper.NewQuerier(input.OracleKeeper)
@@ -17,17 +17,19 @@ import (
 // SpammingPreventionDecorator will check if the transaction's gas is smaller than
 // configured hard cap
 type SpammingPreventionDecorator struct {
-	oracleKeeper  keeper.Keeper
-	oracleVoteMap map[string]int64
-	mu            *sync.Mutex
+	oracleKeeper     keeper.Keeper
+	oracleVoteMap    map[string]int64
+	oraclePrevoteMap map[string]int64
+	mu               *sync.Mutex
 }
 
 // NewSpammingPreventionDecorator returns new spamming prevention decorator instance
 func NewSpammingPreventionDecorator(oracleKeeper keeper.Keeper) SpammingPreventionDecorator {
 	return SpammingPreventionDecorator{
-		oracleKeeper:  oracleKeeper,
-		oracleVoteMap: make(map[string]int64),
-		mu:            &sync.Mutex{},
+		oracleKeeper:     oracleKeeper,
+		oracleVoteMap:    make(map[string]int64),
+		oraclePrevoteMap: make(map[string]int64),
+		mu:               &sync.Mutex{},
 	}
 }
 
@@ -54,8 +56,33 @@ func (spd SpammingPreventionDecorator) AnteDeps(txDeps []sdkacltypes.AccessOpera
 	for _, msg := range tx.GetMsgs() {
 		// Error checking will be handled in AnteHandler
 		switch m := msg.(type) {
+		case *types.MsgAggregateExchangeRatePrevote:
+			valAddr, _ := sdk.ValAddressFromBech32(m.Validator)
+			feederAddr, _ := sdk.AccAddressFromBech32(m.Feeder)
+			deps = append(deps, []sdkacltypes.AccessOperation{
+				// validate feeder
+				// read feeder delegation for val addr - READ
+				{
+					ResourceType:       sdkacltypes.ResourceType_KV_ORACLE_FEEDERS,
+					AccessType:         sdkacltypes.AccessType_READ,
+					IdentifierTemplate: hex.EncodeToString(types.GetFeederDelegationKey(valAddr)),
+				},
+				// read additional feeder permission for val addr - READ
+				{
+					ResourceType:       sdkacltypes.ResourceType_KV_ORACLE_FEEDERS,
+					AccessType:         sdkacltypes.AccessType_READ,
+					IdentifierTemplate: hex.EncodeToString(types.GetFeederPermissionKey(valAddr, feederAddr)),
+				},
+				// read validator from staking - READ
+				{
+					ResourceType:       sdkacltypes.ResourceType_KV_STAKING_VALIDATOR,
+					AccessType:         sdkacltypes.AccessType_READ,
+					IdentifierTemplate: hex.EncodeToString(stakingtypes.GetValidatorKey(valAddr)),
+				},
+			}...)
 		case *types.MsgAggregateExchangeRateVote:
 			valAddr, _ := sdk.ValAddressFromBech32(m.Validator)
+			feederAddr, _ := sdk.AccAddressFromBech32(m.Feeder)
 			deps = append(deps, []sdkacltypes.AccessOperation{
 				// validate feeder
 				// read feeder delegation for val addr - READ
@@ -64,6 +91,12 @@ func (spd SpammingPreventionDecorator) AnteDeps(txDeps []sdkacltypes.AccessOpera
 					AccessType:         sdkacltypes.AccessType_READ,
 					IdentifierTemplate: hex.EncodeToString(types.GetFeederDelegationKey(valAddr)),
 				},
+				// read additional feeder permission for val addr - READ
+				{
+					ResourceType:       sdkacltypes.ResourceType_KV_ORACLE_FEEDERS,
+					AccessType:         sdkacltypes.AccessType_READ,
+					IdentifierTemplate: hex.EncodeToString(types.GetFeederPermissionKey(valAddr, feederAddr)),
+				},
 				// read validator from staking - READ
 				{
 					ResourceType:       sdkacltypes.ResourceType_KV_STAKING_VALIDATOR,
@@ -93,6 +126,27 @@ func (spd SpammingPreventionDecorator) CheckOracleSpamming(ctx sdk.Context, msgs
 	curHeight := ctx.BlockHeight()
 	for _, msg := range msgs {
 		switch msg := msg.(type) {
+		case *types.MsgAggregateExchangeRatePrevote:
+			feederAddr, err := sdk.AccAddressFromBech32(msg.Feeder)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(msg.Validator)
+			if err != nil {
+				return err
+			}
+
+			err = spd.oracleKeeper.ValidateFeeder(ctx, feederAddr, valAddr)
+			if err != nil {
+				return err
+			}
+			if lastSubmittedHeight, ok := spd.oraclePrevoteMap[msg.Validator]; ok && lastSubmittedHeight == curHeight {
+				return sdkerrors.Wrap(sdkerrors.ErrAlreadyExists, fmt.Sprintf("the validator has already submitted a prevote at the current height=%d", curHeight))
+			}
+
+			spd.oraclePrevoteMap[msg.Validator] = curHeight
+			continue
 		case *types.MsgAggregateExchangeRateVote:
 			feederAddr, err := sdk.AccAddressFromBech32(msg.Feeder)
 			if err != nil {
@@ -134,7 +188,7 @@ func (VoteAloneDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool,
 	otherMsg := false
 	for _, msg := range tx.GetMsgs() {
 		switch msg.(type) {
-		case *types.MsgAggregateExchangeRateVote:
+		case *types.MsgAggregateExchangeRatePrevote, *types.MsgAggregateExchangeRateVote:
 			oracleVote = true
 
 		default:
@@ -0,0 +1,138 @@
+package cli
+
+import (
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+
+	"github.com/sei-protocol/sei-chain/x/oracle/types"
+)
+
+type (
+	// AddVoteTargetProposalJSON defines an AddVoteTargetProposal with a deposit
+	AddVoteTargetProposalJSON struct {
+		Title       string      `json:"title" yaml:"title"`
+		Description string      `json:"description" yaml:"description"`
+		Denom       types.Denom `json:"denom" yaml:"denom"`
+		Deposit     string      `json:"deposit" yaml:"deposit"`
+	}
+
+	// RemoveVoteTargetProposalJSON defines a RemoveVoteTargetProposal with a deposit
+	RemoveVoteTargetProposalJSON struct {
+		Title       string `json:"title" yaml:"title"`
+		Description string `json:"description" yaml:"description"`
+		Denom       string `json:"denom" yaml:"denom"`
+		Deposit     string `json:"deposit" yaml:"deposit"`
+	}
+)
+
+// NewAddVoteTargetProposalTxCmd returns a CLI command handler for creating
+// an add vote target proposal governance transaction.
+func NewAddVoteTargetProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-vote-target [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an add oracle vote target proposal",
+		Long: strings.TrimSpace(`
+Submit a proposal to add a denom to the oracle whitelist. Misses on the denom aren't
+penalized during its probation period.
+
+$ seid tx gov submit-proposal add-vote-target proposal.json
+
+where proposal.json contains:
+
+{
+  "title": "Add uosmo",
+  "description": "Start voting on the uosmo exchange rate",
+  "denom": {"name": "uosmo"},
+  "deposit": "10000000usei"
+}
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var proposal AddVoteTargetProposalJSON
+			if err := parseProposalFile(clientCtx.LegacyAmino, args[0], &proposal); err != nil {
+				return err
+			}
+
+			content := types.NewAddVoteTargetProposal(proposal.Title, proposal.Description, proposal.Denom)
+			return submitProposal(cmd, clientCtx, content, proposal.Deposit)
+		},
+	}
+
+	return cmd
+}
+
+// NewRemoveVoteTargetProposalTxCmd returns a CLI command handler for creating
+// a remove vote target proposal governance transaction.
+func NewRemoveVoteTargetProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-vote-target [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a remove oracle vote target proposal",
+		Long: strings.TrimSpace(`
+Submit a proposal to remove a denom from the oracle whitelist. Its last exchange rate
+stays readable, marked stale, during its sunset period.
+
+$ seid tx gov submit-proposal remove-vote-target proposal.json
+
+where proposal.json contains:
+
+{
+  "title": "Remove uosmo",
+  "description": "Stop voting on the uosmo exchange rate",
+  "denom": "uosmo",
+  "deposit": "10000000usei"
+}
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var proposal RemoveVoteTargetProposalJSON
+			if err := parseProposalFile(clientCtx.LegacyAmino, args[0], &proposal); err != nil {
+				return err
+			}
+
+			content := types.NewRemoveVoteTargetProposal(proposal.Title, proposal.Description, proposal.Denom)
+			return submitProposal(cmd, clientCtx, content, proposal.Deposit)
+		},
+	}
+
+	return cmd
+}
+
+func parseProposalFile(cdc *codec.LegacyAmino, proposalFile string, proposal interface{}) error {
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return err
+	}
+
+	return cdc.UnmarshalJSON(contents, proposal)
+}
+
+func submitProposal(cmd *cobra.Command, clientCtx client.Context, content govtypes.Content, depositStr string) error {
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
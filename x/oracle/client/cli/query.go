@@ -26,13 +26,23 @@ func GetQueryCmd() *cobra.Command {
 
 	oracleQueryCmd.AddCommand(
 		GetCmdQueryExchangeRates(),
+		GetCmdQueryFreshExchangeRate(),
 		GetCmdQueryPriceSnapshotHistory(),
 		GetCmdQueryTwaps(),
+		GetCmdQueryEmas(),
+		GetCmdQueryPriceRanges(),
+		GetCmdQueryVolatilities(),
+		GetCmdQueryPriceAtTimestamp(),
 		GetCmdQueryActives(),
 		GetCmdQueryParams(),
 		GetCmdQueryFeederDelegation(),
+		GetCmdQueryFeederPermissions(),
+		GetCmdQueryVoteHistory(),
+		GetCmdQueryAggregatePrevote(),
+		GetCmdQueryOracleRewards(),
 		GetCmdQueryVotePenaltyCounter(),
 		GetCmdQueryVoteTargets(),
+		GetCmdQueryVoteTargetTransitions(),
 	)
 
 	return oracleQueryCmd
@@ -87,6 +97,47 @@ $ seid query oracle exchange-rates ukrw
 	return cmd
 }
 
+// GetCmdQueryFreshExchangeRate implements the query fresh rate command.
+func GetCmdQueryFreshExchangeRate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fresh-exchange-rate [denom] [max-age-seconds]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the current Sei exchange rate w.r.t an asset if it is recent enough",
+		Long: strings.TrimSpace(`
+Query the current exchange rate of Sei with an asset along with the confidence
+of the ballot it was tallied from. The query fails if the exchange rate was
+last updated more than max-age-seconds ago.
+
+$ seid query oracle fresh-exchange-rate uatom 60
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			maxAge, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FreshExchangeRate(
+				context.Background(),
+				&types.QueryFreshExchangeRateRequest{Denom: args[0], MaxAgeSeconds: maxAge},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func GetCmdQueryPriceSnapshotHistory() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "price-snapshot-history",
@@ -157,6 +208,167 @@ $ seid query oracle twaps
 	return cmd
 }
 
+func GetCmdQueryEmas() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "emas [lookback-seconds] [period-seconds]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the exponential moving average prices for denoms with price snapshot data",
+		Long: strings.TrimSpace(`
+Query the exponential moving average prices for denoms with price snapshot data,
+smoothed over period-seconds
+Example:
+
+$ seid query oracle emas 3600 600
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			lookbackSeconds, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			periodSeconds, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Emas(
+				context.Background(),
+				&types.QueryEmasRequest{LookbackSeconds: lookbackSeconds, PeriodSeconds: periodSeconds},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryPriceRanges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-ranges [lookback-seconds]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the lowest and highest prices for denoms with price snapshot data",
+		Long: strings.TrimSpace(`
+Query the lowest and highest prices for denoms with price snapshot data
+Example:
+
+$ seid query oracle price-ranges 3600
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			lookbackSeconds, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PriceRanges(
+				context.Background(),
+				&types.QueryPriceRangesRequest{LookbackSeconds: lookbackSeconds},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryVolatilities() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "volatilities [lookback-seconds]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the realised volatilities for denoms with price snapshot data",
+		Long: strings.TrimSpace(`
+Query the realised volatilities for denoms with price snapshot data
+Example:
+
+$ seid query oracle volatilities 3600
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			lookbackSeconds, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Volatilities(
+				context.Background(),
+				&types.QueryVolatilitiesRequest{LookbackSeconds: lookbackSeconds},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryPriceAtTimestamp() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-at-timestamp [denom] [timestamp]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the price of a denom at a past unix timestamp",
+		Long: strings.TrimSpace(`
+Query the price of a denom from the latest price snapshot taken at or before a unix timestamp
+Example:
+
+$ seid query oracle price-at-timestamp uatom 1672531200
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			timestamp, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PriceAtTimestamp(
+				context.Background(),
+				&types.QueryPriceAtTimestampRequest{Denom: args[0], Timestamp: timestamp},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryActives implements the query actives command.
 func GetCmdQueryActives() *cobra.Command {
 	cmd := &cobra.Command{
@@ -254,6 +466,169 @@ $ seid query oracle feeder terravaloper...
 	return cmd
 }
 
+// GetCmdQueryFeederPermissions implements the query feeder permissions command
+func GetCmdQueryFeederPermissions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "feeder-permissions [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the additional oracle feeders of a validator",
+		Long: strings.TrimSpace(`
+Query the additional accounts the validator authorized to vote for the oracle, with their denom scopes and expiries.
+
+$ seid query oracle feeder-permissions seivaloper...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FeederPermissions(
+				context.Background(),
+				&types.QueryFeederPermissionsRequest{ValidatorAddr: validator.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryVoteHistory implements the query vote history command
+func GetCmdQueryVoteHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-history [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the ballot summaries of a validator for past vote periods",
+		Long: strings.TrimSpace(`
+Query the rates a validator submitted in the retained vote periods, whether each rate was within the reward band,
+how the vote was counted and the resulting medians.
+
+$ seid query oracle vote-history seivaloper...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VoteHistory(
+				context.Background(),
+				&types.QueryVoteHistoryRequest{ValidatorAddr: validator.String(), Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "vote-history")
+	return cmd
+}
+
+// GetCmdQueryAggregatePrevote implements the query aggregate prevote of the validator command
+func GetCmdQueryAggregatePrevote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregate-prevote [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the outstanding oracle aggregate prevote of a validator",
+		Long: strings.TrimSpace(`
+Query the outstanding aggregate prevote of a validator that has not been revealed yet.
+
+$ seid query oracle aggregate-prevote seivaloper...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AggregatePrevote(
+				context.Background(),
+				&types.QueryAggregatePrevoteRequest{ValidatorAddr: validator.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryOracleRewards implements the query oracle rewards of the validator command
+func GetCmdQueryOracleRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "oracle-rewards [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the oracle rewards distributed to a validator",
+		Long: strings.TrimSpace(`
+Query the total oracle rewards distributed to a validator for voting within the reward band.
+
+$ seid query oracle oracle-rewards seivaloper...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.OracleRewards(
+				context.Background(),
+				&types.QueryOracleRewardsRequest{ValidatorAddr: validator.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryVotePenaltyCounter implements the query vote penalty counter of the validator command
 func GetCmdQueryVotePenaltyCounter() *cobra.Command {
 	cmd := &cobra.Command{
@@ -322,3 +697,32 @@ func GetCmdQueryVoteTargets() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryVoteTargetTransitions implements the query vote target transitions command.
+func GetCmdQueryVoteTargetTransitions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-target-transitions",
+		Args:  cobra.NoArgs,
+		Short: "Query the Oracle vote targets on probation and the removed vote targets in their sunset period",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VoteTargetTransitions(
+				context.Background(),
+				&types.QueryVoteTargetTransitionsRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
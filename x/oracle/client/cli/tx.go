@@ -16,6 +16,12 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	flagSalt   = "salt"
+	flagDenoms = "denoms"
+	flagExpiry = "expiry"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	oracleTxCmd := &cobra.Command{
@@ -28,7 +34,10 @@ func GetTxCmd() *cobra.Command {
 
 	oracleTxCmd.AddCommand(
 		GetCmdDelegateFeederPermission(),
+		GetCmdAggregateExchangeRatePrevote(),
 		GetCmdAggregateExchangeRateVote(),
+		GetCmdAuthorizeFeeder(),
+		GetCmdRevokeFeeder(),
 	)
 
 	return oracleTxCmd
@@ -83,6 +92,163 @@ where "terra1..." is the address you want to delegate your voting rights to.
 	return cmd
 }
 
+// GetCmdAuthorizeFeeder will create a feeder authorization tx and sign it with the given key.
+func GetCmdAuthorizeFeeder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authorize-feeder [feeder]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Authorize an additional address to vote for the oracle",
+		Long: strings.TrimSpace(`
+Authorize an additional address to submit exchange rate votes for the oracle on behalf of your validator.
+
+The permission can be limited to a set of denoms and given an expiry as a unix timestamp in seconds.
+Without denoms the feeder may vote on every denom, and an expiry of 0 never expires.
+
+$ seid tx oracle authorize-feeder sei1... --denoms=uatom,ueth --expiry=1700000000
+
+where "sei1..." is the address you want to authorize.
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			validator := sdk.ValAddress(clientCtx.GetFromAddress())
+
+			feeder, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			denoms, err := cmd.Flags().GetStringSlice(flagDenoms)
+			if err != nil {
+				return err
+			}
+
+			expiry, err := cmd.Flags().GetInt64(flagExpiry)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAuthorizeFeeder(validator, feeder, denoms, expiry)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(flagDenoms, []string{}, "denoms the feeder may vote on, all denoms if empty")
+	cmd.Flags().Int64(flagExpiry, 0, "unix timestamp at which the permission expires, never if 0")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRevokeFeeder will create a feeder revocation tx and sign it with the given key.
+func GetCmdRevokeFeeder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-feeder [feeder]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Revoke the permission of an additional feeder",
+		Long: strings.TrimSpace(`
+Revoke the permission of an additional address to vote for the oracle on behalf of your validator.
+
+$ seid tx oracle revoke-feeder sei1...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			validator := sdk.ValAddress(clientCtx.GetFromAddress())
+
+			feeder, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeFeeder(validator, feeder)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdAggregateExchangeRatePrevote will create a aggregateExchangeRatePrevote tx and sign it with the given key.
+func GetCmdAggregateExchangeRatePrevote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregate-prevote [salt] [exchange-rates] [validator]",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "Submit an oracle aggregate prevote for the exchange_rates of the base denom",
+		Long: strings.TrimSpace(`
+Submit an oracle aggregate prevote for the exchange_rates of the base denom w.r.t the input denom.
+Only accepted while the require_prevote param is enabled. The prevote commits to the hash of the
+salt, the exchange rates and the validator, and has to be revealed with an aggregate-vote using the
+same salt and exchange rates in the following vote period.
+
+$ seid tx oracle aggregate-prevote 1234 8888.0ukrw,1.243uusd,0.99usdr
+
+where "ukrw,uusd,usdr" is the denominating currencies, and "8888.0,1.243,0.99" is the exchange rates of micro USD in micro denoms from the voter's point of view.
+
+If voting from a voting delegate, set "validator" to the address of the validator to vote on behalf of:
+$ seid tx oracle aggregate-prevote 1234 8888.0ukrw,1.243uusd,0.99usdr seivaloper1...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			salt := args[0]
+			exchangeRatesStr := args[1]
+			_, err = types.ParseExchangeRateTuples(exchangeRatesStr)
+			if err != nil {
+				return fmt.Errorf("given exchange_rates {%s} is not a valid format; exchange_rate should be formatted as DecCoins; %s", exchangeRatesStr, err.Error())
+			}
+
+			// Get from address
+			voter := clientCtx.GetFromAddress()
+
+			// By default the voter is voting on behalf of itself
+			validator := sdk.ValAddress(voter)
+
+			// Override validator if validator is given
+			if len(args) == 3 {
+				parsedVal, err := sdk.ValAddressFromBech32(args[2])
+				if err != nil {
+					return errors.Wrap(err, "validator address is invalid")
+				}
+				validator = parsedVal
+			}
+
+			hash := types.GetAggregateVoteHash(salt, exchangeRatesStr, validator)
+			msgs := []sdk.Msg{types.NewMsgAggregateExchangeRatePrevote(hash, voter, validator)}
+			for _, msg := range msgs {
+				if err := msg.ValidateBasic(); err != nil {
+					return err
+				}
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdAggregateExchangeRateVote will create a aggregateExchangeRateVote tx and sign it with the given key.
 func GetCmdAggregateExchangeRateVote() *cobra.Command {
 	cmd := &cobra.Command{
@@ -97,7 +263,11 @@ $ seid tx oracle aggregate-vote 8888.0ukrw,1.243uusd,0.99usdr
 where "ukrw,uusd,usdr" is the denominating currencies, and "8888.0,1.243,0.99" is the exchange rates of micro USD in micro denoms from the voter's point of view.
 
 If voting from a voting delegate, set "validator" to the address of the validator to vote on behalf of:
-$ seid tx oracle aggregate-vote 1234 8888.0ukrw,1.243uusd,0.99usdr seivaloper1....
+$ seid tx oracle aggregate-vote 8888.0ukrw,1.243uusd,0.99usdr seivaloper1....
+
+While the require_prevote param is enabled, the vote reveals the prevote submitted in the previous
+vote period and has to carry the salt used for it:
+$ seid tx oracle aggregate-vote 8888.0ukrw,1.243uusd,0.99usdr --salt 1234
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -126,7 +296,14 @@ $ seid tx oracle aggregate-vote 1234 8888.0ukrw,1.243uusd,0.99usdr seivaloper1..
 				validator = parsedVal
 			}
 
-			msgs := []sdk.Msg{types.NewMsgAggregateExchangeRateVote(exchangeRatesStr, voter, validator)}
+			salt, err := cmd.Flags().GetString(flagSalt)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAggregateExchangeRateVote(exchangeRatesStr, voter, validator)
+			msg.Salt = salt
+			msgs := []sdk.Msg{msg}
 			for _, msg := range msgs {
 				if err := msg.ValidateBasic(); err != nil {
 					return err
@@ -137,6 +314,7 @@ $ seid tx oracle aggregate-vote 1234 8888.0ukrw,1.243uusd,0.99usdr seivaloper1..
 		},
 	}
 
+	cmd.Flags().String(flagSalt, "", "Salt of the aggregate prevote being revealed")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,13 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/sei-protocol/sei-chain/x/oracle/client/cli"
+	"github.com/sei-protocol/sei-chain/x/oracle/client/rest"
+)
+
+var (
+	AddVoteTargetProposalHandler    = govclient.NewProposalHandler(cli.NewAddVoteTargetProposalTxCmd, rest.AddVoteTargetProposalRESTHandler)
+	RemoveVoteTargetProposalHandler = govclient.NewProposalHandler(cli.NewRemoveVoteTargetProposalTxCmd, rest.RemoveVoteTargetProposalRESTHandler)
+)
@@ -10,6 +10,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/gorilla/mux"
 )
@@ -97,3 +99,79 @@ func newAggregateVoteHandlerFunction(clientCtx client.Context) http.HandlerFunc
 		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
 	}
 }
+
+type (
+	addVoteTargetProposalReq struct {
+		BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+		Title       string       `json:"title" yaml:"title"`
+		Description string       `json:"description" yaml:"description"`
+		Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+		Denom       types.Denom  `json:"denom" yaml:"denom"`
+	}
+
+	removeVoteTargetProposalReq struct {
+		BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+		Title       string       `json:"title" yaml:"title"`
+		Description string       `json:"description" yaml:"description"`
+		Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+		Denom       string       `json:"denom" yaml:"denom"`
+	}
+)
+
+// AddVoteTargetProposalRESTHandler returns the REST handler for submitting an AddVoteTargetProposal
+func AddVoteTargetProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "add_vote_target",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req addVoteTargetProposalReq
+			if !readProposalReq(w, r, clientCtx, &req, &req.BaseReq) {
+				return
+			}
+
+			content := types.NewAddVoteTargetProposal(req.Title, req.Description, req.Denom)
+			writeProposalTxResponse(w, clientCtx, req.BaseReq, content, req.Deposit)
+		},
+	}
+}
+
+// RemoveVoteTargetProposalRESTHandler returns the REST handler for submitting a RemoveVoteTargetProposal
+func RemoveVoteTargetProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "remove_vote_target",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req removeVoteTargetProposalReq
+			if !readProposalReq(w, r, clientCtx, &req, &req.BaseReq) {
+				return
+			}
+
+			content := types.NewRemoveVoteTargetProposal(req.Title, req.Description, req.Denom)
+			writeProposalTxResponse(w, clientCtx, req.BaseReq, content, req.Deposit)
+		},
+	}
+}
+
+func readProposalReq(w http.ResponseWriter, r *http.Request, clientCtx client.Context, req interface{}, baseReq *rest.BaseReq) bool {
+	if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, req) {
+		return false
+	}
+
+	*baseReq = baseReq.Sanitize()
+	return baseReq.ValidateBasic(w)
+}
+
+func writeProposalTxResponse(w http.ResponseWriter, clientCtx client.Context, baseReq rest.BaseReq, content govtypes.Content, deposit sdk.Coins) {
+	fromAddr, err := sdk.AccAddressFromBech32(baseReq.From)
+	if rest.CheckBadRequestError(w, err) {
+		return
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, fromAddr)
+	if rest.CheckBadRequestError(w, err) {
+		return
+	}
+	if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+		return
+	}
+
+	tx.WriteGeneratedTxResponse(clientCtx, w, baseReq, msg)
+}
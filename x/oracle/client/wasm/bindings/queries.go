@@ -7,4 +7,14 @@ type SeiOracleQuery struct {
 	ExchangeRates *types.QueryExchangeRatesRequest `json:"exchange_rates,omitempty"`
 	// queries the oracle TWAPs
 	OracleTwaps *types.QueryTwapsRequest `json:"oracle_twaps,omitempty"`
+	// queries the oracle exchange rate of a denom if it isn't older than the max age
+	FreshExchangeRate *types.QueryFreshExchangeRateRequest `json:"fresh_exchange_rate,omitempty"`
+	// queries the oracle exponential moving averages
+	OracleEmas *types.QueryEmasRequest `json:"oracle_emas,omitempty"`
+	// queries the oracle lowest and highest prices
+	OraclePriceRanges *types.QueryPriceRangesRequest `json:"oracle_price_ranges,omitempty"`
+	// queries the oracle realised volatilities
+	OracleVolatilities *types.QueryVolatilitiesRequest `json:"oracle_volatilities,omitempty"`
+	// queries the oracle price of a denom at a past timestamp
+	PriceAtTimestamp *types.QueryPriceAtTimestampRequest `json:"price_at_timestamp,omitempty"`
 }
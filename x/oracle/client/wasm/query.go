@@ -27,3 +27,33 @@ func (handler OracleWasmQueryHandler) GetOracleTwaps(ctx sdk.Context, req *types
 	c := sdk.WrapSDKContext(ctx)
 	return querier.Twaps(c, req)
 }
+
+func (handler OracleWasmQueryHandler) GetFreshExchangeRate(ctx sdk.Context, req *types.QueryFreshExchangeRateRequest) (*types.QueryFreshExchangeRateResponse, error) {
+	querier := oraclekeeper.NewQuerier(handler.oracleKeeper)
+	c := sdk.WrapSDKContext(ctx)
+	return querier.FreshExchangeRate(c, req)
+}
+
+func (handler OracleWasmQueryHandler) GetOracleEmas(ctx sdk.Context, req *types.QueryEmasRequest) (*types.QueryEmasResponse, error) {
+	querier := oraclekeeper.NewQuerier(handler.oracleKeeper)
+	c := sdk.WrapSDKContext(ctx)
+	return querier.Emas(c, req)
+}
+
+func (handler OracleWasmQueryHandler) GetOraclePriceRanges(ctx sdk.Context, req *types.QueryPriceRangesRequest) (*types.QueryPriceRangesResponse, error) {
+	querier := oraclekeeper.NewQuerier(handler.oracleKeeper)
+	c := sdk.WrapSDKContext(ctx)
+	return querier.PriceRanges(c, req)
+}
+
+func (handler OracleWasmQueryHandler) GetOracleVolatilities(ctx sdk.Context, req *types.QueryVolatilitiesRequest) (*types.QueryVolatilitiesResponse, error) {
+	querier := oraclekeeper.NewQuerier(handler.oracleKeeper)
+	c := sdk.WrapSDKContext(ctx)
+	return querier.Volatilities(c, req)
+}
+
+func (handler OracleWasmQueryHandler) GetPriceAtTimestamp(ctx sdk.Context, req *types.QueryPriceAtTimestampRequest) (*types.QueryPriceAtTimestampResponse, error) {
+	querier := oraclekeeper.NewQuerier(handler.oracleKeeper)
+	c := sdk.WrapSDKContext(ctx)
+	return querier.PriceAtTimestamp(c, req)
+}
@@ -40,6 +40,15 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 		keeper.SetVotePenaltyCounter(ctx, operator, pc.VotePenaltyCounter.MissCount, pc.VotePenaltyCounter.AbstainCount, pc.VotePenaltyCounter.SuccessCount)
 	}
 
+	for _, ap := range data.AggregateExchangeRatePrevotes {
+		valAddr, err := sdk.ValAddressFromBech32(ap.Voter)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.SetAggregateExchangeRatePrevote(ctx, valAddr, ap)
+	}
+
 	for _, av := range data.AggregateExchangeRateVotes {
 		valAddr, err := sdk.ValAddressFromBech32(av.Voter)
 		if err != nil {
@@ -53,6 +62,46 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 		keeper.AddPriceSnapshot(ctx, priceSnapshot)
 	}
 
+	for _, vr := range data.ValidatorOracleRewards {
+		operator, err := sdk.ValAddressFromBech32(vr.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.SetOracleRewards(ctx, operator, vr.OracleRewards.Rewards)
+	}
+
+	for _, vp := range data.ValidatorFeederPermissions {
+		operator, err := sdk.ValAddressFromBech32(vp.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		feeder, err := sdk.AccAddressFromBech32(vp.FeederPermission.Feeder)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.SetFeederPermission(ctx, operator, feeder, vp.FeederPermission)
+	}
+
+	for _, probation := range data.VoteTargetProbations {
+		keeper.SetVoteTargetProbation(ctx, probation.Denom, probation.EndHeight)
+	}
+
+	for _, sunset := range data.VoteTargetSunsets {
+		keeper.SetVoteTargetSunset(ctx, sunset.Denom, sunset.EndHeight)
+	}
+
+	for _, bh := range data.ValidatorBondHeights {
+		operator, err := sdk.ValAddressFromBech32(bh.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.SetValidatorBondHeight(ctx, operator, bh.Height)
+	}
+
 	// check if the module account exists
 	moduleAcc := keeper.GetOracleAccount(ctx)
 	if moduleAcc == nil {
@@ -89,6 +138,12 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		return false
 	})
 
+	aggregateExchangeRatePrevotes := []types.AggregateExchangeRatePrevote{}
+	keeper.IterateAggregateExchangeRatePrevotes(ctx, func(_ sdk.ValAddress, aggregatePrevote types.AggregateExchangeRatePrevote) bool {
+		aggregateExchangeRatePrevotes = append(aggregateExchangeRatePrevotes, aggregatePrevote)
+		return false
+	})
+
 	aggregateExchangeRateVotes := []types.AggregateExchangeRateVote{}
 	keeper.IterateAggregateExchangeRateVotes(ctx, func(_ sdk.ValAddress, aggregateVote types.AggregateExchangeRateVote) bool {
 		aggregateExchangeRateVotes = append(aggregateExchangeRateVotes, aggregateVote)
@@ -101,12 +156,57 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		return false
 	})
 
+	validatorOracleRewards := []types.ValidatorOracleRewards{}
+	keeper.IterateOracleRewards(ctx, func(operator sdk.ValAddress, oracleRewards types.OracleRewards) (stop bool) {
+		validatorOracleRewards = append(validatorOracleRewards, types.ValidatorOracleRewards{
+			ValidatorAddress: operator.String(),
+			OracleRewards:    oracleRewards,
+		})
+		return false
+	})
+
+	validatorFeederPermissions := []types.ValidatorFeederPermission{}
+	keeper.IterateAllFeederPermissions(ctx, func(operator sdk.ValAddress, permission types.FeederPermission) (stop bool) {
+		validatorFeederPermissions = append(validatorFeederPermissions, types.ValidatorFeederPermission{
+			ValidatorAddress: operator.String(),
+			FeederPermission: permission,
+		})
+		return false
+	})
+
+	voteTargetProbations := []types.VoteTargetTransition{}
+	keeper.IterateVoteTargetProbations(ctx, func(denom string, endHeight int64) (stop bool) {
+		voteTargetProbations = append(voteTargetProbations, types.VoteTargetTransition{Denom: denom, EndHeight: endHeight})
+		return false
+	})
+
+	voteTargetSunsets := []types.VoteTargetTransition{}
+	keeper.IterateVoteTargetSunsets(ctx, func(denom string, endHeight int64) (stop bool) {
+		voteTargetSunsets = append(voteTargetSunsets, types.VoteTargetTransition{Denom: denom, EndHeight: endHeight})
+		return false
+	})
+
+	validatorBondHeights := []types.ValidatorBondHeight{}
+	keeper.IterateValidatorBondHeights(ctx, func(operator sdk.ValAddress, height int64) (stop bool) {
+		validatorBondHeights = append(validatorBondHeights, types.ValidatorBondHeight{
+			ValidatorAddress: operator.String(),
+			Height:           height,
+		})
+		return false
+	})
+
 	return types.NewGenesisState(
 		params,
 		exchangeRates,
 		feederDelegations,
 		penaltyCounters,
+		aggregateExchangeRatePrevotes,
 		aggregateExchangeRateVotes,
 		priceSnapshots,
+		validatorOracleRewards,
+		validatorFeederPermissions,
+		voteTargetProbations,
+		voteTargetSunsets,
+		validatorBondHeights,
 	)
 }
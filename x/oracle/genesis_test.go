@@ -16,12 +16,17 @@ func TestExportInitGenesis(t *testing.T) {
 	input, _ := setup(t)
 
 	input.OracleKeeper.SetFeederDelegation(input.Ctx, keeper.ValAddrs[0], keeper.Addrs[1])
+	input.OracleKeeper.SetFeederPermission(input.Ctx, keeper.ValAddrs[0], keeper.Addrs[2], types.FeederPermission{Feeder: keeper.Addrs[2].String(), Denoms: []string{"usei"}, Expiry: 100})
 	input.OracleKeeper.SetBaseExchangeRate(input.Ctx, "denom", sdk.NewDec(123))
 	input.OracleKeeper.SetAggregateExchangeRateVote(input.Ctx, keeper.ValAddrs[0], types.NewAggregateExchangeRateVote(types.ExchangeRateTuples{{Denom: "foo", ExchangeRate: sdk.NewDec(123)}}, keeper.ValAddrs[0]))
-	input.OracleKeeper.SetVoteTarget(input.Ctx, "denom")
-	input.OracleKeeper.SetVoteTarget(input.Ctx, "denom2")
+	input.OracleKeeper.SetVoteTarget(input.Ctx, types.Denom{Name: "denom"})
+	input.OracleKeeper.SetVoteTarget(input.Ctx, types.Denom{Name: "denom2"})
 	input.OracleKeeper.SetVotePenaltyCounter(input.Ctx, keeper.ValAddrs[0], 2, 3, 0)
 	input.OracleKeeper.SetVotePenaltyCounter(input.Ctx, keeper.ValAddrs[1], 4, 5, 0)
+	input.OracleKeeper.SetOracleRewards(input.Ctx, keeper.ValAddrs[0], sdk.NewCoins(sdk.NewInt64Coin("usei", 100)))
+	input.OracleKeeper.SetVoteTargetProbation(input.Ctx, "denom2", 100)
+	input.OracleKeeper.SetVoteTargetSunset(input.Ctx, "denom3", 200)
+	input.OracleKeeper.SetValidatorBondHeight(input.Ctx, keeper.ValAddrs[2], 50)
 	input.OracleKeeper.AddPriceSnapshot(input.Ctx, types.NewPriceSnapshot(
 		types.PriceSnapshotItems{
 			{
@@ -67,4 +72,9 @@ func TestExportInitGenesis(t *testing.T) {
 	newGenesis := oracle.ExportGenesis(newInput.Ctx, newInput.OracleKeeper)
 
 	require.Equal(t, genesis, newGenesis)
+	require.Len(t, newGenesis.ValidatorOracleRewards, 1)
+	require.Len(t, newGenesis.ValidatorFeederPermissions, 1)
+	require.Equal(t, []types.VoteTargetTransition{{Denom: "denom2", EndHeight: 100}}, newGenesis.VoteTargetProbations)
+	require.Equal(t, []types.VoteTargetTransition{{Denom: "denom3", EndHeight: 200}}, newGenesis.VoteTargetSunsets)
+	require.Contains(t, newGenesis.ValidatorBondHeights, types.ValidatorBondHeight{ValidatorAddress: keeper.ValAddrs[2].String(), Height: 50})
 }
@@ -0,0 +1,22 @@
+package oracle
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/oracle/keeper"
+	"github.com/sei-protocol/sei-chain/x/oracle/types"
+)
+
+func HandleAddVoteTargetProposal(ctx sdk.Context, k *keeper.Keeper, p *types.AddVoteTargetProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	return k.AddVoteTarget(ctx, p.Denom)
+}
+
+func HandleRemoveVoteTargetProposal(ctx sdk.Context, k *keeper.Keeper, p *types.RemoveVoteTargetProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	return k.RemoveVoteTarget(ctx, p.Denom)
+}
@@ -0,0 +1,34 @@
+package oracle_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sei-protocol/sei-chain/x/oracle"
+	"github.com/sei-protocol/sei-chain/x/oracle/keeper"
+	"github.com/sei-protocol/sei-chain/x/oracle/types"
+)
+
+func TestVoteTargetProposalHandler(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	handler := oracle.NewProposalHandler(input.OracleKeeper)
+
+	addProposal := types.NewAddVoteTargetProposal("title", "description", types.Denom{Name: "ufoo"})
+	require.NoError(t, handler(input.Ctx, addProposal))
+	require.True(t, input.OracleKeeper.GetParams(input.Ctx).Whitelist.Contains("ufoo"))
+	require.ErrorIs(t, handler(input.Ctx, addProposal), types.ErrVoteTargetExists)
+
+	removeProposal := types.NewRemoveVoteTargetProposal("title", "description", "ufoo")
+	require.NoError(t, handler(input.Ctx, removeProposal))
+	require.False(t, input.OracleKeeper.GetParams(input.Ctx).Whitelist.Contains("ufoo"))
+	require.ErrorIs(t, handler(input.Ctx, removeProposal), types.ErrNoVoteTarget)
+
+	invalidProposal := types.NewRemoveVoteTargetProposal("title", "description", "")
+	require.Error(t, handler(input.Ctx, invalidProposal))
+
+	textProposal := govtypes.NewTextProposal("title", "description", false)
+	require.ErrorIs(t, handler(input.Ctx, textProposal), sdkerrors.ErrUnknownRequest)
+}
@@ -2,6 +2,7 @@ package oracle_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -63,8 +64,175 @@ func TestFeederDelegation(t *testing.T) {
 	_, err = h(input.Ctx.WithBlockHeight(1), voteMsg)
 	require.Error(t, err)
 
-	// Case 4.2: Normal Vote - with delegation succeeds
+	// Case 4.2: Normal Vote - with delegation succeeds once the earlier vote is tallied
+	input.OracleKeeper.DeleteAggregateExchangeRateVote(input.Ctx, keeper.ValAddrs[0])
 	voteMsg = types.NewMsgAggregateExchangeRateVote(randomExchangeRate.String()+utils.MicroAtomDenom, keeper.Addrs[1], keeper.ValAddrs[0])
 	_, err = h(input.Ctx.WithBlockHeight(1), voteMsg)
 	require.NoError(t, err)
 }
+
+func TestFeederPermissions(t *testing.T) {
+	input, h := setup(t)
+	ctx := input.Ctx.WithBlockHeight(1).WithBlockTime(time.Unix(1000, 0))
+	exchangeRatesStr := randomExchangeRate.String() + utils.MicroAtomDenom
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.MaxFeeders = 2
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// Case 1: a non-validator can't authorize feeders
+	_, err := h(ctx, types.NewMsgAuthorizeFeeder(sdk.ValAddress(keeper.Addrs[3]), keeper.Addrs[1], nil, 0))
+	require.Error(t, err)
+
+	// Case 2: an expiry that already passed is rejected
+	_, err = h(ctx, types.NewMsgAuthorizeFeeder(keeper.ValAddrs[0], keeper.Addrs[1], nil, ctx.BlockTime().Unix()))
+	require.ErrorIs(t, err, types.ErrInvalidFeederExpiry)
+
+	// Case 3: authorized feeders are limited to MaxFeeders
+	_, err = h(ctx, types.NewMsgAuthorizeFeeder(keeper.ValAddrs[0], keeper.Addrs[1], nil, 0))
+	require.NoError(t, err)
+	_, err = h(ctx, types.NewMsgAuthorizeFeeder(keeper.ValAddrs[0], keeper.Addrs[2], []string{utils.MicroSeiDenom}, 0))
+	require.NoError(t, err)
+	_, err = h(ctx, types.NewMsgAuthorizeFeeder(keeper.ValAddrs[0], keeper.Addrs[3], nil, 0))
+	require.ErrorIs(t, err, types.ErrTooManyFeeders)
+
+	// Case 4: re-authorizing an existing feeder updates it
+	_, err = h(ctx, types.NewMsgAuthorizeFeeder(keeper.ValAddrs[0], keeper.Addrs[1], nil, ctx.BlockTime().Unix()+10))
+	require.NoError(t, err)
+	permission, found := input.OracleKeeper.GetFeederPermission(ctx, keeper.ValAddrs[0], keeper.Addrs[1])
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime().Unix()+10, permission.Expiry)
+
+	// Case 5: a feeder scoped to other denoms can't vote
+	voteMsg := types.NewMsgAggregateExchangeRateVote(exchangeRatesStr, keeper.Addrs[2], keeper.ValAddrs[0])
+	_, err = h(ctx, voteMsg)
+	require.ErrorIs(t, err, types.ErrNoVotingPermission)
+
+	// Case 6: an unscoped feeder can vote, and the first vote on a denom in the period wins
+	voteMsg = types.NewMsgAggregateExchangeRateVote(exchangeRatesStr, keeper.Addrs[1], keeper.ValAddrs[0])
+	_, err = h(ctx, voteMsg)
+	require.NoError(t, err)
+	_, err = h(ctx, types.NewMsgAggregateExchangeRateVote(exchangeRatesStr, keeper.Addrs[0], keeper.ValAddrs[0]))
+	require.ErrorIs(t, err, types.ErrAggregateVoteExist)
+	input.OracleKeeper.DeleteAggregateExchangeRateVote(ctx, keeper.ValAddrs[0])
+
+	// Case 7: an expired feeder can't vote
+	_, err = h(ctx.WithBlockTime(ctx.BlockTime().Add(10*time.Second)), voteMsg)
+	require.ErrorIs(t, err, types.ErrNoVotingPermission)
+
+	// Case 8: a revoked feeder can't vote
+	_, err = h(ctx, types.NewMsgRevokeFeeder(keeper.ValAddrs[0], keeper.Addrs[1]))
+	require.NoError(t, err)
+	_, err = h(ctx, voteMsg)
+	require.ErrorIs(t, err, types.ErrNoVotingPermission)
+
+	// Case 9: revoking an unknown feeder fails
+	_, err = h(ctx, types.NewMsgRevokeFeeder(keeper.ValAddrs[0], keeper.Addrs[1]))
+	require.ErrorIs(t, err, types.ErrNoFeederPermission)
+}
+
+func TestScopedFeedersVoteOnTheirDenoms(t *testing.T) {
+	input, h := setup(t)
+	ctx := input.Ctx.WithBlockHeight(1).WithBlockTime(time.Unix(1000, 0))
+	atomRateStr := randomExchangeRate.String() + utils.MicroAtomDenom
+	seiRateStr := anotherRandomExchangeRate.String() + utils.MicroSeiDenom
+	input.OracleKeeper.SetVoteTarget(input.Ctx, types.Denom{Name: utils.MicroSeiDenom})
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.MaxFeeders = 2
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	_, err := h(ctx, types.NewMsgAuthorizeFeeder(keeper.ValAddrs[0], keeper.Addrs[1], []string{utils.MicroAtomDenom}, 0))
+	require.NoError(t, err)
+	_, err = h(ctx, types.NewMsgAuthorizeFeeder(keeper.ValAddrs[0], keeper.Addrs[2], []string{utils.MicroSeiDenom}, 0))
+	require.NoError(t, err)
+
+	// Case 1: feeders with disjoint scopes both vote in the same period
+	_, err = h(ctx, types.NewMsgAggregateExchangeRateVote(atomRateStr, keeper.Addrs[1], keeper.ValAddrs[0]))
+	require.NoError(t, err)
+	_, err = h(ctx, types.NewMsgAggregateExchangeRateVote(seiRateStr, keeper.Addrs[2], keeper.ValAddrs[0]))
+	require.NoError(t, err)
+
+	vote, err := input.OracleKeeper.GetAggregateExchangeRateVote(ctx, keeper.ValAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, types.ExchangeRateTuples{
+		{Denom: utils.MicroAtomDenom, ExchangeRate: randomExchangeRate},
+		{Denom: utils.MicroSeiDenom, ExchangeRate: anotherRandomExchangeRate},
+	}, vote.ExchangeRateTuples)
+
+	// Case 2: a denom can't be voted on twice in the same period
+	_, err = h(ctx, types.NewMsgAggregateExchangeRateVote(anotherRandomExchangeRate.String()+utils.MicroAtomDenom, keeper.Addrs[1], keeper.ValAddrs[0]))
+	require.ErrorIs(t, err, types.ErrAggregateVoteExist)
+	vote, err = input.OracleKeeper.GetAggregateExchangeRateVote(ctx, keeper.ValAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, randomExchangeRate, vote.ExchangeRateTuples[0].ExchangeRate)
+}
+
+func TestAggregatePrevoteAndVote(t *testing.T) {
+	input, h := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.VotePeriod = 2
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	salt := "1"
+	exchangeRatesStr := randomExchangeRate.String() + utils.MicroAtomDenom
+	hash := types.GetAggregateVoteHash(salt, exchangeRatesStr, keeper.ValAddrs[0])
+	prevoteMsg := types.NewMsgAggregateExchangeRatePrevote(hash, keeper.Addrs[0], keeper.ValAddrs[0])
+
+	// Case 1: prevotes are rejected while not required
+	_, err := h(input.Ctx.WithBlockHeight(1), prevoteMsg)
+	require.ErrorIs(t, err, types.ErrPrevoteNotRequired)
+
+	params.RequirePrevote = true
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// Case 2: a vote without a prevote fails
+	voteMsg := types.NewMsgAggregateExchangeRateVote(exchangeRatesStr, keeper.Addrs[0], keeper.ValAddrs[0])
+	voteMsg.Salt = salt
+	_, err = h(input.Ctx.WithBlockHeight(1), voteMsg)
+	require.ErrorIs(t, err, types.ErrNoAggregatePrevote)
+
+	// Case 3: a vote without a salt fails
+	voteMsg.Salt = ""
+	_, err = h(input.Ctx.WithBlockHeight(1), voteMsg)
+	require.ErrorIs(t, err, types.ErrInvalidSaltLength)
+	voteMsg.Salt = salt
+
+	// Case 4: prevote from a non-feeder fails
+	_, err = h(input.Ctx.WithBlockHeight(1), types.NewMsgAggregateExchangeRatePrevote(hash, keeper.Addrs[1], keeper.ValAddrs[0]))
+	require.Error(t, err)
+
+	_, err = h(input.Ctx.WithBlockHeight(1), prevoteMsg)
+	require.NoError(t, err)
+	prevote, err := input.OracleKeeper.GetAggregateExchangeRatePrevote(input.Ctx, keeper.ValAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), prevote.SubmitBlock)
+
+	// Case 5: revealing in the same vote period fails
+	_, err = h(input.Ctx.WithBlockHeight(1), voteMsg)
+	require.ErrorIs(t, err, types.ErrRevealPeriodMissMatch)
+
+	// Case 6: revealing two vote periods later fails
+	_, err = h(input.Ctx.WithBlockHeight(4), voteMsg)
+	require.ErrorIs(t, err, types.ErrRevealPeriodMissMatch)
+
+	// Case 7: revealing different exchange rates fails
+	tamperedMsg := types.NewMsgAggregateExchangeRateVote(anotherRandomExchangeRate.String()+utils.MicroAtomDenom, keeper.Addrs[0], keeper.ValAddrs[0])
+	tamperedMsg.Salt = salt
+	_, err = h(input.Ctx.WithBlockHeight(2), tamperedMsg)
+	require.ErrorIs(t, err, types.ErrVerificationFailed)
+
+	// Case 8: revealing in the next vote period succeeds and consumes the prevote
+	_, err = h(input.Ctx.WithBlockHeight(2), voteMsg)
+	require.NoError(t, err)
+	_, err = input.OracleKeeper.GetAggregateExchangeRateVote(input.Ctx, keeper.ValAddrs[0])
+	require.NoError(t, err)
+	_, err = input.OracleKeeper.GetAggregateExchangeRatePrevote(input.Ctx, keeper.ValAddrs[0])
+	require.Error(t, err)
+
+	// Case 9: only the first prevote of a vote period is accepted
+	_, err = h(input.Ctx.WithBlockHeight(2), prevoteMsg)
+	require.NoError(t, err)
+	_, err = h(input.Ctx.WithBlockHeight(3), prevoteMsg)
+	require.ErrorIs(t, err, types.ErrAggregatePrevoteExist)
+}
@@ -0,0 +1,208 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sei-protocol/sei-chain/x/oracle/types"
+)
+
+// pricePoint is an exchange rate that is in effect from its timestamp on
+type pricePoint struct {
+	timestamp    int64
+	exchangeRate sdk.Dec
+}
+
+// getPriceHistory returns the price points of every vote target and derived
+// denom over the lookback in chronological order. Like CalculateTwaps, the
+// snapshot in effect at the start of the lookback is included with its
+// timestamp bounded to the start of the lookback.
+func (k Keeper) getPriceHistory(ctx sdk.Context, lookbackSeconds uint64) (map[string][]pricePoint, error) {
+	if err := k.ValidateLookbackSeconds(ctx, lookbackSeconds); err != nil {
+		return nil, err
+	}
+	currentTime := ctx.BlockTime().Unix()
+
+	// get targets - only calculate for the targets
+	targetsMap := k.getPriceTargets(ctx)
+
+	history := make(map[string][]pricePoint)
+	k.IteratePriceSnapshotsReverse(ctx, func(snapshot types.PriceSnapshot) (stop bool) {
+		stop = false
+		snapshotTimestamp := snapshot.SnapshotTimestamp
+		if currentTime-int64(lookbackSeconds) > snapshotTimestamp {
+			snapshotTimestamp = currentTime - int64(lookbackSeconds)
+			stop = true
+		}
+
+		for _, priceItem := range snapshot.PriceSnapshotItems {
+			if _, ok := targetsMap[priceItem.Denom]; !ok {
+				continue
+			}
+			history[priceItem.Denom] = append(history[priceItem.Denom], pricePoint{
+				timestamp:    snapshotTimestamp,
+				exchangeRate: priceItem.OracleExchangeRate.ExchangeRate,
+			})
+		}
+		return stop
+	})
+
+	// snapshots were traversed from the newest one
+	for _, points := range history {
+		for i, j := 0, len(points)-1; i < j; i, j = i+1, j-1 {
+			points[i], points[j] = points[j], points[i]
+		}
+	}
+
+	if len(history) == 0 {
+		return nil, types.ErrNoPriceHistory
+	}
+	return history, nil
+}
+
+func sortedDenoms(history map[string][]pricePoint) []string {
+	denoms := make([]string, 0, len(history))
+	for denom := range history {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+	return denoms
+}
+
+// CalculateEmas calculates the exponential moving average of the exchange rate
+// of every vote target over the lookback. Every price point moves the average
+// towards its exchange rate by dt / (dt + periodSeconds), where dt is the time
+// the exchange rate was in effect, which approximates the continuous
+// 1 - exp(-dt / periodSeconds) decay without floating point math.
+func (k Keeper) CalculateEmas(ctx sdk.Context, lookbackSeconds uint64, periodSeconds uint64) (types.OracleEmas, error) {
+	history, err := k.getPriceHistory(ctx, lookbackSeconds)
+	if err != nil {
+		return nil, err
+	}
+	currentTime := ctx.BlockTime().Unix()
+	period := sdk.NewDecFromInt(sdk.NewIntFromUint64(periodSeconds))
+
+	oracleEmas := types.OracleEmas{}
+	for _, denom := range sortedDenoms(history) {
+		points := history[denom]
+		ema := points[0].exchangeRate
+		for i, point := range points {
+			// an exchange rate is in effect until the next price point, and
+			// the last one until now
+			until := currentTime
+			if i+1 < len(points) {
+				until = points[i+1].timestamp
+			}
+			dt := sdk.NewDec(until - point.timestamp)
+			if !dt.IsPositive() {
+				continue
+			}
+			alpha := dt.Quo(dt.Add(period))
+			ema = ema.Add(point.exchangeRate.Sub(ema).Mul(alpha))
+		}
+
+		oracleEmas = append(oracleEmas, types.OracleEma{
+			Denom:           denom,
+			Ema:             ema,
+			LookbackSeconds: currentTime - points[0].timestamp,
+		})
+	}
+	return oracleEmas, nil
+}
+
+// CalculatePriceRanges calculates the lowest and highest exchange rate of
+// every vote target over the lookback
+func (k Keeper) CalculatePriceRanges(ctx sdk.Context, lookbackSeconds uint64) (types.OraclePriceRanges, error) {
+	history, err := k.getPriceHistory(ctx, lookbackSeconds)
+	if err != nil {
+		return nil, err
+	}
+	currentTime := ctx.BlockTime().Unix()
+
+	oraclePriceRanges := types.OraclePriceRanges{}
+	for _, denom := range sortedDenoms(history) {
+		points := history[denom]
+		lowest, highest := points[0].exchangeRate, points[0].exchangeRate
+		for _, point := range points[1:] {
+			lowest = sdk.MinDec(lowest, point.exchangeRate)
+			highest = sdk.MaxDec(highest, point.exchangeRate)
+		}
+
+		oraclePriceRanges = append(oraclePriceRanges, types.OraclePriceRange{
+			Denom:           denom,
+			Min:             lowest,
+			Max:             highest,
+			LookbackSeconds: currentTime - points[0].timestamp,
+		})
+	}
+	return oraclePriceRanges, nil
+}
+
+// CalculateVolatilities calculates the realised volatility of the exchange
+// rate of every vote target over the lookback, i.e. the square root of the sum
+// of squared returns between consecutive price points
+func (k Keeper) CalculateVolatilities(ctx sdk.Context, lookbackSeconds uint64) (types.OracleVolatilities, error) {
+	history, err := k.getPriceHistory(ctx, lookbackSeconds)
+	if err != nil {
+		return nil, err
+	}
+	currentTime := ctx.BlockTime().Unix()
+
+	oracleVolatilities := types.OracleVolatilities{}
+	for _, denom := range sortedDenoms(history) {
+		points := history[denom]
+		sumSquaredReturns := sdk.ZeroDec()
+		for i := 1; i < len(points); i++ {
+			previous := points[i-1].exchangeRate
+			if !previous.IsPositive() {
+				continue
+			}
+			ret := points[i].exchangeRate.Quo(previous).Sub(sdk.OneDec())
+			sumSquaredReturns = sumSquaredReturns.Add(ret.Mul(ret))
+		}
+
+		volatility, err := sumSquaredReturns.ApproxSqrt()
+		if err != nil {
+			return nil, err
+		}
+
+		oracleVolatilities = append(oracleVolatilities, types.OracleVolatility{
+			Denom:           denom,
+			Volatility:      volatility,
+			LookbackSeconds: currentTime - points[0].timestamp,
+		})
+	}
+	return oracleVolatilities, nil
+}
+
+// GetPriceAtTimestamp returns the exchange rate of a denom from the latest
+// price snapshot taken at or before the timestamp, along with the timestamp
+// of that snapshot
+func (k Keeper) GetPriceAtTimestamp(ctx sdk.Context, denom string, timestamp int64) (types.OracleExchangeRate, int64, error) {
+	var (
+		exchangeRate      types.OracleExchangeRate
+		snapshotTimestamp int64
+		found             bool
+	)
+	k.IteratePriceSnapshotsReverse(ctx, func(snapshot types.PriceSnapshot) (stop bool) {
+		if snapshot.SnapshotTimestamp > timestamp {
+			return false
+		}
+		for _, priceItem := range snapshot.PriceSnapshotItems {
+			if priceItem.Denom == denom {
+				exchangeRate = priceItem.OracleExchangeRate
+				snapshotTimestamp = snapshot.SnapshotTimestamp
+				found = true
+			}
+		}
+		// the denom had no exchange rate at the time if it's not in the snapshot
+		return true
+	})
+
+	if !found {
+		return exchangeRate, 0, sdkerrors.Wrapf(types.ErrNoPriceAtTimestamp, "%s at %d", denom, timestamp)
+	}
+	return exchangeRate, snapshotTimestamp, nil
+}
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/oracle/types"
+	"github.com/sei-protocol/sei-chain/x/oracle/utils"
+)
+
+func setAggregatesPriceSnapshots(input TestInput) {
+	priceSnapshots := types.PriceSnapshots{
+		types.NewPriceSnapshot(types.PriceSnapshotItems{
+			types.NewPriceSnapshotItem(utils.MicroAtomDenom, types.OracleExchangeRate{
+				ExchangeRate: sdk.NewDec(40),
+				LastUpdate:   sdk.NewInt(1200),
+			}),
+		}, 1200),
+		types.NewPriceSnapshot(types.PriceSnapshotItems{
+			types.NewPriceSnapshotItem(utils.MicroEthDenom, types.OracleExchangeRate{
+				ExchangeRate: sdk.NewDec(10),
+				LastUpdate:   sdk.NewInt(3600),
+			}),
+			types.NewPriceSnapshotItem(utils.MicroAtomDenom, types.OracleExchangeRate{
+				ExchangeRate: sdk.NewDec(20),
+				LastUpdate:   sdk.NewInt(3600),
+			}),
+		}, 3600),
+		types.NewPriceSnapshot(types.PriceSnapshotItems{
+			types.NewPriceSnapshotItem(utils.MicroEthDenom, types.OracleExchangeRate{
+				ExchangeRate: sdk.NewDec(20),
+				LastUpdate:   sdk.NewInt(4500),
+			}),
+			types.NewPriceSnapshotItem(utils.MicroAtomDenom, types.OracleExchangeRate{
+				ExchangeRate: sdk.NewDec(40),
+				LastUpdate:   sdk.NewInt(4500),
+			}),
+		}, 4500),
+	}
+	for _, snap := range priceSnapshots {
+		input.OracleKeeper.SetPriceSnapshot(input.Ctx, snap)
+	}
+}
+
+func TestCalculateEmas(t *testing.T) {
+	input := CreateTestInput(t)
+
+	_, err := input.OracleKeeper.CalculateEmas(input.Ctx, 3600, 1800)
+	require.Equal(t, types.ErrNoPriceHistory, err)
+
+	setAggregatesPriceSnapshots(input)
+	input.Ctx = input.Ctx.WithBlockTime(time.Unix(5400, 0))
+
+	_, err = input.OracleKeeper.CalculateEmas(input.Ctx, 0, 1800)
+	require.Equal(t, types.ErrInvalidTwapLookback, err)
+
+	emas, err := input.OracleKeeper.CalculateEmas(input.Ctx, 3600, 1800)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(emas))
+
+	// atom was 40 until 3600, 20 until 4500 and 40 since, each step moving
+	// the average by 900 / (900 + 1800) = 1/3 of the way
+	require.Equal(t, utils.MicroAtomDenom, emas[0].Denom)
+	require.Equal(t, int64(3600), emas[0].LookbackSeconds)
+	require.Equal(t, sdk.MustNewDecFromStr("35.555555555555555558"), emas[0].Ema)
+
+	require.Equal(t, utils.MicroEthDenom, emas[1].Denom)
+	require.Equal(t, int64(1800), emas[1].LookbackSeconds)
+	require.Equal(t, sdk.MustNewDecFromStr("13.333333333333333330"), emas[1].Ema)
+}
+
+func TestCalculatePriceRanges(t *testing.T) {
+	input := CreateTestInput(t)
+
+	_, err := input.OracleKeeper.CalculatePriceRanges(input.Ctx, 3600)
+	require.Equal(t, types.ErrNoPriceHistory, err)
+
+	setAggregatesPriceSnapshots(input)
+	input.Ctx = input.Ctx.WithBlockTime(time.Unix(5400, 0))
+
+	priceRanges, err := input.OracleKeeper.CalculatePriceRanges(input.Ctx, 3600)
+	require.NoError(t, err)
+	require.Equal(t, types.OraclePriceRanges{
+		{Denom: utils.MicroAtomDenom, Min: sdk.NewDec(20), Max: sdk.NewDec(40), LookbackSeconds: 3600},
+		{Denom: utils.MicroEthDenom, Min: sdk.NewDec(10), Max: sdk.NewDec(20), LookbackSeconds: 1800},
+	}, priceRanges)
+
+	// the atom price of 40 from 1200 falls out of a shorter lookback
+	priceRanges, err = input.OracleKeeper.CalculatePriceRanges(input.Ctx, 1200)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(20), priceRanges[0].Min)
+	require.Equal(t, sdk.NewDec(40), priceRanges[0].Max)
+	require.Equal(t, int64(1200), priceRanges[0].LookbackSeconds)
+}
+
+func TestCalculateVolatilities(t *testing.T) {
+	input := CreateTestInput(t)
+
+	_, err := input.OracleKeeper.CalculateVolatilities(input.Ctx, 3600)
+	require.Equal(t, types.ErrNoPriceHistory, err)
+
+	setAggregatesPriceSnapshots(input)
+	input.Ctx = input.Ctx.WithBlockTime(time.Unix(5400, 0))
+
+	volatilities, err := input.OracleKeeper.CalculateVolatilities(input.Ctx, 3600)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(volatilities))
+
+	// atom returns are -50% and +100%
+	expectedAtom, err := sdk.NewDecWithPrec(125, 2).ApproxSqrt()
+	require.NoError(t, err)
+	require.Equal(t, utils.MicroAtomDenom, volatilities[0].Denom)
+	require.Equal(t, expectedAtom, volatilities[0].Volatility)
+
+	// eth return is +100%
+	require.Equal(t, utils.MicroEthDenom, volatilities[1].Denom)
+	require.Equal(t, sdk.OneDec(), volatilities[1].Volatility)
+}
+
+func TestGetPriceAtTimestamp(t *testing.T) {
+	input := CreateTestInput(t)
+	setAggregatesPriceSnapshots(input)
+
+	exchangeRate, snapshotTimestamp, err := input.OracleKeeper.GetPriceAtTimestamp(input.Ctx, utils.MicroAtomDenom, 4000)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(20), exchangeRate.ExchangeRate)
+	require.Equal(t, int64(3600), snapshotTimestamp)
+
+	exchangeRate, snapshotTimestamp, err = input.OracleKeeper.GetPriceAtTimestamp(input.Ctx, utils.MicroAtomDenom, 4500)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(40), exchangeRate.ExchangeRate)
+	require.Equal(t, int64(4500), snapshotTimestamp)
+
+	// there is no snapshot that old
+	_, _, err = input.OracleKeeper.GetPriceAtTimestamp(input.Ctx, utils.MicroAtomDenom, 1000)
+	require.ErrorIs(t, err, types.ErrNoPriceAtTimestamp)
+
+	// eth had no exchange rate at the time
+	_, _, err = input.OracleKeeper.GetPriceAtTimestamp(input.Ctx, utils.MicroEthDenom, 2000)
+	require.ErrorIs(t, err, types.ErrNoPriceAtTimestamp)
+}
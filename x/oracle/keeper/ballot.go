@@ -10,7 +10,9 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
-// OrganizeBallotByDenom collects all oracle votes for the period, categorized by the votes' denom parameter
+// OrganizeBallotByDenom collects all oracle votes for the period, categorized by the votes' denom parameter.
+// When prevotes are required, votes are only stored once they reveal a matching prevote hash, so every vote
+// collected here is a verified reveal.
 func (k Keeper) OrganizeBallotByDenom(ctx sdk.Context, validatorClaimMap map[string]types.Claim) (votes map[string]types.ExchangeRateBallot) {
 	votes = map[string]types.ExchangeRateBallot{}
 
@@ -54,8 +56,33 @@ func (k Keeper) OrganizeBallotByDenom(ctx sdk.Context, validatorClaimMap map[str
 	return votes
 }
 
-// ClearBallots clears all tallied votes from the store
-func (k Keeper) ClearBallots(ctx sdk.Context, _ uint64) {
+// GetUnrevealedPrevoters returns the validators that submitted a prevote in the
+// previous vote period and did not reveal it in the current one. Revealing a
+// prevote removes it from the store.
+func (k Keeper) GetUnrevealedPrevoters(ctx sdk.Context, votePeriod uint64) map[string]struct{} {
+	unrevealed := map[string]struct{}{}
+	currentPeriod := uint64(ctx.BlockHeight()) / votePeriod
+	k.IterateAggregateExchangeRatePrevotes(ctx, func(voterAddr sdk.ValAddress, aggregatePrevote types.AggregateExchangeRatePrevote) (stop bool) {
+		if aggregatePrevote.SubmitBlock/votePeriod+1 == currentPeriod {
+			unrevealed[voterAddr.String()] = struct{}{}
+		}
+		return false
+	})
+	return unrevealed
+}
+
+// ClearBallots clears all tallied votes and all prevotes that can no longer be
+// revealed from the store
+func (k Keeper) ClearBallots(ctx sdk.Context, votePeriod uint64) {
+	// Clear all aggregate prevotes submitted before the current vote period
+	currentPeriod := uint64(ctx.BlockHeight()) / votePeriod
+	k.IterateAggregateExchangeRatePrevotes(ctx, func(voterAddr sdk.ValAddress, aggregatePrevote types.AggregateExchangeRatePrevote) (stop bool) {
+		if aggregatePrevote.SubmitBlock/votePeriod < currentPeriod {
+			k.DeleteAggregateExchangeRatePrevote(ctx, voterAddr)
+		}
+		return false
+	})
+
 	// Clear all aggregate votes
 	k.IterateAggregateExchangeRateVotes(ctx, func(voterAddr sdk.ValAddress, aggregateVote types.AggregateExchangeRateVote) (stop bool) {
 		k.DeleteAggregateExchangeRateVote(ctx, voterAddr)
@@ -71,7 +98,8 @@ func (k Keeper) ApplyWhitelist(ctx sdk.Context, whitelist types.DenomList, voteT
 		updateRequired = true
 	} else {
 		for _, item := range whitelist {
-			if _, ok := voteTargets[item.Name]; !ok {
+			// the per-denom overrides of the whitelist entry may have changed
+			if voteTarget, ok := voteTargets[item.Name]; !ok || !voteTarget.Equal(&item) {
 				updateRequired = true
 				break
 			}
@@ -82,7 +110,7 @@ func (k Keeper) ApplyWhitelist(ctx sdk.Context, whitelist types.DenomList, voteT
 		k.ClearVoteTargets(ctx)
 
 		for _, item := range whitelist {
-			k.SetVoteTarget(ctx, item.Name)
+			k.SetVoteTarget(ctx, item)
 
 			// Register meta data to bank module
 			if _, ok := k.bankKeeper.GetDenomMetaData(ctx, item.Name); !ok {
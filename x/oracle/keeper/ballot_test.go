@@ -114,6 +114,14 @@ func TestClearBallots(t *testing.T) {
 			}, ValAddrs[i]))
 	}
 
+	// prevote from the previous vote period can no longer be revealed,
+	// prevote from the current vote period is revealed in the next one
+	input.Ctx = input.Ctx.WithBlockHeight(14)
+	input.OracleKeeper.SetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[0],
+		types.NewAggregateExchangeRatePrevote(types.AggregateVoteHash{}, ValAddrs[0], 7))
+	input.OracleKeeper.SetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[1],
+		types.NewAggregateExchangeRatePrevote(types.AggregateVoteHash{}, ValAddrs[1], 10))
+
 	input.OracleKeeper.ClearBallots(input.Ctx, 5)
 
 	voteCounter := 0
@@ -122,6 +130,29 @@ func TestClearBallots(t *testing.T) {
 		return false
 	})
 	require.Equal(t, voteCounter, 0)
+
+	_, err = input.OracleKeeper.GetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[0])
+	require.Error(t, err)
+	_, err = input.OracleKeeper.GetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[1])
+	require.NoError(t, err)
+}
+
+func TestGetUnrevealedPrevoters(t *testing.T) {
+	input := CreateTestInput(t)
+	input.Ctx = input.Ctx.WithBlockHeight(14)
+
+	// submitted in the previous vote period and not revealed
+	input.OracleKeeper.SetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[0],
+		types.NewAggregateExchangeRatePrevote(types.AggregateVoteHash{}, ValAddrs[0], 7))
+	// submitted in the current vote period
+	input.OracleKeeper.SetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[1],
+		types.NewAggregateExchangeRatePrevote(types.AggregateVoteHash{}, ValAddrs[1], 12))
+	// submitted too long ago to be revealed
+	input.OracleKeeper.SetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[2],
+		types.NewAggregateExchangeRatePrevote(types.AggregateVoteHash{}, ValAddrs[2], 2))
+
+	unrevealed := input.OracleKeeper.GetUnrevealedPrevoters(input.Ctx, 5)
+	require.Equal(t, map[string]struct{}{ValAddrs[0].String(): {}}, unrevealed)
 }
 
 func TestApplyWhitelist(t *testing.T) {
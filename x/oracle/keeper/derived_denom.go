@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/oracle/types"
+)
+
+// SetDerivedExchangeRates sets the exchange rates of the derived denoms from the exchange rates
+// tallied in the current vote period. A derived denom keeps its previous exchange rate when any
+// of its terms wasn't tallied, like a voted denom whose ballot didn't pass.
+func (k Keeper) SetDerivedExchangeRates(ctx sdk.Context, derivedDenoms types.DerivedDenomList, exchangeRates map[string]sdk.Dec) {
+	for _, derived := range derivedDenoms {
+		exchangeRate, ok := derived.Compute(exchangeRates)
+		if !ok {
+			continue
+		}
+		k.SetBaseExchangeRateWithEvent(ctx, derived.Name, exchangeRate)
+	}
+}
+
+// getPriceTargets returns the denoms that price snapshot aggregates are calculated for,
+// which are the vote targets and the derived denoms
+func (k Keeper) getPriceTargets(ctx sdk.Context) map[string]struct{} {
+	targetsMap := make(map[string]struct{})
+	k.IterateVoteTargets(ctx, func(denom string, denomInfo types.Denom) (stop bool) {
+		targetsMap[denom] = struct{}{}
+		return false
+	})
+	for _, derived := range k.DerivedDenoms(ctx) {
+		targetsMap[derived.Name] = struct{}{}
+	}
+	return targetsMap
+}
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sei-protocol/sei-chain/x/oracle/types"
+)
+
+// GetFeederPermission retrieves the permission a validator granted to an additional feeder
+func (k Keeper) GetFeederPermission(ctx sdk.Context, operator sdk.ValAddress, feeder sdk.AccAddress) (types.FeederPermission, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetFeederPermissionKey(operator, feeder))
+	if bz == nil {
+		return types.FeederPermission{}, false
+	}
+
+	permission := types.FeederPermission{}
+	k.cdc.MustUnmarshal(bz, &permission)
+	return permission, true
+}
+
+// SetFeederPermission stores the permission a validator grants to an additional feeder
+func (k Keeper) SetFeederPermission(ctx sdk.Context, operator sdk.ValAddress, feeder sdk.AccAddress, permission types.FeederPermission) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&permission)
+	store.Set(types.GetFeederPermissionKey(operator, feeder), bz)
+}
+
+// DeleteFeederPermission revokes the permission of an additional feeder
+func (k Keeper) DeleteFeederPermission(ctx sdk.Context, operator sdk.ValAddress, feeder sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetFeederPermissionKey(operator, feeder))
+}
+
+// IterateFeederPermissions iterates over the additional feeders of a validator
+func (k Keeper) IterateFeederPermissions(ctx sdk.Context, operator sdk.ValAddress,
+	handler func(feeder sdk.AccAddress, permission types.FeederPermission) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetFeederPermissionsPrefix(operator))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var permission types.FeederPermission
+		k.cdc.MustUnmarshal(iter.Value(), &permission)
+
+		feeder, err := sdk.AccAddressFromBech32(permission.Feeder)
+		if err != nil {
+			panic(err)
+		}
+
+		if handler(feeder, permission) {
+			break
+		}
+	}
+}
+
+// IterateAllFeederPermissions iterates over the additional feeders of every validator
+func (k Keeper) IterateAllFeederPermissions(ctx sdk.Context,
+	handler func(operator sdk.ValAddress, permission types.FeederPermission) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.FeederPermissionKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		// key layout: prefix | len(operator) | operator | len(feeder) | feeder
+		key := iter.Key()
+		operator := sdk.ValAddress(key[2 : 2+int(key[1])])
+
+		var permission types.FeederPermission
+		k.cdc.MustUnmarshal(iter.Value(), &permission)
+
+		if handler(operator, permission) {
+			break
+		}
+	}
+}
+
+// PruneExpiredFeederPermissions removes the permissions of a validator that have expired as of the current block
+func (k Keeper) PruneExpiredFeederPermissions(ctx sdk.Context, operator sdk.ValAddress) {
+	var expired []sdk.AccAddress
+	k.IterateFeederPermissions(ctx, operator, func(feeder sdk.AccAddress, permission types.FeederPermission) bool {
+		if isFeederPermissionExpired(ctx, permission) {
+			expired = append(expired, feeder)
+		}
+		return false
+	})
+
+	for _, feeder := range expired {
+		k.DeleteFeederPermission(ctx, operator, feeder)
+	}
+}
+
+// isAuthorizedFeeder returns the permission of the feeder if it is an unexpired additional feeder of the validator
+func (k Keeper) isAuthorizedFeeder(ctx sdk.Context, feederAddr sdk.AccAddress, validatorAddr sdk.ValAddress) (types.FeederPermission, bool) {
+	permission, found := k.GetFeederPermission(ctx, validatorAddr, feederAddr)
+	if !found || isFeederPermissionExpired(ctx, permission) {
+		return types.FeederPermission{}, false
+	}
+
+	return permission, true
+}
+
+// ValidateFeederScope checks that the feeder is allowed to vote on every given denom. The validator
+// itself and its primary delegate may vote on any denom; an additional feeder without denoms in its
+// permission is unscoped as well.
+func (k Keeper) ValidateFeederScope(ctx sdk.Context, feederAddr sdk.AccAddress, validatorAddr sdk.ValAddress, denoms []string) error {
+	if feederAddr.Equals(validatorAddr) || k.GetFeederDelegation(ctx, validatorAddr).Equals(feederAddr) {
+		return nil
+	}
+
+	permission, found := k.isAuthorizedFeeder(ctx, feederAddr, validatorAddr)
+	if !found {
+		return sdkerrors.Wrap(types.ErrNoVotingPermission, feederAddr.String())
+	}
+
+	if len(permission.Denoms) == 0 {
+		return nil
+	}
+
+	scope := make(map[string]struct{}, len(permission.Denoms))
+	for _, denom := range permission.Denoms {
+		scope[denom] = struct{}{}
+	}
+
+	for _, denom := range denoms {
+		if _, ok := scope[denom]; !ok {
+			return sdkerrors.Wrapf(types.ErrNoVotingPermission, "feeder %s may not vote on %s", feederAddr.String(), denom)
+		}
+	}
+
+	return nil
+}
+
+func isFeederPermissionExpired(ctx sdk.Context, permission types.FeederPermission) bool {
+	return permission.Expiry != 0 && ctx.BlockTime().Unix() >= permission.Expiry
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/oracle/types"
+	"github.com/sei-protocol/sei-chain/x/oracle/utils"
+)
+
+func TestFeederPermission(t *testing.T) {
+	input := CreateTestInput(t)
+
+	_, found := input.OracleKeeper.GetFeederPermission(input.Ctx, ValAddrs[0], Addrs[1])
+	require.False(t, found)
+
+	permission := types.FeederPermission{Feeder: Addrs[1].String(), Denoms: []string{utils.MicroAtomDenom}}
+	input.OracleKeeper.SetFeederPermission(input.Ctx, ValAddrs[0], Addrs[1], permission)
+	input.OracleKeeper.SetFeederPermission(input.Ctx, ValAddrs[1], Addrs[2], types.FeederPermission{Feeder: Addrs[2].String()})
+
+	stored, found := input.OracleKeeper.GetFeederPermission(input.Ctx, ValAddrs[0], Addrs[1])
+	require.True(t, found)
+	require.Equal(t, permission, stored)
+
+	var feeders []sdk.AccAddress
+	input.OracleKeeper.IterateFeederPermissions(input.Ctx, ValAddrs[0], func(feeder sdk.AccAddress, _ types.FeederPermission) bool {
+		feeders = append(feeders, feeder)
+		return false
+	})
+	require.Equal(t, []sdk.AccAddress{Addrs[1]}, feeders)
+
+	var operators []sdk.ValAddress
+	input.OracleKeeper.IterateAllFeederPermissions(input.Ctx, func(operator sdk.ValAddress, _ types.FeederPermission) bool {
+		operators = append(operators, operator)
+		return false
+	})
+	require.Len(t, operators, 2)
+
+	input.OracleKeeper.DeleteFeederPermission(input.Ctx, ValAddrs[0], Addrs[1])
+	_, found = input.OracleKeeper.GetFeederPermission(input.Ctx, ValAddrs[0], Addrs[1])
+	require.False(t, found)
+}
+
+func TestPruneExpiredFeederPermissions(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := input.Ctx.WithBlockTime(time.Unix(100, 0))
+
+	input.OracleKeeper.SetFeederPermission(ctx, ValAddrs[0], Addrs[1], types.FeederPermission{Feeder: Addrs[1].String(), Expiry: 100})
+	input.OracleKeeper.SetFeederPermission(ctx, ValAddrs[0], Addrs[2], types.FeederPermission{Feeder: Addrs[2].String(), Expiry: 101})
+	input.OracleKeeper.SetFeederPermission(ctx, ValAddrs[0], Addrs[3], types.FeederPermission{Feeder: Addrs[3].String()})
+
+	input.OracleKeeper.PruneExpiredFeederPermissions(ctx, ValAddrs[0])
+
+	_, found := input.OracleKeeper.GetFeederPermission(ctx, ValAddrs[0], Addrs[1])
+	require.False(t, found)
+	_, found = input.OracleKeeper.GetFeederPermission(ctx, ValAddrs[0], Addrs[2])
+	require.True(t, found)
+	_, found = input.OracleKeeper.GetFeederPermission(ctx, ValAddrs[0], Addrs[3])
+	require.True(t, found)
+}
+
+func TestValidateFeederScope(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := input.Ctx.WithBlockTime(time.Unix(100, 0))
+
+	input.OracleKeeper.SetFeederDelegation(ctx, ValAddrs[0], Addrs[1])
+	input.OracleKeeper.SetFeederPermission(ctx, ValAddrs[0], Addrs[2], types.FeederPermission{Feeder: Addrs[2].String(), Denoms: []string{utils.MicroAtomDenom}})
+	input.OracleKeeper.SetFeederPermission(ctx, ValAddrs[0], Addrs[3], types.FeederPermission{Feeder: Addrs[3].String(), Expiry: 200})
+
+	denoms := []string{utils.MicroAtomDenom, utils.MicroEthDenom}
+
+	// the validator and its primary delegate are unscoped
+	require.NoError(t, input.OracleKeeper.ValidateFeederScope(ctx, Addrs[0], ValAddrs[0], denoms))
+	require.NoError(t, input.OracleKeeper.ValidateFeederScope(ctx, Addrs[1], ValAddrs[0], denoms))
+
+	// scoped feeders may only vote on their denoms
+	require.NoError(t, input.OracleKeeper.ValidateFeederScope(ctx, Addrs[2], ValAddrs[0], denoms[:1]))
+	require.ErrorIs(t, input.OracleKeeper.ValidateFeederScope(ctx, Addrs[2], ValAddrs[0], denoms), types.ErrNoVotingPermission)
+
+	// feeders without denoms are unscoped until they expire
+	require.NoError(t, input.OracleKeeper.ValidateFeederScope(ctx, Addrs[3], ValAddrs[0], denoms))
+	require.ErrorIs(t, input.OracleKeeper.ValidateFeederScope(ctx.WithBlockTime(time.Unix(200, 0)), Addrs[3], ValAddrs[0], denoms), types.ErrNoVotingPermission)
+
+	// other accounts have no permission at all
+	require.ErrorIs(t, input.OracleKeeper.ValidateFeederScope(ctx, Addrs[4], ValAddrs[0], denoms), types.ErrNoVotingPermission)
+	require.ErrorIs(t, input.OracleKeeper.ValidateFeeder(ctx, Addrs[4], ValAddrs[0]), types.ErrNoVotingPermission)
+}
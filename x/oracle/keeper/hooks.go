@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Hooks wrapper struct for the oracle keeper
+type Hooks struct {
+	k Keeper
+}
+
+var _ stakingtypes.StakingHooks = Hooks{}
+
+// Hooks returns the staking hooks of the oracle module
+func (k Keeper) Hooks() Hooks { return Hooks{k} }
+
+// AfterValidatorBonded records the bond height, which starts the slashing grace period of the validator
+func (h Hooks) AfterValidatorBonded(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) {
+	h.k.SetValidatorBondHeight(ctx, valAddr, ctx.BlockHeight())
+}
+
+// AfterValidatorRemoved cleans up the bond height of a removed validator
+func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) {
+	h.k.DeleteValidatorBondHeight(ctx, valAddr)
+}
+
+// the remaining hooks are no-ops for the oracle module
+
+func (h Hooks) AfterValidatorCreated(_ sdk.Context, _ sdk.ValAddress) {}
+
+func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress) {}
+
+func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) {}
+
+func (h Hooks) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) {}
+
+func (h Hooks) BeforeDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) {}
+
+func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) {}
+
+func (h Hooks) AfterDelegationModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) {}
+
+func (h Hooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdk.Dec) {}
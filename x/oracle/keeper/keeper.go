@@ -104,6 +104,31 @@ func (k Keeper) DeleteBaseExchangeRate(ctx sdk.Context, denom string) {
 	store.Delete(types.GetExchangeRateKey(denom))
 }
 
+// GetExchangeRateConfidence returns the confidence of the last tallied exchange rate of a denom
+func (k Keeper) GetExchangeRateConfidence(ctx sdk.Context, denom string) (types.ExchangeRateConfidence, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetExchangeRateConfidenceKey(denom))
+	if bz == nil {
+		return types.ExchangeRateConfidence{}, false
+	}
+
+	confidence := types.ExchangeRateConfidence{}
+	k.cdc.MustUnmarshal(bz, &confidence)
+	return confidence, true
+}
+
+// SetExchangeRateConfidence sets the confidence of the last tallied exchange rate of a denom
+func (k Keeper) SetExchangeRateConfidence(ctx sdk.Context, denom string, confidence types.ExchangeRateConfidence) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&confidence)
+	store.Set(types.GetExchangeRateConfidenceKey(denom), bz)
+}
+
+func (k Keeper) DeleteExchangeRateConfidence(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetExchangeRateConfidenceKey(denom))
+}
+
 func (k Keeper) IterateBaseExchangeRates(ctx sdk.Context, handler func(denom string, exchangeRate types.OracleExchangeRate) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
 	iter := sdk.KVStorePrefixIterator(store, types.ExchangeRateKey)
@@ -125,9 +150,13 @@ func (k Keeper) RemoveExcessFeeds(ctx sdk.Context) {
 		excessActives[denom] = struct{}{}
 		return false
 	})
-	// get vote targets
-	k.IterateVoteTargets(ctx, func(denom string, denomInfo types.Denom) (stop bool) {
-		// remove vote targets from actives
+	// get vote targets and derived denoms
+	for denom := range k.getPriceTargets(ctx) {
+		// remove them from actives
+		delete(excessActives, denom)
+	}
+	// removed vote targets are cleared once their sunset period ends
+	k.IterateVoteTargetSunsets(ctx, func(denom string, _ int64) (stop bool) {
 		delete(excessActives, denom)
 		return false
 	})
@@ -142,6 +171,7 @@ func (k Keeper) RemoveExcessFeeds(ctx sdk.Context) {
 	for _, denom := range activesToClear {
 		// clear exchange rates
 		k.DeleteBaseExchangeRate(ctx, denom)
+		k.DeleteExchangeRateConfidence(ctx, denom)
 	}
 }
 
@@ -270,6 +300,50 @@ func (k Keeper) IterateVotePenaltyCounters(ctx sdk.Context,
 	}
 }
 
+//-----------------------------------
+// AggregateExchangeRatePrevote logic
+
+// GetAggregateExchangeRatePrevote retrieves an oracle prevote from the store
+func (k Keeper) GetAggregateExchangeRatePrevote(ctx sdk.Context, voter sdk.ValAddress) (aggregatePrevote types.AggregateExchangeRatePrevote, err error) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetAggregateExchangeRatePrevoteKey(voter))
+	if b == nil {
+		err = sdkerrors.Wrap(types.ErrNoAggregatePrevote, voter.String())
+		return
+	}
+	k.cdc.MustUnmarshal(b, &aggregatePrevote)
+	return
+}
+
+// SetAggregateExchangeRatePrevote set an oracle aggregate prevote to the store
+func (k Keeper) SetAggregateExchangeRatePrevote(ctx sdk.Context, voter sdk.ValAddress, prevote types.AggregateExchangeRatePrevote) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&prevote)
+	store.Set(types.GetAggregateExchangeRatePrevoteKey(voter), bz)
+}
+
+// DeleteAggregateExchangeRatePrevote deletes an oracle prevote from the store
+func (k Keeper) DeleteAggregateExchangeRatePrevote(ctx sdk.Context, voter sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAggregateExchangeRatePrevoteKey(voter))
+}
+
+// IterateAggregateExchangeRatePrevotes iterates rate over prevotes in the store
+func (k Keeper) IterateAggregateExchangeRatePrevotes(ctx sdk.Context, handler func(voterAddr sdk.ValAddress, aggregatePrevote types.AggregateExchangeRatePrevote) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AggregateExchangeRatePrevoteKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		voterAddr := sdk.ValAddress(iter.Key()[2:])
+
+		var aggregatePrevote types.AggregateExchangeRatePrevote
+		k.cdc.MustUnmarshal(iter.Value(), &aggregatePrevote)
+		if handler(voterAddr, aggregatePrevote) {
+			break
+		}
+	}
+}
+
 //-----------------------------------
 // AggregateExchangeRateVote logic
 
@@ -328,10 +402,10 @@ func (k Keeper) GetVoteTarget(ctx sdk.Context, denom string) (types.Denom, error
 	return voteTarget, nil
 }
 
-func (k Keeper) SetVoteTarget(ctx sdk.Context, denom string) {
+func (k Keeper) SetVoteTarget(ctx sdk.Context, denom types.Denom) {
 	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(&types.Denom{Name: denom})
-	store.Set(types.GetVoteTargetKey(denom), bz)
+	bz := k.cdc.MustMarshal(&denom)
+	store.Set(types.GetVoteTargetKey(denom.Name), bz)
 }
 
 func (k Keeper) IterateVoteTargets(ctx sdk.Context, handler func(denom string, denomInfo types.Denom) (stop bool)) {
@@ -371,7 +445,9 @@ func (k Keeper) ValidateFeeder(ctx sdk.Context, feederAddr sdk.AccAddress, valid
 	if !feederAddr.Equals(validatorAddr) {
 		delegate := k.GetFeederDelegation(ctx, validatorAddr)
 		if !delegate.Equals(feederAddr) {
-			return sdkerrors.Wrap(types.ErrNoVotingPermission, feederAddr.String())
+			if _, authorized := k.isAuthorizedFeeder(ctx, feederAddr, validatorAddr); !authorized {
+				return sdkerrors.Wrap(types.ErrNoVotingPermission, feederAddr.String())
+			}
 		}
 	}
 
@@ -480,11 +556,7 @@ func (k Keeper) CalculateTwaps(ctx sdk.Context, lookbackSeconds uint64) (types.O
 	denomDurationMap := make(map[string]int64)
 
 	// get targets - only calculate for the targets
-	targetsMap := make(map[string]struct{})
-	k.IterateVoteTargets(ctx, func(denom string, denomInfo types.Denom) (stop bool) {
-		targetsMap[denom] = struct{}{}
-		return false
-	})
+	targetsMap := k.getPriceTargets(ctx)
 
 	k.IteratePriceSnapshotsReverse(ctx, func(snapshot types.PriceSnapshot) (stop bool) {
 		stop = false
@@ -87,8 +87,8 @@ func TestExchangeRate(t *testing.T) {
 
 	// eth removed
 	input.OracleKeeper.ClearVoteTargets(input.Ctx)
-	input.OracleKeeper.SetVoteTarget(input.Ctx, utils.MicroSeiDenom)
-	input.OracleKeeper.SetVoteTarget(input.Ctx, utils.MicroAtomDenom)
+	input.OracleKeeper.SetVoteTarget(input.Ctx, types.Denom{Name: utils.MicroSeiDenom})
+	input.OracleKeeper.SetVoteTarget(input.Ctx, types.Denom{Name: utils.MicroAtomDenom})
 	// should remove eth
 	input.OracleKeeper.RemoveExcessFeeds(input.Ctx)
 
@@ -152,6 +152,16 @@ func TestParams(t *testing.T) {
 	slashFraction := sdk.NewDecWithPrec(1, 2)
 	slashWindow := uint64(1000)
 	minValidPerWindow := sdk.NewDecWithPrec(1, 4)
+	rewardDistributionWindow := uint64(10000)
+	maxFeeders := uint64(5)
+	voteHistoryRetention := uint64(50)
+	warningThreshold := sdk.NewDecWithPrec(1, 2)
+	jailThreshold := sdk.NewDecWithPrec(1, 5)
+	missPenaltyWeight := sdk.OneDec()
+	abstainPenaltyWeight := sdk.NewDecWithPrec(5, 1)
+	slashGracePeriod := uint64(2000)
+	voteTargetProbationPeriod := uint64(3000)
+	voteTargetSunsetPeriod := uint64(4000)
 	whitelist := types.DenomList{
 		{Name: utils.MicroEthDenom},
 		{Name: utils.MicroAtomDenom},
@@ -159,13 +169,23 @@ func TestParams(t *testing.T) {
 
 	// Should really test validateParams, but skipping because obvious
 	newParams := types.Params{
-		VotePeriod:        votePeriod,
-		VoteThreshold:     voteThreshold,
-		RewardBand:        oracleRewardBand,
-		Whitelist:         whitelist,
-		SlashFraction:     slashFraction,
-		SlashWindow:       slashWindow,
-		MinValidPerWindow: minValidPerWindow,
+		VotePeriod:                votePeriod,
+		VoteThreshold:             voteThreshold,
+		RewardBand:                oracleRewardBand,
+		Whitelist:                 whitelist,
+		SlashFraction:             slashFraction,
+		SlashWindow:               slashWindow,
+		MinValidPerWindow:         minValidPerWindow,
+		RewardDistributionWindow:  rewardDistributionWindow,
+		MaxFeeders:                maxFeeders,
+		VoteHistoryRetention:      voteHistoryRetention,
+		WarningThreshold:          warningThreshold,
+		JailThreshold:             jailThreshold,
+		MissPenaltyWeight:         missPenaltyWeight,
+		AbstainPenaltyWeight:      abstainPenaltyWeight,
+		SlashGracePeriod:          slashGracePeriod,
+		VoteTargetProbationPeriod: voteTargetProbationPeriod,
+		VoteTargetSunsetPeriod:    voteTargetSunsetPeriod,
 	}
 	input.OracleKeeper.SetParams(input.Ctx, newParams)
 
@@ -349,7 +369,7 @@ func TestVoteTargetGetSet(t *testing.T) {
 	}
 
 	for denom := range voteTargets {
-		input.OracleKeeper.SetVoteTarget(input.Ctx, denom)
+		input.OracleKeeper.SetVoteTarget(input.Ctx, types.Denom{Name: denom})
 		denomInfo, err := input.OracleKeeper.GetVoteTarget(input.Ctx, denom)
 		require.NoError(t, err)
 		require.Equal(t, voteTargets[denom], denomInfo)
@@ -734,7 +754,7 @@ func TestCalculateTwapsWithUnsupportedDenom(t *testing.T) {
 	}
 	// eth removed
 	input.OracleKeeper.ClearVoteTargets(input.Ctx)
-	input.OracleKeeper.SetVoteTarget(input.Ctx, utils.MicroAtomDenom)
+	input.OracleKeeper.SetVoteTarget(input.Ctx, types.Denom{Name: utils.MicroAtomDenom})
 
 	input.Ctx = input.Ctx.WithBlockTime(time.Unix(5400, 0))
 	twaps, err := input.OracleKeeper.CalculateTwaps(input.Ctx, 3600)
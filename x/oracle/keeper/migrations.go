@@ -94,3 +94,72 @@ func (m Migrator) Migrate5To6(ctx sdk.Context) error {
 	}
 	return nil
 }
+
+// Migrate6to7 migrates from version 6 to 7
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	// the commit-reveal prevote flow is opt-in, so existing chains keep
+	// accepting direct votes until governance turns it on
+	m.keeper.paramSpace.Set(ctx, types.KeyRequirePrevote, types.DefaultRequirePrevote)
+	return nil
+}
+
+// Migrate7to8 migrates from version 7 to 8
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	// set the reward distribution window introduced along with reward distribution
+	m.keeper.paramSpace.Set(ctx, types.KeyRewardDistributionWindow, types.DefaultRewardDistributionWindow)
+	return nil
+}
+
+// Migrate8to9 migrates from version 8 to 9
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	// vote targets now carry the per-denom overrides of their whitelist entry,
+	// so rewrite the existing vote targets from the whitelist
+	for _, denom := range m.keeper.Whitelist(ctx) {
+		if m.keeper.IsVoteTarget(ctx, denom.Name) {
+			m.keeper.SetVoteTarget(ctx, denom)
+		}
+	}
+	return nil
+}
+
+// Migrate9to10 migrates from version 9 to 10
+func (m Migrator) Migrate9to10(ctx sdk.Context) error {
+	// set the limit on additional feeders introduced along with feeder permissions
+	m.keeper.paramSpace.Set(ctx, types.KeyMaxFeeders, types.DefaultMaxFeeders)
+	return nil
+}
+
+// Migrate10to11 migrates from version 10 to 11
+func (m Migrator) Migrate10to11(ctx sdk.Context) error {
+	// set the retention of the vote history introduced along with ballot summaries
+	m.keeper.paramSpace.Set(ctx, types.KeyVoteHistoryRetention, types.DefaultVoteHistoryRetention)
+	return nil
+}
+
+// Migrate11to12 migrates from version 11 to 12
+func (m Migrator) Migrate11to12(ctx sdk.Context) error {
+	// jailing at the current slashing threshold keeps the existing penalties,
+	// and the warning threshold may not be below it
+	minValidPerWindow := m.keeper.MinValidPerWindow(ctx)
+	warningThreshold := sdk.MaxDec(types.DefaultWarningThreshold, minValidPerWindow)
+
+	m.keeper.paramSpace.Set(ctx, types.KeyWarningThreshold, warningThreshold)
+	m.keeper.paramSpace.Set(ctx, types.KeyJailThreshold, minValidPerWindow)
+	m.keeper.paramSpace.Set(ctx, types.KeyMissPenaltyWeight, types.DefaultMissPenaltyWeight)
+	m.keeper.paramSpace.Set(ctx, types.KeyAbstainPenaltyWeight, types.DefaultAbstainPenaltyWeight)
+	m.keeper.paramSpace.Set(ctx, types.KeySlashGracePeriod, types.DefaultSlashGracePeriod)
+	return nil
+}
+
+// Migrate12to13 migrates from version 12 to 13
+func (m Migrator) Migrate12to13(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyDerivedDenoms, types.DefaultDerivedDenoms)
+	return nil
+}
+
+// Migrate13to14 migrates from version 13 to 14
+func (m Migrator) Migrate13to14(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyVoteTargetProbationPeriod, types.DefaultVoteTargetProbationPeriod)
+	m.keeper.paramSpace.Set(ctx, types.KeyVoteTargetSunsetPeriod, types.DefaultVoteTargetSunsetPeriod)
+	return nil
+}
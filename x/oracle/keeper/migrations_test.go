@@ -152,3 +152,111 @@ func TestMigrate5to6(t *testing.T) {
 		SuccessCount: 9975,
 	}, votePenaltyCounter)
 }
+
+func TestMigrate6to7(t *testing.T) {
+	input := CreateTestInput(t)
+
+	m := NewMigrator(input.OracleKeeper)
+	input.OracleKeeper.paramSpace.Set(input.Ctx, types.KeyRequirePrevote, true)
+	require.True(t, input.OracleKeeper.RequirePrevote(input.Ctx))
+
+	require.NoError(t, m.Migrate6to7(input.Ctx))
+	require.False(t, input.OracleKeeper.RequirePrevote(input.Ctx))
+	require.NotPanics(t, func() { input.OracleKeeper.GetParams(input.Ctx) })
+}
+
+func TestMigrate7to8(t *testing.T) {
+	input := CreateTestInput(t)
+
+	m := NewMigrator(input.OracleKeeper)
+	input.OracleKeeper.paramSpace.Set(input.Ctx, types.KeyRewardDistributionWindow, uint64(1))
+
+	require.NoError(t, m.Migrate7to8(input.Ctx))
+	require.Equal(t, types.DefaultRewardDistributionWindow, input.OracleKeeper.RewardDistributionWindow(input.Ctx))
+	require.NotPanics(t, func() { input.OracleKeeper.GetParams(input.Ctx) })
+}
+
+func TestMigrate8to9(t *testing.T) {
+	input := CreateTestInput(t)
+
+	rewardBand := sdk.NewDecWithPrec(5, 2)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{
+		{Name: utils.MicroAtomDenom, RewardBand: &rewardBand, MinVoters: 2},
+		{Name: utils.MicroEthDenom},
+	}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	input.OracleKeeper.ClearVoteTargets(input.Ctx)
+	input.OracleKeeper.SetVoteTarget(input.Ctx, types.Denom{Name: utils.MicroAtomDenom})
+
+	m := NewMigrator(input.OracleKeeper)
+	require.NoError(t, m.Migrate8to9(input.Ctx))
+
+	voteTarget, err := input.OracleKeeper.GetVoteTarget(input.Ctx, utils.MicroAtomDenom)
+	require.NoError(t, err)
+	require.True(t, params.Whitelist[0].Equal(&voteTarget))
+	// denoms that aren't vote targets yet are left to ApplyWhitelist
+	require.False(t, input.OracleKeeper.IsVoteTarget(input.Ctx, utils.MicroEthDenom))
+}
+
+func TestMigrate9to10(t *testing.T) {
+	input := CreateTestInput(t)
+
+	m := NewMigrator(input.OracleKeeper)
+	input.OracleKeeper.paramSpace.Set(input.Ctx, types.KeyMaxFeeders, uint64(10))
+
+	require.NoError(t, m.Migrate9to10(input.Ctx))
+	require.Equal(t, types.DefaultMaxFeeders, input.OracleKeeper.MaxFeeders(input.Ctx))
+	require.NotPanics(t, func() { input.OracleKeeper.GetParams(input.Ctx) })
+}
+
+func TestMigrate10to11(t *testing.T) {
+	input := CreateTestInput(t)
+
+	m := NewMigrator(input.OracleKeeper)
+	input.OracleKeeper.paramSpace.Set(input.Ctx, types.KeyVoteHistoryRetention, uint64(1))
+
+	require.NoError(t, m.Migrate10to11(input.Ctx))
+	require.Equal(t, types.DefaultVoteHistoryRetention, input.OracleKeeper.VoteHistoryRetention(input.Ctx))
+	require.NotPanics(t, func() { input.OracleKeeper.GetParams(input.Ctx) })
+}
+
+func TestMigrate11to12(t *testing.T) {
+	input := CreateTestInput(t)
+
+	m := NewMigrator(input.OracleKeeper)
+	input.OracleKeeper.paramSpace.Set(input.Ctx, types.KeyMinValidPerWindow, sdk.NewDecWithPrec(20, 2))
+
+	require.NoError(t, m.Migrate11to12(input.Ctx))
+	// the warning threshold is raised to the slashing threshold and jailing keeps the existing behavior
+	require.Equal(t, sdk.NewDecWithPrec(20, 2), input.OracleKeeper.WarningThreshold(input.Ctx))
+	require.Equal(t, sdk.NewDecWithPrec(20, 2), input.OracleKeeper.JailThreshold(input.Ctx))
+	require.Equal(t, types.DefaultMissPenaltyWeight, input.OracleKeeper.MissPenaltyWeight(input.Ctx))
+	require.Equal(t, types.DefaultAbstainPenaltyWeight, input.OracleKeeper.AbstainPenaltyWeight(input.Ctx))
+	require.Equal(t, types.DefaultSlashGracePeriod, input.OracleKeeper.SlashGracePeriod(input.Ctx))
+	require.NoError(t, input.OracleKeeper.GetParams(input.Ctx).Validate())
+}
+
+func TestMigrate12to13(t *testing.T) {
+	input := CreateTestInput(t)
+
+	m := NewMigrator(input.OracleKeeper)
+	input.OracleKeeper.paramSpace.Set(input.Ctx, types.KeyDerivedDenoms, types.DerivedDenomList{{Name: "uatom/ueth"}})
+
+	require.NoError(t, m.Migrate12to13(input.Ctx))
+	require.Empty(t, input.OracleKeeper.DerivedDenoms(input.Ctx))
+	require.NotPanics(t, func() { input.OracleKeeper.GetParams(input.Ctx) })
+}
+
+func TestMigrate13to14(t *testing.T) {
+	input := CreateTestInput(t)
+
+	m := NewMigrator(input.OracleKeeper)
+	input.OracleKeeper.paramSpace.Set(input.Ctx, types.KeyVoteTargetProbationPeriod, uint64(1))
+	input.OracleKeeper.paramSpace.Set(input.Ctx, types.KeyVoteTargetSunsetPeriod, uint64(1))
+
+	require.NoError(t, m.Migrate13to14(input.Ctx))
+	require.Equal(t, types.DefaultVoteTargetProbationPeriod, input.OracleKeeper.VoteTargetProbationPeriod(input.Ctx))
+	require.Equal(t, types.DefaultVoteTargetSunsetPeriod, input.OracleKeeper.VoteTargetSunsetPeriod(input.Ctx))
+	require.NotPanics(t, func() { input.OracleKeeper.GetParams(input.Ctx) })
+}
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -20,6 +22,58 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 	return &msgServer{Keeper: keeper}
 }
 
+func (ms msgServer) AggregateExchangeRatePrevote(goCtx context.Context, msg *types.MsgAggregateExchangeRatePrevote) (*types.MsgAggregateExchangeRatePrevoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !ms.RequirePrevote(ctx) {
+		return nil, types.ErrPrevoteNotRequired
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	feederAddr, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.ValidateFeeder(ctx, feederAddr, valAddr); err != nil {
+		return nil, err
+	}
+
+	// With several feeders per validator, the first prevote of a vote period wins
+	votePeriod := ms.VotePeriod(ctx)
+	if existing, err := ms.GetAggregateExchangeRatePrevote(ctx, valAddr); err == nil &&
+		existing.SubmitBlock/votePeriod == uint64(ctx.BlockHeight())/votePeriod {
+		return nil, sdkerrors.Wrap(types.ErrAggregatePrevoteExist, msg.Validator)
+	}
+
+	// Convert hex string to votehash
+	voteHash, err := types.AggregateVoteHashFromHexString(msg.Hash)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidHash, err.Error())
+	}
+
+	aggregatePrevote := types.NewAggregateExchangeRatePrevote(voteHash, valAddr, uint64(ctx.BlockHeight()))
+	ms.SetAggregateExchangeRatePrevote(ctx, valAddr, aggregatePrevote)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAggregatePrevote,
+			sdk.NewAttribute(types.AttributeKeyVoter, msg.Validator),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Feeder),
+		),
+	})
+
+	return &types.MsgAggregateExchangeRatePrevoteResponse{}, nil
+}
+
 func (ms msgServer) AggregateExchangeRateVote(goCtx context.Context, msg *types.MsgAggregateExchangeRateVote) (*types.MsgAggregateExchangeRateVoteResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -43,13 +97,41 @@ func (ms msgServer) AggregateExchangeRateVote(goCtx context.Context, msg *types.
 	}
 
 	// check all denoms are in the vote target
+	denoms := make([]string, 0, len(exchangeRateTuples))
 	for _, tuple := range exchangeRateTuples {
 		if !ms.IsVoteTarget(ctx, tuple.Denom) {
 			return nil, sdkerrors.Wrap(types.ErrUnknownDenom, tuple.Denom)
 		}
+		denoms = append(denoms, tuple.Denom)
+	}
+
+	if err := ms.ValidateFeederScope(ctx, feederAddr, valAddr, denoms); err != nil {
+		return nil, err
+	}
+
+	// With several feeders per validator, the votes of a vote period are merged
+	// and the first vote on each denom wins
+	aggregateVote := types.NewAggregateExchangeRateVote(exchangeRateTuples, valAddr)
+	if existing, err := ms.GetAggregateExchangeRateVote(ctx, valAddr); err == nil {
+		voted := make(map[string]bool, len(existing.ExchangeRateTuples))
+		for _, tuple := range existing.ExchangeRateTuples {
+			voted[tuple.Denom] = true
+		}
+		for _, denom := range denoms {
+			if voted[denom] {
+				return nil, sdkerrors.Wrapf(types.ErrAggregateVoteExist, "%s already voted on %s", msg.Validator, denom)
+			}
+		}
+		aggregateVote.ExchangeRateTuples = append(existing.ExchangeRateTuples, exchangeRateTuples...)
+	}
+
+	if ms.RequirePrevote(ctx) {
+		if err := ms.revealAggregateExchangeRatePrevote(ctx, msg, valAddr); err != nil {
+			return nil, err
+		}
 	}
 
-	ms.SetAggregateExchangeRateVote(ctx, valAddr, types.NewAggregateExchangeRateVote(exchangeRateTuples, valAddr))
+	ms.SetAggregateExchangeRateVote(ctx, valAddr, aggregateVote)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -103,3 +185,127 @@ func (ms msgServer) DelegateFeedConsent(goCtx context.Context, msg *types.MsgDel
 
 	return &types.MsgDelegateFeedConsentResponse{}, nil
 }
+
+func (ms msgServer) AuthorizeFeeder(goCtx context.Context, msg *types.MsgAuthorizeFeeder) (*types.MsgAuthorizeFeederResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAddr, err := sdk.ValAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	feederAddr, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the delegator is a validator
+	val := ms.StakingKeeper.Validator(ctx, operatorAddr)
+	if val == nil {
+		return nil, sdkerrors.Wrap(stakingtypes.ErrNoValidatorFound, msg.Operator)
+	}
+
+	if msg.Expiry != 0 && msg.Expiry <= ctx.BlockTime().Unix() {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidFeederExpiry, "expiry %d is not after block time %d", msg.Expiry, ctx.BlockTime().Unix())
+	}
+
+	// Expired permissions don't count against the limit
+	ms.PruneExpiredFeederPermissions(ctx, operatorAddr)
+
+	numFeeders := uint64(0)
+	ms.IterateFeederPermissions(ctx, operatorAddr, func(feeder sdk.AccAddress, _ types.FeederPermission) bool {
+		if !feeder.Equals(feederAddr) {
+			numFeeders++
+		}
+		return false
+	})
+	if maxFeeders := ms.MaxFeeders(ctx); numFeeders >= maxFeeders {
+		return nil, sdkerrors.Wrapf(types.ErrTooManyFeeders, "validator %s already has %d feeders", msg.Operator, maxFeeders)
+	}
+
+	ms.SetFeederPermission(ctx, operatorAddr, feederAddr, types.FeederPermission{
+		Feeder: msg.Feeder,
+		Denoms: msg.Denoms,
+		Expiry: msg.Expiry,
+	})
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAuthorizeFeeder,
+			sdk.NewAttribute(types.AttributeKeyFeeder, msg.Feeder),
+			sdk.NewAttribute(types.AttributeKeyDenom, strings.Join(msg.Denoms, ",")),
+			sdk.NewAttribute(types.AttributeKeyExpiry, strconv.FormatInt(msg.Expiry, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	})
+
+	return &types.MsgAuthorizeFeederResponse{}, nil
+}
+
+func (ms msgServer) RevokeFeeder(goCtx context.Context, msg *types.MsgRevokeFeeder) (*types.MsgRevokeFeederResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAddr, err := sdk.ValAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	feederAddr, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := ms.GetFeederPermission(ctx, operatorAddr, feederAddr); !found {
+		return nil, sdkerrors.Wrap(types.ErrNoFeederPermission, msg.Feeder)
+	}
+
+	ms.DeleteFeederPermission(ctx, operatorAddr, feederAddr)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevokeFeeder,
+			sdk.NewAttribute(types.AttributeKeyFeeder, msg.Feeder),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	})
+
+	return &types.MsgRevokeFeederResponse{}, nil
+}
+
+// revealAggregateExchangeRatePrevote checks that the vote matches the hash the
+// validator prevoted in the previous vote period, and consumes the prevote.
+// Votes that don't match are rejected, so only verified reveals make it into
+// the ballot.
+func (ms msgServer) revealAggregateExchangeRatePrevote(ctx sdk.Context, msg *types.MsgAggregateExchangeRateVote, valAddr sdk.ValAddress) error {
+	if len(msg.Salt) == 0 {
+		return types.ErrInvalidSaltLength
+	}
+
+	aggregatePrevote, err := ms.GetAggregateExchangeRatePrevote(ctx, valAddr)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrNoAggregatePrevote, msg.Validator)
+	}
+
+	// Check a msg is submitted proper period
+	votePeriod := ms.VotePeriod(ctx)
+	if (uint64(ctx.BlockHeight())/votePeriod)-(aggregatePrevote.SubmitBlock/votePeriod) != 1 {
+		return types.ErrRevealPeriodMissMatch
+	}
+
+	// Verify a exchange rate with aggregate prevote hash
+	hash := types.GetAggregateVoteHash(msg.Salt, msg.ExchangeRates, valAddr)
+	if aggregatePrevote.Hash != hash.String() {
+		return sdkerrors.Wrapf(types.ErrVerificationFailed, "must be given %s not %s", aggregatePrevote.Hash, hash)
+	}
+
+	ms.DeleteAggregateExchangeRatePrevote(ctx, valAddr)
+	return nil
+}
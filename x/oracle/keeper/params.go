@@ -59,6 +59,78 @@ func (k Keeper) LookbackDuration(ctx sdk.Context) (res uint64) {
 	return
 }
 
+// RequirePrevote returns whether votes must reveal a prevote from the previous vote period
+func (k Keeper) RequirePrevote(ctx sdk.Context) (res bool) {
+	k.paramSpace.Get(ctx, types.KeyRequirePrevote, &res)
+	return
+}
+
+// RewardDistributionWindow returns the number of blocks over which the reward pool is distributed
+func (k Keeper) RewardDistributionWindow(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyRewardDistributionWindow, &res)
+	return
+}
+
+// MaxFeeders returns the maximum number of additional feeders a validator may authorise
+func (k Keeper) MaxFeeders(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyMaxFeeders, &res)
+	return
+}
+
+// VoteHistoryRetention returns the number of vote periods for which ballot summaries are retained
+func (k Keeper) VoteHistoryRetention(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyVoteHistoryRetention, &res)
+	return
+}
+
+// WarningThreshold returns the valid vote rate below which validators get a warning
+func (k Keeper) WarningThreshold(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyWarningThreshold, &res)
+	return
+}
+
+// JailThreshold returns the valid vote rate below which validators are jailed
+func (k Keeper) JailThreshold(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyJailThreshold, &res)
+	return
+}
+
+// MissPenaltyWeight returns the weight of a missed vote period in the valid vote rate
+func (k Keeper) MissPenaltyWeight(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyMissPenaltyWeight, &res)
+	return
+}
+
+// AbstainPenaltyWeight returns the weight of an abstained vote period in the valid vote rate
+func (k Keeper) AbstainPenaltyWeight(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyAbstainPenaltyWeight, &res)
+	return
+}
+
+// SlashGracePeriod returns the number of blocks after bonding during which validators aren't penalized
+func (k Keeper) SlashGracePeriod(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeySlashGracePeriod, &res)
+	return
+}
+
+// DerivedDenoms returns the denoms whose exchange rates are derived from the voted denoms
+func (k Keeper) DerivedDenoms(ctx sdk.Context) (res types.DerivedDenomList) {
+	k.paramSpace.Get(ctx, types.KeyDerivedDenoms, &res)
+	return
+}
+
+// VoteTargetProbationPeriod returns the number of blocks misses on a newly added vote target aren't penalized for
+func (k Keeper) VoteTargetProbationPeriod(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyVoteTargetProbationPeriod, &res)
+	return
+}
+
+// VoteTargetSunsetPeriod returns the number of blocks the exchange rate of a removed vote target stays readable
+func (k Keeper) VoteTargetSunsetPeriod(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyVoteTargetSunsetPeriod, &res)
+	return
+}
+
 // GetParams returns the total set of oracle parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
@@ -2,11 +2,15 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/sei-protocol/sei-chain/x/oracle/types"
 )
@@ -49,9 +53,52 @@ func (q querier) ExchangeRate(c context.Context, req *types.QueryExchangeRateReq
 		return nil, err
 	}
 
-	return &types.QueryExchangeRateResponse{OracleExchangeRate: types.OracleExchangeRate{
-		ExchangeRate: exchangeRate, LastUpdate: lastUpdate, LastUpdateTimestamp: lastUpdateTimestamp,
-	}}, nil
+	return &types.QueryExchangeRateResponse{
+		OracleExchangeRate: types.OracleExchangeRate{
+			ExchangeRate: exchangeRate, LastUpdate: lastUpdate, LastUpdateTimestamp: lastUpdateTimestamp,
+		},
+		Stale: q.IsVoteTargetInSunset(ctx, req.Denom),
+	}, nil
+}
+
+// FreshExchangeRate queries exchange rate of a denom along with its confidence,
+// failing if the rate was last updated more than the max age ago
+func (q querier) FreshExchangeRate(c context.Context, req *types.QueryFreshExchangeRateRequest) (*types.QueryFreshExchangeRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Denom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	if req.MaxAgeSeconds == 0 {
+		return nil, status.Error(codes.InvalidArgument, "max age must be positive")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	exchangeRate, lastUpdate, lastUpdateTimestamp, err := q.GetBaseExchangeRate(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// the exchange rates of removed vote targets are no longer updated
+	if q.IsVoteTargetInSunset(ctx, req.Denom) {
+		return nil, sdkerrors.Wrapf(types.ErrStaleExchangeRate, "%s is no longer a vote target", req.Denom)
+	}
+
+	age := time.Duration(ctx.BlockTime().UnixMilli()-lastUpdateTimestamp) * time.Millisecond
+	if age > time.Duration(req.MaxAgeSeconds)*time.Second {
+		return nil, sdkerrors.Wrapf(types.ErrStaleExchangeRate, "%s was last updated %s ago", req.Denom, age)
+	}
+
+	confidence, _ := q.GetExchangeRateConfidence(ctx, req.Denom)
+	return &types.QueryFreshExchangeRateResponse{
+		OracleExchangeRate: types.OracleExchangeRate{
+			ExchangeRate: exchangeRate, LastUpdate: lastUpdate, LastUpdateTimestamp: lastUpdateTimestamp,
+		},
+		Confidence: confidence,
+	}, nil
 }
 
 // ExchangeRates queries exchange rates of all denoms
@@ -60,7 +107,11 @@ func (q querier) ExchangeRates(c context.Context, _ *types.QueryExchangeRatesReq
 
 	exchangeRates := []types.DenomOracleExchangeRatePair{}
 	q.IterateBaseExchangeRates(ctx, func(denom string, rate types.OracleExchangeRate) (stop bool) {
-		exchangeRates = append(exchangeRates, types.DenomOracleExchangeRatePair{Denom: denom, OracleExchangeRate: rate})
+		exchangeRates = append(exchangeRates, types.DenomOracleExchangeRatePair{
+			Denom:              denom,
+			OracleExchangeRate: rate,
+			Stale:              q.IsVoteTargetInSunset(ctx, denom),
+		})
 		return false
 	})
 
@@ -86,6 +137,25 @@ func (q querier) VoteTargets(c context.Context, _ *types.QueryVoteTargetsRequest
 	return &types.QueryVoteTargetsResponse{VoteTargets: q.GetVoteTargets(ctx)}, nil
 }
 
+// VoteTargetTransitions queries the vote targets on probation and the removed vote targets in their sunset period
+func (q querier) VoteTargetTransitions(c context.Context, _ *types.QueryVoteTargetTransitionsRequest) (*types.QueryVoteTargetTransitionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	probations := []types.VoteTargetTransition{}
+	q.IterateVoteTargetProbations(ctx, func(denom string, endHeight int64) (stop bool) {
+		probations = append(probations, types.VoteTargetTransition{Denom: denom, EndHeight: endHeight})
+		return false
+	})
+
+	sunsets := []types.VoteTargetTransition{}
+	q.IterateVoteTargetSunsets(ctx, func(denom string, endHeight int64) (stop bool) {
+		sunsets = append(sunsets, types.VoteTargetTransition{Denom: denom, EndHeight: endHeight})
+		return false
+	})
+
+	return &types.QueryVoteTargetTransitionsResponse{Probations: probations, Sunsets: sunsets}, nil
+}
+
 func (q querier) PriceSnapshotHistory(c context.Context, _ *types.QueryPriceSnapshotHistoryRequest) (*types.QueryPriceSnapshotHistoryResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	priceSnapshots := types.PriceSnapshots{}
@@ -107,6 +177,74 @@ func (q querier) Twaps(c context.Context, req *types.QueryTwapsRequest) (*types.
 	return &response, nil
 }
 
+// Emas queries the exponential moving averages of the exchange rates over the lookback
+func (q querier) Emas(c context.Context, req *types.QueryEmasRequest) (*types.QueryEmasResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.PeriodSeconds == 0 {
+		return nil, status.Error(codes.InvalidArgument, "period must be positive")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	emas, err := q.CalculateEmas(ctx, req.LookbackSeconds, req.PeriodSeconds)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryEmasResponse{OracleEmas: emas}, nil
+}
+
+// PriceRanges queries the lowest and highest exchange rates over the lookback
+func (q querier) PriceRanges(c context.Context, req *types.QueryPriceRangesRequest) (*types.QueryPriceRangesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	priceRanges, err := q.CalculatePriceRanges(ctx, req.LookbackSeconds)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPriceRangesResponse{OraclePriceRanges: priceRanges}, nil
+}
+
+// Volatilities queries the realised volatilities of the exchange rates over the lookback
+func (q querier) Volatilities(c context.Context, req *types.QueryVolatilitiesRequest) (*types.QueryVolatilitiesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	volatilities, err := q.CalculateVolatilities(ctx, req.LookbackSeconds)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryVolatilitiesResponse{OracleVolatilities: volatilities}, nil
+}
+
+// PriceAtTimestamp queries the exchange rate of a denom in effect at a past timestamp
+func (q querier) PriceAtTimestamp(c context.Context, req *types.QueryPriceAtTimestampRequest) (*types.QueryPriceAtTimestampResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Denom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if req.Timestamp > ctx.BlockTime().Unix() {
+		return nil, status.Error(codes.InvalidArgument, "timestamp is in the future")
+	}
+
+	exchangeRate, snapshotTimestamp, err := q.GetPriceAtTimestamp(ctx, req.Denom, req.Timestamp)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPriceAtTimestampResponse{OracleExchangeRate: exchangeRate, SnapshotTimestamp: snapshotTimestamp}, nil
+}
+
 // FeederDelegation queries the account address that the validator operator delegated oracle vote rights to
 func (q querier) FeederDelegation(c context.Context, req *types.QueryFeederDelegationRequest) (*types.QueryFeederDelegationResponse, error) {
 	if req == nil {
@@ -124,6 +262,114 @@ func (q querier) FeederDelegation(c context.Context, req *types.QueryFeederDeleg
 	}, nil
 }
 
+// FeederPermissions queries the additional feeders authorised by a validator
+func (q querier) FeederPermissions(c context.Context, req *types.QueryFeederPermissionsRequest) (*types.QueryFeederPermissionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	permissions := []types.FeederPermission{}
+	q.IterateFeederPermissions(ctx, valAddr, func(_ sdk.AccAddress, permission types.FeederPermission) bool {
+		permissions = append(permissions, permission)
+		return false
+	})
+
+	return &types.QueryFeederPermissionsResponse{
+		FeederPermissions: permissions,
+	}, nil
+}
+
+// VoteHistory queries the ballot summaries of a validator for the retained vote periods
+func (q querier) VoteHistory(c context.Context, req *types.QueryVoteHistoryRequest) (*types.QueryVoteHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	voter := valAddr.String()
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.VotePeriodBallotKey)
+
+	ballots := []types.VotePeriodBallot{}
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var ballot types.VotePeriodBallot
+		if err := q.cdc.Unmarshal(value, &ballot); err != nil {
+			return false, err
+		}
+
+		// only keep the vote periods in which the validator was in the active set
+		for _, validatorBallot := range ballot.ValidatorBallots {
+			if validatorBallot.Voter != voter {
+				continue
+			}
+			if accumulate {
+				ballot.ValidatorBallots = []types.ValidatorBallot{validatorBallot}
+				ballots = append(ballots, ballot)
+			}
+			return true, nil
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVoteHistoryResponse{
+		Ballots:    ballots,
+		Pagination: pageRes,
+	}, nil
+}
+
+// AggregatePrevote queries an aggregate prevote of a validator
+func (q querier) AggregatePrevote(c context.Context, req *types.QueryAggregatePrevoteRequest) (*types.QueryAggregatePrevoteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	prevote, err := q.GetAggregateExchangeRatePrevote(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAggregatePrevoteResponse{
+		AggregatePrevote: prevote,
+	}, nil
+}
+
+// OracleRewards queries the oracle rewards distributed to a validator
+func (q querier) OracleRewards(c context.Context, req *types.QueryOracleRewardsRequest) (*types.QueryOracleRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryOracleRewardsResponse{
+		Rewards: q.GetOracleRewards(ctx, valAddr),
+	}, nil
+}
+
 // MissCounter queries oracle miss counter of a validator
 func (q querier) VotePenaltyCounter(c context.Context, req *types.QueryVotePenaltyCounterRequest) (*types.QueryVotePenaltyCounterResponse, error) {
 	if req == nil {
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/sei-protocol/sei-chain/x/oracle/types"
 	"github.com/sei-protocol/sei-chain/x/oracle/utils"
@@ -39,6 +40,41 @@ func TestQueryExchangeRate(t *testing.T) {
 	require.Equal(t, rate, res.OracleExchangeRate.ExchangeRate)
 }
 
+func TestQueryFreshExchangeRate(t *testing.T) {
+	input := CreateTestInput(t)
+	querier := NewQuerier(input.OracleKeeper)
+
+	input.Ctx = input.Ctx.WithBlockTime(time.Unix(3600, 0))
+	rate := sdk.NewDec(1700)
+	input.OracleKeeper.SetBaseExchangeRate(input.Ctx, utils.MicroAtomDenom, rate)
+	confidence := types.ExchangeRateConfidence{
+		NumVoters:         2,
+		VotingPowerShare:  sdk.NewDecWithPrec(75, 2),
+		StandardDeviation: sdk.NewDec(5),
+		Spread:            sdk.NewDec(10),
+	}
+	input.OracleKeeper.SetExchangeRateConfidence(input.Ctx, utils.MicroAtomDenom, confidence)
+
+	_, err := querier.FreshExchangeRate(sdk.WrapSDKContext(input.Ctx), &types.QueryFreshExchangeRateRequest{Denom: utils.MicroAtomDenom})
+	require.Error(t, err)
+
+	_, err = querier.FreshExchangeRate(sdk.WrapSDKContext(input.Ctx), &types.QueryFreshExchangeRateRequest{Denom: utils.MicroEthDenom, MaxAgeSeconds: 30})
+	require.ErrorIs(t, err, types.ErrUnknownDenom)
+
+	ctx := sdk.WrapSDKContext(input.Ctx.WithBlockTime(time.Unix(3630, 0)))
+	res, err := querier.FreshExchangeRate(ctx, &types.QueryFreshExchangeRateRequest{Denom: utils.MicroAtomDenom, MaxAgeSeconds: 30})
+	require.NoError(t, err)
+	require.Equal(t, rate, res.OracleExchangeRate.ExchangeRate)
+	require.Equal(t, uint64(2), res.Confidence.NumVoters)
+	require.Equal(t, confidence.VotingPowerShare, res.Confidence.VotingPowerShare)
+	require.Equal(t, confidence.StandardDeviation, res.Confidence.StandardDeviation)
+	require.Equal(t, confidence.Spread, res.Confidence.Spread)
+
+	ctx = sdk.WrapSDKContext(input.Ctx.WithBlockTime(time.Unix(3631, 0)))
+	_, err = querier.FreshExchangeRate(ctx, &types.QueryFreshExchangeRateRequest{Denom: utils.MicroAtomDenom, MaxAgeSeconds: 30})
+	require.ErrorIs(t, err, types.ErrStaleExchangeRate)
+}
+
 func TestQueryEmptyExchangeRates(t *testing.T) {
 	input := CreateTestInput(t)
 	ctx := sdk.WrapSDKContext(input.Ctx)
@@ -83,6 +119,77 @@ func TestQueryFeederDelegation(t *testing.T) {
 	require.Equal(t, Addrs[1].String(), res.FeederAddr)
 }
 
+func TestQueryVoteHistory(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	for votePeriod := uint64(1); votePeriod <= 3; votePeriod++ {
+		ballot := types.VotePeriodBallot{
+			VotePeriod: votePeriod,
+			Medians:    types.ExchangeRateTuples{types.NewExchangeRateTuple(utils.MicroAtomDenom, sdk.NewDec(int64(votePeriod)))},
+			ValidatorBallots: []types.ValidatorBallot{
+				{Voter: ValAddrs[0].String(), Outcome: types.VoteOutcome_VOTE_OUTCOME_SUCCESS},
+			},
+		}
+		// the second validator only joined the active set in the last vote period
+		if votePeriod == 3 {
+			ballot.ValidatorBallots = append(ballot.ValidatorBallots, types.ValidatorBallot{
+				Voter:   ValAddrs[1].String(),
+				Outcome: types.VoteOutcome_VOTE_OUTCOME_ABSTAIN,
+			})
+		}
+		input.OracleKeeper.SetVotePeriodBallot(input.Ctx, ballot)
+	}
+
+	res, err := querier.VoteHistory(ctx, &types.QueryVoteHistoryRequest{
+		ValidatorAddr: ValAddrs[1].String(),
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Ballots, 1)
+	require.Equal(t, uint64(3), res.Ballots[0].VotePeriod)
+	require.Equal(t, []types.ValidatorBallot{{Voter: ValAddrs[1].String(), Outcome: types.VoteOutcome_VOTE_OUTCOME_ABSTAIN}}, res.Ballots[0].ValidatorBallots)
+
+	// most recent vote periods first
+	res, err = querier.VoteHistory(ctx, &types.QueryVoteHistoryRequest{
+		ValidatorAddr: ValAddrs[0].String(),
+		Pagination:    &query.PageRequest{Limit: 2, Reverse: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Ballots, 2)
+	require.Equal(t, uint64(3), res.Ballots[0].VotePeriod)
+	require.Equal(t, uint64(2), res.Ballots[1].VotePeriod)
+	require.Len(t, res.Ballots[0].ValidatorBallots, 1)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	_, err = querier.VoteHistory(ctx, &types.QueryVoteHistoryRequest{ValidatorAddr: "invalid"})
+	require.Error(t, err)
+}
+
+func TestQueryOracleRewards(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	res, err := querier.OracleRewards(ctx, &types.QueryOracleRewardsRequest{
+		ValidatorAddr: ValAddrs[0].String(),
+	})
+	require.NoError(t, err)
+	require.True(t, res.Rewards.IsZero())
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(utils.MicroSeiDenom, 100))
+	input.OracleKeeper.SetOracleRewards(input.Ctx, ValAddrs[0], rewards)
+
+	res, err = querier.OracleRewards(ctx, &types.QueryOracleRewardsRequest{
+		ValidatorAddr: ValAddrs[0].String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, rewards, res.Rewards)
+
+	_, err = querier.OracleRewards(ctx, &types.QueryOracleRewardsRequest{ValidatorAddr: "invalid"})
+	require.Error(t, err)
+}
+
 func TestQuerySlashingWindow(t *testing.T) {
 	input := CreateTestInput(t)
 	querier := NewQuerier(input.OracleKeeper)
@@ -117,7 +224,7 @@ func TestQueryVoteTargets(t *testing.T) {
 
 	voteTargets := []string{"denom", "denom2", "denom3"}
 	for _, target := range voteTargets {
-		input.OracleKeeper.SetVoteTarget(input.Ctx, target)
+		input.OracleKeeper.SetVoteTarget(input.Ctx, types.Denom{Name: target})
 	}
 
 	res, err := querier.VoteTargets(ctx, &types.QueryVoteTargetsRequest{})
@@ -125,6 +232,49 @@ func TestQueryVoteTargets(t *testing.T) {
 	require.Equal(t, voteTargets, res.VoteTargets)
 }
 
+func TestQueryVoteTargetTransitions(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	input.OracleKeeper.SetVoteTargetProbation(input.Ctx, "ufoo", 100)
+	input.OracleKeeper.SetVoteTargetSunset(input.Ctx, "ubar", 200)
+
+	res, err := querier.VoteTargetTransitions(ctx, &types.QueryVoteTargetTransitionsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.VoteTargetTransition{{Denom: "ufoo", EndHeight: 100}}, res.Probations)
+	require.Equal(t, []types.VoteTargetTransition{{Denom: "ubar", EndHeight: 200}}, res.Sunsets)
+}
+
+func TestQuerySunsetExchangeRate(t *testing.T) {
+	input := CreateTestInput(t)
+	querier := NewQuerier(input.OracleKeeper)
+
+	input.Ctx = input.Ctx.WithBlockHeight(10)
+	rate := sdk.NewDec(1700)
+	input.OracleKeeper.SetBaseExchangeRate(input.Ctx, utils.MicroAtomDenom, rate)
+	input.OracleKeeper.SetVoteTargetSunset(input.Ctx, utils.MicroAtomDenom, 20)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+
+	res, err := querier.ExchangeRate(ctx, &types.QueryExchangeRateRequest{Denom: utils.MicroAtomDenom})
+	require.NoError(t, err)
+	require.Equal(t, rate, res.OracleExchangeRate.ExchangeRate)
+	require.True(t, res.Stale)
+
+	ratesRes, err := querier.ExchangeRates(ctx, &types.QueryExchangeRatesRequest{})
+	require.NoError(t, err)
+	require.Len(t, ratesRes.DenomOracleExchangeRatePairs, 1)
+	require.True(t, ratesRes.DenomOracleExchangeRatePairs[0].Stale)
+
+	_, err = querier.FreshExchangeRate(ctx, &types.QueryFreshExchangeRateRequest{Denom: utils.MicroAtomDenom, MaxAgeSeconds: 30})
+	require.ErrorIs(t, err, types.ErrStaleExchangeRate)
+
+	// the rate is no longer stale once the sunset period ends
+	res, err = querier.ExchangeRate(sdk.WrapSDKContext(input.Ctx.WithBlockHeight(20)), &types.QueryExchangeRateRequest{Denom: utils.MicroAtomDenom})
+	require.NoError(t, err)
+	require.False(t, res.Stale)
+}
+
 func TestQueryPriceSnapshotHistory(t *testing.T) {
 	input := CreateTestInput(t)
 	ctx := sdk.WrapSDKContext(input.Ctx)
@@ -215,3 +365,32 @@ func TestQueryTwaps(t *testing.T) {
 	require.Equal(t, int64(1800), ethTwap.LookbackSeconds)
 	require.Equal(t, sdk.NewDec(15), ethTwap.Twap)
 }
+
+func TestQueryPriceAggregates(t *testing.T) {
+	input := CreateTestInput(t)
+	querier := NewQuerier(input.OracleKeeper)
+	setAggregatesPriceSnapshots(input)
+	input.Ctx = input.Ctx.WithBlockTime(time.Unix(5400, 0))
+	ctx := sdk.WrapSDKContext(input.Ctx)
+
+	_, err := querier.Emas(ctx, &types.QueryEmasRequest{LookbackSeconds: 3600})
+	require.Error(t, err)
+	emas, err := querier.Emas(ctx, &types.QueryEmasRequest{LookbackSeconds: 3600, PeriodSeconds: 1800})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(emas.OracleEmas))
+
+	priceRanges, err := querier.PriceRanges(ctx, &types.QueryPriceRangesRequest{LookbackSeconds: 3600})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(priceRanges.OraclePriceRanges))
+
+	volatilities, err := querier.Volatilities(ctx, &types.QueryVolatilitiesRequest{LookbackSeconds: 3600})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(volatilities.OracleVolatilities))
+
+	_, err = querier.PriceAtTimestamp(ctx, &types.QueryPriceAtTimestampRequest{Denom: utils.MicroAtomDenom, Timestamp: 5401})
+	require.Error(t, err)
+	res, err := querier.PriceAtTimestamp(ctx, &types.QueryPriceAtTimestampRequest{Denom: utils.MicroAtomDenom, Timestamp: 5400})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(40), res.OracleExchangeRate.ExchangeRate)
+	require.Equal(t, int64(4500), res.SnapshotTimestamp)
+}
@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/oracle/types"
+)
+
+// GetOracleRewards returns the total oracle rewards distributed to a validator
+func (k Keeper) GetOracleRewards(ctx sdk.Context, operator sdk.ValAddress) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetOracleRewardsKey(operator))
+	if bz == nil {
+		return sdk.NewCoins()
+	}
+
+	var oracleRewards types.OracleRewards
+	k.cdc.MustUnmarshal(bz, &oracleRewards)
+	return oracleRewards.Rewards
+}
+
+// SetOracleRewards sets the total oracle rewards distributed to a validator
+func (k Keeper) SetOracleRewards(ctx sdk.Context, operator sdk.ValAddress, rewards sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&types.OracleRewards{Rewards: rewards})
+	store.Set(types.GetOracleRewardsKey(operator), bz)
+}
+
+// IterateOracleRewards iterates over the oracle rewards of all validators and performs a callback function.
+func (k Keeper) IterateOracleRewards(ctx sdk.Context,
+	handler func(operator sdk.ValAddress, oracleRewards types.OracleRewards) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.OracleRewardsKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		operator := sdk.ValAddress(iter.Key()[2:])
+
+		var oracleRewards types.OracleRewards
+		k.cdc.MustUnmarshal(iter.Value(), &oracleRewards)
+
+		if handler(operator, oracleRewards) {
+			break
+		}
+	}
+}
+
+// RewardBallotWinners distributes votePeriod / rewardDistributionWindow of the
+// oracle reward pool to the ballot winners, proportional to the weight of
+// their claims. The rewards are moved to the distribution module and allocated
+// to the validators there, so they are paid out like any other validator reward.
+func (k Keeper) RewardBallotWinners(
+	ctx sdk.Context,
+	votePeriod uint64,
+	rewardDistributionWindow uint64,
+	ballotWinners map[string]types.Claim,
+) {
+	// Sort the winners so the distribution is deterministic
+	winners := []types.Claim{}
+	weightSum := int64(0)
+	for _, winner := range ballotWinners {
+		if winner.Weight <= 0 {
+			continue
+		}
+		winners = append(winners, winner)
+		weightSum += winner.Weight
+	}
+	if weightSum == 0 {
+		return
+	}
+	sort.Slice(winners, func(i, j int) bool {
+		return winners[i].Recipient.String() < winners[j].Recipient.String()
+	})
+
+	rewardPool := k.GetRewardPoolLegacy(ctx)
+	if rewardPool.IsZero() {
+		return
+	}
+
+	distributionRatio := sdk.NewDecFromInt(sdk.NewIntFromUint64(votePeriod)).QuoInt(sdk.NewIntFromUint64(rewardDistributionWindow))
+	periodRewards := sdk.NewDecCoinsFromCoins(rewardPool...).MulDecTruncate(distributionRatio)
+
+	validators := []sdk.ValAddress{}
+	rewards := []sdk.Coins{}
+	distributedReward := sdk.NewCoins()
+	for _, winner := range winners {
+		receiverVal := k.StakingKeeper.Validator(ctx, winner.Recipient)
+		if receiverVal == nil {
+			continue
+		}
+
+		rewardCoins, _ := periodRewards.MulDecTruncate(sdk.NewDec(winner.Weight).QuoInt64(weightSum)).TruncateDecimal()
+		if rewardCoins.IsZero() {
+			continue
+		}
+
+		validators = append(validators, winner.Recipient)
+		rewards = append(rewards, rewardCoins)
+		distributedReward = distributedReward.Add(rewardCoins...)
+	}
+	if distributedReward.IsZero() {
+		return
+	}
+
+	// Move distributed reward to distribution module
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.distrName, distributedReward); err != nil {
+		ctx.Logger().Error("failed to send oracle rewards to distribution module", "error", err)
+		return
+	}
+
+	for i, valAddr := range validators {
+		k.distrKeeper.AllocateTokensToValidator(ctx, k.StakingKeeper.Validator(ctx, valAddr), sdk.NewDecCoinsFromCoins(rewards[i]...))
+		k.SetOracleRewards(ctx, valAddr, k.GetOracleRewards(ctx, valAddr).Add(rewards[i]...))
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeOracleReward,
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, rewards[i].String()),
+			),
+		)
+	}
+}
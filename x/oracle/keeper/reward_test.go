@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/sei-protocol/sei-chain/x/oracle/types"
+	"github.com/sei-protocol/sei-chain/x/oracle/utils"
+)
+
+func TestRewardBallotWinners(t *testing.T) {
+	input := CreateTestInput(t)
+
+	power := int64(100)
+	amt := sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
+	sh := staking.NewHandler(input.StakingKeeper)
+	ctx := input.Ctx
+
+	// Validator created
+	_, err := sh(ctx, NewTestMsgCreateValidator(ValAddrs[0], ValPubKeys[0], amt))
+	require.NoError(t, err)
+	_, err = sh(ctx, NewTestMsgCreateValidator(ValAddrs[1], ValPubKeys[1], amt))
+	require.NoError(t, err)
+	_, err = sh(ctx, NewTestMsgCreateValidator(ValAddrs[2], ValPubKeys[2], amt))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	rewardPool := sdk.NewCoins(sdk.NewCoin(utils.MicroSeiDenom, sdk.NewInt(100_000_000)))
+	err = FundAccount(input, input.AccountKeeper.GetModuleAddress(types.ModuleName), rewardPool)
+	require.NoError(t, err)
+
+	// validator 2 voted outside of the reward band
+	claims := map[string]types.Claim{
+		ValAddrs[0].String(): types.NewClaim(power, power, 1, ValAddrs[0], true),
+		ValAddrs[1].String(): types.NewClaim(power, 3*power, 3, ValAddrs[1], true),
+		ValAddrs[2].String(): types.NewClaim(power, 0, 0, ValAddrs[2], true),
+	}
+
+	// 2 / 100 of the pool is distributed this vote period
+	input.OracleKeeper.RewardBallotWinners(ctx, 2, 100, claims)
+
+	require.Equal(t, sdk.NewInt(500_000), input.OracleKeeper.GetOracleRewards(ctx, ValAddrs[0]).AmountOf(utils.MicroSeiDenom))
+	require.Equal(t, sdk.NewInt(1_500_000), input.OracleKeeper.GetOracleRewards(ctx, ValAddrs[1]).AmountOf(utils.MicroSeiDenom))
+	require.True(t, input.OracleKeeper.GetOracleRewards(ctx, ValAddrs[2]).IsZero())
+
+	require.Equal(t, sdk.NewDec(500_000), input.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, ValAddrs[0]).AmountOf(utils.MicroSeiDenom))
+	require.Equal(t, sdk.NewDec(1_500_000), input.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, ValAddrs[1]).AmountOf(utils.MicroSeiDenom))
+	require.True(t, input.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, ValAddrs[2]).IsZero())
+	require.Equal(t, sdk.NewInt(98_000_000), input.OracleKeeper.GetRewardPool(ctx, utils.MicroSeiDenom).Amount)
+
+	// rewards accumulate over vote periods
+	input.OracleKeeper.RewardBallotWinners(ctx, 2, 100, claims)
+	require.Equal(t, sdk.NewInt(990_000), input.OracleKeeper.GetOracleRewards(ctx, ValAddrs[0]).AmountOf(utils.MicroSeiDenom))
+
+	count := 0
+	input.OracleKeeper.IterateOracleRewards(ctx, func(_ sdk.ValAddress, _ types.OracleRewards) bool {
+		count++
+		return false
+	})
+	require.Equal(t, 2, count)
+}
+
+func TestRewardBallotWinnersNoWinners(t *testing.T) {
+	input := CreateTestInput(t)
+
+	rewardPool := sdk.NewCoins(sdk.NewCoin(utils.MicroSeiDenom, sdk.NewInt(100_000_000)))
+	err := FundAccount(input, input.AccountKeeper.GetModuleAddress(types.ModuleName), rewardPool)
+	require.NoError(t, err)
+
+	claims := map[string]types.Claim{
+		ValAddrs[0].String(): types.NewClaim(100, 0, 0, ValAddrs[0], false),
+	}
+	input.OracleKeeper.RewardBallotWinners(input.Ctx, 2, 100, claims)
+
+	require.True(t, input.OracleKeeper.GetOracleRewards(input.Ctx, ValAddrs[0]).IsZero())
+	require.Equal(t, rewardPool[0], input.OracleKeeper.GetRewardPool(input.Ctx, utils.MicroSeiDenom))
+}
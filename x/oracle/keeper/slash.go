@@ -8,17 +8,25 @@ import (
 	"github.com/sei-protocol/sei-chain/x/oracle/types"
 )
 
-// SlashAndResetCounters do slash any operator who over criteria & clear all operators miss counter to zero
+// SlashAndResetCounters applies the graduated oracle penalties & clear all operators miss counter to zero.
+// Operators whose valid vote rate is below WarningThreshold get a warning event, below MinValidPerWindow
+// they are slashed by SlashFraction and below JailThreshold they are jailed as well. Operators bonded
+// less than SlashGracePeriod blocks ago are only warned.
 func (k Keeper) SlashAndResetCounters(ctx sdk.Context) {
 	height := ctx.BlockHeight()
 	distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
+	warningThreshold := k.WarningThreshold(ctx)
 	minValidPerWindow := k.MinValidPerWindow(ctx)
+	jailThreshold := k.JailThreshold(ctx)
+	missPenaltyWeight := k.MissPenaltyWeight(ctx)
+	abstainPenaltyWeight := k.AbstainPenaltyWeight(ctx)
+	slashGracePeriod := k.SlashGracePeriod(ctx)
 	slashFraction := k.SlashFraction(ctx)
 	powerReduction := k.StakingKeeper.PowerReduction(ctx)
 
 	k.IterateVotePenaltyCounters(ctx, func(operator sdk.ValAddress, votePenaltyCounter types.VotePenaltyCounter) bool {
-		// Calculate valid vote rate; (totalVotes - (MissCounter + AbstainCounter))/totalVotes
+		// Calculate valid vote rate; (totalVotes - (MissCounter * MissPenaltyWeight + AbstainCounter * AbstainPenaltyWeight))/totalVotes
 		// this accounts for changes in vote period within a window, and will take the overall success rate
 		// as opposed to the one expected based on the number of vote period expected based on the ending slash window or vote period
 		totalVotes := votePenaltyCounter.SuccessCount + votePenaltyCounter.AbstainCount + votePenaltyCounter.MissCount
@@ -26,12 +34,19 @@ func (k Keeper) SlashAndResetCounters(ctx sdk.Context) {
 			ctx.Logger().Error("zero votes in penalty counter, this should never happen")
 			return false
 		}
-		validVoteRate := sdk.NewDecFromInt(
-			sdk.NewInt(int64(votePenaltyCounter.SuccessCount))).
-			QuoInt64(int64(totalVotes))
+		validVoteRate := calculateValidVoteRate(votePenaltyCounter, totalVotes, missPenaltyWeight, abstainPenaltyWeight)
+
+		if validVoteRate.LT(warningThreshold) {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(types.EventTypePenaltyWarning,
+					sdk.NewAttribute(types.AttributeKeyOperator, operator.String()),
+					sdk.NewAttribute(types.AttributeKeyValidVoteRate, validVoteRate.String()),
+				),
+			)
+		}
 
 		// Penalize the validator whose the valid vote rate is smaller than min threshold
-		if validVoteRate.LT(minValidPerWindow) {
+		if validVoteRate.LT(minValidPerWindow) && !k.isInSlashGracePeriod(ctx, operator, slashGracePeriod) {
 			validator := k.StakingKeeper.Validator(ctx, operator)
 			if validator.IsBonded() && !validator.IsJailed() {
 				consAddr, err := validator.GetConsAddr()
@@ -43,7 +58,10 @@ func (k Keeper) SlashAndResetCounters(ctx sdk.Context) {
 					ctx, consAddr,
 					distributionHeight, validator.GetConsensusPower(powerReduction), slashFraction,
 				)
-				k.StakingKeeper.Jail(ctx, consAddr)
+				// only the validators below the lowest tier are jailed
+				if validVoteRate.LT(jailThreshold) {
+					k.StakingKeeper.Jail(ctx, consAddr)
+				}
 				cosmostelemetry.IncrValidatorSlashedCounter(consAddr.String(), "oracle")
 			}
 		}
@@ -61,3 +79,62 @@ func (k Keeper) SlashAndResetCounters(ctx sdk.Context) {
 		return false
 	})
 }
+
+// calculateValidVoteRate weighs the misses and abstains of the penalty counter against the total
+// number of vote periods, flooring the rate at zero for weights above one
+func calculateValidVoteRate(votePenaltyCounter types.VotePenaltyCounter, totalVotes uint64, missPenaltyWeight, abstainPenaltyWeight sdk.Dec) sdk.Dec {
+	penalty := missPenaltyWeight.MulInt64(int64(votePenaltyCounter.MissCount)).
+		Add(abstainPenaltyWeight.MulInt64(int64(votePenaltyCounter.AbstainCount))).
+		QuoInt64(int64(totalVotes))
+
+	validVoteRate := sdk.OneDec().Sub(penalty)
+	if validVoteRate.IsNegative() {
+		return sdk.ZeroDec()
+	}
+	return validVoteRate
+}
+
+// isInSlashGracePeriod returns whether the validator was bonded less than gracePeriod blocks ago
+func (k Keeper) isInSlashGracePeriod(ctx sdk.Context, operator sdk.ValAddress, gracePeriod uint64) bool {
+	bondHeight, found := k.GetValidatorBondHeight(ctx, operator)
+	if !found {
+		return false
+	}
+	return ctx.BlockHeight()-bondHeight < int64(gracePeriod)
+}
+
+// GetValidatorBondHeight returns the height the validator was last bonded at, if it was bonded
+// since bond heights started being recorded
+func (k Keeper) GetValidatorBondHeight(ctx sdk.Context, operator sdk.ValAddress) (int64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorBondHeightKey(operator))
+	if bz == nil {
+		return 0, false
+	}
+	return int64(sdk.BigEndianToUint64(bz)), true
+}
+
+// SetValidatorBondHeight records the height the validator was bonded at
+func (k Keeper) SetValidatorBondHeight(ctx sdk.Context, operator sdk.ValAddress, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorBondHeightKey(operator), sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// IterateValidatorBondHeights iterates over the bond heights of the validators
+func (k Keeper) IterateValidatorBondHeights(ctx sdk.Context, handler func(operator sdk.ValAddress, height int64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorBondHeightKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		operator := sdk.ValAddress(iter.Key()[2:])
+		if handler(operator, int64(sdk.BigEndianToUint64(iter.Value()))) {
+			break
+		}
+	}
+}
+
+// DeleteValidatorBondHeight removes the bond height of the validator
+func (k Keeper) DeleteValidatorBondHeight(ctx sdk.Context, operator sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorBondHeightKey(operator))
+}
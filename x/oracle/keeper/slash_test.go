@@ -8,6 +8,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/sei-protocol/sei-chain/x/oracle/types"
 )
 
 func TestSlashAndResetMissCounters(t *testing.T) {
@@ -101,3 +103,120 @@ func TestSlashAndResetMissCounters(t *testing.T) {
 	validator, _ = input.StakingKeeper.GetValidator(input.Ctx, ValAddrs[0])
 	require.Equal(t, amt, validator.Tokens)
 }
+
+func TestGraduatedSlashing(t *testing.T) {
+	input := CreateTestInput(t)
+	addr, val := ValAddrs[0], ValPubKeys[0]
+	amt := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	sh := staking.NewHandler(input.StakingKeeper)
+
+	_, err := sh(input.Ctx, NewTestMsgCreateValidator(addr, val, amt))
+	require.NoError(t, err)
+	staking.EndBlocker(input.Ctx, input.StakingKeeper)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.SlashFraction = sdk.NewDecWithPrec(1, 2)
+	params.WarningThreshold = sdk.NewDecWithPrec(5, 1)
+	params.MinValidPerWindow = sdk.NewDecWithPrec(3, 1)
+	params.JailThreshold = sdk.NewDecWithPrec(1, 1)
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	slashed := amt.Sub(params.SlashFraction.MulInt(amt).TruncateInt())
+
+	resetValidator := func() {
+		validator, _ := input.StakingKeeper.GetValidator(input.Ctx, addr)
+		validator.Jailed = false
+		validator.Tokens = amt
+		input.StakingKeeper.SetValidator(input.Ctx, validator)
+	}
+
+	testCases := []struct {
+		name                 string
+		missCount            uint64
+		abstainCount         uint64
+		successCount         uint64
+		abstainPenaltyWeight sdk.Dec
+		expectWarning        bool
+		expectedTokens       sdk.Int
+		expectJailed         bool
+	}{
+		{"above warning threshold", 4, 0, 6, sdk.OneDec(), false, amt, false},
+		{"warning only", 6, 0, 4, sdk.OneDec(), true, amt, false},
+		{"slash without jail", 8, 0, 2, sdk.OneDec(), true, slashed, false},
+		{"slash and jail", 10, 0, 0, sdk.OneDec(), true, slashed, true},
+		{"abstains weighted like misses", 0, 8, 2, sdk.OneDec(), true, slashed, false},
+		{"abstains weighted lightly", 0, 8, 2, sdk.NewDecWithPrec(5, 1), false, amt, false},
+		{"abstains not penalized", 0, 10, 0, sdk.ZeroDec(), false, amt, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resetValidator()
+			params.AbstainPenaltyWeight = tc.abstainPenaltyWeight
+			input.OracleKeeper.SetParams(input.Ctx, params)
+
+			ctx := input.Ctx.WithEventManager(sdk.NewEventManager())
+			input.OracleKeeper.SetVotePenaltyCounter(ctx, addr, tc.missCount, tc.abstainCount, tc.successCount)
+			input.OracleKeeper.SlashAndResetCounters(ctx)
+
+			require.Equal(t, tc.expectWarning, hasEvent(ctx, types.EventTypePenaltyWarning))
+			validator, _ := input.StakingKeeper.GetValidator(ctx, addr)
+			require.Equal(t, tc.expectedTokens, validator.GetBondedTokens())
+			require.Equal(t, tc.expectJailed, validator.IsJailed())
+		})
+	}
+}
+
+func TestSlashGracePeriod(t *testing.T) {
+	input := CreateTestInput(t)
+	addr, val := ValAddrs[0], ValPubKeys[0]
+	amt := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	sh := staking.NewHandler(input.StakingKeeper)
+
+	_, err := sh(input.Ctx, NewTestMsgCreateValidator(addr, val, amt))
+	require.NoError(t, err)
+	staking.EndBlocker(input.Ctx, input.StakingKeeper)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.SlashFraction = sdk.NewDecWithPrec(1, 2)
+	params.SlashGracePeriod = 100
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// the staking hooks record the bond height of the validator
+	bondCtx := input.Ctx.WithBlockHeight(1000)
+	consAddr := sdk.ConsAddress(val.Address())
+	input.OracleKeeper.Hooks().AfterValidatorBonded(bondCtx, consAddr, addr)
+	bondHeight, found := input.OracleKeeper.GetValidatorBondHeight(input.Ctx, addr)
+	require.True(t, found)
+	require.Equal(t, int64(1000), bondHeight)
+
+	// within the grace period the validator is only warned
+	ctx := input.Ctx.WithBlockHeight(1099).WithEventManager(sdk.NewEventManager())
+	input.OracleKeeper.SetVotePenaltyCounter(ctx, addr, 10, 0, 0)
+	input.OracleKeeper.SlashAndResetCounters(ctx)
+	require.True(t, hasEvent(ctx, types.EventTypePenaltyWarning))
+	validator, _ := input.StakingKeeper.GetValidator(ctx, addr)
+	require.Equal(t, amt, validator.GetBondedTokens())
+	require.False(t, validator.IsJailed())
+
+	// once the grace period is over the validator is slashed and jailed
+	ctx = input.Ctx.WithBlockHeight(1100)
+	input.OracleKeeper.SetVotePenaltyCounter(ctx, addr, 10, 0, 0)
+	input.OracleKeeper.SlashAndResetCounters(ctx)
+	validator, _ = input.StakingKeeper.GetValidator(ctx, addr)
+	require.Equal(t, amt.Sub(params.SlashFraction.MulInt(amt).TruncateInt()), validator.GetBondedTokens())
+	require.True(t, validator.IsJailed())
+
+	// removing the validator drops its bond height
+	input.OracleKeeper.Hooks().AfterValidatorRemoved(ctx, consAddr, addr)
+	_, found = input.OracleKeeper.GetValidatorBondHeight(ctx, addr)
+	require.False(t, found)
+}
+
+func hasEvent(ctx sdk.Context, eventType string) bool {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
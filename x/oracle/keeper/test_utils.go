@@ -242,7 +242,7 @@ func CreateTestInput(t *testing.T) TestInput {
 	keeper.SetParams(ctx, defaults)
 
 	for _, denom := range defaults.Whitelist {
-		keeper.SetVoteTarget(ctx, denom.Name)
+		keeper.SetVoteTarget(ctx, denom)
 	}
 
 	return TestInput{ctx, legacyAmino, accountKeeper, bankKeeper, keeper, stakingKeeper, distrKeeper}
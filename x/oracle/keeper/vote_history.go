@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/oracle/types"
+)
+
+// GetVotePeriodBallot retrieves the ballot summary of a vote period
+func (k Keeper) GetVotePeriodBallot(ctx sdk.Context, votePeriod uint64) (types.VotePeriodBallot, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetVotePeriodBallotKey(votePeriod))
+	if bz == nil {
+		return types.VotePeriodBallot{}, false
+	}
+
+	ballot := types.VotePeriodBallot{}
+	k.cdc.MustUnmarshal(bz, &ballot)
+	return ballot, true
+}
+
+// SetVotePeriodBallot stores the ballot summary of a vote period
+func (k Keeper) SetVotePeriodBallot(ctx sdk.Context, ballot types.VotePeriodBallot) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&ballot)
+	store.Set(types.GetVotePeriodBallotKey(ballot.VotePeriod), bz)
+}
+
+// DeleteVotePeriodBallot deletes the ballot summary of a vote period
+func (k Keeper) DeleteVotePeriodBallot(ctx sdk.Context, votePeriod uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetVotePeriodBallotKey(votePeriod))
+}
+
+// IterateVotePeriodBallots iterates over the retained ballot summaries from the oldest vote period
+func (k Keeper) IterateVotePeriodBallots(ctx sdk.Context, handler func(ballot types.VotePeriodBallot) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.VotePeriodBallotKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var ballot types.VotePeriodBallot
+		k.cdc.MustUnmarshal(iter.Value(), &ballot)
+		if handler(ballot) {
+			break
+		}
+	}
+}
+
+// AddVotePeriodBallot stores the ballot summary of a vote period and prunes
+// the summaries that fall out of the retention window. A retention of zero
+// disables the vote history.
+func (k Keeper) AddVotePeriodBallot(ctx sdk.Context, ballot types.VotePeriodBallot) {
+	retention := k.VoteHistoryRetention(ctx)
+	if retention == 0 {
+		return
+	}
+
+	k.SetVotePeriodBallot(ctx, ballot)
+
+	if ballot.VotePeriod < retention {
+		return
+	}
+	cutoff := ballot.VotePeriod - retention
+
+	var expired []uint64
+	k.IterateVotePeriodBallots(ctx, func(b types.VotePeriodBallot) bool {
+		if b.VotePeriod > cutoff {
+			return true
+		}
+		expired = append(expired, b.VotePeriod)
+		return false
+	})
+
+	for _, votePeriod := range expired {
+		k.DeleteVotePeriodBallot(ctx, votePeriod)
+	}
+}
+
+// BuildVotePeriodBallot summarises the votes of the current vote period for
+// the validators in the claim map. It must be called before the ballots are
+// cleared.
+func (k Keeper) BuildVotePeriodBallot(
+	ctx sdk.Context,
+	votePeriod uint64,
+	medians types.ExchangeRateTuples,
+	validatorClaimMap map[string]types.Claim,
+	outcomes map[string]types.VoteOutcome,
+) types.VotePeriodBallot {
+	voters := make([]string, 0, len(validatorClaimMap))
+	for voter := range validatorClaimMap {
+		voters = append(voters, voter)
+	}
+	sort.Strings(voters)
+
+	validatorBallots := make([]types.ValidatorBallot, 0, len(voters))
+	for _, voter := range voters {
+		claim := validatorClaimMap[voter]
+
+		inBand := make(map[string]bool, len(claim.WinDenoms))
+		for _, denom := range claim.WinDenoms {
+			inBand[denom] = true
+		}
+
+		votes := []types.BallotVote{}
+		if aggregateVote, err := k.GetAggregateExchangeRateVote(ctx, claim.Recipient); err == nil {
+			for _, tuple := range aggregateVote.ExchangeRateTuples {
+				votes = append(votes, types.BallotVote{
+					Denom:        tuple.Denom,
+					ExchangeRate: tuple.ExchangeRate,
+					InBand:       inBand[tuple.Denom],
+				})
+			}
+		}
+
+		validatorBallots = append(validatorBallots, types.ValidatorBallot{
+			Voter:   voter,
+			Outcome: outcomes[voter],
+			Votes:   votes,
+		})
+	}
+
+	return types.VotePeriodBallot{
+		VotePeriod:       uint64(ctx.BlockHeight()) / votePeriod,
+		BlockHeight:      ctx.BlockHeight(),
+		Timestamp:        ctx.BlockTime().Unix(),
+		Medians:          medians,
+		ValidatorBallots: validatorBallots,
+	}
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sei-protocol/sei-chain/x/oracle/types"
+)
+
+func TestAddVotePeriodBallot(t *testing.T) {
+	input := CreateTestInput(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.VoteHistoryRetention = 3
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	for votePeriod := uint64(1); votePeriod <= 5; votePeriod++ {
+		input.OracleKeeper.AddVotePeriodBallot(input.Ctx, types.VotePeriodBallot{VotePeriod: votePeriod})
+	}
+
+	var votePeriods []uint64
+	input.OracleKeeper.IterateVotePeriodBallots(input.Ctx, func(ballot types.VotePeriodBallot) bool {
+		votePeriods = append(votePeriods, ballot.VotePeriod)
+		return false
+	})
+	require.Equal(t, []uint64{3, 4, 5}, votePeriods)
+
+	// a retention of zero disables the history
+	params.VoteHistoryRetention = 0
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	input.OracleKeeper.AddVotePeriodBallot(input.Ctx, types.VotePeriodBallot{VotePeriod: 6})
+	_, found := input.OracleKeeper.GetVotePeriodBallot(input.Ctx, 6)
+	require.False(t, found)
+}
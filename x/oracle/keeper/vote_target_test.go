@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/sei-protocol/sei-chain/x/oracle/types"
 )
 
 func TestKeeper_GetVoteTargets(t *testing.T) {
@@ -13,7 +15,7 @@ func TestKeeper_GetVoteTargets(t *testing.T) {
 
 	expectedTargets := []string{"bar", "foo", "whoowhoo"}
 	for _, target := range expectedTargets {
-		input.OracleKeeper.SetVoteTarget(input.Ctx, target)
+		input.OracleKeeper.SetVoteTarget(input.Ctx, types.Denom{Name: target})
 	}
 
 	targets := input.OracleKeeper.GetVoteTargets(input.Ctx)
@@ -27,7 +29,7 @@ func TestKeeper_IsVoteTarget(t *testing.T) {
 
 	validTargets := []string{"bar", "foo", "whoowhoo"}
 	for _, target := range validTargets {
-		input.OracleKeeper.SetVoteTarget(input.Ctx, target)
+		input.OracleKeeper.SetVoteTarget(input.Ctx, types.Denom{Name: target})
 		require.True(t, input.OracleKeeper.IsVoteTarget(input.Ctx, target))
 	}
 }
@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sei-protocol/sei-chain/x/oracle/types"
+)
+
+// AddVoteTarget adds the denom to the whitelist, which makes it a vote target from the next
+// vote period on. Misses on the denom aren't penalized during its probation period.
+func (k Keeper) AddVoteTarget(ctx sdk.Context, denom types.Denom) error {
+	params := k.GetParams(ctx)
+	if params.Whitelist.Contains(denom.Name) {
+		return sdkerrors.Wrap(types.ErrVoteTargetExists, denom.Name)
+	}
+
+	params.Whitelist = append(params.Whitelist, denom)
+	if err := params.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	k.SetParams(ctx, params)
+
+	// a denom added back during its sunset period is voted on again
+	k.DeleteVoteTargetSunset(ctx, denom.Name)
+
+	endHeight := ctx.BlockHeight()
+	if params.VoteTargetProbationPeriod > 0 {
+		endHeight += int64(params.VoteTargetProbationPeriod)
+		k.SetVoteTargetProbation(ctx, denom.Name, endHeight)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeAddVoteTarget,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom.Name),
+			sdk.NewAttribute(types.AttributeKeyEndHeight, strconv.FormatInt(endHeight, 10)),
+		),
+	)
+	return nil
+}
+
+// RemoveVoteTarget removes the denom from the whitelist, which stops it from being a vote target
+// from the next vote period on. Its last exchange rate stays readable, marked stale, during its
+// sunset period.
+func (k Keeper) RemoveVoteTarget(ctx sdk.Context, denom string) error {
+	params := k.GetParams(ctx)
+	if !params.Whitelist.Contains(denom) {
+		return sdkerrors.Wrap(types.ErrNoVoteTarget, denom)
+	}
+
+	whitelist := types.DenomList{}
+	for _, item := range params.Whitelist {
+		if item.Name != denom {
+			whitelist = append(whitelist, item)
+		}
+	}
+	params.Whitelist = whitelist
+	if err := params.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	k.SetParams(ctx, params)
+
+	k.DeleteVoteTargetProbation(ctx, denom)
+
+	endHeight := ctx.BlockHeight()
+	if params.VoteTargetSunsetPeriod > 0 {
+		endHeight += int64(params.VoteTargetSunsetPeriod)
+		k.SetVoteTargetSunset(ctx, denom, endHeight)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeRemoveVoteTarget,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyEndHeight, strconv.FormatInt(endHeight, 10)),
+		),
+	)
+	return nil
+}
+
+// EndVoteTargetTransitions removes the probation periods that ended, and clears the exchange
+// rates of the removed vote targets whose sunset period ended
+func (k Keeper) EndVoteTargetTransitions(ctx sdk.Context) {
+	var endedProbations []string
+	k.IterateVoteTargetProbations(ctx, func(denom string, endHeight int64) bool {
+		if ctx.BlockHeight() >= endHeight {
+			endedProbations = append(endedProbations, denom)
+		}
+		return false
+	})
+	for _, denom := range endedProbations {
+		k.DeleteVoteTargetProbation(ctx, denom)
+	}
+
+	var endedSunsets []string
+	k.IterateVoteTargetSunsets(ctx, func(denom string, endHeight int64) bool {
+		if ctx.BlockHeight() >= endHeight {
+			endedSunsets = append(endedSunsets, denom)
+		}
+		return false
+	})
+	for _, denom := range endedSunsets {
+		k.DeleteVoteTargetSunset(ctx, denom)
+		k.DeleteBaseExchangeRate(ctx, denom)
+		k.DeleteExchangeRateConfidence(ctx, denom)
+	}
+}
+
+// IsVoteTargetOnProbation returns whether the vote target was added less than
+// VoteTargetProbationPeriod blocks ago
+func (k Keeper) IsVoteTargetOnProbation(ctx sdk.Context, denom string) bool {
+	endHeight, found := k.GetVoteTargetProbation(ctx, denom)
+	return found && ctx.BlockHeight() < endHeight
+}
+
+// IsVoteTargetInSunset returns whether the denom was removed from the vote targets
+// less than VoteTargetSunsetPeriod blocks ago
+func (k Keeper) IsVoteTargetInSunset(ctx sdk.Context, denom string) bool {
+	endHeight, found := k.GetVoteTargetSunset(ctx, denom)
+	return found && ctx.BlockHeight() < endHeight
+}
+
+// GetVoteTargetProbation returns the height the probation period of a vote target ends at
+func (k Keeper) GetVoteTargetProbation(ctx sdk.Context, denom string) (int64, bool) {
+	return k.getTransitionEndHeight(ctx, types.GetVoteTargetProbationKey(denom))
+}
+
+// SetVoteTargetProbation stores the height the probation period of a vote target ends at
+func (k Keeper) SetVoteTargetProbation(ctx sdk.Context, denom string, endHeight int64) {
+	k.setTransitionEndHeight(ctx, types.GetVoteTargetProbationKey(denom), endHeight)
+}
+
+// DeleteVoteTargetProbation deletes the probation period of a vote target
+func (k Keeper) DeleteVoteTargetProbation(ctx sdk.Context, denom string) {
+	ctx.KVStore(k.storeKey).Delete(types.GetVoteTargetProbationKey(denom))
+}
+
+// IterateVoteTargetProbations iterates over the probation periods of the vote targets
+func (k Keeper) IterateVoteTargetProbations(ctx sdk.Context, handler func(denom string, endHeight int64) (stop bool)) {
+	k.iterateTransitions(ctx, types.VoteTargetProbationKey, handler)
+}
+
+// GetVoteTargetSunset returns the height the sunset period of a removed vote target ends at
+func (k Keeper) GetVoteTargetSunset(ctx sdk.Context, denom string) (int64, bool) {
+	return k.getTransitionEndHeight(ctx, types.GetVoteTargetSunsetKey(denom))
+}
+
+// SetVoteTargetSunset stores the height the sunset period of a removed vote target ends at
+func (k Keeper) SetVoteTargetSunset(ctx sdk.Context, denom string, endHeight int64) {
+	k.setTransitionEndHeight(ctx, types.GetVoteTargetSunsetKey(denom), endHeight)
+}
+
+// DeleteVoteTargetSunset deletes the sunset period of a removed vote target
+func (k Keeper) DeleteVoteTargetSunset(ctx sdk.Context, denom string) {
+	ctx.KVStore(k.storeKey).Delete(types.GetVoteTargetSunsetKey(denom))
+}
+
+// IterateVoteTargetSunsets iterates over the sunset periods of the removed vote targets
+func (k Keeper) IterateVoteTargetSunsets(ctx sdk.Context, handler func(denom string, endHeight int64) (stop bool)) {
+	k.iterateTransitions(ctx, types.VoteTargetSunsetKey, handler)
+}
+
+func (k Keeper) getTransitionEndHeight(ctx sdk.Context, key []byte) (int64, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return 0, false
+	}
+	return int64(sdk.BigEndianToUint64(bz)), true
+}
+
+func (k Keeper) setTransitionEndHeight(ctx sdk.Context, key []byte, endHeight int64) {
+	ctx.KVStore(k.storeKey).Set(key, sdk.Uint64ToBigEndian(uint64(endHeight)))
+}
+
+func (k Keeper) iterateTransitions(ctx sdk.Context, prefix []byte, handler func(denom string, endHeight int64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		denom := string(iter.Key()[len(prefix):])
+		if handler(denom, int64(sdk.BigEndianToUint64(iter.Value()))) {
+			break
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sei-protocol/sei-chain/x/oracle/types"
+	"github.com/sei-protocol/sei-chain/x/oracle/utils"
+)
+
+func TestAddVoteTarget(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := input.Ctx.WithBlockHeight(10)
+
+	params := input.OracleKeeper.GetParams(ctx)
+	params.VoteTargetProbationPeriod = 100
+	input.OracleKeeper.SetParams(ctx, params)
+
+	err := input.OracleKeeper.AddVoteTarget(ctx, types.Denom{Name: utils.MicroAtomDenom})
+	require.ErrorIs(t, err, types.ErrVoteTargetExists)
+
+	err = input.OracleKeeper.AddVoteTarget(ctx, types.Denom{Name: "ufoo"})
+	require.NoError(t, err)
+	require.True(t, input.OracleKeeper.GetParams(ctx).Whitelist.Contains("ufoo"))
+
+	endHeight, found := input.OracleKeeper.GetVoteTargetProbation(ctx, "ufoo")
+	require.True(t, found)
+	require.Equal(t, int64(110), endHeight)
+	require.True(t, input.OracleKeeper.IsVoteTargetOnProbation(ctx, "ufoo"))
+	require.False(t, input.OracleKeeper.IsVoteTargetOnProbation(ctx.WithBlockHeight(110), "ufoo"))
+
+	// without a probation period the new vote target is penalized right away
+	params = input.OracleKeeper.GetParams(ctx)
+	params.VoteTargetProbationPeriod = 0
+	input.OracleKeeper.SetParams(ctx, params)
+
+	require.NoError(t, input.OracleKeeper.AddVoteTarget(ctx, types.Denom{Name: "ubar"}))
+	_, found = input.OracleKeeper.GetVoteTargetProbation(ctx, "ubar")
+	require.False(t, found)
+}
+
+func TestRemoveVoteTarget(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := input.Ctx.WithBlockHeight(10)
+
+	params := input.OracleKeeper.GetParams(ctx)
+	params.VoteTargetSunsetPeriod = 100
+	input.OracleKeeper.SetParams(ctx, params)
+
+	err := input.OracleKeeper.RemoveVoteTarget(ctx, "ufoo")
+	require.ErrorIs(t, err, types.ErrNoVoteTarget)
+
+	require.NoError(t, input.OracleKeeper.AddVoteTarget(ctx, types.Denom{Name: "ufoo"}))
+	require.NoError(t, input.OracleKeeper.RemoveVoteTarget(ctx, "ufoo"))
+	require.False(t, input.OracleKeeper.GetParams(ctx).Whitelist.Contains("ufoo"))
+
+	// removing a vote target ends its probation
+	_, found := input.OracleKeeper.GetVoteTargetProbation(ctx, "ufoo")
+	require.False(t, found)
+
+	endHeight, found := input.OracleKeeper.GetVoteTargetSunset(ctx, "ufoo")
+	require.True(t, found)
+	require.Equal(t, int64(110), endHeight)
+	require.True(t, input.OracleKeeper.IsVoteTargetInSunset(ctx, "ufoo"))
+	require.False(t, input.OracleKeeper.IsVoteTargetInSunset(ctx.WithBlockHeight(110), "ufoo"))
+
+	// adding the denom back ends its sunset
+	require.NoError(t, input.OracleKeeper.AddVoteTarget(ctx, types.Denom{Name: "ufoo"}))
+	_, found = input.OracleKeeper.GetVoteTargetSunset(ctx, "ufoo")
+	require.False(t, found)
+}
+
+func TestRemoveVoteTargetOfDerivedDenom(t *testing.T) {
+	input := CreateTestInput(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.DerivedDenoms = types.DerivedDenomList{
+		{Name: "uatometh", Numerators: []string{utils.MicroAtomDenom}, Denominators: []string{utils.MicroEthDenom}},
+	}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	err := input.OracleKeeper.RemoveVoteTarget(input.Ctx, utils.MicroEthDenom)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.True(t, input.OracleKeeper.GetParams(input.Ctx).Whitelist.Contains(utils.MicroEthDenom))
+}
+
+func TestEndVoteTargetTransitions(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := input.Ctx.WithBlockHeight(10)
+
+	input.OracleKeeper.SetVoteTargetProbation(ctx, "ufoo", 20)
+	input.OracleKeeper.SetVoteTargetSunset(ctx, "ubar", 20)
+	input.OracleKeeper.SetBaseExchangeRate(ctx, "ubar", sdk.OneDec())
+
+	input.OracleKeeper.EndVoteTargetTransitions(ctx.WithBlockHeight(19))
+	_, found := input.OracleKeeper.GetVoteTargetProbation(ctx, "ufoo")
+	require.True(t, found)
+	_, found = input.OracleKeeper.GetVoteTargetSunset(ctx, "ubar")
+	require.True(t, found)
+	_, _, _, err := input.OracleKeeper.GetBaseExchangeRate(ctx, "ubar")
+	require.NoError(t, err)
+
+	input.OracleKeeper.EndVoteTargetTransitions(ctx.WithBlockHeight(20))
+	_, found = input.OracleKeeper.GetVoteTargetProbation(ctx, "ufoo")
+	require.False(t, found)
+	_, found = input.OracleKeeper.GetVoteTargetSunset(ctx, "ubar")
+	require.False(t, found)
+	_, _, _, err = input.OracleKeeper.GetBaseExchangeRate(ctx, "ubar")
+	require.Error(t, err)
+}
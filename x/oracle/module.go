@@ -144,6 +144,14 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	_ = cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4)
 	_ = cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5)
 	_ = cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5To6)
+	_ = cfg.RegisterMigration(types.ModuleName, 6, m.Migrate6to7)
+	_ = cfg.RegisterMigration(types.ModuleName, 7, m.Migrate7to8)
+	_ = cfg.RegisterMigration(types.ModuleName, 8, m.Migrate8to9)
+	_ = cfg.RegisterMigration(types.ModuleName, 9, m.Migrate9to10)
+	_ = cfg.RegisterMigration(types.ModuleName, 10, m.Migrate10to11)
+	_ = cfg.RegisterMigration(types.ModuleName, 11, m.Migrate11to12)
+	_ = cfg.RegisterMigration(types.ModuleName, 12, m.Migrate12to13)
+	_ = cfg.RegisterMigration(types.ModuleName, 13, m.Migrate13to14)
 }
 
 // InitGenesis performs genesis initialization for the oracle module. It returns
@@ -164,7 +172,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 6 }
+func (AppModule) ConsensusVersion() uint64 { return 14 }
 
 // BeginBlock returns the begin blocker for the oracle module.
 func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
@@ -38,6 +38,25 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &voteTargetA)
 			cdc.MustUnmarshal(kvB.Value, &voteTargetB)
 			return fmt.Sprintf("%v\n%v", voteTargetA, voteTargetB)
+		case bytes.Equal(kvA.Key[:1], types.ExchangeRateConfidenceKey):
+			var confidenceA, confidenceB types.ExchangeRateConfidence
+			cdc.MustUnmarshal(kvA.Value, &confidenceA)
+			cdc.MustUnmarshal(kvB.Value, &confidenceB)
+			return fmt.Sprintf("%v\n%v", confidenceA, confidenceB)
+		case bytes.Equal(kvA.Key[:1], types.FeederPermissionKey):
+			var permissionA, permissionB types.FeederPermission
+			cdc.MustUnmarshal(kvA.Value, &permissionA)
+			cdc.MustUnmarshal(kvB.Value, &permissionB)
+			return fmt.Sprintf("%v\n%v", permissionA, permissionB)
+		case bytes.Equal(kvA.Key[:1], types.VotePeriodBallotKey):
+			var ballotA, ballotB types.VotePeriodBallot
+			cdc.MustUnmarshal(kvA.Value, &ballotA)
+			cdc.MustUnmarshal(kvB.Value, &ballotB)
+			return fmt.Sprintf("%v\n%v", ballotA, ballotB)
+		case bytes.Equal(kvA.Key[:1], types.ValidatorBondHeightKey),
+			bytes.Equal(kvA.Key[:1], types.VoteTargetProbationKey),
+			bytes.Equal(kvA.Key[:1], types.VoteTargetSunsetKey):
+			return fmt.Sprintf("%v\n%v", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
 		default:
 			panic(fmt.Sprintf("invalid oracle key prefix %X", kvA.Key[:1]))
 		}
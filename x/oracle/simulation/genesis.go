@@ -16,13 +16,22 @@ import (
 
 // Simulation parameter constants
 const (
-	votePeriodKey               = "vote_period"
-	voteThresholdKey            = "vote_threshold"
-	rewardBandKey               = "reward_band"
-	rewardDistributionWindowKey = "reward_distribution_window" //nolint:deadcode // we will use this later
-	slashFractionKey            = "slash_fraction"
-	slashWindowKey              = "slash_window"
-	minValidPerWindowKey        = "min_valid_per_window"
+	votePeriodKey                = "vote_period"
+	voteThresholdKey             = "vote_threshold"
+	rewardBandKey                = "reward_band"
+	rewardDistributionWindowKey  = "reward_distribution_window"
+	slashFractionKey             = "slash_fraction"
+	slashWindowKey               = "slash_window"
+	minValidPerWindowKey         = "min_valid_per_window"
+	maxFeedersKey                = "max_feeders"
+	voteHistoryRetentionKey      = "vote_history_retention"
+	warningThresholdKey          = "warning_threshold"
+	jailThresholdKey             = "jail_threshold"
+	missPenaltyWeightKey         = "miss_penalty_weight"
+	abstainPenaltyWeightKey      = "abstain_penalty_weight"
+	slashGracePeriodKey          = "slash_grace_period"
+	voteTargetProbationPeriodKey = "vote_target_probation_period"
+	voteTargetSunsetPeriodKey    = "vote_target_sunset_period"
 )
 
 // GenVotePeriod randomized VotePeriod
@@ -40,6 +49,11 @@ func GenRewardBand(r *rand.Rand) sdk.Dec {
 	return sdk.ZeroDec().Add(sdk.NewDecWithPrec(int64(r.Intn(100)), 3))
 }
 
+// GenRewardDistributionWindow randomized RewardDistributionWindow
+func GenRewardDistributionWindow(r *rand.Rand) uint64 {
+	return uint64(100 + r.Intn(100000))
+}
+
 // GenSlashFraction randomized SlashFraction
 func GenSlashFraction(r *rand.Rand) sdk.Dec {
 	return sdk.ZeroDec().Add(sdk.NewDecWithPrec(int64(r.Intn(100)), 3))
@@ -55,6 +69,41 @@ func GenMinValidPerWindow(r *rand.Rand) sdk.Dec {
 	return sdk.ZeroDec().Add(sdk.NewDecWithPrec(int64(r.Intn(500)), 3))
 }
 
+// GenMaxFeeders randomized MaxFeeders
+func GenMaxFeeders(r *rand.Rand) uint64 {
+	return uint64(r.Intn(10))
+}
+
+// GenVoteHistoryRetention randomized VoteHistoryRetention
+func GenVoteHistoryRetention(r *rand.Rand) uint64 {
+	return uint64(r.Intn(1000))
+}
+
+// GenWarningThreshold randomized WarningThreshold, not below minValidPerWindow
+func GenWarningThreshold(r *rand.Rand, minValidPerWindow sdk.Dec) sdk.Dec {
+	return sdk.MinDec(sdk.OneDec(), minValidPerWindow.Add(sdk.NewDecWithPrec(int64(r.Intn(500)), 3)))
+}
+
+// GenJailThreshold randomized JailThreshold, not above minValidPerWindow
+func GenJailThreshold(r *rand.Rand, minValidPerWindow sdk.Dec) sdk.Dec {
+	return minValidPerWindow.Mul(sdk.NewDecWithPrec(int64(r.Intn(1001)), 3))
+}
+
+// GenPenaltyWeight randomized MissPenaltyWeight and AbstainPenaltyWeight
+func GenPenaltyWeight(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(500+r.Intn(1000)), 3)
+}
+
+// GenSlashGracePeriod randomized SlashGracePeriod
+func GenSlashGracePeriod(r *rand.Rand) uint64 {
+	return uint64(r.Intn(100000))
+}
+
+// GenVoteTargetTransitionPeriod randomized VoteTargetProbationPeriod and VoteTargetSunsetPeriod
+func GenVoteTargetTransitionPeriod(r *rand.Rand) uint64 {
+	return uint64(r.Intn(100000))
+}
+
 // RandomizedGenState generates a random GenesisState for oracle
 func RandomizedGenState(simState *module.SimulationState) {
 	var votePeriod uint64
@@ -75,6 +124,12 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { rewardBand = GenRewardBand(r) },
 	)
 
+	var rewardDistributionWindow uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, rewardDistributionWindowKey, &rewardDistributionWindow, simState.Rand,
+		func(r *rand.Rand) { rewardDistributionWindow = GenRewardDistributionWindow(r) },
+	)
+
 	var slashFraction sdk.Dec
 	simState.AppParams.GetOrGenerate(
 		simState.Cdc, slashFractionKey, &slashFraction, simState.Rand,
@@ -93,6 +148,60 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { minValidPerWindow = GenMinValidPerWindow(r) },
 	)
 
+	var maxFeeders uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, maxFeedersKey, &maxFeeders, simState.Rand,
+		func(r *rand.Rand) { maxFeeders = GenMaxFeeders(r) },
+	)
+
+	var voteHistoryRetention uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, voteHistoryRetentionKey, &voteHistoryRetention, simState.Rand,
+		func(r *rand.Rand) { voteHistoryRetention = GenVoteHistoryRetention(r) },
+	)
+
+	var warningThreshold sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, warningThresholdKey, &warningThreshold, simState.Rand,
+		func(r *rand.Rand) { warningThreshold = GenWarningThreshold(r, minValidPerWindow) },
+	)
+
+	var jailThreshold sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, jailThresholdKey, &jailThreshold, simState.Rand,
+		func(r *rand.Rand) { jailThreshold = GenJailThreshold(r, minValidPerWindow) },
+	)
+
+	var missPenaltyWeight sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, missPenaltyWeightKey, &missPenaltyWeight, simState.Rand,
+		func(r *rand.Rand) { missPenaltyWeight = GenPenaltyWeight(r) },
+	)
+
+	var abstainPenaltyWeight sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, abstainPenaltyWeightKey, &abstainPenaltyWeight, simState.Rand,
+		func(r *rand.Rand) { abstainPenaltyWeight = GenPenaltyWeight(r) },
+	)
+
+	var slashGracePeriod uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, slashGracePeriodKey, &slashGracePeriod, simState.Rand,
+		func(r *rand.Rand) { slashGracePeriod = GenSlashGracePeriod(r) },
+	)
+
+	var voteTargetProbationPeriod uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, voteTargetProbationPeriodKey, &voteTargetProbationPeriod, simState.Rand,
+		func(r *rand.Rand) { voteTargetProbationPeriod = GenVoteTargetTransitionPeriod(r) },
+	)
+
+	var voteTargetSunsetPeriod uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, voteTargetSunsetPeriodKey, &voteTargetSunsetPeriod, simState.Rand,
+		func(r *rand.Rand) { voteTargetSunsetPeriod = GenVoteTargetTransitionPeriod(r) },
+	)
+
 	oracleGenesis := types.NewGenesisState(
 		types.Params{
 			VotePeriod:    votePeriod,
@@ -102,15 +211,34 @@ func RandomizedGenState(simState *module.SimulationState) {
 				{Name: utils.MicroSeiDenom},
 				{Name: utils.MicroAtomDenom},
 			},
-			SlashFraction:     slashFraction,
-			SlashWindow:       slashWindow,
-			MinValidPerWindow: minValidPerWindow,
+			SlashFraction:            slashFraction,
+			SlashWindow:              slashWindow,
+			MinValidPerWindow:        minValidPerWindow,
+			RewardDistributionWindow: rewardDistributionWindow,
+			MaxFeeders:               maxFeeders,
+			VoteHistoryRetention:     voteHistoryRetention,
+			WarningThreshold:         warningThreshold,
+			JailThreshold:            jailThreshold,
+			MissPenaltyWeight:        missPenaltyWeight,
+			AbstainPenaltyWeight:     abstainPenaltyWeight,
+			SlashGracePeriod:         slashGracePeriod,
+			DerivedDenoms: types.DerivedDenomList{
+				{Name: "uatom/usei", Numerators: []string{utils.MicroAtomDenom}, Denominators: []string{utils.MicroSeiDenom}},
+			},
+			VoteTargetProbationPeriod: voteTargetProbationPeriod,
+			VoteTargetSunsetPeriod:    voteTargetSunsetPeriod,
 		},
 		[]types.ExchangeRateTuple{},
 		[]types.FeederDelegation{},
 		[]types.PenaltyCounter{},
+		[]types.AggregateExchangeRatePrevote{},
 		[]types.AggregateExchangeRateVote{},
 		types.PriceSnapshots{},
+		[]types.ValidatorOracleRewards{},
+		[]types.ValidatorFeederPermission{},
+		[]types.VoteTargetTransition{},
+		[]types.VoteTargetTransition{},
+		[]types.ValidatorBondHeight{},
 	)
 
 	bz, err := json.MarshalIndent(&oracleGenesis.Params, "", " ")
@@ -29,6 +29,7 @@ func Tally(_ sdk.Context, pb types.ExchangeRateBallot, rewardBand sdk.Dec, valid
 
 			claim.Weight += vote.Power
 			claim.WinCount++
+			claim.WinDenoms = append(claim.WinDenoms, vote.Denom)
 		}
 		claim.DidVote = true
 		validatorClaimMap[key] = claim
@@ -38,9 +39,10 @@ func Tally(_ sdk.Context, pb types.ExchangeRateBallot, rewardBand sdk.Dec, valid
 }
 
 // ballot for the asset is passing the threshold amount of voting power
-func ballotIsPassing(ballot types.ExchangeRateBallot, thresholdVotes sdk.Int) (sdk.Int, bool) {
+// and has at least minVoters non-abstaining voters
+func ballotIsPassing(ballot types.ExchangeRateBallot, thresholdVotes sdk.Int, minVoters uint64) (sdk.Int, bool) {
 	ballotPower := sdk.NewInt(ballot.Power())
-	return ballotPower, !ballotPower.IsZero() && ballotPower.GTE(thresholdVotes)
+	return ballotPower, !ballotPower.IsZero() && ballotPower.GTE(thresholdVotes) && ballot.NumVoters() >= minVoters
 }
 
 // choose reference denom with the highest voter turnout
@@ -53,21 +55,24 @@ func pickReferenceDenom(ctx sdk.Context, k keeper.Keeper, voteTargets map[string
 
 	totalBondedPower := sdk.TokensToConsensusPower(k.StakingKeeper.TotalBondedTokens(ctx), k.StakingKeeper.PowerReduction(ctx))
 	voteThreshold := k.VoteThreshold(ctx)
-	thresholdVotes := voteThreshold.MulInt64(totalBondedPower).RoundInt()
 
 	for denom, ballot := range voteMap {
 		// If denom is not in the voteTargets, or the ballot for it has failed, then skip
 		// and remove it from voteMap for iteration efficiency
-		if _, exists := voteTargets[denom]; !exists {
+		denomInfo, exists := voteTargets[denom]
+		if !exists {
 			delete(voteMap, denom)
 			continue
 		}
 
 		ballotPower := int64(0)
 
+		// The denom may override the global vote threshold
+		thresholdVotes := denomInfo.GetVoteThreshold(voteThreshold).MulInt64(totalBondedPower).RoundInt()
+
 		// If the ballot is not passed, remove it from the voteTargets array
 		// to prevent slashing validators who did valid vote.
-		if power, ok := ballotIsPassing(ballot, thresholdVotes); ok {
+		if power, ok := ballotIsPassing(ballot, thresholdVotes, denomInfo.MinVoters); ok {
 			ballotPower = power.Int64()
 		} else {
 			// add assets below threshold to separate map for tally evaluation
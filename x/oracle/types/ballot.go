@@ -81,6 +81,18 @@ func (pb ExchangeRateBallot) Power() int64 {
 	return totalPower
 }
 
+// NumVoters returns the number of non-abstaining voters in the ballot
+func (pb ExchangeRateBallot) NumVoters() uint64 {
+	numVoters := uint64(0)
+	for _, vote := range pb {
+		if vote.Power > 0 && vote.ExchangeRate.IsPositive() {
+			numVoters++
+		}
+	}
+
+	return numVoters
+}
+
 // WeightedMedian returns the median weighted by the power of the ExchangeRateVote.
 // CONTRACT: ballot must be sorted
 func (pb ExchangeRateBallot) WeightedMedian() sdk.Dec {
@@ -148,6 +160,47 @@ func (pb ExchangeRateBallot) StandardDeviation(median sdk.Dec) (standardDeviatio
 	return
 }
 
+// Spread returns the difference between the highest and the lowest
+// non-abstaining vote of the ballot
+func (pb ExchangeRateBallot) Spread() sdk.Dec {
+	lowest, highest := sdk.ZeroDec(), sdk.ZeroDec()
+	for _, vote := range pb {
+		if vote.Power <= 0 || !vote.ExchangeRate.IsPositive() {
+			continue
+		}
+		if lowest.IsZero() || vote.ExchangeRate.LT(lowest) {
+			lowest = vote.ExchangeRate
+		}
+		if vote.ExchangeRate.GT(highest) {
+			highest = vote.ExchangeRate
+		}
+	}
+
+	return highest.Sub(lowest)
+}
+
+// Confidence returns the confidence of the exchange rate tallied from the ballot
+func (pb ExchangeRateBallot) Confidence(exchangeRate sdk.Dec, totalBondedPower int64) ExchangeRateConfidence {
+	votes := ExchangeRateBallot{}
+	for _, vote := range pb {
+		if vote.Power > 0 && vote.ExchangeRate.IsPositive() {
+			votes = append(votes, vote)
+		}
+	}
+
+	votingPowerShare := sdk.ZeroDec()
+	if totalBondedPower > 0 {
+		votingPowerShare = sdk.NewDec(votes.Power()).QuoInt64(totalBondedPower)
+	}
+
+	return ExchangeRateConfidence{
+		NumVoters:         uint64(len(votes)),
+		VotingPowerShare:  votingPowerShare,
+		StandardDeviation: votes.StandardDeviation(exchangeRate),
+		Spread:            votes.Spread(),
+	}
+}
+
 // Len implements sort.Interface
 func (pb ExchangeRateBallot) Len() int {
 	return len(pb)
@@ -171,6 +224,8 @@ type Claim struct {
 	WinCount  int64
 	DidVote   bool
 	Recipient sdk.ValAddress
+	// WinDenoms are the denoms the validator voted within the reward band for
+	WinDenoms []string
 }
 
 // NewClaim generates a Claim instance.
@@ -143,6 +143,48 @@ func TestPBPower(t *testing.T) {
 	require.Equal(t, ballotPower, pb.Power())
 }
 
+func TestPBNumVoters(t *testing.T) {
+	valAddrs := []sdk.ValAddress{
+		sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()),
+	}
+	pb := ExchangeRateBallot{
+		NewVoteForTally(sdk.OneDec(), utils.MicroAtomDenom, valAddrs[0], 10),
+		NewVoteForTally(sdk.NewDec(2), utils.MicroAtomDenom, valAddrs[1], 10),
+		// abstain votes aren't counted
+		NewVoteForTally(sdk.ZeroDec(), utils.MicroAtomDenom, valAddrs[2], 0),
+	}
+	require.Equal(t, uint64(2), pb.NumVoters())
+	require.Equal(t, uint64(0), ExchangeRateBallot{}.NumVoters())
+}
+
+func TestPBConfidence(t *testing.T) {
+	valAddrs := []sdk.ValAddress{
+		sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()),
+	}
+	pb := ExchangeRateBallot{
+		NewVoteForTally(sdk.NewDec(8), utils.MicroAtomDenom, valAddrs[0], 10),
+		NewVoteForTally(sdk.NewDec(10), utils.MicroAtomDenom, valAddrs[1], 20),
+		NewVoteForTally(sdk.NewDec(12), utils.MicroAtomDenom, valAddrs[2], 10),
+		// abstain votes are left out of the confidence
+		NewVoteForTally(sdk.ZeroDec(), utils.MicroAtomDenom, valAddrs[3], 0),
+	}
+	require.Equal(t, sdk.NewDec(4), pb.Spread())
+
+	confidence := pb.Confidence(sdk.NewDec(10), 80)
+	require.Equal(t, uint64(3), confidence.NumVoters)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), confidence.VotingPowerShare)
+	require.Equal(t, pb[:3].StandardDeviation(sdk.NewDec(10)), confidence.StandardDeviation)
+	require.Equal(t, sdk.NewDec(4), confidence.Spread)
+
+	require.Equal(t, sdk.ZeroDec(), ExchangeRateBallot{}.Spread())
+	require.Equal(t, sdk.ZeroDec(), pb.Confidence(sdk.NewDec(10), 0).VotingPowerShare)
+}
+
 func TestPBWeightedMedian(t *testing.T) {
 	tests := []struct {
 		inputs      []int64
@@ -5,20 +5,39 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgAggregateExchangeRatePrevote{}, "oracle/MsgAggregateExchangeRatePrevote", nil)
 	cdc.RegisterConcrete(&MsgAggregateExchangeRateVote{}, "oracle/MsgAggregateExchangeRateVote", nil)
 	cdc.RegisterConcrete(&MsgDelegateFeedConsent{}, "oracle/MsgDelegateFeedConsent", nil)
+	cdc.RegisterConcrete(&MsgAuthorizeFeeder{}, "oracle/MsgAuthorizeFeeder", nil)
+	cdc.RegisterConcrete(&MsgRevokeFeeder{}, "oracle/MsgRevokeFeeder", nil)
+	cdc.RegisterConcrete(&AddVoteTargetProposal{}, "oracle/AddVoteTargetProposal", nil)
+	cdc.RegisterConcrete(&RemoveVoteTargetProposal{}, "oracle/RemoveVoteTargetProposal", nil)
 }
 
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAggregateExchangeRatePrevote{},
+	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgAggregateExchangeRateVote{},
 	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgDelegateFeedConsent{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAuthorizeFeeder{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRevokeFeeder{},
+	)
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&AddVoteTargetProposal{},
+		&RemoveVoteTargetProposal{},
+	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -1,8 +1,10 @@
 package types
 
 import (
+	"fmt"
 	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"gopkg.in/yaml.v2"
 )
 
@@ -14,7 +16,56 @@ func (d Denom) String() string {
 
 // Equal implements equal interface
 func (d Denom) Equal(d1 *Denom) bool {
-	return d.Name == d1.Name
+	return d.Name == d1.Name &&
+		optionalDecEqual(d.VoteThreshold, d1.VoteThreshold) &&
+		optionalDecEqual(d.RewardBand, d1.RewardBand) &&
+		d.MinVoters == d1.MinVoters
+}
+
+// GetVoteThreshold returns the vote threshold override of the denom, or the
+// given default when the denom doesn't set one
+func (d Denom) GetVoteThreshold(defaultVoteThreshold sdk.Dec) sdk.Dec {
+	if d.VoteThreshold == nil {
+		return defaultVoteThreshold
+	}
+	return *d.VoteThreshold
+}
+
+// GetRewardBand returns the reward band override of the denom, or the given
+// default when the denom doesn't set one
+func (d Denom) GetRewardBand(defaultRewardBand sdk.Dec) sdk.Dec {
+	if d.RewardBand == nil {
+		return defaultRewardBand
+	}
+	return *d.RewardBand
+}
+
+// Validate performs basic validation of the denom and its overrides
+func (d Denom) Validate() error {
+	if len(d.Name) == 0 {
+		return fmt.Errorf("oracle parameter Whitelist Denom must have name")
+	}
+
+	if d.VoteThreshold != nil {
+		if err := validateVoteThreshold(*d.VoteThreshold); err != nil {
+			return fmt.Errorf("denom %s: %w", d.Name, err)
+		}
+	}
+
+	if d.RewardBand != nil {
+		if err := validateRewardBand(*d.RewardBand); err != nil {
+			return fmt.Errorf("denom %s: %w", d.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func optionalDecEqual(a, b *sdk.Dec) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
 }
 
 // DenomList is array of Denom
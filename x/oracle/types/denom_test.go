@@ -1,6 +1,11 @@
 package types
 
-import "testing"
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
 
 func TestDenomListContains(t *testing.T) {
 	tests := []struct {
@@ -45,3 +50,30 @@ func TestDenomListContains(t *testing.T) {
 		})
 	}
 }
+
+func TestDenomOverrides(t *testing.T) {
+	defaultThreshold := sdk.NewDecWithPrec(667, 3)
+	defaultBand := sdk.NewDecWithPrec(2, 2)
+
+	denom := Denom{Name: "uatom"}
+	require.NoError(t, denom.Validate())
+	require.Equal(t, defaultThreshold, denom.GetVoteThreshold(defaultThreshold))
+	require.Equal(t, defaultBand, denom.GetRewardBand(defaultBand))
+
+	threshold := sdk.NewDecWithPrec(3, 1)
+	band := sdk.NewDecWithPrec(1, 1)
+	overridden := Denom{Name: "uatom", VoteThreshold: &threshold, RewardBand: &band, MinVoters: 3}
+	require.NoError(t, overridden.Validate())
+	require.Equal(t, threshold, overridden.GetVoteThreshold(defaultThreshold))
+	require.Equal(t, band, overridden.GetRewardBand(defaultBand))
+
+	require.False(t, denom.Equal(&overridden))
+	sameThreshold := sdk.NewDecWithPrec(30, 2)
+	sameBand := sdk.NewDecWithPrec(10, 2)
+	require.True(t, overridden.Equal(&Denom{Name: "uatom", VoteThreshold: &sameThreshold, RewardBand: &sameBand, MinVoters: 3}))
+
+	invalid := sdk.NewDec(2)
+	require.Error(t, Denom{}.Validate())
+	require.Error(t, Denom{Name: "uatom", VoteThreshold: &invalid}.Validate())
+	require.Error(t, Denom{Name: "uatom", RewardBand: &invalid}.Validate())
+}
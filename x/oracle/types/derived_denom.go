@@ -0,0 +1,91 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gopkg.in/yaml.v2"
+)
+
+// String implements fmt.Stringer interface
+func (d DerivedDenom) String() string {
+	out, _ := yaml.Marshal(d)
+	return string(out)
+}
+
+// Validate performs basic validation of the derived denom's expression
+func (d DerivedDenom) Validate() error {
+	if len(d.Name) == 0 {
+		return fmt.Errorf("oracle parameter DerivedDenoms entry must have name")
+	}
+
+	if err := sdk.ValidateDenom(d.Name); err != nil {
+		return fmt.Errorf("derived denom %s: %w", d.Name, err)
+	}
+
+	if len(d.Numerators) == 0 {
+		return fmt.Errorf("derived denom %s must have at least one numerator", d.Name)
+	}
+
+	for _, denom := range d.Terms() {
+		if len(denom) == 0 {
+			return fmt.Errorf("derived denom %s has an empty term", d.Name)
+		}
+		if denom == d.Name {
+			return fmt.Errorf("derived denom %s may not reference itself", d.Name)
+		}
+	}
+
+	return nil
+}
+
+// Terms returns the denoms the exchange rate is derived from
+func (d DerivedDenom) Terms() []string {
+	return append(append([]string{}, d.Numerators...), d.Denominators...)
+}
+
+// Compute derives the exchange rate from the given exchange rates of the
+// voted denoms. It returns false when any term has no exchange rate, or when
+// a denominator's exchange rate is zero.
+func (d DerivedDenom) Compute(exchangeRates map[string]sdk.Dec) (sdk.Dec, bool) {
+	exchangeRate := sdk.OneDec()
+	for _, denom := range d.Numerators {
+		rate, ok := exchangeRates[denom]
+		if !ok {
+			return sdk.ZeroDec(), false
+		}
+		exchangeRate = exchangeRate.Mul(rate)
+	}
+
+	for _, denom := range d.Denominators {
+		rate, ok := exchangeRates[denom]
+		if !ok || !rate.IsPositive() {
+			return sdk.ZeroDec(), false
+		}
+		exchangeRate = exchangeRate.Quo(rate)
+	}
+
+	return exchangeRate, true
+}
+
+// DerivedDenomList is array of DerivedDenom
+type DerivedDenomList []DerivedDenom
+
+// Contains returns whether the list has a derived denom with the name
+func (dl DerivedDenomList) Contains(denom string) bool {
+	for _, d := range dl {
+		if d.Name == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// String implements fmt.Stringer interface
+func (dl DerivedDenomList) String() (out string) {
+	for _, d := range dl {
+		out += d.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDerivedDenomCompute(t *testing.T) {
+	exchangeRates := map[string]sdk.Dec{
+		"uatom":  sdk.NewDec(10),
+		"ueth":   sdk.NewDec(2000),
+		"ustsei": sdk.NewDecWithPrec(55, 2),
+		"usei":   sdk.NewDecWithPrec(50, 2),
+		"uzero":  sdk.ZeroDec(),
+	}
+
+	tests := []struct {
+		name     string
+		derived  DerivedDenom
+		want     sdk.Dec
+		computed bool
+	}{
+		{
+			name:     "cross rate",
+			derived:  DerivedDenom{Name: "uatom/ueth", Numerators: []string{"uatom"}, Denominators: []string{"ueth"}},
+			want:     sdk.NewDecWithPrec(5, 3),
+			computed: true,
+		},
+		{
+			name:     "staking derivative to underlying",
+			derived:  DerivedDenom{Name: "ustsei/usei", Numerators: []string{"ustsei"}, Denominators: []string{"usei"}},
+			want:     sdk.NewDecWithPrec(11, 1),
+			computed: true,
+		},
+		{
+			name:     "product",
+			derived:  DerivedDenom{Name: "uatom*ueth", Numerators: []string{"uatom", "ueth"}},
+			want:     sdk.NewDec(20000),
+			computed: true,
+		},
+		{
+			name:     "missing term",
+			derived:  DerivedDenom{Name: "uatom/uosmo", Numerators: []string{"uatom"}, Denominators: []string{"uosmo"}},
+			computed: false,
+		},
+		{
+			name:     "zero denominator",
+			derived:  DerivedDenom{Name: "uatom/uzero", Numerators: []string{"uatom"}, Denominators: []string{"uzero"}},
+			computed: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, computed := tt.derived.Compute(exchangeRates)
+			require.Equal(t, tt.computed, computed)
+			if tt.computed {
+				require.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDerivedDenomValidate(t *testing.T) {
+	require.NoError(t, DerivedDenom{Name: "uatom/ueth", Numerators: []string{"uatom"}, Denominators: []string{"ueth"}}.Validate())
+	require.Error(t, DerivedDenom{Numerators: []string{"uatom"}}.Validate())
+	require.Error(t, DerivedDenom{Name: "uatom/ueth", Denominators: []string{"ueth"}}.Validate())
+	require.Error(t, DerivedDenom{Name: "uatom/ueth", Numerators: []string{""}}.Validate())
+	require.Error(t, DerivedDenom{Name: "uatom", Numerators: []string{"uatom"}}.Validate())
+}
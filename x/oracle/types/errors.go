@@ -10,11 +10,15 @@ import (
 // Oracle Errors
 var (
 	ErrInvalidExchangeRate   = sdkerrors.Register(ModuleName, 2, "invalid exchange rate")
+	ErrNoPrevote             = sdkerrors.Register(ModuleName, 3, "no prevote")
 	ErrNoVote                = sdkerrors.Register(ModuleName, 4, "no vote")
 	ErrNoVotingPermission    = sdkerrors.Register(ModuleName, 5, "unauthorized voter")
 	ErrInvalidHash           = sdkerrors.Register(ModuleName, 6, "invalid hash")
 	ErrInvalidHashLength     = sdkerrors.Register(ModuleName, 7, fmt.Sprintf("invalid hash length; should equal %d", ed25519.TruncatedSize))
 	ErrVerificationFailed    = sdkerrors.Register(ModuleName, 8, "hash verification failed")
+	ErrRevealPeriodMissMatch = sdkerrors.Register(ModuleName, 9, "reveal period of submitted vote do not match with registered prevote")
+	ErrInvalidSaltLength     = sdkerrors.Register(ModuleName, 10, fmt.Sprintf("invalid salt length; should be 1~%d", MaxSaltLength))
+	ErrNoAggregatePrevote    = sdkerrors.Register(ModuleName, 11, "no aggregate prevote")
 	ErrNoAggregateVote       = sdkerrors.Register(ModuleName, 12, "no aggregate vote")
 	ErrNoVoteTarget          = sdkerrors.Register(ModuleName, 13, "no vote target")
 	ErrUnknownDenom          = sdkerrors.Register(ModuleName, 14, "unknown denom")
@@ -28,4 +32,18 @@ var (
 	ErrEncodingOracleTwaps   = sdkerrors.Register(ModuleName, 22, "Error encoding oracle twaps as JSON")
 	ErrUnknownSeiOracleQuery = sdkerrors.Register(ModuleName, 23, "Error unknown sei oracle query")
 	ErrAggregateVoteExist    = sdkerrors.Register(ModuleName, 24, "aggregate vote still present in current voting window")
+	ErrPrevoteNotRequired    = sdkerrors.Register(ModuleName, 25, "prevotes are not required")
+	ErrAggregatePrevoteExist = sdkerrors.Register(ModuleName, 26, "aggregate prevote already submitted in current voting window")
+	ErrStaleExchangeRate     = sdkerrors.Register(ModuleName, 27, "exchange rate is older than the max age")
+	ErrEncodingFreshRate     = sdkerrors.Register(ModuleName, 28, "Error encoding fresh exchange rate as JSON")
+	ErrNoPriceHistory        = sdkerrors.Register(ModuleName, 29, "No price snapshots in the lookback window")
+	ErrNoPriceAtTimestamp    = sdkerrors.Register(ModuleName, 30, "No price snapshot at the timestamp")
+	ErrEncodingOracleEmas    = sdkerrors.Register(ModuleName, 31, "Error encoding oracle emas as JSON")
+	ErrEncodingPriceRanges   = sdkerrors.Register(ModuleName, 32, "Error encoding oracle price ranges as JSON")
+	ErrEncodingVolatilities  = sdkerrors.Register(ModuleName, 33, "Error encoding oracle volatilities as JSON")
+	ErrEncodingPriceAtTime   = sdkerrors.Register(ModuleName, 34, "Error encoding oracle price at timestamp as JSON")
+	ErrTooManyFeeders        = sdkerrors.Register(ModuleName, 35, "validator has authorised the maximum number of feeders")
+	ErrNoFeederPermission    = sdkerrors.Register(ModuleName, 36, "no feeder permission")
+	ErrInvalidFeederExpiry   = sdkerrors.Register(ModuleName, 37, "feeder permission expiry must be in the future")
+	ErrVoteTargetExists      = sdkerrors.Register(ModuleName, 38, "denom is already a vote target")
 )
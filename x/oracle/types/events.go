@@ -5,8 +5,15 @@ const (
 	EventTypeExchangeRateUpdate = "exchange_rate_update"
 	EventTypeVote               = "vote"
 	EventTypeFeedDelegate       = "feed_delegate"
+	EventTypeAggregatePrevote   = "aggregate_prevote"
 	EventTypeAggregateVote      = "aggregate_vote"
 	EventTypeEndSlashWindow     = "end_slash_window"
+	EventTypeOracleReward       = "oracle_reward"
+	EventTypeAuthorizeFeeder    = "authorize_feeder"
+	EventTypeRevokeFeeder       = "revoke_feeder"
+	EventTypePenaltyWarning     = "oracle_penalty_warning"
+	EventTypeAddVoteTarget      = "add_vote_target"
+	EventTypeRemoveVoteTarget   = "remove_vote_target"
 
 	AttributeKeyDenom         = "denom"
 	AttributeKeyVoter         = "voter"
@@ -18,6 +25,11 @@ const (
 	AttributeKeyAbstainCount  = "abstain_count"
 	AttributeKeyWinCount      = "win_count"
 	AttributeKeySuccessCount  = "success_count"
+	AttributeKeyValidator     = "validator"
+	AttributeKeyAmount        = "amount"
+	AttributeKeyExpiry        = "expiry"
+	AttributeKeyValidVoteRate = "valid_vote_rate"
+	AttributeKeyEndHeight     = "end_height"
 
 	AttributeValueCategory = ModuleName
 )
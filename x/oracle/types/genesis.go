@@ -10,28 +10,46 @@ import (
 func NewGenesisState(
 	params Params, rates []ExchangeRateTuple,
 	feederDelegations []FeederDelegation, penaltyCounters []PenaltyCounter,
+	aggregateExchangeRatePrevotes []AggregateExchangeRatePrevote,
 	aggregateExchangeRateVotes []AggregateExchangeRateVote,
 	priceSnapshots []PriceSnapshot,
+	validatorOracleRewards []ValidatorOracleRewards,
+	validatorFeederPermissions []ValidatorFeederPermission,
+	voteTargetProbations []VoteTargetTransition,
+	voteTargetSunsets []VoteTargetTransition,
+	validatorBondHeights []ValidatorBondHeight,
 ) *GenesisState {
 	return &GenesisState{
-		Params:                     params,
-		ExchangeRates:              rates,
-		FeederDelegations:          feederDelegations,
-		PenaltyCounters:            penaltyCounters,
-		AggregateExchangeRateVotes: aggregateExchangeRateVotes,
-		PriceSnapshots:             priceSnapshots,
+		Params:                        params,
+		ExchangeRates:                 rates,
+		FeederDelegations:             feederDelegations,
+		PenaltyCounters:               penaltyCounters,
+		AggregateExchangeRatePrevotes: aggregateExchangeRatePrevotes,
+		AggregateExchangeRateVotes:    aggregateExchangeRateVotes,
+		PriceSnapshots:                priceSnapshots,
+		ValidatorOracleRewards:        validatorOracleRewards,
+		ValidatorFeederPermissions:    validatorFeederPermissions,
+		VoteTargetProbations:          voteTargetProbations,
+		VoteTargetSunsets:             voteTargetSunsets,
+		ValidatorBondHeights:          validatorBondHeights,
 	}
 }
 
 // DefaultGenesisState - default GenesisState used by columbus-2
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:                     DefaultParams(),
-		ExchangeRates:              []ExchangeRateTuple{},
-		FeederDelegations:          []FeederDelegation{},
-		PenaltyCounters:            []PenaltyCounter{},
-		AggregateExchangeRateVotes: []AggregateExchangeRateVote{},
-		PriceSnapshots:             PriceSnapshots{},
+		Params:                        DefaultParams(),
+		ExchangeRates:                 []ExchangeRateTuple{},
+		FeederDelegations:             []FeederDelegation{},
+		PenaltyCounters:               []PenaltyCounter{},
+		AggregateExchangeRatePrevotes: []AggregateExchangeRatePrevote{},
+		AggregateExchangeRateVotes:    []AggregateExchangeRateVote{},
+		PriceSnapshots:                PriceSnapshots{},
+		ValidatorOracleRewards:        []ValidatorOracleRewards{},
+		ValidatorFeederPermissions:    []ValidatorFeederPermission{},
+		VoteTargetProbations:          []VoteTargetTransition{},
+		VoteTargetSunsets:             []VoteTargetTransition{},
+		ValidatorBondHeights:          []ValidatorBondHeight{},
 	}
 }
 
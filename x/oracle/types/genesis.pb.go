@@ -25,12 +25,18 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
-	Params                     Params                      `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	FeederDelegations          []FeederDelegation          `protobuf:"bytes,2,rep,name=feeder_delegations,json=feederDelegations,proto3" json:"feeder_delegations"`
-	ExchangeRates              ExchangeRateTuples          `protobuf:"bytes,3,rep,name=exchange_rates,json=exchangeRates,proto3,castrepeated=ExchangeRateTuples" json:"exchange_rates"`
-	PenaltyCounters            []PenaltyCounter            `protobuf:"bytes,4,rep,name=penalty_counters,json=penaltyCounters,proto3" json:"penalty_counters"`
-	AggregateExchangeRateVotes []AggregateExchangeRateVote `protobuf:"bytes,6,rep,name=aggregate_exchange_rate_votes,json=aggregateExchangeRateVotes,proto3" json:"aggregate_exchange_rate_votes"`
-	PriceSnapshots             PriceSnapshots              `protobuf:"bytes,7,rep,name=price_snapshots,json=priceSnapshots,proto3,castrepeated=PriceSnapshots" json:"price_snapshots"`
+	Params                        Params                         `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	FeederDelegations             []FeederDelegation             `protobuf:"bytes,2,rep,name=feeder_delegations,json=feederDelegations,proto3" json:"feeder_delegations"`
+	ExchangeRates                 ExchangeRateTuples             `protobuf:"bytes,3,rep,name=exchange_rates,json=exchangeRates,proto3,castrepeated=ExchangeRateTuples" json:"exchange_rates"`
+	PenaltyCounters               []PenaltyCounter               `protobuf:"bytes,4,rep,name=penalty_counters,json=penaltyCounters,proto3" json:"penalty_counters"`
+	AggregateExchangeRatePrevotes []AggregateExchangeRatePrevote `protobuf:"bytes,5,rep,name=aggregate_exchange_rate_prevotes,json=aggregateExchangeRatePrevotes,proto3" json:"aggregate_exchange_rate_prevotes"`
+	AggregateExchangeRateVotes    []AggregateExchangeRateVote    `protobuf:"bytes,6,rep,name=aggregate_exchange_rate_votes,json=aggregateExchangeRateVotes,proto3" json:"aggregate_exchange_rate_votes"`
+	PriceSnapshots                PriceSnapshots                 `protobuf:"bytes,7,rep,name=price_snapshots,json=priceSnapshots,proto3,castrepeated=PriceSnapshots" json:"price_snapshots"`
+	ValidatorOracleRewards        []ValidatorOracleRewards       `protobuf:"bytes,8,rep,name=validator_oracle_rewards,json=validatorOracleRewards,proto3" json:"validator_oracle_rewards"`
+	ValidatorFeederPermissions    []ValidatorFeederPermission    `protobuf:"bytes,9,rep,name=validator_feeder_permissions,json=validatorFeederPermissions,proto3" json:"validator_feeder_permissions"`
+	VoteTargetProbations          []VoteTargetTransition         `protobuf:"bytes,10,rep,name=vote_target_probations,json=voteTargetProbations,proto3" json:"vote_target_probations"`
+	VoteTargetSunsets             []VoteTargetTransition         `protobuf:"bytes,11,rep,name=vote_target_sunsets,json=voteTargetSunsets,proto3" json:"vote_target_sunsets"`
+	ValidatorBondHeights          []ValidatorBondHeight          `protobuf:"bytes,12,rep,name=validator_bond_heights,json=validatorBondHeights,proto3" json:"validator_bond_heights"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -94,6 +100,13 @@ func (m *GenesisState) GetPenaltyCounters() []PenaltyCounter {
 	return nil
 }
 
+func (m *GenesisState) GetAggregateExchangeRatePrevotes() []AggregateExchangeRatePrevote {
+	if m != nil {
+		return m.AggregateExchangeRatePrevotes
+	}
+	return nil
+}
+
 func (m *GenesisState) GetAggregateExchangeRateVotes() []AggregateExchangeRateVote {
 	if m != nil {
 		return m.AggregateExchangeRateVotes
@@ -108,6 +121,41 @@ func (m *GenesisState) GetPriceSnapshots() PriceSnapshots {
 	return nil
 }
 
+func (m *GenesisState) GetValidatorOracleRewards() []ValidatorOracleRewards {
+	if m != nil {
+		return m.ValidatorOracleRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorFeederPermissions() []ValidatorFeederPermission {
+	if m != nil {
+		return m.ValidatorFeederPermissions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVoteTargetProbations() []VoteTargetTransition {
+	if m != nil {
+		return m.VoteTargetProbations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVoteTargetSunsets() []VoteTargetTransition {
+	if m != nil {
+		return m.VoteTargetSunsets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorBondHeights() []ValidatorBondHeight {
+	if m != nil {
+		return m.ValidatorBondHeights
+	}
+	return nil
+}
+
 type FeederDelegation struct {
 	FeederAddress    string `protobuf:"bytes,1,opt,name=feeder_address,json=feederAddress,proto3" json:"feeder_address,omitempty"`
 	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
@@ -212,49 +260,226 @@ func (m *PenaltyCounter) GetVotePenaltyCounter() *VotePenaltyCounter {
 	return nil
 }
 
+type ValidatorOracleRewards struct {
+	ValidatorAddress string        `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	OracleRewards    OracleRewards `protobuf:"bytes,2,opt,name=oracle_rewards,json=oracleRewards,proto3" json:"oracle_rewards"`
+}
+
+func (m *ValidatorOracleRewards) Reset()         { *m = ValidatorOracleRewards{} }
+func (m *ValidatorOracleRewards) String() string { return proto.CompactTextString(m) }
+func (*ValidatorOracleRewards) ProtoMessage()    {}
+func (*ValidatorOracleRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce0b3a2b4a184fc3, []int{3}
+}
+func (m *ValidatorOracleRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorOracleRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorOracleRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorOracleRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorOracleRewards.Merge(m, src)
+}
+func (m *ValidatorOracleRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorOracleRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorOracleRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorOracleRewards proto.InternalMessageInfo
+
+func (m *ValidatorOracleRewards) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorOracleRewards) GetOracleRewards() OracleRewards {
+	if m != nil {
+		return m.OracleRewards
+	}
+	return OracleRewards{}
+}
+
+type ValidatorFeederPermission struct {
+	ValidatorAddress string           `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	FeederPermission FeederPermission `protobuf:"bytes,2,opt,name=feeder_permission,json=feederPermission,proto3" json:"feeder_permission"`
+}
+
+func (m *ValidatorFeederPermission) Reset()         { *m = ValidatorFeederPermission{} }
+func (m *ValidatorFeederPermission) String() string { return proto.CompactTextString(m) }
+func (*ValidatorFeederPermission) ProtoMessage()    {}
+func (*ValidatorFeederPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce0b3a2b4a184fc3, []int{4}
+}
+func (m *ValidatorFeederPermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorFeederPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorFeederPermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorFeederPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorFeederPermission.Merge(m, src)
+}
+func (m *ValidatorFeederPermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorFeederPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorFeederPermission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorFeederPermission proto.InternalMessageInfo
+
+func (m *ValidatorFeederPermission) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorFeederPermission) GetFeederPermission() FeederPermission {
+	if m != nil {
+		return m.FeederPermission
+	}
+	return FeederPermission{}
+}
+
+// ValidatorBondHeight is the height a validator was last bonded at, which its
+// slashing grace period starts from
+type ValidatorBondHeight struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Height           int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ValidatorBondHeight) Reset()         { *m = ValidatorBondHeight{} }
+func (m *ValidatorBondHeight) String() string { return proto.CompactTextString(m) }
+func (*ValidatorBondHeight) ProtoMessage()    {}
+func (*ValidatorBondHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce0b3a2b4a184fc3, []int{5}
+}
+func (m *ValidatorBondHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorBondHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorBondHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorBondHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorBondHeight.Merge(m, src)
+}
+func (m *ValidatorBondHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorBondHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorBondHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorBondHeight proto.InternalMessageInfo
+
+func (m *ValidatorBondHeight) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorBondHeight) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "seiprotocol.seichain.oracle.GenesisState")
 	proto.RegisterType((*FeederDelegation)(nil), "seiprotocol.seichain.oracle.FeederDelegation")
 	proto.RegisterType((*PenaltyCounter)(nil), "seiprotocol.seichain.oracle.PenaltyCounter")
+	proto.RegisterType((*ValidatorOracleRewards)(nil), "seiprotocol.seichain.oracle.ValidatorOracleRewards")
+	proto.RegisterType((*ValidatorFeederPermission)(nil), "seiprotocol.seichain.oracle.ValidatorFeederPermission")
+	proto.RegisterType((*ValidatorBondHeight)(nil), "seiprotocol.seichain.oracle.ValidatorBondHeight")
 }
 
 func init() { proto.RegisterFile("oracle/genesis.proto", fileDescriptor_ce0b3a2b4a184fc3) }
 
 var fileDescriptor_ce0b3a2b4a184fc3 = []byte{
-	// 521 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x8d, 0xdb, 0x10, 0x60, 0x43, 0xd3, 0x74, 0x89, 0x50, 0x14, 0x84, 0x5b, 0x05, 0x21, 0x55,
-	0x54, 0xb5, 0x69, 0x90, 0xb8, 0x27, 0x7c, 0x49, 0x3d, 0x21, 0x17, 0x71, 0x40, 0x48, 0xd6, 0xda,
-	0x9e, 0x38, 0x2b, 0x1c, 0xaf, 0xf1, 0x6c, 0xa2, 0xf6, 0xc4, 0x5f, 0xe0, 0x27, 0x70, 0xe6, 0x97,
-	0xf4, 0xd8, 0x23, 0x27, 0x40, 0xc9, 0xbf, 0xe0, 0x84, 0xbc, 0xbb, 0x2d, 0x75, 0xda, 0xfa, 0x94,
-	0xdd, 0x37, 0xef, 0xcd, 0xcb, 0x9b, 0x59, 0x93, 0x8e, 0xc8, 0x59, 0x98, 0x80, 0x1b, 0x43, 0x0a,
-	0xc8, 0xd1, 0xc9, 0x72, 0x21, 0x05, 0x7d, 0x88, 0xc0, 0xd5, 0x29, 0x14, 0x89, 0x83, 0xc0, 0xc3,
-	0x09, 0xe3, 0xa9, 0xa3, 0xa9, 0xbd, 0x4e, 0x2c, 0x62, 0xa1, 0xaa, 0x6e, 0x71, 0xd2, 0x92, 0xde,
-	0x7d, 0xd3, 0x48, 0xff, 0x18, 0xd0, 0x0e, 0x05, 0x4e, 0x05, 0xba, 0x01, 0x43, 0x70, 0xe7, 0x07,
-	0x01, 0x48, 0x76, 0xe0, 0x86, 0x82, 0xa7, 0xba, 0xde, 0xff, 0x5b, 0x27, 0xf7, 0xde, 0x6a, 0xe7,
-	0x23, 0xc9, 0x24, 0xd0, 0x21, 0x69, 0x64, 0x2c, 0x67, 0x53, 0xec, 0x5a, 0x3b, 0xd6, 0x6e, 0x73,
-	0xf0, 0xd8, 0xa9, 0xf8, 0x27, 0xce, 0x3b, 0x45, 0x1d, 0xd5, 0x4f, 0x7f, 0x6d, 0xd7, 0x3c, 0x23,
-	0xa4, 0x01, 0xa1, 0x63, 0x80, 0x08, 0x72, 0x3f, 0x82, 0x04, 0x62, 0x26, 0xb9, 0x48, 0xb1, 0xbb,
-	0xb6, 0xb3, 0xbe, 0xdb, 0x1c, 0xec, 0x57, 0xb6, 0x7b, 0xa3, 0x64, 0xaf, 0x2e, 0x54, 0xa6, 0xf1,
-	0xd6, 0x78, 0x05, 0x47, 0xfa, 0x85, 0xb4, 0xe0, 0x38, 0x9c, 0xb0, 0x34, 0x06, 0x3f, 0x67, 0x12,
-	0xb0, 0xbb, 0xae, 0xfa, 0x3b, 0x95, 0xfd, 0x5f, 0x1b, 0x89, 0xc7, 0x24, 0xbc, 0x9f, 0x65, 0x09,
-	0x8c, 0x7a, 0x85, 0xc1, 0x8f, 0xdf, 0xdb, 0xf4, 0x4a, 0x09, 0xbd, 0x0d, 0xb8, 0x84, 0x21, 0xfd,
-	0x44, 0xda, 0x19, 0xa4, 0x2c, 0x91, 0x27, 0x7e, 0x28, 0x66, 0xa9, 0x84, 0x1c, 0xbb, 0x75, 0x65,
-	0xba, 0x57, 0x3d, 0x23, 0x2d, 0x7a, 0xa9, 0x35, 0x26, 0xd2, 0x66, 0x56, 0x42, 0x91, 0x7e, 0x25,
-	0x8f, 0x58, 0x1c, 0xe7, 0x45, 0x40, 0xf0, 0x4b, 0xd1, 0xfc, 0xb9, 0x28, 0xf2, 0x35, 0x94, 0xd5,
-	0x8b, 0x4a, 0xab, 0xe1, 0x79, 0x87, 0xcb, 0x69, 0x3e, 0x08, 0x09, 0xc6, 0xb5, 0xc7, 0x6e, 0x22,
-	0x20, 0xfd, 0x4c, 0x36, 0xb3, 0x9c, 0x87, 0xe0, 0x63, 0xca, 0x32, 0x9c, 0x08, 0x89, 0xdd, 0xdb,
-	0xca, 0xf2, 0x69, 0x75, 0xba, 0x42, 0x73, 0x64, 0x24, 0xa3, 0x07, 0x66, 0x9c, 0xad, 0x12, 0x8c,
-	0x5e, 0x2b, 0x2b, 0xdd, 0x0f, 0xeb, 0x77, 0x6e, 0xb5, 0x1b, 0xfd, 0x31, 0x69, 0xaf, 0x6e, 0x9c,
-	0x3e, 0x21, 0x2d, 0xf3, 0x78, 0x58, 0x14, 0xe5, 0x80, 0xfa, 0x1d, 0xde, 0xf5, 0x36, 0x34, 0x3a,
-	0xd4, 0x20, 0xdd, 0x23, 0x5b, 0x73, 0x96, 0xf0, 0x88, 0x49, 0xf1, 0x9f, 0xb9, 0xa6, 0x98, 0xed,
-	0x8b, 0x82, 0x21, 0xf7, 0xbf, 0x5b, 0xa4, 0x55, 0xde, 0xc2, 0xf5, 0x7a, 0xeb, 0x7a, 0x3d, 0x65,
-	0xa4, 0x53, 0xec, 0xc0, 0x5f, 0x59, 0xbf, 0xf2, 0x6b, 0x0e, 0xdc, 0xca, 0xf9, 0x14, 0xc3, 0x2d,
-	0x7b, 0x7b, 0x74, 0x7e, 0x05, 0x1b, 0x1d, 0x9e, 0x2e, 0x6c, 0xeb, 0x6c, 0x61, 0x5b, 0x7f, 0x16,
-	0xb6, 0xf5, 0x6d, 0x69, 0xd7, 0xce, 0x96, 0x76, 0xed, 0xe7, 0xd2, 0xae, 0x7d, 0x7c, 0x16, 0x73,
-	0x39, 0x99, 0x05, 0x4e, 0x28, 0xa6, 0x2e, 0x02, 0xdf, 0x3f, 0x77, 0x52, 0x17, 0x65, 0xe5, 0x1e,
-	0x9b, 0x6f, 0xde, 0x95, 0x27, 0x19, 0x60, 0xd0, 0x50, 0x94, 0xe7, 0xff, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x29, 0xe9, 0x57, 0x70, 0x5a, 0x04, 0x00, 0x00,
+	// 770 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdf, 0x4e, 0x3b, 0x45,
+	0x14, 0xee, 0x02, 0x56, 0x99, 0x42, 0x69, 0x07, 0xd2, 0xac, 0x55, 0x0a, 0xa9, 0x31, 0x21, 0x12,
+	0xba, 0xfc, 0x49, 0x4c, 0xbc, 0xa4, 0xfe, 0x8d, 0x37, 0x36, 0x0b, 0xc1, 0x84, 0x98, 0xac, 0xd3,
+	0xdd, 0xd3, 0xed, 0xc6, 0x76, 0x67, 0x9d, 0x33, 0xad, 0x70, 0xa3, 0xb7, 0x5e, 0xfa, 0x04, 0xc6,
+	0x6b, 0x1e, 0xc1, 0x27, 0xe0, 0x92, 0x4b, 0xaf, 0xd4, 0xc0, 0x8b, 0x98, 0xce, 0x4c, 0xff, 0xb7,
+	0x2b, 0xcd, 0xef, 0x8a, 0xce, 0x39, 0xe7, 0x3b, 0xdf, 0x77, 0xce, 0xf4, 0x1b, 0x4a, 0xf6, 0xb8,
+	0x60, 0x7e, 0x07, 0x9c, 0x10, 0x62, 0xc0, 0x08, 0x6b, 0x89, 0xe0, 0x92, 0xd3, 0xf7, 0x10, 0x22,
+	0xf5, 0xc9, 0xe7, 0x9d, 0x1a, 0x42, 0xe4, 0xb7, 0x59, 0x14, 0xd7, 0x74, 0x69, 0x79, 0x2f, 0xe4,
+	0x21, 0x57, 0x59, 0x67, 0xf0, 0x49, 0x43, 0xca, 0xbb, 0xa6, 0x91, 0xfe, 0x63, 0x82, 0x15, 0x9f,
+	0x63, 0x97, 0xa3, 0xd3, 0x64, 0x08, 0x4e, 0xff, 0xac, 0x09, 0x92, 0x9d, 0x39, 0x3e, 0x8f, 0x62,
+	0x9d, 0xaf, 0xfe, 0x49, 0xc8, 0xd6, 0x97, 0x9a, 0xf9, 0x4a, 0x32, 0x09, 0xf4, 0x92, 0x64, 0x13,
+	0x26, 0x58, 0x17, 0x6d, 0xeb, 0xd0, 0x3a, 0xca, 0x9d, 0x7f, 0x50, 0x4b, 0x51, 0x52, 0x6b, 0xa8,
+	0xd2, 0xfa, 0xc6, 0xe3, 0xdf, 0x07, 0x19, 0xd7, 0x00, 0x69, 0x93, 0xd0, 0x16, 0x40, 0x00, 0xc2,
+	0x0b, 0xa0, 0x03, 0x21, 0x93, 0x11, 0x8f, 0xd1, 0x5e, 0x3b, 0x5c, 0x3f, 0xca, 0x9d, 0x9f, 0xa4,
+	0xb6, 0xfb, 0x42, 0xc1, 0x3e, 0x1b, 0xa1, 0x4c, 0xe3, 0x62, 0x6b, 0x26, 0x8e, 0xf4, 0x47, 0x92,
+	0x87, 0x3b, 0xbf, 0xcd, 0xe2, 0x10, 0x3c, 0xc1, 0x24, 0xa0, 0xbd, 0xae, 0xfa, 0xd7, 0x52, 0xfb,
+	0x7f, 0x6e, 0x20, 0x2e, 0x93, 0x70, 0xdd, 0x4b, 0x3a, 0x50, 0x2f, 0x0f, 0x08, 0x1e, 0xfe, 0x39,
+	0xa0, 0x73, 0x29, 0x74, 0xb7, 0x61, 0x22, 0x86, 0xf4, 0x3b, 0x52, 0x48, 0x20, 0x66, 0x1d, 0x79,
+	0xef, 0xf9, 0xbc, 0x17, 0x4b, 0x10, 0x68, 0x6f, 0x28, 0xd2, 0xe3, 0xf4, 0x1d, 0x69, 0xd0, 0xa7,
+	0x1a, 0x63, 0x46, 0xda, 0x49, 0xa6, 0xa2, 0x48, 0x7f, 0xb5, 0xc8, 0x21, 0x0b, 0x43, 0x31, 0x98,
+	0x10, 0xbc, 0xa9, 0xd9, 0xbc, 0x44, 0x40, 0x9f, 0x0f, 0x66, 0x7c, 0x4b, 0xd1, 0x7d, 0x92, 0x4a,
+	0x77, 0x39, 0x6c, 0x32, 0x39, 0x51, 0x43, 0x77, 0x30, 0xe4, 0xfb, 0x2c, 0xa5, 0x06, 0xe9, 0x2f,
+	0x64, 0x7f, 0x99, 0x12, 0x2d, 0x23, 0xab, 0x64, 0x7c, 0xbc, 0xba, 0x8c, 0x9b, 0xb1, 0x86, 0x32,
+	0x5b, 0x56, 0x80, 0xf4, 0x07, 0xb2, 0x93, 0x88, 0xc8, 0x07, 0x0f, 0x63, 0x96, 0x60, 0x9b, 0x4b,
+	0xb4, 0xdf, 0x56, 0x94, 0x1f, 0xa5, 0x2f, 0x7a, 0x80, 0xb9, 0x32, 0x90, 0x7a, 0xc9, 0xdc, 0x6c,
+	0x7e, 0x2a, 0x8c, 0x6e, 0x3e, 0x99, 0x3a, 0x53, 0x24, 0x76, 0x9f, 0x75, 0xa2, 0x80, 0x49, 0x2e,
+	0x3c, 0xdd, 0xc9, 0x13, 0xf0, 0x13, 0x13, 0x01, 0xda, 0xef, 0x28, 0xd6, 0x8b, 0x54, 0xd6, 0x9b,
+	0x21, 0xf8, 0x1b, 0x75, 0x76, 0x35, 0xd4, 0x4c, 0x59, 0xea, 0x2f, 0xcc, 0xd2, 0x9f, 0xc9, 0xfb,
+	0x63, 0x52, 0x63, 0x96, 0x04, 0x44, 0x37, 0x42, 0x54, 0x66, 0xd9, 0x7c, 0xc5, 0x86, 0x47, 0xc4,
+	0xda, 0x35, 0x8d, 0x11, 0x7c, 0xb8, 0xe1, 0xfe, 0xb2, 0x02, 0xa4, 0x5d, 0x52, 0x1a, 0x5c, 0xa5,
+	0x27, 0x99, 0x08, 0x41, 0x7a, 0x89, 0xe0, 0x4d, 0x63, 0x53, 0xa2, 0x98, 0xcf, 0xd2, 0x99, 0xb9,
+	0x84, 0x6b, 0x85, 0xbc, 0x16, 0x2c, 0xc6, 0x68, 0xc2, 0xaa, 0x7b, 0xfd, 0x51, 0xae, 0x31, 0x6a,
+	0x4a, 0x43, 0xb2, 0x3b, 0x49, 0x87, 0xbd, 0x18, 0x41, 0xa2, 0x9d, 0x7b, 0x33, 0xae, 0xe2, 0x98,
+	0xeb, 0x4a, 0x77, 0xa4, 0x1d, 0x32, 0xde, 0xb8, 0xd7, 0xe4, 0x71, 0xe0, 0xb5, 0x21, 0x0a, 0xdb,
+	0x12, 0xed, 0x2d, 0xc5, 0x75, 0xfa, 0xba, 0x8d, 0xd6, 0x79, 0x1c, 0x7c, 0xa5, 0x80, 0xa3, 0xb1,
+	0xe6, 0x53, 0x58, 0x6d, 0x91, 0xc2, 0xec, 0x8b, 0x45, 0x3f, 0x24, 0x79, 0x73, 0x9f, 0x2c, 0x08,
+	0x04, 0xa0, 0x7e, 0x47, 0x37, 0xdd, 0x6d, 0x1d, 0xbd, 0xd4, 0x41, 0x7a, 0x4c, 0x8a, 0x63, 0xa1,
+	0xc3, 0xca, 0x35, 0x55, 0x59, 0x18, 0x25, 0x4c, 0x71, 0xf5, 0x0f, 0x8b, 0xe4, 0xa7, 0x5f, 0x91,
+	0xc5, 0x78, 0x6b, 0x31, 0x9e, 0x32, 0xa2, 0xae, 0xc5, 0x9b, 0x79, 0xbe, 0x14, 0x5f, 0xee, 0xdc,
+	0xf9, 0xdf, 0xfd, 0x4f, 0x73, 0xbb, 0xb4, 0x3f, 0x17, 0xab, 0xfe, 0x6e, 0x91, 0xd2, 0x62, 0x27,
+	0xac, 0x26, 0xf5, 0x5b, 0x92, 0x9f, 0xf1, 0xa0, 0x16, 0x99, 0xee, 0xfc, 0x45, 0xd6, 0xdb, 0xe6,
+	0x93, 0xc1, 0xea, 0x83, 0x45, 0xde, 0x5d, 0xea, 0x98, 0xd5, 0x34, 0x7e, 0x4f, 0x8a, 0x73, 0x96,
+	0x35, 0x32, 0x5f, 0xf3, 0xef, 0x6d, 0xce, 0xa8, 0x85, 0xd6, 0x4c, 0xbc, 0x7a, 0x4b, 0x76, 0x17,
+	0x7c, 0x17, 0x57, 0x53, 0x59, 0x22, 0x59, 0xfd, 0xdd, 0x57, 0xd2, 0xd6, 0x5d, 0x73, 0xaa, 0x7f,
+	0xfd, 0xf8, 0x5c, 0xb1, 0x9e, 0x9e, 0x2b, 0xd6, 0xbf, 0xcf, 0x15, 0xeb, 0xb7, 0x97, 0x4a, 0xe6,
+	0xe9, 0xa5, 0x92, 0xf9, 0xeb, 0xa5, 0x92, 0xb9, 0x3d, 0x0d, 0x23, 0xd9, 0xee, 0x35, 0x6b, 0x3e,
+	0xef, 0x3a, 0x08, 0xd1, 0xc9, 0x70, 0x0e, 0x75, 0x50, 0x83, 0x38, 0x77, 0xe6, 0xd7, 0x85, 0x23,
+	0xef, 0x13, 0xc0, 0x66, 0x56, 0x95, 0x5c, 0xfc, 0x37, 0x00, 0x43, 0x5a, 0x7c, 0x65, 0xc4, 0x08,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -277,6 +502,76 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValidatorBondHeights) > 0 {
+		for iNdEx := len(m.ValidatorBondHeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorBondHeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.VoteTargetSunsets) > 0 {
+		for iNdEx := len(m.VoteTargetSunsets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteTargetSunsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.VoteTargetProbations) > 0 {
+		for iNdEx := len(m.VoteTargetProbations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteTargetProbations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ValidatorFeederPermissions) > 0 {
+		for iNdEx := len(m.ValidatorFeederPermissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorFeederPermissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ValidatorOracleRewards) > 0 {
+		for iNdEx := len(m.ValidatorOracleRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorOracleRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.PriceSnapshots) > 0 {
 		for iNdEx := len(m.PriceSnapshots) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -305,6 +600,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x32
 		}
 	}
+	if len(m.AggregateExchangeRatePrevotes) > 0 {
+		for iNdEx := len(m.AggregateExchangeRatePrevotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AggregateExchangeRatePrevotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.PenaltyCounters) > 0 {
 		for iNdEx := len(m.PenaltyCounters) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -439,62 +748,213 @@ func (m *PenaltyCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ValidatorOracleRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ValidatorOracleRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorOracleRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.FeederDelegations) > 0 {
-		for _, e := range m.FeederDelegations {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.ExchangeRates) > 0 {
-		for _, e := range m.ExchangeRates {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.PenaltyCounters) > 0 {
-		for _, e := range m.PenaltyCounters {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.AggregateExchangeRateVotes) > 0 {
-		for _, e := range m.AggregateExchangeRateVotes {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	{
+		size, err := m.OracleRewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.PriceSnapshots) > 0 {
-		for _, e := range m.PriceSnapshots {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *FeederDelegation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ValidatorFeederPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorFeederPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorFeederPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeederPermission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorBondHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorBondHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorBondHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FeederDelegations) > 0 {
+		for _, e := range m.FeederDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExchangeRates) > 0 {
+		for _, e := range m.ExchangeRates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PenaltyCounters) > 0 {
+		for _, e := range m.PenaltyCounters {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AggregateExchangeRatePrevotes) > 0 {
+		for _, e := range m.AggregateExchangeRatePrevotes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AggregateExchangeRateVotes) > 0 {
+		for _, e := range m.AggregateExchangeRateVotes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PriceSnapshots) > 0 {
+		for _, e := range m.PriceSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorOracleRewards) > 0 {
+		for _, e := range m.ValidatorOracleRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorFeederPermissions) > 0 {
+		for _, e := range m.ValidatorFeederPermissions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VoteTargetProbations) > 0 {
+		for _, e := range m.VoteTargetProbations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VoteTargetSunsets) > 0 {
+		for _, e := range m.VoteTargetSunsets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorBondHeights) > 0 {
+		for _, e := range m.ValidatorBondHeights {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeederDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = len(m.FeederAddress)
@@ -505,33 +965,536 @@ func (m *FeederDelegation) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *PenaltyCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.VotePenaltyCounter != nil {
+		l = m.VotePenaltyCounter.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorOracleRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.OracleRewards.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ValidatorFeederPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.FeederPermission.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ValidatorBondHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeederDelegations = append(m.FeederDelegations, FeederDelegation{})
+			if err := m.FeederDelegations[len(m.FeederDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRates = append(m.ExchangeRates, ExchangeRateTuple{})
+			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyCounters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PenaltyCounters = append(m.PenaltyCounters, PenaltyCounter{})
+			if err := m.PenaltyCounters[len(m.PenaltyCounters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateExchangeRatePrevotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregateExchangeRatePrevotes = append(m.AggregateExchangeRatePrevotes, AggregateExchangeRatePrevote{})
+			if err := m.AggregateExchangeRatePrevotes[len(m.AggregateExchangeRatePrevotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateExchangeRateVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregateExchangeRateVotes = append(m.AggregateExchangeRateVotes, AggregateExchangeRateVote{})
+			if err := m.AggregateExchangeRateVotes[len(m.AggregateExchangeRateVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceSnapshots = append(m.PriceSnapshots, PriceSnapshot{})
+			if err := m.PriceSnapshots[len(m.PriceSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorOracleRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorOracleRewards = append(m.ValidatorOracleRewards, ValidatorOracleRewards{})
+			if err := m.ValidatorOracleRewards[len(m.ValidatorOracleRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorFeederPermissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorFeederPermissions = append(m.ValidatorFeederPermissions, ValidatorFeederPermission{})
+			if err := m.ValidatorFeederPermissions[len(m.ValidatorFeederPermissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteTargetProbations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteTargetProbations = append(m.VoteTargetProbations, VoteTargetTransition{})
+			if err := m.VoteTargetProbations[len(m.VoteTargetProbations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteTargetSunsets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteTargetSunsets = append(m.VoteTargetSunsets, VoteTargetTransition{})
+			if err := m.VoteTargetSunsets[len(m.VoteTargetSunsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondHeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorBondHeights = append(m.ValidatorBondHeights, ValidatorBondHeight{})
+			if err := m.ValidatorBondHeights[len(m.ValidatorBondHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *PenaltyCounter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.VotePenaltyCounter != nil {
-		l = m.VotePenaltyCounter.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *FeederDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -554,17 +1517,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeederDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeederDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -574,30 +1537,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FeederAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeederDelegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -607,31 +1569,79 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeederDelegations = append(m.FeederDelegations, FeederDelegation{})
-			if err := m.FeederDelegations[len(m.FeederDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PenaltyCounter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PenaltyCounter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PenaltyCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -641,29 +1651,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExchangeRates = append(m.ExchangeRates, ExchangeRateTuple{})
-			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyCounters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePenaltyCounter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -690,16 +1698,68 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PenaltyCounters = append(m.PenaltyCounters, PenaltyCounter{})
-			if err := m.PenaltyCounters[len(m.PenaltyCounters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.VotePenaltyCounter == nil {
+				m.VotePenaltyCounter = &VotePenaltyCounter{}
+			}
+			if err := m.VotePenaltyCounter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorOracleRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorOracleRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorOracleRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregateExchangeRateVotes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -709,29 +1769,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AggregateExchangeRateVotes = append(m.AggregateExchangeRateVotes, AggregateExchangeRateVote{})
-			if err := m.AggregateExchangeRateVotes[len(m.AggregateExchangeRateVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceSnapshots", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -758,8 +1816,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriceSnapshots = append(m.PriceSnapshots, PriceSnapshot{})
-			if err := m.PriceSnapshots[len(m.PriceSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OracleRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -784,7 +1841,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeederDelegation) Unmarshal(dAtA []byte) error {
+func (m *ValidatorFeederPermission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -807,15 +1864,15 @@ func (m *FeederDelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeederDelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorFeederPermission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeederDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorFeederPermission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -843,13 +1900,13 @@ func (m *FeederDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeederAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederPermission", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -859,23 +1916,24 @@ func (m *FeederDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.FeederPermission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -898,7 +1956,7 @@ func (m *FeederDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PenaltyCounter) Unmarshal(dAtA []byte) error {
+func (m *ValidatorBondHeight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -921,10 +1979,10 @@ func (m *PenaltyCounter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PenaltyCounter: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorBondHeight: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PenaltyCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorBondHeight: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -960,10 +2018,10 @@ func (m *PenaltyCounter) Unmarshal(dAtA []byte) error {
 			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotePenaltyCounter", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -973,28 +2031,11 @@ func (m *PenaltyCounter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.VotePenaltyCounter == nil {
-				m.VotePenaltyCounter = &VotePenaltyCounter{}
-			}
-			if err := m.VotePenaltyCounter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeAddVoteTarget    = "AddVoteTarget"
+	ProposalTypeRemoveVoteTarget = "RemoveVoteTarget"
+)
+
+func init() {
+	// for routing
+	govtypes.RegisterProposalType(ProposalTypeAddVoteTarget)
+	govtypes.RegisterProposalType(ProposalTypeRemoveVoteTarget)
+	// for marshal and unmarshal
+	govtypes.RegisterProposalTypeCodec(&AddVoteTargetProposal{}, "oracle/AddVoteTargetProposal")
+	govtypes.RegisterProposalTypeCodec(&RemoveVoteTargetProposal{}, "oracle/RemoveVoteTargetProposal")
+}
+
+// NewAddVoteTargetProposal creates a new AddVoteTargetProposal instance
+func NewAddVoteTargetProposal(title, description string, denom Denom) *AddVoteTargetProposal {
+	return &AddVoteTargetProposal{Title: title, Description: description, Denom: denom}
+}
+
+func (p *AddVoteTargetProposal) GetTitle() string { return p.Title }
+
+func (p *AddVoteTargetProposal) GetDescription() string { return p.Description }
+
+func (p *AddVoteTargetProposal) ProposalRoute() string { return RouterKey }
+
+func (p *AddVoteTargetProposal) ProposalType() string {
+	return ProposalTypeAddVoteTarget
+}
+
+func (p *AddVoteTargetProposal) ValidateBasic() error {
+	if err := sdk.ValidateDenom(p.Denom.Name); err != nil {
+		return err
+	}
+	if err := p.Denom.Validate(); err != nil {
+		return err
+	}
+
+	return govtypes.ValidateAbstract(p)
+}
+
+func (p AddVoteTargetProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Add Vote Target Proposal:
+  Title:       %s
+  Description: %s
+  Denom:       %s
+`, p.Title, p.Description, p.Denom.Name))
+	return b.String()
+}
+
+// NewRemoveVoteTargetProposal creates a new RemoveVoteTargetProposal instance
+func NewRemoveVoteTargetProposal(title, description, denom string) *RemoveVoteTargetProposal {
+	return &RemoveVoteTargetProposal{Title: title, Description: description, Denom: denom}
+}
+
+func (p *RemoveVoteTargetProposal) GetTitle() string { return p.Title }
+
+func (p *RemoveVoteTargetProposal) GetDescription() string { return p.Description }
+
+func (p *RemoveVoteTargetProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RemoveVoteTargetProposal) ProposalType() string {
+	return ProposalTypeRemoveVoteTarget
+}
+
+func (p *RemoveVoteTargetProposal) ValidateBasic() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return err
+	}
+
+	return govtypes.ValidateAbstract(p)
+}
+
+func (p RemoveVoteTargetProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Remove Vote Target Proposal:
+  Title:       %s
+  Description: %s
+  Denom:       %s
+`, p.Title, p.Description, p.Denom))
+	return b.String()
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddVoteTargetProposalValidateBasic(t *testing.T) {
+	proposal := NewAddVoteTargetProposal("title", "description", Denom{Name: "uosmo"})
+	require.NoError(t, proposal.ValidateBasic())
+	require.Equal(t, RouterKey, proposal.ProposalRoute())
+	require.Equal(t, ProposalTypeAddVoteTarget, proposal.ProposalType())
+
+	// invalid denom
+	proposal.Denom.Name = "1"
+	require.Error(t, proposal.ValidateBasic())
+
+	// invalid override
+	rewardBand := sdk.NewDec(2)
+	proposal.Denom = Denom{Name: "uosmo", RewardBand: &rewardBand}
+	require.Error(t, proposal.ValidateBasic())
+
+	// missing title
+	proposal = NewAddVoteTargetProposal("", "description", Denom{Name: "uosmo"})
+	require.Error(t, proposal.ValidateBasic())
+}
+
+func TestRemoveVoteTargetProposalValidateBasic(t *testing.T) {
+	proposal := NewRemoveVoteTargetProposal("title", "description", "uosmo")
+	require.NoError(t, proposal.ValidateBasic())
+	require.Equal(t, RouterKey, proposal.ProposalRoute())
+	require.Equal(t, ProposalTypeRemoveVoteTarget, proposal.ProposalType())
+
+	// invalid denom
+	proposal.Denom = ""
+	require.Error(t, proposal.ValidateBasic())
+
+	// missing description
+	proposal = NewRemoveVoteTargetProposal("title", "", "uosmo")
+	require.Error(t, proposal.ValidateBasic())
+}
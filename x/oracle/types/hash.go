@@ -0,0 +1,84 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+// MaxSaltLength is the maximum length of the salt used to hash a prevote
+const MaxSaltLength = 64
+
+// AggregateVoteHash is hash value to hide vote exchange rates
+// which is formatted as hex string in SHA256("{salt}:{exchange rate}{denom},...,{exchange rate}{denom}:{voter}"),
+// truncated to 20 bytes
+type AggregateVoteHash []byte
+
+// GetAggregateVoteHash computes hash value of ExchangeRateVote
+// to avoid redundant DecCoins stringify operation, use string argument
+func GetAggregateVoteHash(salt string, exchangeRatesStr string, voter sdk.ValAddress) AggregateVoteHash {
+	sourceStr := fmt.Sprintf("%s:%s:%s", salt, exchangeRatesStr, voter.String())
+	return tmhash.Sum([]byte(sourceStr))[:tmhash.TruncatedSize]
+}
+
+// AggregateVoteHashFromHexString convert hex string to AggregateVoteHash
+func AggregateVoteHashFromHexString(s string) (AggregateVoteHash, error) {
+	h, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// String implements fmt.Stringer interface
+func (h AggregateVoteHash) String() string {
+	return hex.EncodeToString(h)
+}
+
+// Equal does byte comparison of two hashes
+func (h AggregateVoteHash) Equal(h2 AggregateVoteHash) bool {
+	return bytes.Equal(h, h2)
+}
+
+// Empty check the name hash has zero length
+func (h AggregateVoteHash) Empty() bool {
+	return len(h) == 0
+}
+
+// Bytes returns the raw address bytes.
+func (h AggregateVoteHash) Bytes() []byte {
+	return []byte(h)
+}
+
+// Size returns the raw address bytes.
+func (h AggregateVoteHash) Size() int {
+	return len(h)
+}
+
+// MarshalYAML marshals to YAML using Bech32.
+func (h AggregateVoteHash) MarshalYAML() (interface{}, error) {
+	return h.String(), nil
+}
+
+// UnmarshalYAML unmarshals from YAML
+func (h *AggregateVoteHash) UnmarshalYAML(data []byte) error {
+	var s string
+	err := yaml.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+
+	h2, err := AggregateVoteHashFromHexString(s)
+	if err != nil {
+		return err
+	}
+
+	*h = h2
+	return nil
+}
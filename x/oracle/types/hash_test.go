@@ -0,0 +1,38 @@
+package types
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestAggregateVoteHash(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1_______________")),
+	}
+
+	aggregateVoteHash := GetAggregateVoteHash("salt", "0.1foo", sdk.ValAddress(addrs[0]))
+	hexStr := hex.EncodeToString(aggregateVoteHash)
+	aggregateVoteHashRes, err := AggregateVoteHashFromHexString(hexStr)
+	require.NoError(t, err)
+	require.Equal(t, aggregateVoteHash, aggregateVoteHashRes)
+	require.True(t, aggregateVoteHash.Equal(aggregateVoteHash))
+	require.True(t, AggregateVoteHash([]byte{}).Empty())
+	require.Equal(t, 20, aggregateVoteHash.Size())
+
+	// a different salt or different exchange rates give a different hash
+	require.False(t, aggregateVoteHash.Equal(GetAggregateVoteHash("salt2", "0.1foo", sdk.ValAddress(addrs[0]))))
+	require.False(t, aggregateVoteHash.Equal(GetAggregateVoteHash("salt", "0.2foo", sdk.ValAddress(addrs[0]))))
+
+	got, err := yaml.Marshal(&aggregateVoteHash)
+	require.NoError(t, err)
+	require.Equal(t, aggregateVoteHash.String()+"\n", string(got))
+
+	res := AggregateVoteHash{}
+	require.NoError(t, (&res).UnmarshalYAML(got))
+	require.Equal(t, aggregateVoteHash, res)
+}
@@ -30,11 +30,29 @@ const (
 //
 // - 0x03<valAddress_Bytes>: int64
 //
-// - 0x04<valAddress_Bytes>: DEPRECATED: AggregateExchangeRatePrevote
+// - 0x04<valAddress_Bytes>: DEPRECATED: AggregateExchangeRatePrevote, replaced by 0x08
 //
 // - 0x05<valAddress_Bytes>: AggregateExchangeRateVote
 //
 // - 0x06<denom_Bytes>: sdk.Dec
+//
+// - 0x07<timestamp_Bytes>: PriceSnapshot
+//
+// - 0x08<valAddress_Bytes>: AggregateExchangeRatePrevote
+//
+// - 0x09<valAddress_Bytes>: OracleRewards
+//
+// - 0x0A<denom_Bytes>: ExchangeRateConfidence
+//
+// - 0x0B<valAddress_Bytes><feederAddress_Bytes>: FeederPermission
+//
+// - 0x0C<votePeriod_Bytes>: VotePeriodBallot
+//
+// - 0x0D<valAddress_Bytes>: ValidatorBondHeight
+//
+// - 0x0E<denom_Bytes>: VoteTargetProbation end height
+//
+// - 0x0F<denom_Bytes>: VoteTargetSunset end height
 var (
 	// Keys for store prefixes
 	ExchangeRateKey       = []byte{0x01} // prefix for each key to a rate
@@ -44,6 +62,16 @@ var (
 	AggregateExchangeRateVoteKey = []byte{0x05} // prefix for each key to a aggregate vote
 	VoteTargetKey                = []byte{0x06} // prefix for each key to a vote target
 	PriceSnapshotKey             = []byte{0x07} // key for price snapshots history
+	// prevotes were removed along with 0x04 and cleared from the store in the
+	// v5 migration, so reinstated prevotes use a fresh prefix
+	AggregateExchangeRatePrevoteKey = []byte{0x08} // prefix for each key to a aggregate prevote
+	OracleRewardsKey                = []byte{0x09} // prefix for each key to the oracle rewards of a validator
+	ExchangeRateConfidenceKey       = []byte{0x0A} // prefix for each key to the confidence of a rate
+	FeederPermissionKey             = []byte{0x0B} // prefix for each key to an additional feeder of a validator
+	VotePeriodBallotKey             = []byte{0x0C} // prefix for each key to the ballot summary of a vote period
+	ValidatorBondHeightKey          = []byte{0x0D} // prefix for each key to the height a validator was last bonded at
+	VoteTargetProbationKey          = []byte{0x0E} // prefix for each key to the end of a vote target's probation period
+	VoteTargetSunsetKey             = []byte{0x0F} // prefix for each key to the end of a removed vote target's sunset period
 )
 
 // GetExchangeRateKey - stored by *denom*
@@ -51,16 +79,46 @@ func GetExchangeRateKey(denom string) []byte {
 	return append(ExchangeRateKey, []byte(denom)...)
 }
 
+// GetExchangeRateConfidenceKey - stored by *denom*
+func GetExchangeRateConfidenceKey(denom string) []byte {
+	return append(ExchangeRateConfidenceKey, []byte(denom)...)
+}
+
 // GetFeederDelegationKey - stored by *Validator* address
 func GetFeederDelegationKey(v sdk.ValAddress) []byte {
 	return append(FeederDelegationKey, address.MustLengthPrefix(v)...)
 }
 
+// GetFeederPermissionsPrefix - prefix of the additional feeders of a *Validator*
+func GetFeederPermissionsPrefix(v sdk.ValAddress) []byte {
+	return append(FeederPermissionKey, address.MustLengthPrefix(v)...)
+}
+
+// GetFeederPermissionKey - stored by *Validator* address and *Feeder* address
+func GetFeederPermissionKey(v sdk.ValAddress, feeder sdk.AccAddress) []byte {
+	return append(GetFeederPermissionsPrefix(v), address.MustLengthPrefix(feeder)...)
+}
+
 // GetVotePenaltyCounterKey - stored by *Validator* address
 func GetVotePenaltyCounterKey(v sdk.ValAddress) []byte {
 	return append(VotePenaltyCounterKey, address.MustLengthPrefix(v)...)
 }
 
+// GetAggregateExchangeRatePrevoteKey - stored by *Validator* address
+func GetAggregateExchangeRatePrevoteKey(v sdk.ValAddress) []byte {
+	return append(AggregateExchangeRatePrevoteKey, address.MustLengthPrefix(v)...)
+}
+
+// GetOracleRewardsKey - stored by *Validator* address
+func GetOracleRewardsKey(v sdk.ValAddress) []byte {
+	return append(OracleRewardsKey, address.MustLengthPrefix(v)...)
+}
+
+// GetValidatorBondHeightKey - stored by *Validator* address
+func GetValidatorBondHeightKey(v sdk.ValAddress) []byte {
+	return append(ValidatorBondHeightKey, address.MustLengthPrefix(v)...)
+}
+
 // GetAggregateExchangeRateVoteKey - stored by *Validator* address
 func GetAggregateExchangeRateVoteKey(v sdk.ValAddress) []byte {
 	return append(AggregateExchangeRateVoteKey, address.MustLengthPrefix(v)...)
@@ -84,3 +142,18 @@ func GetKeyForTimestamp(timestamp uint64) []byte {
 func GetPriceSnapshotKey(timestamp uint64) []byte {
 	return append(PriceSnapshotKey, GetKeyForTimestamp(timestamp)...)
 }
+
+// GetVotePeriodBallotKey - stored by *vote period*
+func GetVotePeriodBallotKey(votePeriod uint64) []byte {
+	return append(VotePeriodBallotKey, sdk.Uint64ToBigEndian(votePeriod)...)
+}
+
+// GetVoteTargetProbationKey - stored by *denom*
+func GetVoteTargetProbationKey(denom string) []byte {
+	return append(VoteTargetProbationKey, []byte(denom)...)
+}
+
+// GetVoteTargetSunsetKey - stored by *denom*
+func GetVoteTargetSunsetKey(denom string) []byte {
+	return append(VoteTargetSunsetKey, []byte(denom)...)
+}
@@ -3,23 +3,85 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 )
 
 // ensure Msg interface compliance at compile time
 var (
 	_ sdk.Msg = &MsgDelegateFeedConsent{}
+	_ sdk.Msg = &MsgAggregateExchangeRatePrevote{}
 	_ sdk.Msg = &MsgAggregateExchangeRateVote{}
+	_ sdk.Msg = &MsgAuthorizeFeeder{}
+	_ sdk.Msg = &MsgRevokeFeeder{}
 )
 
 // oracle message types
 const (
-	TypeMsgDelegateFeedConsent       = "delegate_feeder"
-	TypeMsgAggregateExchangeRateVote = "aggregate_exchange_rate_vote"
+	TypeMsgDelegateFeedConsent          = "delegate_feeder"
+	TypeMsgAggregateExchangeRatePrevote = "aggregate_exchange_rate_prevote"
+	TypeMsgAggregateExchangeRateVote    = "aggregate_exchange_rate_vote"
+	TypeMsgAuthorizeFeeder              = "authorize_feeder"
+	TypeMsgRevokeFeeder                 = "revoke_feeder"
 )
 
 //-------------------------------------------------
 //-------------------------------------------------
 
+// NewMsgAggregateExchangeRatePrevote returns MsgAggregateExchangeRatePrevote instance
+func NewMsgAggregateExchangeRatePrevote(hash AggregateVoteHash, feeder sdk.AccAddress, validator sdk.ValAddress) *MsgAggregateExchangeRatePrevote {
+	return &MsgAggregateExchangeRatePrevote{
+		Hash:      hash.String(),
+		Feeder:    feeder.String(),
+		Validator: validator.String(),
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgAggregateExchangeRatePrevote) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgAggregateExchangeRatePrevote) Type() string { return TypeMsgAggregateExchangeRatePrevote }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgAggregateExchangeRatePrevote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgAggregateExchangeRatePrevote) GetSigners() []sdk.AccAddress {
+	feeder, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{feeder}
+}
+
+// ValidateBasic Implements sdk.Msg
+func (msg MsgAggregateExchangeRatePrevote) ValidateBasic() error {
+	_, err := AggregateVoteHashFromHexString(msg.Hash)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidHash, "Invalid vote hash (%s)", err)
+	}
+
+	// HEX encoding doubles the hash length
+	if len(msg.Hash) != tmhash.TruncatedSize*2 {
+		return ErrInvalidHashLength
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid feeder address (%s)", err)
+	}
+
+	_, err = sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid operator address (%s)", err)
+	}
+
+	return nil
+}
+
 // NewMsgAggregateExchangeRateVote returns MsgAggregateExchangeRateVote instance
 func NewMsgAggregateExchangeRateVote(exchangeRates string, feeder sdk.AccAddress, validator sdk.ValAddress) *MsgAggregateExchangeRateVote {
 	return &MsgAggregateExchangeRateVote{
@@ -73,6 +135,10 @@ func (msg MsgAggregateExchangeRateVote) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "failed to parse exchange rates string cause: "+err.Error())
 	}
 
+	if len(msg.Salt) > MaxSaltLength {
+		return ErrInvalidSaltLength
+	}
+
 	for _, exchangeRate := range exchangeRates {
 		// Check overflow bit length
 		if exchangeRate.ExchangeRate.BigInt().BitLen() > 255+sdk.DecimalPrecisionBits {
@@ -125,3 +191,108 @@ func (msg MsgDelegateFeedConsent) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgAuthorizeFeeder creates a MsgAuthorizeFeeder instance
+func NewMsgAuthorizeFeeder(operatorAddress sdk.ValAddress, feederAddress sdk.AccAddress, denoms []string, expiry int64) *MsgAuthorizeFeeder {
+	return &MsgAuthorizeFeeder{
+		Operator: operatorAddress.String(),
+		Feeder:   feederAddress.String(),
+		Denoms:   denoms,
+		Expiry:   expiry,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgAuthorizeFeeder) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgAuthorizeFeeder) Type() string { return TypeMsgAuthorizeFeeder }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgAuthorizeFeeder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgAuthorizeFeeder) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.ValAddressFromBech32(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{sdk.AccAddress(operator)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgAuthorizeFeeder) ValidateBasic() error {
+	_, err := sdk.ValAddressFromBech32(msg.Operator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid operator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid feeder address (%s)", err)
+	}
+
+	if msg.Expiry < 0 {
+		return sdkerrors.Wrapf(ErrInvalidFeederExpiry, "%d", msg.Expiry)
+	}
+
+	seen := make(map[string]struct{}, len(msg.Denoms))
+	for _, denom := range msg.Denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if _, ok := seen[denom]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate denom %s", denom)
+		}
+		seen[denom] = struct{}{}
+	}
+
+	return nil
+}
+
+// NewMsgRevokeFeeder creates a MsgRevokeFeeder instance
+func NewMsgRevokeFeeder(operatorAddress sdk.ValAddress, feederAddress sdk.AccAddress) *MsgRevokeFeeder {
+	return &MsgRevokeFeeder{
+		Operator: operatorAddress.String(),
+		Feeder:   feederAddress.String(),
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgRevokeFeeder) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgRevokeFeeder) Type() string { return TypeMsgRevokeFeeder }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgRevokeFeeder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRevokeFeeder) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.ValAddressFromBech32(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{sdk.AccAddress(operator)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRevokeFeeder) ValidateBasic() error {
+	_, err := sdk.ValAddressFromBech32(msg.Operator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid operator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid feeder address (%s)", err)
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -35,6 +36,37 @@ func TestMsgFeederDelegation(t *testing.T) {
 	}
 }
 
+func TestMsgAggregateExchangeRatePrevote(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1_______________")),
+	}
+
+	exchangeRates := sdk.DecCoins{sdk.NewDecCoinFromDec("foo", sdk.OneDec()), sdk.NewDecCoinFromDec("bar", sdk.OneDec())}
+	bz := GetAggregateVoteHash("1", exchangeRates.String(), sdk.ValAddress(addrs[0]))
+
+	tests := []struct {
+		hash          AggregateVoteHash
+		exchangeRates sdk.DecCoins
+		voter         sdk.AccAddress
+		expectPass    bool
+	}{
+		{bz, exchangeRates, addrs[0], true},
+		{bz[1:], exchangeRates, addrs[0], false},
+		{[]byte("1234567890123456789012345678901234567890"), exchangeRates, addrs[0], false},
+		{AggregateVoteHash{}, exchangeRates, addrs[0], false},
+		{bz, exchangeRates, sdk.AccAddress{}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgAggregateExchangeRatePrevote(tc.hash, tc.voter, sdk.ValAddress(tc.voter))
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 func TestMsgAggregateExchangeRateVote(t *testing.T) {
 	addrs := []sdk.AccAddress{
 		sdk.AccAddress([]byte("addr1_______________")),
@@ -65,4 +97,68 @@ func TestMsgAggregateExchangeRateVote(t *testing.T) {
 			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
 		}
 	}
+
+	msg := NewMsgAggregateExchangeRateVote(exchangeRates, addrs[0], sdk.ValAddress(addrs[0]))
+	msg.Salt = strings.Repeat("a", MaxSaltLength)
+	require.NoError(t, msg.ValidateBasic())
+	msg.Salt = strings.Repeat("a", MaxSaltLength+1)
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidSaltLength)
+}
+
+func TestMsgAuthorizeFeeder(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1_______________")),
+		sdk.AccAddress([]byte("addr2_______________")),
+	}
+
+	tests := []struct {
+		operator   sdk.ValAddress
+		feeder     sdk.AccAddress
+		denoms     []string
+		expiry     int64
+		expectPass bool
+	}{
+		{sdk.ValAddress(addrs[0]), addrs[1], nil, 0, true},
+		{sdk.ValAddress(addrs[0]), addrs[1], []string{"uatom", "ueth"}, 100, true},
+		{sdk.ValAddress(addrs[0]), addrs[1], []string{"uatom", "uatom"}, 0, false},
+		{sdk.ValAddress(addrs[0]), addrs[1], []string{"1"}, 0, false},
+		{sdk.ValAddress(addrs[0]), addrs[1], nil, -1, false},
+		{sdk.ValAddress{}, addrs[1], nil, 0, false},
+		{sdk.ValAddress(addrs[0]), sdk.AccAddress{}, nil, 0, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgAuthorizeFeeder(tc.operator, tc.feeder, tc.denoms, tc.expiry)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgRevokeFeeder(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1_______________")),
+		sdk.AccAddress([]byte("addr2_______________")),
+	}
+
+	tests := []struct {
+		operator   sdk.ValAddress
+		feeder     sdk.AccAddress
+		expectPass bool
+	}{
+		{sdk.ValAddress(addrs[0]), addrs[1], true},
+		{sdk.ValAddress{}, addrs[1], false},
+		{sdk.ValAddress(addrs[0]), sdk.AccAddress{}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgRevokeFeeder(tc.operator, tc.feeder)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
 }
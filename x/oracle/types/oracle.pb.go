@@ -5,8 +5,8 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -25,6 +25,35 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// VoteOutcome is how a validator's participation in a vote period was counted
+type VoteOutcome int32
+
+const (
+	VoteOutcome_VOTE_OUTCOME_SUCCESS VoteOutcome = 0
+	VoteOutcome_VOTE_OUTCOME_ABSTAIN VoteOutcome = 1
+	VoteOutcome_VOTE_OUTCOME_MISS    VoteOutcome = 2
+)
+
+var VoteOutcome_name = map[int32]string{
+	0: "VOTE_OUTCOME_SUCCESS",
+	1: "VOTE_OUTCOME_ABSTAIN",
+	2: "VOTE_OUTCOME_MISS",
+}
+
+var VoteOutcome_value = map[string]int32{
+	"VOTE_OUTCOME_SUCCESS": 0,
+	"VOTE_OUTCOME_ABSTAIN": 1,
+	"VOTE_OUTCOME_MISS":    2,
+}
+
+func (x VoteOutcome) String() string {
+	return proto.EnumName(VoteOutcome_name, int32(x))
+}
+
+func (VoteOutcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{0}
+}
+
 type Params struct {
 	// The number of blocks per voting window, at the end of the vote period, the oracle votes are assessed and exchange rates are calculated. If the vote period is 1 this is equivalent to having oracle votes assessed and exchange rates calculated in each block.
 	VotePeriod    uint64                                 `protobuf:"varint,1,opt,name=vote_period,json=votePeriod,proto3" json:"vote_period,omitempty" yaml:"vote_period"`
@@ -37,6 +66,30 @@ type Params struct {
 	// The minimum percentage of voting windows for which a validator must have `success`es in order to not be penalized at the end of the slash window.
 	MinValidPerWindow github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=min_valid_per_window,json=minValidPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_valid_per_window" yaml:"min_valid_per_window"`
 	LookbackDuration  uint64                                 `protobuf:"varint,9,opt,name=lookback_duration,json=lookbackDuration,proto3" json:"lookback_duration,omitempty" yaml:"lookback_duration"`
+	// If set, exchange rate votes must reveal a hashed prevote submitted in the previous vote period.
+	RequirePrevote bool `protobuf:"varint,10,opt,name=require_prevote,json=requirePrevote,proto3" json:"require_prevote,omitempty" yaml:"require_prevote"`
+	// The number of blocks over which the oracle reward pool is paid out. Every vote period, votePeriod / rewardDistributionWindow of the pool is distributed to the validators that voted within the reward band.
+	RewardDistributionWindow uint64 `protobuf:"varint,11,opt,name=reward_distribution_window,json=rewardDistributionWindow,proto3" json:"reward_distribution_window,omitempty" yaml:"reward_distribution_window"`
+	// The maximum number of feeders a validator can authorise in addition to its feeder delegation.
+	MaxFeeders uint64 `protobuf:"varint,12,opt,name=max_feeders,json=maxFeeders,proto3" json:"max_feeders,omitempty" yaml:"max_feeders"`
+	// The number of most recent vote periods for which ballot summaries are retained.
+	VoteHistoryRetention uint64 `protobuf:"varint,13,opt,name=vote_history_retention,json=voteHistoryRetention,proto3" json:"vote_history_retention,omitempty" yaml:"vote_history_retention"`
+	// Validators with a valid vote rate below this threshold at the end of the slash window get a warning event.
+	WarningThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=warning_threshold,json=warningThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"warning_threshold" yaml:"warning_threshold"`
+	// Validators with a valid vote rate below this threshold at the end of the slash window are jailed in addition to being slashed.
+	JailThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=jail_threshold,json=jailThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"jail_threshold" yaml:"jail_threshold"`
+	// The weight of a missed vote period when calculating the valid vote rate.
+	MissPenaltyWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=miss_penalty_weight,json=missPenaltyWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"miss_penalty_weight" yaml:"miss_penalty_weight"`
+	// The weight of an abstained vote period when calculating the valid vote rate.
+	AbstainPenaltyWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,17,opt,name=abstain_penalty_weight,json=abstainPenaltyWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"abstain_penalty_weight" yaml:"abstain_penalty_weight"`
+	// The number of blocks after bonding during which a validator is not slashed or jailed for oracle misses.
+	SlashGracePeriod uint64 `protobuf:"varint,18,opt,name=slash_grace_period,json=slashGracePeriod,proto3" json:"slash_grace_period,omitempty" yaml:"slash_grace_period"`
+	// Denoms whose exchange rates are derived from the exchange rates of voted denoms after every tally.
+	DerivedDenoms DerivedDenomList `protobuf:"bytes,19,rep,name=derived_denoms,json=derivedDenoms,proto3,castrepeated=DerivedDenomList" json:"derived_denoms" yaml:"derived_denoms"`
+	// The number of blocks after a vote target is added through governance during which its votes are collected but misses on it aren't penalized.
+	VoteTargetProbationPeriod uint64 `protobuf:"varint,20,opt,name=vote_target_probation_period,json=voteTargetProbationPeriod,proto3" json:"vote_target_probation_period,omitempty" yaml:"vote_target_probation_period"`
+	// The number of blocks after a vote target is removed through governance during which its last exchange rate stays readable, marked stale.
+	VoteTargetSunsetPeriod uint64 `protobuf:"varint,21,opt,name=vote_target_sunset_period,json=voteTargetSunsetPeriod,proto3" json:"vote_target_sunset_period,omitempty" yaml:"vote_target_sunset_period"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -99,8 +152,71 @@ func (m *Params) GetLookbackDuration() uint64 {
 	return 0
 }
 
+func (m *Params) GetRequirePrevote() bool {
+	if m != nil {
+		return m.RequirePrevote
+	}
+	return false
+}
+
+func (m *Params) GetRewardDistributionWindow() uint64 {
+	if m != nil {
+		return m.RewardDistributionWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxFeeders() uint64 {
+	if m != nil {
+		return m.MaxFeeders
+	}
+	return 0
+}
+
+func (m *Params) GetVoteHistoryRetention() uint64 {
+	if m != nil {
+		return m.VoteHistoryRetention
+	}
+	return 0
+}
+
+func (m *Params) GetSlashGracePeriod() uint64 {
+	if m != nil {
+		return m.SlashGracePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetDerivedDenoms() DerivedDenomList {
+	if m != nil {
+		return m.DerivedDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetVoteTargetProbationPeriod() uint64 {
+	if m != nil {
+		return m.VoteTargetProbationPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetVoteTargetSunsetPeriod() uint64 {
+	if m != nil {
+		return m.VoteTargetSunsetPeriod
+	}
+	return 0
+}
+
 type Denom struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	// vote_threshold overrides the global vote threshold for this denom when set
+	VoteThreshold *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=vote_threshold,json=voteThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"vote_threshold,omitempty" yaml:"vote_threshold,omitempty"`
+	// reward_band overrides the global reward band for this denom when set
+	RewardBand *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=reward_band,json=rewardBand,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_band,omitempty" yaml:"reward_band,omitempty"`
+	// min_voters is the minimum number of validators that must vote for this
+	// denom for its ballot to pass, zero disables the check
+	MinVoters uint64 `protobuf:"varint,4,opt,name=min_voters,json=minVoters,proto3" json:"min_voters,omitempty" yaml:"min_voters,omitempty"`
 }
 
 func (m *Denom) Reset()      { *m = Denom{} }
@@ -135,6 +251,170 @@ func (m *Denom) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Denom proto.InternalMessageInfo
 
+// DerivedDenom is a denom without a ballot of its own whose exchange rate is
+// the product of the numerators' exchange rates divided by the product of the
+// denominators' exchange rates
+type DerivedDenom struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	// voted denoms whose exchange rates are multiplied
+	Numerators []string `protobuf:"bytes,2,rep,name=numerators,proto3" json:"numerators,omitempty" yaml:"numerators"`
+	// voted denoms whose exchange rates divide the product of the numerators
+	Denominators []string `protobuf:"bytes,3,rep,name=denominators,proto3" json:"denominators,omitempty" yaml:"denominators"`
+}
+
+func (m *DerivedDenom) Reset()      { *m = DerivedDenom{} }
+func (*DerivedDenom) ProtoMessage() {}
+func (*DerivedDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{2}
+}
+func (m *DerivedDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DerivedDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DerivedDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DerivedDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DerivedDenom.Merge(m, src)
+}
+func (m *DerivedDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *DerivedDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_DerivedDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DerivedDenom proto.InternalMessageInfo
+
+// VoteTargetTransition is the probation period of a vote target added through
+// governance, or the sunset period of one removed through governance
+type VoteTargetTransition struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// block height at which the period ends
+	EndHeight int64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty" yaml:"end_height"`
+}
+
+func (m *VoteTargetTransition) Reset()         { *m = VoteTargetTransition{} }
+func (m *VoteTargetTransition) String() string { return proto.CompactTextString(m) }
+func (*VoteTargetTransition) ProtoMessage()    {}
+func (*VoteTargetTransition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{3}
+}
+func (m *VoteTargetTransition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteTargetTransition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteTargetTransition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteTargetTransition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteTargetTransition.Merge(m, src)
+}
+func (m *VoteTargetTransition) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteTargetTransition) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteTargetTransition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteTargetTransition proto.InternalMessageInfo
+
+// FeederPermission authorises an additional feeder to vote on behalf of a validator
+type FeederPermission struct {
+	Feeder string `protobuf:"bytes,1,opt,name=feeder,proto3" json:"feeder,omitempty" yaml:"feeder"`
+	// denoms the feeder may vote for, all vote targets when empty
+	Denoms []string `protobuf:"bytes,2,rep,name=denoms,proto3" json:"denoms,omitempty" yaml:"denoms"`
+	// unix timestamp in seconds after which the permission expires, never when zero
+	Expiry int64 `protobuf:"varint,3,opt,name=expiry,proto3" json:"expiry,omitempty" yaml:"expiry"`
+}
+
+func (m *FeederPermission) Reset()         { *m = FeederPermission{} }
+func (m *FeederPermission) String() string { return proto.CompactTextString(m) }
+func (*FeederPermission) ProtoMessage()    {}
+func (*FeederPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{4}
+}
+func (m *FeederPermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeederPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeederPermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeederPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeederPermission.Merge(m, src)
+}
+func (m *FeederPermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeederPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeederPermission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeederPermission proto.InternalMessageInfo
+
+type AggregateExchangeRatePrevote struct {
+	Hash        string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty" yaml:"hash"`
+	Voter       string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty" yaml:"voter"`
+	SubmitBlock uint64 `protobuf:"varint,3,opt,name=submit_block,json=submitBlock,proto3" json:"submit_block,omitempty" yaml:"submit_block"`
+}
+
+func (m *AggregateExchangeRatePrevote) Reset()      { *m = AggregateExchangeRatePrevote{} }
+func (*AggregateExchangeRatePrevote) ProtoMessage() {}
+func (*AggregateExchangeRatePrevote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{5}
+}
+func (m *AggregateExchangeRatePrevote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregateExchangeRatePrevote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregateExchangeRatePrevote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregateExchangeRatePrevote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregateExchangeRatePrevote.Merge(m, src)
+}
+func (m *AggregateExchangeRatePrevote) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregateExchangeRatePrevote) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregateExchangeRatePrevote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregateExchangeRatePrevote proto.InternalMessageInfo
+
 type AggregateExchangeRateVote struct {
 	ExchangeRateTuples ExchangeRateTuples `protobuf:"bytes,1,rep,name=exchange_rate_tuples,json=exchangeRateTuples,proto3,castrepeated=ExchangeRateTuples" json:"exchange_rate_tuples" yaml:"exchange_rate_tuples"`
 	Voter              string             `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty" yaml:"voter"`
@@ -143,7 +423,7 @@ type AggregateExchangeRateVote struct {
 func (m *AggregateExchangeRateVote) Reset()      { *m = AggregateExchangeRateVote{} }
 func (*AggregateExchangeRateVote) ProtoMessage() {}
 func (*AggregateExchangeRateVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc470b50b143d488, []int{2}
+	return fileDescriptor_dc470b50b143d488, []int{6}
 }
 func (m *AggregateExchangeRateVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -180,7 +460,7 @@ type ExchangeRateTuple struct {
 func (m *ExchangeRateTuple) Reset()      { *m = ExchangeRateTuple{} }
 func (*ExchangeRateTuple) ProtoMessage() {}
 func (*ExchangeRateTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc470b50b143d488, []int{3}
+	return fileDescriptor_dc470b50b143d488, []int{7}
 }
 func (m *ExchangeRateTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -218,7 +498,7 @@ type OracleExchangeRate struct {
 func (m *OracleExchangeRate) Reset()      { *m = OracleExchangeRate{} }
 func (*OracleExchangeRate) ProtoMessage() {}
 func (*OracleExchangeRate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc470b50b143d488, []int{4}
+	return fileDescriptor_dc470b50b143d488, []int{8}
 }
 func (m *OracleExchangeRate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -247,6 +527,51 @@ func (m *OracleExchangeRate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OracleExchangeRate proto.InternalMessageInfo
 
+// ExchangeRateConfidence describes the ballot an exchange rate was tallied from
+type ExchangeRateConfidence struct {
+	// num_voters is the number of validators that voted for the denom
+	NumVoters uint64 `protobuf:"varint,1,opt,name=num_voters,json=numVoters,proto3" json:"num_voters,omitempty" yaml:"num_voters"`
+	// voting_power_share is the share of the total bonded power that voted for the denom
+	VotingPowerShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=voting_power_share,json=votingPowerShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"voting_power_share" yaml:"voting_power_share"`
+	// standard_deviation is the standard deviation of the votes from the exchange rate
+	StandardDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=standard_deviation,json=standardDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"standard_deviation" yaml:"standard_deviation"`
+	// spread is the difference between the highest and the lowest vote
+	Spread github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=spread,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"spread" yaml:"spread"`
+}
+
+func (m *ExchangeRateConfidence) Reset()         { *m = ExchangeRateConfidence{} }
+func (m *ExchangeRateConfidence) String() string { return proto.CompactTextString(m) }
+func (*ExchangeRateConfidence) ProtoMessage()    {}
+func (*ExchangeRateConfidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{9}
+}
+func (m *ExchangeRateConfidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeRateConfidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeRateConfidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExchangeRateConfidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeRateConfidence.Merge(m, src)
+}
+func (m *ExchangeRateConfidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeRateConfidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeRateConfidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeRateConfidence proto.InternalMessageInfo
+
 type PriceSnapshotItem struct {
 	Denom              string             `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 	OracleExchangeRate OracleExchangeRate `protobuf:"bytes,2,opt,name=oracle_exchange_rate,json=oracleExchangeRate,proto3" json:"oracle_exchange_rate"`
@@ -256,7 +581,7 @@ func (m *PriceSnapshotItem) Reset()         { *m = PriceSnapshotItem{} }
 func (m *PriceSnapshotItem) String() string { return proto.CompactTextString(m) }
 func (*PriceSnapshotItem) ProtoMessage()    {}
 func (*PriceSnapshotItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc470b50b143d488, []int{5}
+	return fileDescriptor_dc470b50b143d488, []int{10}
 }
 func (m *PriceSnapshotItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -308,7 +633,7 @@ func (m *PriceSnapshot) Reset()         { *m = PriceSnapshot{} }
 func (m *PriceSnapshot) String() string { return proto.CompactTextString(m) }
 func (*PriceSnapshot) ProtoMessage()    {}
 func (*PriceSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc470b50b143d488, []int{6}
+	return fileDescriptor_dc470b50b143d488, []int{11}
 }
 func (m *PriceSnapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -361,7 +686,7 @@ func (m *OracleTwap) Reset()         { *m = OracleTwap{} }
 func (m *OracleTwap) String() string { return proto.CompactTextString(m) }
 func (*OracleTwap) ProtoMessage()    {}
 func (*OracleTwap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc470b50b143d488, []int{7}
+	return fileDescriptor_dc470b50b143d488, []int{12}
 }
 func (m *OracleTwap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -404,24 +729,25 @@ func (m *OracleTwap) GetLookbackSeconds() int64 {
 	return 0
 }
 
-type VotePenaltyCounter struct {
-	MissCount    uint64 `protobuf:"varint,1,opt,name=miss_count,json=missCount,proto3" json:"miss_count,omitempty"`
-	AbstainCount uint64 `protobuf:"varint,2,opt,name=abstain_count,json=abstainCount,proto3" json:"abstain_count,omitempty"`
-	SuccessCount uint64 `protobuf:"varint,3,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+type OracleEma struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// ema is the exponential moving average of the exchange rate
+	Ema             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=ema,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ema" yaml:"ema"`
+	LookbackSeconds int64                                  `protobuf:"varint,3,opt,name=lookback_seconds,json=lookbackSeconds,proto3" json:"lookback_seconds,omitempty"`
 }
 
-func (m *VotePenaltyCounter) Reset()         { *m = VotePenaltyCounter{} }
-func (m *VotePenaltyCounter) String() string { return proto.CompactTextString(m) }
-func (*VotePenaltyCounter) ProtoMessage()    {}
-func (*VotePenaltyCounter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc470b50b143d488, []int{8}
+func (m *OracleEma) Reset()         { *m = OracleEma{} }
+func (m *OracleEma) String() string { return proto.CompactTextString(m) }
+func (*OracleEma) ProtoMessage()    {}
+func (*OracleEma) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{13}
 }
-func (m *VotePenaltyCounter) XXX_Unmarshal(b []byte) error {
+func (m *OracleEma) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *VotePenaltyCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OracleEma) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_VotePenaltyCounter.Marshal(b, m, deterministic)
+		return xxx_messageInfo_OracleEma.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -431,139 +757,560 @@ func (m *VotePenaltyCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *VotePenaltyCounter) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VotePenaltyCounter.Merge(m, src)
+func (m *OracleEma) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleEma.Merge(m, src)
 }
-func (m *VotePenaltyCounter) XXX_Size() int {
+func (m *OracleEma) XXX_Size() int {
 	return m.Size()
 }
-func (m *VotePenaltyCounter) XXX_DiscardUnknown() {
-	xxx_messageInfo_VotePenaltyCounter.DiscardUnknown(m)
+func (m *OracleEma) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleEma.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VotePenaltyCounter proto.InternalMessageInfo
+var xxx_messageInfo_OracleEma proto.InternalMessageInfo
 
-func (m *VotePenaltyCounter) GetMissCount() uint64 {
+func (m *OracleEma) GetDenom() string {
 	if m != nil {
-		return m.MissCount
+		return m.Denom
 	}
-	return 0
+	return ""
 }
 
-func (m *VotePenaltyCounter) GetAbstainCount() uint64 {
+func (m *OracleEma) GetLookbackSeconds() int64 {
 	if m != nil {
-		return m.AbstainCount
+		return m.LookbackSeconds
 	}
 	return 0
 }
 
-func (m *VotePenaltyCounter) GetSuccessCount() uint64 {
-	if m != nil {
-		return m.SuccessCount
-	}
-	return 0
+type OraclePriceRange struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// min is the lowest exchange rate over the lookback
+	Min github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=min,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min" yaml:"min"`
+	// max is the highest exchange rate over the lookback
+	Max             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max" yaml:"max"`
+	LookbackSeconds int64                                  `protobuf:"varint,4,opt,name=lookback_seconds,json=lookbackSeconds,proto3" json:"lookback_seconds,omitempty"`
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "seiprotocol.seichain.oracle.Params")
-	proto.RegisterType((*Denom)(nil), "seiprotocol.seichain.oracle.Denom")
-	proto.RegisterType((*AggregateExchangeRateVote)(nil), "seiprotocol.seichain.oracle.AggregateExchangeRateVote")
-	proto.RegisterType((*ExchangeRateTuple)(nil), "seiprotocol.seichain.oracle.ExchangeRateTuple")
-	proto.RegisterType((*OracleExchangeRate)(nil), "seiprotocol.seichain.oracle.OracleExchangeRate")
-	proto.RegisterType((*PriceSnapshotItem)(nil), "seiprotocol.seichain.oracle.PriceSnapshotItem")
-	proto.RegisterType((*PriceSnapshot)(nil), "seiprotocol.seichain.oracle.PriceSnapshot")
-	proto.RegisterType((*OracleTwap)(nil), "seiprotocol.seichain.oracle.OracleTwap")
-	proto.RegisterType((*VotePenaltyCounter)(nil), "seiprotocol.seichain.oracle.VotePenaltyCounter")
+func (m *OraclePriceRange) Reset()         { *m = OraclePriceRange{} }
+func (m *OraclePriceRange) String() string { return proto.CompactTextString(m) }
+func (*OraclePriceRange) ProtoMessage()    {}
+func (*OraclePriceRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{14}
+}
+func (m *OraclePriceRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OraclePriceRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OraclePriceRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OraclePriceRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OraclePriceRange.Merge(m, src)
+}
+func (m *OraclePriceRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *OraclePriceRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_OraclePriceRange.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("oracle/oracle.proto", fileDescriptor_dc470b50b143d488) }
+var xxx_messageInfo_OraclePriceRange proto.InternalMessageInfo
 
-var fileDescriptor_dc470b50b143d488 = []byte{
-	// 992 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xbd, 0x6f, 0x23, 0x45,
-	0x14, 0xf7, 0xe6, 0x0b, 0x3c, 0xb6, 0xb9, 0x78, 0xe2, 0x03, 0xdf, 0x97, 0x37, 0x9a, 0x13, 0xa7,
-	0x50, 0x9c, 0xcd, 0x1d, 0x05, 0xc2, 0x12, 0x05, 0x26, 0x1c, 0x0a, 0x3a, 0x44, 0x98, 0x33, 0x41,
-	0xa2, 0x59, 0x8d, 0x77, 0x07, 0x7b, 0x94, 0xdd, 0x9d, 0xd5, 0xce, 0x38, 0xbe, 0x14, 0x50, 0x50,
-	0x51, 0x22, 0x2a, 0x24, 0x9a, 0xd4, 0xfc, 0x01, 0xfc, 0x0d, 0x57, 0x50, 0x5c, 0x89, 0x28, 0x16,
-	0x94, 0x34, 0x74, 0x48, 0x6e, 0x69, 0xd0, 0x7c, 0xd8, 0xde, 0x64, 0x43, 0x84, 0x85, 0xae, 0xda,
-	0x7d, 0xbf, 0xf7, 0xe6, 0x37, 0x6f, 0x7e, 0xef, 0xbd, 0xd1, 0x80, 0x2d, 0x9e, 0x12, 0x3f, 0xa4,
-	0x1d, 0xf3, 0x69, 0x27, 0x29, 0x97, 0x1c, 0xde, 0x12, 0x94, 0xe9, 0x3f, 0x9f, 0x87, 0x6d, 0x41,
-	0x99, 0x3f, 0x22, 0x2c, 0x6e, 0x9b, 0x90, 0x9b, 0x8d, 0x21, 0x1f, 0x72, 0xed, 0xed, 0xa8, 0x3f,
-	0xb3, 0xe4, 0x66, 0xcb, 0xe7, 0x22, 0xe2, 0xa2, 0x33, 0x20, 0x82, 0x76, 0x8e, 0x1e, 0x0c, 0xa8,
-	0x24, 0x0f, 0x3a, 0x3e, 0x67, 0xb1, 0xf1, 0xa3, 0x6f, 0x36, 0xc0, 0xc6, 0x3e, 0x49, 0x49, 0x24,
-	0xe0, 0xdb, 0xa0, 0x72, 0xc4, 0x25, 0xf5, 0x12, 0x9a, 0x32, 0x1e, 0x34, 0x9d, 0x6d, 0x67, 0x67,
-	0xad, 0xf7, 0xea, 0x34, 0x73, 0xe1, 0x31, 0x89, 0xc2, 0x2e, 0xca, 0x39, 0x11, 0x06, 0xca, 0xda,
-	0xd7, 0x06, 0x8c, 0xc1, 0x2b, 0xda, 0x27, 0x47, 0x29, 0x15, 0x23, 0x1e, 0x06, 0xcd, 0x95, 0x6d,
-	0x67, 0xa7, 0xdc, 0xfb, 0xf0, 0x59, 0xe6, 0x96, 0x7e, 0xcb, 0xdc, 0x7b, 0x43, 0x26, 0x47, 0xe3,
-	0x41, 0xdb, 0xe7, 0x51, 0xc7, 0xa6, 0x63, 0x3e, 0xf7, 0x45, 0x70, 0xd8, 0x91, 0xc7, 0x09, 0x15,
-	0xed, 0x5d, 0xea, 0x4f, 0x33, 0xf7, 0x7a, 0x6e, 0xa7, 0x39, 0x1b, 0xc2, 0x35, 0x05, 0xf4, 0x67,
-	0x36, 0xa4, 0xa0, 0x92, 0xd2, 0x09, 0x49, 0x03, 0x6f, 0x40, 0xe2, 0xa0, 0xb9, 0xaa, 0x37, 0xdb,
-	0x5d, 0x7a, 0x33, 0x7b, 0xac, 0x1c, 0x15, 0xc2, 0xc0, 0x58, 0x3d, 0x12, 0x07, 0x70, 0x08, 0xca,
-	0x93, 0x11, 0x93, 0x34, 0x64, 0x42, 0x36, 0xd7, 0xb6, 0x57, 0x77, 0x2a, 0x0f, 0x51, 0xfb, 0x8a,
-	0x0a, 0xb4, 0x77, 0x69, 0xcc, 0xa3, 0xde, 0xeb, 0x2a, 0x91, 0x69, 0xe6, 0x6e, 0x1a, 0xfa, 0x39,
-	0x05, 0xfa, 0xe9, 0x77, 0xb7, 0xac, 0x43, 0x1e, 0x33, 0x21, 0xf1, 0x82, 0x5b, 0xe9, 0x27, 0x42,
-	0x22, 0x46, 0xde, 0x97, 0x29, 0xf1, 0x25, 0xe3, 0x71, 0x73, 0xfd, 0xff, 0xe9, 0x77, 0x9e, 0x0d,
-	0xe1, 0x9a, 0x06, 0x1e, 0x59, 0x1b, 0x76, 0x41, 0xd5, 0x44, 0x4c, 0x58, 0x1c, 0xf0, 0x49, 0x73,
-	0x43, 0x57, 0xfa, 0xb5, 0x69, 0xe6, 0x6e, 0xe5, 0xd7, 0x1b, 0x2f, 0xc2, 0x15, 0x6d, 0x7e, 0xae,
-	0x2d, 0xf8, 0x35, 0x68, 0x44, 0x2c, 0xf6, 0x8e, 0x48, 0xc8, 0x02, 0xd5, 0x0c, 0x33, 0x8e, 0x97,
-	0x74, 0xc6, 0x1f, 0x2f, 0x9d, 0xf1, 0x2d, 0xb3, 0xe3, 0x65, 0x9c, 0x08, 0xd7, 0x23, 0x16, 0x1f,
-	0x28, 0x74, 0x9f, 0xa6, 0x76, 0xff, 0x3d, 0x50, 0x0f, 0x39, 0x3f, 0x1c, 0x10, 0xff, 0xd0, 0x0b,
-	0xc6, 0x29, 0xd1, 0x72, 0x95, 0xf5, 0x01, 0x6e, 0x4f, 0x33, 0xb7, 0x69, 0xe8, 0x0a, 0x21, 0x08,
-	0x6f, 0xce, 0xb0, 0x5d, 0x0b, 0x75, 0x5f, 0xfe, 0xe1, 0xc4, 0x2d, 0xfd, 0x79, 0xe2, 0x3a, 0xa8,
-	0x0b, 0xd6, 0x75, 0x61, 0xe0, 0x5d, 0xb0, 0x16, 0x93, 0x88, 0xea, 0xde, 0x2f, 0xf7, 0xae, 0x4d,
-	0x33, 0xb7, 0x62, 0x08, 0x15, 0x8a, 0xb0, 0x76, 0x76, 0xab, 0xdf, 0x9e, 0xb8, 0x25, 0xbb, 0xb6,
-	0x84, 0xfe, 0x72, 0xc0, 0x8d, 0xf7, 0x86, 0xc3, 0x94, 0x0e, 0x89, 0xa4, 0x1f, 0x3c, 0xf5, 0x47,
-	0x24, 0x1e, 0x52, 0x4c, 0x24, 0x3d, 0xe0, 0x92, 0xc2, 0x1f, 0x1d, 0xd0, 0xa0, 0x16, 0xf4, 0x52,
-	0xa2, 0xda, 0x7a, 0x9c, 0x84, 0x54, 0x34, 0x1d, 0xdd, 0x4f, 0xed, 0x2b, 0xfb, 0x29, 0xcf, 0xd6,
-	0x57, 0xcb, 0x7a, 0xef, 0xd8, 0xde, 0xb2, 0xaa, 0x5d, 0xc6, 0xac, 0xda, 0x0c, 0x16, 0x56, 0x0a,
-	0x0c, 0x69, 0x01, 0x83, 0xf7, 0xc0, 0xba, 0x9a, 0xac, 0xd4, 0xce, 0xeb, 0xe6, 0x34, 0x73, 0xab,
-	0x8b, 0x09, 0x4c, 0x11, 0x36, 0xee, 0x0b, 0x27, 0xfe, 0xd9, 0x01, 0xf5, 0xc2, 0x06, 0x8a, 0x2b,
-	0x50, 0x1a, 0x5a, 0xed, 0x72, 0x5c, 0x1a, 0x46, 0xd8, 0xb8, 0xe1, 0x21, 0xa8, 0x9d, 0x4b, 0xdb,
-	0xee, 0xfd, 0x68, 0xe9, 0xce, 0x69, 0x5c, 0xa2, 0x01, 0xc2, 0xd5, 0xfc, 0x31, 0x2f, 0x24, 0xfe,
-	0xcb, 0x0a, 0x80, 0x9f, 0x68, 0x69, 0xf3, 0xe9, 0x17, 0x33, 0x72, 0x5e, 0x5c, 0x46, 0xea, 0xee,
-	0x0a, 0x89, 0x90, 0xde, 0x38, 0x09, 0x16, 0x87, 0x5f, 0xe6, 0xee, 0xda, 0x8b, 0xe5, 0xe2, 0xee,
-	0xca, 0x51, 0x21, 0x0c, 0x94, 0xf5, 0x99, 0x36, 0x60, 0x1f, 0x5c, 0xcf, 0xf9, 0x3c, 0xc9, 0x22,
-	0x2a, 0x24, 0x89, 0x12, 0x7d, 0x59, 0xae, 0xf6, 0xb6, 0xa7, 0x99, 0x7b, 0xbb, 0x40, 0xb1, 0x08,
-	0x43, 0x78, 0x6b, 0x41, 0xd6, 0x9f, 0xa1, 0x17, 0xe4, 0xfc, 0xde, 0x01, 0xf5, 0xfd, 0x94, 0xf9,
-	0xf4, 0x49, 0x4c, 0x12, 0x31, 0xe2, 0x72, 0x4f, 0xd2, 0x08, 0x36, 0xce, 0xf5, 0xc1, 0xac, 0xea,
-	0x43, 0xd0, 0x30, 0x4d, 0xed, 0x15, 0x8b, 0x5f, 0x79, 0xd8, 0xb9, 0x72, 0x0c, 0x8a, 0x25, 0xeb,
-	0xad, 0x29, 0xc1, 0x30, 0xe4, 0x05, 0x0f, 0xfa, 0xdb, 0x01, 0xb5, 0x73, 0x49, 0xc1, 0xc7, 0x00,
-	0x0a, 0xfb, 0x9f, 0xd3, 0xc1, 0xd1, 0x3a, 0xdc, 0x99, 0x66, 0xee, 0x0d, 0x7b, 0xe7, 0x15, 0x62,
-	0x10, 0xae, 0xcf, 0xc0, 0xb9, 0x04, 0x7a, 0xa0, 0x13, 0xc5, 0xef, 0xcd, 0x17, 0x30, 0x49, 0x23,
-	0xd1, 0x5c, 0xf9, 0x0f, 0x03, 0x5d, 0x50, 0xeb, 0xe2, 0x40, 0x5f, 0xc6, 0xac, 0x07, 0xba, 0xb0,
-	0x52, 0x60, 0x98, 0x14, 0x30, 0x74, 0xe2, 0x00, 0x60, 0xe4, 0xea, 0x4f, 0x48, 0xf2, 0x2f, 0xb5,
-	0xf8, 0x14, 0xac, 0xc9, 0x09, 0x49, 0x6c, 0xef, 0xbd, 0xbb, 0x74, 0x9b, 0xdb, 0x2b, 0x51, 0x71,
-	0x20, 0xac, 0xa9, 0xe0, 0x1b, 0x60, 0x7e, 0xbd, 0x7a, 0x82, 0xfa, 0x3c, 0x0e, 0x84, 0xe9, 0x34,
-	0x7c, 0x6d, 0x86, 0x3f, 0x31, 0x30, 0xfa, 0x0a, 0xc0, 0x03, 0xfd, 0x74, 0x88, 0x49, 0x28, 0x8f,
-	0xdf, 0xe7, 0xe3, 0x58, 0xd2, 0x14, 0xde, 0x01, 0x20, 0x62, 0x42, 0x78, 0xbe, 0xb2, 0xcd, 0xd3,
-	0x03, 0x97, 0x15, 0xa2, 0x03, 0xe0, 0x5d, 0x50, 0x23, 0x03, 0x21, 0x09, 0x8b, 0x6d, 0xc4, 0x8a,
-	0x8e, 0xa8, 0x5a, 0x70, 0x1e, 0x24, 0xc6, 0xbe, 0x4f, 0xe7, 0x34, 0xab, 0x26, 0xc8, 0x82, 0x3a,
-	0xa8, 0xf7, 0xd1, 0xb3, 0xd3, 0x96, 0xf3, 0xfc, 0xb4, 0xe5, 0xfc, 0x71, 0xda, 0x72, 0xbe, 0x3b,
-	0x6b, 0x95, 0x9e, 0x9f, 0xb5, 0x4a, 0xbf, 0x9e, 0xb5, 0x4a, 0x5f, 0xbc, 0x99, 0x13, 0x40, 0x50,
-	0x76, 0x7f, 0x56, 0x45, 0x6d, 0xe8, 0x32, 0x76, 0x9e, 0xda, 0xe7, 0x98, 0x91, 0x63, 0xb0, 0xa1,
-	0x43, 0xde, 0xfa, 0x27, 0x00, 0x00, 0xff, 0xff, 0xa3, 0x10, 0x21, 0xd7, 0xac, 0x09, 0x00, 0x00,
+func (m *OraclePriceRange) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *OraclePriceRange) GetLookbackSeconds() int64 {
+	if m != nil {
+		return m.LookbackSeconds
 	}
+	return 0
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.VotePeriod != that1.VotePeriod {
+type OracleVolatility struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// volatility is the realised volatility of the exchange rate over the lookback
+	Volatility      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=volatility,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"volatility" yaml:"volatility"`
+	LookbackSeconds int64                                  `protobuf:"varint,3,opt,name=lookback_seconds,json=lookbackSeconds,proto3" json:"lookback_seconds,omitempty"`
+}
+
+func (m *OracleVolatility) Reset()         { *m = OracleVolatility{} }
+func (m *OracleVolatility) String() string { return proto.CompactTextString(m) }
+func (*OracleVolatility) ProtoMessage()    {}
+func (*OracleVolatility) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{15}
+}
+func (m *OracleVolatility) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleVolatility) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleVolatility.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleVolatility) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleVolatility.Merge(m, src)
+}
+func (m *OracleVolatility) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleVolatility) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleVolatility.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleVolatility proto.InternalMessageInfo
+
+func (m *OracleVolatility) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *OracleVolatility) GetLookbackSeconds() int64 {
+	if m != nil {
+		return m.LookbackSeconds
+	}
+	return 0
+}
+
+type VotePenaltyCounter struct {
+	MissCount    uint64 `protobuf:"varint,1,opt,name=miss_count,json=missCount,proto3" json:"miss_count,omitempty"`
+	AbstainCount uint64 `protobuf:"varint,2,opt,name=abstain_count,json=abstainCount,proto3" json:"abstain_count,omitempty"`
+	SuccessCount uint64 `protobuf:"varint,3,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+}
+
+func (m *VotePenaltyCounter) Reset()         { *m = VotePenaltyCounter{} }
+func (m *VotePenaltyCounter) String() string { return proto.CompactTextString(m) }
+func (*VotePenaltyCounter) ProtoMessage()    {}
+func (*VotePenaltyCounter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{16}
+}
+func (m *VotePenaltyCounter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VotePenaltyCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VotePenaltyCounter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VotePenaltyCounter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotePenaltyCounter.Merge(m, src)
+}
+func (m *VotePenaltyCounter) XXX_Size() int {
+	return m.Size()
+}
+func (m *VotePenaltyCounter) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotePenaltyCounter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VotePenaltyCounter proto.InternalMessageInfo
+
+func (m *VotePenaltyCounter) GetMissCount() uint64 {
+	if m != nil {
+		return m.MissCount
+	}
+	return 0
+}
+
+func (m *VotePenaltyCounter) GetAbstainCount() uint64 {
+	if m != nil {
+		return m.AbstainCount
+	}
+	return 0
+}
+
+func (m *VotePenaltyCounter) GetSuccessCount() uint64 {
+	if m != nil {
+		return m.SuccessCount
+	}
+	return 0
+}
+
+type OracleRewards struct {
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards" yaml:"rewards"`
+}
+
+func (m *OracleRewards) Reset()         { *m = OracleRewards{} }
+func (m *OracleRewards) String() string { return proto.CompactTextString(m) }
+func (*OracleRewards) ProtoMessage()    {}
+func (*OracleRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{17}
+}
+func (m *OracleRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleRewards.Merge(m, src)
+}
+func (m *OracleRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleRewards proto.InternalMessageInfo
+
+func (m *OracleRewards) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+// BallotVote is an exchange rate submitted by a validator and whether it was within the reward band
+type BallotVote struct {
+	Denom        string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate" yaml:"exchange_rate"`
+	InBand       bool                                   `protobuf:"varint,3,opt,name=in_band,json=inBand,proto3" json:"in_band,omitempty" yaml:"in_band"`
+}
+
+func (m *BallotVote) Reset()         { *m = BallotVote{} }
+func (m *BallotVote) String() string { return proto.CompactTextString(m) }
+func (*BallotVote) ProtoMessage()    {}
+func (*BallotVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{18}
+}
+func (m *BallotVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BallotVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BallotVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BallotVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BallotVote.Merge(m, src)
+}
+func (m *BallotVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *BallotVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_BallotVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BallotVote proto.InternalMessageInfo
+
+// ValidatorBallot is the summary of a validator's vote in a vote period
+type ValidatorBallot struct {
+	Voter   string       `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty" yaml:"voter"`
+	Outcome VoteOutcome  `protobuf:"varint,2,opt,name=outcome,proto3,enum=seiprotocol.seichain.oracle.VoteOutcome" json:"outcome,omitempty" yaml:"outcome"`
+	Votes   []BallotVote `protobuf:"bytes,3,rep,name=votes,proto3" json:"votes" yaml:"votes"`
+}
+
+func (m *ValidatorBallot) Reset()         { *m = ValidatorBallot{} }
+func (m *ValidatorBallot) String() string { return proto.CompactTextString(m) }
+func (*ValidatorBallot) ProtoMessage()    {}
+func (*ValidatorBallot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{19}
+}
+func (m *ValidatorBallot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorBallot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorBallot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorBallot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorBallot.Merge(m, src)
+}
+func (m *ValidatorBallot) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorBallot) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorBallot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorBallot proto.InternalMessageInfo
+
+// VotePeriodBallot is the summary of the ballots tallied at the end of a vote period
+type VotePeriodBallot struct {
+	// the index of the vote period, i.e. the block height divided by the VotePeriod param
+	VotePeriod  uint64 `protobuf:"varint,1,opt,name=vote_period,json=votePeriod,proto3" json:"vote_period,omitempty" yaml:"vote_period"`
+	BlockHeight int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty" yaml:"block_height"`
+	Timestamp   int64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty" yaml:"timestamp"`
+	// the weighted medians set as exchange rates for the vote period
+	Medians          ExchangeRateTuples `protobuf:"bytes,4,rep,name=medians,proto3,castrepeated=ExchangeRateTuples" json:"medians" yaml:"medians"`
+	ValidatorBallots []ValidatorBallot  `protobuf:"bytes,5,rep,name=validator_ballots,json=validatorBallots,proto3" json:"validator_ballots" yaml:"validator_ballots"`
+}
+
+func (m *VotePeriodBallot) Reset()         { *m = VotePeriodBallot{} }
+func (m *VotePeriodBallot) String() string { return proto.CompactTextString(m) }
+func (*VotePeriodBallot) ProtoMessage()    {}
+func (*VotePeriodBallot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{20}
+}
+func (m *VotePeriodBallot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VotePeriodBallot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VotePeriodBallot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VotePeriodBallot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotePeriodBallot.Merge(m, src)
+}
+func (m *VotePeriodBallot) XXX_Size() int {
+	return m.Size()
+}
+func (m *VotePeriodBallot) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotePeriodBallot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VotePeriodBallot proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("seiprotocol.seichain.oracle.VoteOutcome", VoteOutcome_name, VoteOutcome_value)
+	proto.RegisterType((*Params)(nil), "seiprotocol.seichain.oracle.Params")
+	proto.RegisterType((*Denom)(nil), "seiprotocol.seichain.oracle.Denom")
+	proto.RegisterType((*DerivedDenom)(nil), "seiprotocol.seichain.oracle.DerivedDenom")
+	proto.RegisterType((*VoteTargetTransition)(nil), "seiprotocol.seichain.oracle.VoteTargetTransition")
+	proto.RegisterType((*FeederPermission)(nil), "seiprotocol.seichain.oracle.FeederPermission")
+	proto.RegisterType((*AggregateExchangeRatePrevote)(nil), "seiprotocol.seichain.oracle.AggregateExchangeRatePrevote")
+	proto.RegisterType((*AggregateExchangeRateVote)(nil), "seiprotocol.seichain.oracle.AggregateExchangeRateVote")
+	proto.RegisterType((*ExchangeRateTuple)(nil), "seiprotocol.seichain.oracle.ExchangeRateTuple")
+	proto.RegisterType((*OracleExchangeRate)(nil), "seiprotocol.seichain.oracle.OracleExchangeRate")
+	proto.RegisterType((*ExchangeRateConfidence)(nil), "seiprotocol.seichain.oracle.ExchangeRateConfidence")
+	proto.RegisterType((*PriceSnapshotItem)(nil), "seiprotocol.seichain.oracle.PriceSnapshotItem")
+	proto.RegisterType((*PriceSnapshot)(nil), "seiprotocol.seichain.oracle.PriceSnapshot")
+	proto.RegisterType((*OracleTwap)(nil), "seiprotocol.seichain.oracle.OracleTwap")
+	proto.RegisterType((*OracleEma)(nil), "seiprotocol.seichain.oracle.OracleEma")
+	proto.RegisterType((*OraclePriceRange)(nil), "seiprotocol.seichain.oracle.OraclePriceRange")
+	proto.RegisterType((*OracleVolatility)(nil), "seiprotocol.seichain.oracle.OracleVolatility")
+	proto.RegisterType((*VotePenaltyCounter)(nil), "seiprotocol.seichain.oracle.VotePenaltyCounter")
+	proto.RegisterType((*OracleRewards)(nil), "seiprotocol.seichain.oracle.OracleRewards")
+	proto.RegisterType((*BallotVote)(nil), "seiprotocol.seichain.oracle.BallotVote")
+	proto.RegisterType((*ValidatorBallot)(nil), "seiprotocol.seichain.oracle.ValidatorBallot")
+	proto.RegisterType((*VotePeriodBallot)(nil), "seiprotocol.seichain.oracle.VotePeriodBallot")
+}
+
+func init() { proto.RegisterFile("oracle/oracle.proto", fileDescriptor_dc470b50b143d488) }
+
+var fileDescriptor_dc470b50b143d488 = []byte{
+	// 2218 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x8f, 0x1b, 0x49,
+	0x15, 0x9f, 0x1e, 0x4f, 0x26, 0xf1, 0xf3, 0x7c, 0xd8, 0x15, 0x67, 0xf0, 0x64, 0x93, 0xe9, 0xd9,
+	0x0a, 0xbb, 0x3b, 0x81, 0xac, 0x87, 0x04, 0xd0, 0x8a, 0xe1, 0x4b, 0xf1, 0x4c, 0xb2, 0x9b, 0xdd,
+	0x24, 0x33, 0x5b, 0x9e, 0x4c, 0x10, 0x97, 0x56, 0xd9, 0x5d, 0xb1, 0x9b, 0xb8, 0xbb, 0x4d, 0x57,
+	0x7b, 0x3c, 0xc3, 0xc7, 0x5e, 0x10, 0x52, 0x8e, 0x88, 0x13, 0x12, 0x20, 0xe5, 0x88, 0xb8, 0x2f,
+	0x42, 0xfc, 0x05, 0x91, 0xe0, 0xb0, 0xc7, 0x15, 0x07, 0x83, 0x12, 0x21, 0x71, 0x03, 0x99, 0x23,
+	0x17, 0x54, 0x1f, 0x6d, 0x97, 0xdd, 0x9e, 0xd9, 0x98, 0x08, 0x89, 0xd3, 0xb8, 0xde, 0x7b, 0xf5,
+	0x7b, 0x1f, 0xf5, 0xea, 0xbd, 0x7a, 0x3d, 0x70, 0x3e, 0x8c, 0x68, 0xbd, 0xc5, 0x36, 0xd5, 0x9f,
+	0x72, 0x3b, 0x0a, 0xe3, 0x10, 0xbd, 0xc6, 0x99, 0x27, 0x7f, 0xd5, 0xc3, 0x56, 0x99, 0x33, 0xaf,
+	0xde, 0xa4, 0x5e, 0x50, 0x56, 0x22, 0x17, 0x8b, 0x8d, 0xb0, 0x11, 0x4a, 0xee, 0xa6, 0xf8, 0xa5,
+	0xb6, 0x5c, 0x5c, 0xab, 0x87, 0xdc, 0x0f, 0xf9, 0x66, 0x8d, 0x72, 0xb6, 0x79, 0x78, 0xbd, 0xc6,
+	0x62, 0x7a, 0x7d, 0xb3, 0x1e, 0x7a, 0x81, 0xe2, 0xe3, 0x3f, 0x2e, 0xc3, 0xfc, 0x1e, 0x8d, 0xa8,
+	0xcf, 0xd1, 0x3b, 0x90, 0x3b, 0x0c, 0x63, 0xe6, 0xb4, 0x59, 0xe4, 0x85, 0x6e, 0xc9, 0x5a, 0xb7,
+	0x36, 0xe6, 0x2a, 0x2b, 0xfd, 0x9e, 0x8d, 0x8e, 0xa9, 0xdf, 0xda, 0xc2, 0x06, 0x13, 0x13, 0x10,
+	0xab, 0x3d, 0xb9, 0x40, 0x01, 0x2c, 0x49, 0x5e, 0xdc, 0x8c, 0x18, 0x6f, 0x86, 0x2d, 0xb7, 0x34,
+	0xbb, 0x6e, 0x6d, 0x64, 0x2b, 0xef, 0x3e, 0xeb, 0xd9, 0x33, 0x7f, 0xee, 0xd9, 0x6f, 0x36, 0xbc,
+	0xb8, 0xd9, 0xa9, 0x95, 0xeb, 0xa1, 0xbf, 0xa9, 0xcd, 0x51, 0x7f, 0xde, 0xe6, 0xee, 0xe3, 0xcd,
+	0xf8, 0xb8, 0xcd, 0x78, 0x79, 0x87, 0xd5, 0xfb, 0x3d, 0xfb, 0x82, 0xa1, 0x69, 0x80, 0x86, 0xc9,
+	0xa2, 0x20, 0xec, 0x27, 0x6b, 0xc4, 0x20, 0x17, 0xb1, 0x2e, 0x8d, 0x5c, 0xa7, 0x46, 0x03, 0xb7,
+	0x94, 0x91, 0xca, 0x76, 0xa6, 0x56, 0xa6, 0xdd, 0x32, 0xa0, 0x30, 0x01, 0xb5, 0xaa, 0xd0, 0xc0,
+	0x45, 0x0d, 0xc8, 0x76, 0x9b, 0x5e, 0xcc, 0x5a, 0x1e, 0x8f, 0x4b, 0x73, 0xeb, 0x99, 0x8d, 0xdc,
+	0x0d, 0x5c, 0x3e, 0xe5, 0x04, 0xca, 0x3b, 0x2c, 0x08, 0xfd, 0xca, 0x1b, 0xc2, 0x90, 0x7e, 0xcf,
+	0xce, 0x2b, 0xf8, 0x01, 0x04, 0xfe, 0xed, 0x5f, 0xec, 0xac, 0x14, 0xb9, 0xeb, 0xf1, 0x98, 0x0c,
+	0xb1, 0x45, 0xfc, 0x78, 0x8b, 0xf2, 0xa6, 0xf3, 0x28, 0xa2, 0xf5, 0xd8, 0x0b, 0x83, 0xd2, 0x99,
+	0x57, 0x8b, 0xdf, 0x28, 0x1a, 0x26, 0x8b, 0x92, 0x70, 0x5b, 0xaf, 0xd1, 0x16, 0x2c, 0x28, 0x89,
+	0xae, 0x17, 0xb8, 0x61, 0xb7, 0x34, 0x2f, 0x4f, 0xfa, 0x73, 0xfd, 0x9e, 0x7d, 0xde, 0xdc, 0xaf,
+	0xb8, 0x98, 0xe4, 0xe4, 0xf2, 0xa1, 0x5c, 0xa1, 0x8f, 0xa0, 0xe8, 0x7b, 0x81, 0x73, 0x48, 0x5b,
+	0x9e, 0x2b, 0x92, 0x21, 0xc1, 0x38, 0x2b, 0x2d, 0xbe, 0x37, 0xb5, 0xc5, 0xaf, 0x29, 0x8d, 0x93,
+	0x30, 0x31, 0x29, 0xf8, 0x5e, 0x70, 0x20, 0xa8, 0x7b, 0x2c, 0xd2, 0xfa, 0xef, 0x40, 0xa1, 0x15,
+	0x86, 0x8f, 0x6b, 0xb4, 0xfe, 0xd8, 0x71, 0x3b, 0x11, 0x95, 0xe1, 0xca, 0x4a, 0x07, 0x2e, 0xf5,
+	0x7b, 0x76, 0x49, 0xc1, 0xa5, 0x44, 0x30, 0xc9, 0x27, 0xb4, 0x1d, 0x4d, 0x42, 0xdb, 0xb0, 0x1c,
+	0xb1, 0xef, 0x77, 0xbc, 0x88, 0x39, 0xed, 0x88, 0x89, 0x14, 0x2b, 0xc1, 0xba, 0xb5, 0x71, 0xae,
+	0x72, 0xb1, 0xdf, 0xb3, 0x57, 0x92, 0xe4, 0x18, 0x11, 0xc0, 0x64, 0x49, 0x53, 0xf6, 0x14, 0x01,
+	0xd5, 0xe1, 0xa2, 0x4e, 0x20, 0xd7, 0xe3, 0x71, 0xe4, 0xd5, 0x3a, 0x02, 0x3b, 0x89, 0x4a, 0x4e,
+	0x1a, 0xf6, 0x46, 0xbf, 0x67, 0xbf, 0x3e, 0x92, 0x6c, 0x13, 0x64, 0x31, 0x29, 0x29, 0xe6, 0x8e,
+	0xc1, 0xd3, 0x4e, 0xbf, 0x03, 0x39, 0x9f, 0x1e, 0x39, 0x8f, 0x18, 0x73, 0x59, 0xc4, 0x4b, 0x0b,
+	0xe3, 0x37, 0xd3, 0x60, 0x62, 0x02, 0x3e, 0x3d, 0xba, 0xad, 0x16, 0xe8, 0x21, 0xac, 0xc8, 0xbb,
+	0xd4, 0xf4, 0x78, 0x1c, 0x46, 0xc7, 0x4e, 0xc4, 0x62, 0x16, 0xc8, 0x90, 0x2d, 0x4a, 0x8c, 0xd7,
+	0xfb, 0x3d, 0xfb, 0xb2, 0x71, 0xe7, 0x52, 0x72, 0x98, 0x14, 0x05, 0xe3, 0x3d, 0x45, 0x27, 0x09,
+	0x19, 0x75, 0xa1, 0xd0, 0xa5, 0x51, 0xe0, 0x05, 0x0d, 0xe3, 0xd6, 0x2f, 0xc9, 0x1c, 0x78, 0x7f,
+	0xea, 0x1c, 0xd0, 0x87, 0x96, 0x02, 0xc4, 0x24, 0xaf, 0x69, 0xc3, 0xbb, 0x1f, 0xc0, 0xd2, 0xf7,
+	0xa8, 0xd7, 0x32, 0xb4, 0x2e, 0xbf, 0xda, 0x5d, 0x19, 0x45, 0xc3, 0x64, 0x51, 0x10, 0x86, 0xfa,
+	0x7e, 0x04, 0xe7, 0x7d, 0x8f, 0x73, 0xa7, 0xcd, 0x02, 0xda, 0x8a, 0x8f, 0x9d, 0x2e, 0xf3, 0x1a,
+	0xcd, 0xb8, 0x94, 0x97, 0x4a, 0xef, 0x4e, 0xad, 0xf4, 0x62, 0x92, 0xee, 0x29, 0x48, 0x99, 0xed,
+	0x9c, 0xef, 0x29, 0xe2, 0x43, 0x49, 0x43, 0x3f, 0xb5, 0x60, 0x85, 0xd6, 0x78, 0x4c, 0xbd, 0x60,
+	0xdc, 0x82, 0x82, 0xb4, 0x60, 0x77, 0x6a, 0x0b, 0xf4, 0x71, 0x4f, 0x46, 0xc5, 0xa4, 0xa8, 0x19,
+	0xa3, 0x76, 0x7c, 0x00, 0x48, 0xd5, 0x84, 0x46, 0x44, 0xeb, 0x83, 0x0e, 0x81, 0x64, 0x0e, 0x5d,
+	0xee, 0xf7, 0xec, 0x55, 0xb3, 0x6e, 0x98, 0x32, 0x98, 0xe4, 0x25, 0xf1, 0x5d, 0x41, 0xd3, 0xed,
+	0xe2, 0x27, 0x16, 0x2c, 0xb9, 0x2c, 0xf2, 0x0e, 0x99, 0xeb, 0xb8, 0xa2, 0x1e, 0xf2, 0xd2, 0x79,
+	0x59, 0x5d, 0xaf, 0x7e, 0x46, 0x75, 0x95, 0x5b, 0x54, 0x91, 0xbd, 0xae, 0x8b, 0xac, 0x3e, 0xc4,
+	0x51, 0x38, 0x51, 0x69, 0xf3, 0xa6, 0xb8, 0x2c, 0xb8, 0x8b, 0xae, 0x41, 0xe1, 0xa8, 0x09, 0x97,
+	0x54, 0x9b, 0xa1, 0x51, 0x83, 0xc5, 0x4e, 0x3b, 0x0a, 0x6b, 0xb2, 0x2c, 0x24, 0xce, 0x15, 0xa5,
+	0x73, 0x6f, 0xf5, 0x7b, 0xf6, 0x15, 0xb3, 0x29, 0x4d, 0x96, 0xc6, 0x64, 0x55, 0xb6, 0x28, 0xc9,
+	0xdd, 0x4b, 0x98, 0xda, 0x5f, 0x07, 0x56, 0xcd, 0xbd, 0xbc, 0x13, 0x70, 0x01, 0xa1, 0xd4, 0x5c,
+	0x90, 0x6a, 0x3e, 0xdf, 0xef, 0xd9, 0xeb, 0x69, 0x35, 0x23, 0xa2, 0x98, 0xac, 0x0c, 0x75, 0x54,
+	0x25, 0x47, 0x29, 0xd8, 0x3a, 0xf7, 0x8b, 0xa7, 0xf6, 0xcc, 0xdf, 0x9f, 0xda, 0x16, 0xfe, 0xdb,
+	0x2c, 0x9c, 0x91, 0xfe, 0xa1, 0x2b, 0x30, 0x17, 0x50, 0x9f, 0xc9, 0x2e, 0x9e, 0xad, 0x2c, 0xf7,
+	0x7b, 0x76, 0x4e, 0xe1, 0x0b, 0x2a, 0x26, 0x92, 0x89, 0x8e, 0x4e, 0x68, 0xdc, 0x1f, 0x3e, 0xeb,
+	0xd9, 0xd6, 0x54, 0x59, 0x65, 0x4f, 0x6a, 0xdc, 0xd7, 0x42, 0xdf, 0x8b, 0x99, 0xdf, 0x8e, 0x8f,
+	0x53, 0x2d, 0x3c, 0x9c, 0xd4, 0xc2, 0xef, 0x4f, 0xad, 0xf6, 0x52, 0xaa, 0x85, 0x9b, 0x3a, 0xcd,
+	0x66, 0xfe, 0x2d, 0x00, 0xd9, 0x63, 0xc2, 0x58, 0x54, 0xd0, 0x39, 0x19, 0x75, 0x7b, 0xac, 0xff,
+	0x48, 0x9e, 0x09, 0x90, 0x15, 0xfd, 0x47, 0x52, 0xb7, 0x16, 0x9e, 0x3c, 0xb5, 0x67, 0x74, 0x9c,
+	0x67, 0xf0, 0xc7, 0x16, 0x2c, 0x98, 0x09, 0xf6, 0x72, 0xe1, 0xfe, 0x2a, 0x40, 0xd0, 0xf1, 0x59,
+	0x44, 0xe3, 0x30, 0xe2, 0xa5, 0xd9, 0xf5, 0xcc, 0x46, 0xb6, 0x72, 0xa1, 0xdf, 0xb3, 0x0b, 0x5a,
+	0x74, 0xc0, 0xc3, 0xc4, 0x10, 0x44, 0x5f, 0x87, 0x05, 0x99, 0xd7, 0x5e, 0xa0, 0x36, 0x66, 0xe4,
+	0x46, 0xa3, 0x5d, 0x9b, 0x5c, 0x4c, 0x46, 0x84, 0x47, 0xec, 0xb6, 0xf0, 0x47, 0x50, 0x3c, 0x18,
+	0xe4, 0xd0, 0x7e, 0x44, 0x03, 0xee, 0xc9, 0x72, 0xfe, 0x26, 0x9c, 0x91, 0xbb, 0xb4, 0xfd, 0xf9,
+	0x7e, 0xcf, 0x5e, 0x30, 0xb0, 0x31, 0x51, 0x6c, 0xf4, 0x15, 0x00, 0x16, 0xb8, 0x4e, 0x53, 0x95,
+	0x20, 0x91, 0x2c, 0x19, 0xd3, 0x83, 0x21, 0x0f, 0x93, 0x2c, 0x0b, 0xdc, 0xf7, 0xe4, 0xef, 0xad,
+	0x73, 0x4f, 0x92, 0xb8, 0xfd, 0xda, 0x82, 0xbc, 0xea, 0x4d, 0x7b, 0x2c, 0x12, 0xe5, 0x4e, 0x28,
+	0xbf, 0x0a, 0xf3, 0xaa, 0x79, 0x69, 0xed, 0x85, 0x7e, 0xcf, 0x5e, 0x54, 0x80, 0x8a, 0x8e, 0x89,
+	0x16, 0x10, 0xa2, 0xba, 0x62, 0xa8, 0xe8, 0x19, 0xa2, 0xfa, 0xea, 0x13, 0x2d, 0x20, 0x44, 0xd9,
+	0x51, 0xdb, 0x8b, 0x8e, 0x65, 0x72, 0x65, 0x4c, 0x51, 0x45, 0xc7, 0x44, 0x0b, 0x18, 0xf6, 0x7d,
+	0x6c, 0xc1, 0xa5, 0x9b, 0x8d, 0x46, 0xc4, 0x1a, 0x34, 0x66, 0xb7, 0x8e, 0xea, 0x4d, 0x1a, 0x34,
+	0x18, 0xa1, 0xf1, 0xa0, 0xdd, 0x5f, 0x81, 0xb9, 0x26, 0xe5, 0xcd, 0xf4, 0x39, 0x0b, 0x2a, 0x26,
+	0x92, 0x29, 0xa2, 0x29, 0x73, 0xa9, 0x34, 0x3b, 0x1e, 0x4d, 0x49, 0xc6, 0x44, 0xb1, 0xe5, 0x3b,
+	0xac, 0x53, 0xf3, 0xbd, 0xd8, 0xa9, 0xb5, 0xc2, 0xfa, 0xe3, 0x52, 0x26, 0xf5, 0x0e, 0x33, 0xb8,
+	0xe2, 0x1d, 0x26, 0x97, 0x15, 0xb1, 0x1a, 0xcb, 0xc7, 0x7f, 0x58, 0xb0, 0x3a, 0xd1, 0x6e, 0x71,
+	0xda, 0xe8, 0x97, 0x16, 0x14, 0x99, 0x26, 0x3a, 0x11, 0x15, 0x57, 0xb4, 0xd3, 0x6e, 0x31, 0x5e,
+	0xb2, 0x64, 0xd9, 0x2d, 0x9f, 0x5a, 0x76, 0x4d, 0xb4, 0x7d, 0xb1, 0xad, 0xf2, 0x35, 0x5d, 0x7b,
+	0x5f, 0x4b, 0xa2, 0x99, 0x46, 0x16, 0x15, 0x18, 0xa5, 0x76, 0x72, 0x82, 0x58, 0x8a, 0xf6, 0xb2,
+	0xd1, 0x1a, 0xf3, 0xf8, 0x77, 0x16, 0x14, 0x52, 0x0a, 0x5e, 0x3a, 0x8f, 0x1f, 0xc3, 0xe2, 0x88,
+	0xd9, 0x5a, 0xf7, 0xed, 0xa9, 0xbb, 0x69, 0x71, 0x42, 0x0c, 0x30, 0x59, 0x30, 0xdd, 0x1c, 0x33,
+	0xfc, 0x4f, 0xb3, 0x80, 0x76, 0x65, 0x68, 0x4d, 0xf3, 0xd3, 0x16, 0x59, 0xff, 0x3b, 0x8b, 0xc4,
+	0x00, 0xd5, 0xa2, 0x3c, 0x76, 0x3a, 0x6d, 0x77, 0xe8, 0xfc, 0x34, 0x03, 0xd4, 0x9d, 0x20, 0x1e,
+	0xbe, 0x3e, 0x0d, 0x28, 0x4c, 0x40, 0xac, 0x1e, 0xc8, 0x05, 0xda, 0x87, 0x0b, 0x06, 0xcf, 0x89,
+	0x3d, 0x9f, 0xf1, 0x98, 0xfa, 0x6d, 0x7d, 0x23, 0xd7, 0x87, 0x05, 0x7c, 0xa2, 0x18, 0x26, 0xe7,
+	0x87, 0x60, 0xfb, 0x09, 0x75, 0x2c, 0x9c, 0xbf, 0xc9, 0xc0, 0x8a, 0x19, 0xc8, 0xed, 0x30, 0x78,
+	0xe4, 0xb9, 0x2c, 0xa8, 0x33, 0x51, 0xac, 0x82, 0x8e, 0x9f, 0x94, 0x7c, 0x35, 0xce, 0x8e, 0x96,
+	0x5b, 0xcd, 0xc3, 0x24, 0x1b, 0x74, 0x7c, 0x55, 0xe8, 0xd1, 0x31, 0xa0, 0xc3, 0x30, 0x16, 0xef,
+	0xd0, 0x76, 0xd8, 0x65, 0x91, 0xc3, 0x9b, 0x34, 0x4a, 0x42, 0xf4, 0xc1, 0xd4, 0xa7, 0xb1, 0x3a,
+	0xc8, 0xe4, 0x31, 0x44, 0x4c, 0xf2, 0x8a, 0xb8, 0x27, 0x68, 0x55, 0x41, 0x42, 0x3f, 0x00, 0xc4,
+	0x63, 0x1a, 0xb8, 0x72, 0x42, 0x60, 0x87, 0x9e, 0x1a, 0x6e, 0x32, 0xaf, 0xa6, 0x3a, 0x8d, 0x88,
+	0x49, 0x21, 0x21, 0xee, 0x24, 0x34, 0xf4, 0x10, 0xe6, 0x79, 0x3b, 0x62, 0xd4, 0x95, 0xbd, 0x31,
+	0x5b, 0xf9, 0xf6, 0xd4, 0xfa, 0x74, 0x71, 0x55, 0x28, 0x98, 0x68, 0x38, 0xa3, 0xb8, 0xfe, 0xdc,
+	0x82, 0xc2, 0x5e, 0xe4, 0xd5, 0x59, 0x35, 0xa0, 0x6d, 0xde, 0x0c, 0xe3, 0x3b, 0x31, 0xf3, 0x51,
+	0x71, 0xe4, 0xca, 0x26, 0x17, 0xb4, 0x01, 0x45, 0x55, 0x7f, 0x9c, 0xf4, 0x3d, 0xcd, 0xdd, 0xd8,
+	0x3c, 0xb5, 0x62, 0xa5, 0x6f, 0x57, 0x65, 0x4e, 0x78, 0x43, 0x50, 0x98, 0xe2, 0xe0, 0x7f, 0x5b,
+	0xb0, 0x38, 0x62, 0x14, 0xba, 0x0b, 0x88, 0xeb, 0xdf, 0x46, 0xca, 0x5a, 0x32, 0x65, 0xcd, 0xb7,
+	0x6e, 0x4a, 0x46, 0xc4, 0x55, 0x13, 0x07, 0xd9, 0x2a, 0x6b, 0x6f, 0x5b, 0xe0, 0x3b, 0x83, 0x0d,
+	0xe2, 0x79, 0xa1, 0x1a, 0xd8, 0x67, 0xd5, 0xde, 0x54, 0xb4, 0xc6, 0x6b, 0xef, 0x24, 0x64, 0x59,
+	0x7b, 0x53, 0x3b, 0x39, 0x41, 0xed, 0x14, 0x0d, 0x3f, 0xb5, 0x00, 0x54, 0xb8, 0xf6, 0xbb, 0xb4,
+	0x7d, 0xc2, 0x59, 0x7c, 0x08, 0x73, 0x71, 0x97, 0xb6, 0xf5, 0x1d, 0xf8, 0xe6, 0xd4, 0x89, 0xa1,
+	0x3b, 0xa4, 0xc0, 0xc0, 0x44, 0x42, 0xa1, 0xab, 0x30, 0x18, 0xc7, 0x1d, 0xce, 0xea, 0x61, 0xe0,
+	0x72, 0x55, 0x14, 0xc8, 0x72, 0x42, 0xaf, 0x2a, 0x32, 0xfe, 0x95, 0x05, 0x59, 0x7d, 0xa2, 0x3e,
+	0x3d, 0xc1, 0xc2, 0xfb, 0x90, 0x61, 0x3e, 0xd5, 0x06, 0x7e, 0x63, 0x6a, 0x03, 0x41, 0x97, 0x4c,
+	0x9f, 0x62, 0x22, 0x80, 0xa6, 0x31, 0xef, 0x5f, 0x16, 0xe4, 0x95, 0x79, 0x32, 0xe4, 0x44, 0x24,
+	0xd6, 0xc9, 0x56, 0xfa, 0x5e, 0xf0, 0xaa, 0x56, 0xfa, 0x5e, 0x80, 0x89, 0x00, 0x92, 0x78, 0xf4,
+	0xa8, 0x94, 0x79, 0x45, 0x3c, 0x7a, 0x24, 0xf0, 0xe8, 0xd1, 0x44, 0xaf, 0xe7, 0x26, 0x7b, 0xfd,
+	0xfb, 0x81, 0xd7, 0x07, 0x61, 0x8b, 0xc6, 0x5e, 0xcb, 0x8b, 0x8f, 0x4f, 0xf0, 0xba, 0x0e, 0x70,
+	0x38, 0x90, 0xd1, 0xce, 0x6f, 0x4f, 0x6d, 0x6c, 0x21, 0xa9, 0xa3, 0x09, 0x92, 0xfc, 0x02, 0x39,
+	0x50, 0x3d, 0xc5, 0x81, 0xfd, 0x18, 0xd0, 0x81, 0xfc, 0x74, 0x29, 0xe7, 0xdb, 0xed, 0xb0, 0x13,
+	0x88, 0xa7, 0xd8, 0x65, 0x31, 0x1e, 0x70, 0xee, 0xd4, 0xc5, 0x5a, 0xf5, 0x0a, 0xf1, 0xfa, 0xe7,
+	0x5c, 0x0a, 0xa0, 0x2b, 0xb0, 0x98, 0x0c, 0xcc, 0x4a, 0x62, 0x56, 0x4a, 0x2c, 0x68, 0xe2, 0x40,
+	0x88, 0x77, 0xea, 0x75, 0x36, 0x80, 0xc9, 0x28, 0x21, 0x4d, 0x94, 0x42, 0xf8, 0x89, 0x05, 0x8b,
+	0x2a, 0x72, 0x44, 0x0e, 0x27, 0x1c, 0x75, 0xe1, 0xac, 0x9a, 0x53, 0x92, 0xf7, 0xd8, 0x6a, 0x59,
+	0x05, 0xa1, 0x2c, 0xbe, 0xd9, 0x96, 0xf5, 0x37, 0xdb, 0xf2, 0x76, 0xe8, 0x05, 0x95, 0x8a, 0xbe,
+	0xfe, 0x4b, 0xe6, 0xdc, 0x23, 0x6f, 0xfc, 0xc6, 0x4b, 0x84, 0x52, 0x40, 0x70, 0x92, 0x68, 0xc3,
+	0x9f, 0x5a, 0x00, 0x15, 0xda, 0x6a, 0x85, 0xb1, 0x7c, 0x25, 0xfe, 0x3f, 0xbe, 0x9d, 0xd0, 0x17,
+	0xe1, 0xac, 0x17, 0x0c, 0x67, 0xc4, 0x73, 0x15, 0x34, 0xf4, 0x5e, 0x33, 0x30, 0x99, 0xf7, 0x02,
+	0x31, 0xe3, 0x19, 0xad, 0xe6, 0x9f, 0x16, 0x2c, 0xcb, 0x0f, 0x87, 0x34, 0x0e, 0x23, 0xe5, 0xe3,
+	0xf0, 0x9d, 0x69, 0x9d, 0xfe, 0x2a, 0x3f, 0x80, 0xb3, 0x61, 0x27, 0xae, 0x87, 0xbe, 0xf2, 0x6c,
+	0xe9, 0xc6, 0xc6, 0xa9, 0x35, 0x5a, 0xc4, 0x6e, 0x57, 0xc9, 0x9b, 0xc6, 0x69, 0x08, 0x4c, 0x12,
+	0x30, 0x54, 0x55, 0xfa, 0xd5, 0xfc, 0x96, 0xbb, 0xf1, 0xd6, 0xa9, 0xa8, 0xc3, 0x73, 0xa9, 0x14,
+	0xf5, 0x99, 0x1b, 0xc6, 0x72, 0x6d, 0x2c, 0x37, 0x5c, 0xfe, 0x43, 0x06, 0xf2, 0x07, 0x83, 0x6f,
+	0xf2, 0xda, 0xe7, 0xff, 0xfa, 0x93, 0xfe, 0x16, 0x2c, 0xc8, 0xa9, 0x63, 0x74, 0xd4, 0x33, 0x46,
+	0x13, 0x93, 0x8b, 0x49, 0x4e, 0x2e, 0xd5, 0xb8, 0x87, 0x6e, 0x40, 0x76, 0xfc, 0xa9, 0x57, 0x1c,
+	0x7e, 0x0f, 0x37, 0xda, 0xe5, 0x50, 0x0c, 0x1d, 0xc2, 0x59, 0x9f, 0xb9, 0x1e, 0x0d, 0x78, 0x69,
+	0xee, 0x25, 0x1a, 0x63, 0x7a, 0x28, 0xb9, 0x36, 0x7a, 0x33, 0x34, 0xd8, 0x49, 0x73, 0x48, 0xa2,
+	0x0c, 0xfd, 0x10, 0x0a, 0x87, 0x49, 0x9e, 0x38, 0x35, 0x19, 0x34, 0x5e, 0x3a, 0x23, 0x2d, 0xb8,
+	0x76, 0xfa, 0xb1, 0x8f, 0x66, 0x57, 0x65, 0x5d, 0xeb, 0xd7, 0xdf, 0x32, 0x53, 0xa0, 0xe2, 0xbd,
+	0x37, 0xba, 0xc5, 0x38, 0xbc, 0x2f, 0x7c, 0x07, 0x72, 0x46, 0x1e, 0xa1, 0x12, 0x14, 0x0f, 0x76,
+	0xf7, 0x6f, 0x39, 0xbb, 0x0f, 0xf6, 0xb7, 0x77, 0xef, 0xdd, 0x72, 0xaa, 0x0f, 0xb6, 0xb7, 0x6f,
+	0x55, 0xab, 0xf9, 0x99, 0x14, 0xe7, 0x66, 0xa5, 0xba, 0x7f, 0xf3, 0xce, 0xfd, 0xbc, 0x85, 0x2e,
+	0x40, 0x61, 0x84, 0x73, 0xef, 0x4e, 0xb5, 0x9a, 0x9f, 0xad, 0xbc, 0xff, 0xec, 0xf9, 0x9a, 0xf5,
+	0xc9, 0xf3, 0x35, 0xeb, 0xaf, 0xcf, 0xd7, 0xac, 0x9f, 0xbd, 0x58, 0x9b, 0xf9, 0xe4, 0xc5, 0xda,
+	0xcc, 0xa7, 0x2f, 0xd6, 0x66, 0xbe, 0xfb, 0x25, 0xe3, 0xa2, 0x72, 0xe6, 0xbd, 0x9d, 0xb8, 0x2a,
+	0x17, 0xd2, 0xd7, 0xcd, 0x23, 0xfd, 0xef, 0x27, 0x75, 0x6d, 0x6b, 0xf3, 0x52, 0xe4, 0xcb, 0xff,
+	0x19, 0x00, 0xad, 0x91, 0xbf, 0x8b, 0x9c, 0x1a, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.VotePeriod != that1.VotePeriod {
 		return false
 	}
 	if !this.VoteThreshold.Equal(that1.VoteThreshold) {
@@ -592,40 +1339,227 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.LookbackDuration != that1.LookbackDuration {
 		return false
 	}
-	return true
-}
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.RequirePrevote != that1.RequirePrevote {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LookbackDuration != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.LookbackDuration))
-		i--
-		dAtA[i] = 0x48
+	if this.RewardDistributionWindow != that1.RewardDistributionWindow {
+		return false
 	}
-	{
-		size := m.MinValidPerWindow.Size()
-		i -= size
-		if _, err := m.MinValidPerWindow.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
+	if this.MaxFeeders != that1.MaxFeeders {
+		return false
+	}
+	if this.VoteHistoryRetention != that1.VoteHistoryRetention {
+		return false
+	}
+	if !this.WarningThreshold.Equal(that1.WarningThreshold) {
+		return false
+	}
+	if !this.JailThreshold.Equal(that1.JailThreshold) {
+		return false
+	}
+	if !this.MissPenaltyWeight.Equal(that1.MissPenaltyWeight) {
+		return false
+	}
+	if !this.AbstainPenaltyWeight.Equal(that1.AbstainPenaltyWeight) {
+		return false
+	}
+	if this.SlashGracePeriod != that1.SlashGracePeriod {
+		return false
+	}
+	if len(this.DerivedDenoms) != len(that1.DerivedDenoms) {
+		return false
+	}
+	for i := range this.DerivedDenoms {
+		if !this.DerivedDenoms[i].Equal(&that1.DerivedDenoms[i]) {
+			return false
+		}
+	}
+	if this.VoteTargetProbationPeriod != that1.VoteTargetProbationPeriod {
+		return false
+	}
+	if this.VoteTargetSunsetPeriod != that1.VoteTargetSunsetPeriod {
+		return false
+	}
+	return true
+}
+func (this *DerivedDenom) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DerivedDenom)
+	if !ok {
+		that2, ok := that.(DerivedDenom)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if len(this.Numerators) != len(that1.Numerators) {
+		return false
+	}
+	for i := range this.Numerators {
+		if this.Numerators[i] != that1.Numerators[i] {
+			return false
+		}
+	}
+	if len(this.Denominators) != len(that1.Denominators) {
+		return false
+	}
+	for i := range this.Denominators {
+		if this.Denominators[i] != that1.Denominators[i] {
+			return false
+		}
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VoteTargetSunsetPeriod != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VoteTargetSunsetPeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.VoteTargetProbationPeriod != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VoteTargetProbationPeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.DerivedDenoms) > 0 {
+		for iNdEx := len(m.DerivedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DerivedDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.SlashGracePeriod != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.SlashGracePeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	{
+		size := m.AbstainPenaltyWeight.Size()
+		i -= size
+		if _, err := m.AbstainPenaltyWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.MissPenaltyWeight.Size()
+		i -= size
+		if _, err := m.MissPenaltyWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.JailThreshold.Size()
+		i -= size
+		if _, err := m.JailThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.WarningThreshold.Size()
+		i -= size
+		if _, err := m.WarningThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if m.VoteHistoryRetention != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VoteHistoryRetention))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.MaxFeeders != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxFeeders))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.RewardDistributionWindow != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RewardDistributionWindow))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.RequirePrevote {
+		i--
+		if m.RequirePrevote {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.LookbackDuration != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.LookbackDuration))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.MinValidPerWindow.Size()
+		i -= size
+		if _, err := m.MinValidPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x3a
@@ -706,6 +1640,35 @@ func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MinVoters != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinVoters))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RewardBand != nil {
+		{
+			size := m.RewardBand.Size()
+			i -= size
+			if _, err := m.RewardBand.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.VoteThreshold != nil {
+		{
+			size := m.VoteThreshold.Size()
+			i -= size
+			if _, err := m.VoteThreshold.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Name) > 0 {
 		i -= len(m.Name)
 		copy(dAtA[i:], m.Name)
@@ -716,7 +1679,7 @@ func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *AggregateExchangeRateVote) Marshal() (dAtA []byte, err error) {
+func (m *DerivedDenom) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -726,41 +1689,45 @@ func (m *AggregateExchangeRateVote) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AggregateExchangeRateVote) MarshalTo(dAtA []byte) (int, error) {
+func (m *DerivedDenom) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AggregateExchangeRateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DerivedDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Voter) > 0 {
-		i -= len(m.Voter)
-		copy(dAtA[i:], m.Voter)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Voter)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.Denominators) > 0 {
+		for iNdEx := len(m.Denominators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denominators[iNdEx])
+			copy(dAtA[i:], m.Denominators[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Denominators[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if len(m.ExchangeRateTuples) > 0 {
-		for iNdEx := len(m.ExchangeRateTuples) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ExchangeRateTuples[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOracle(dAtA, i, uint64(size))
-			}
+	if len(m.Numerators) > 0 {
+		for iNdEx := len(m.Numerators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Numerators[iNdEx])
+			copy(dAtA[i:], m.Numerators[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Numerators[iNdEx])))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
 		}
 	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ExchangeRateTuple) Marshal() (dAtA []byte, err error) {
+func (m *VoteTargetTransition) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -770,26 +1737,21 @@ func (m *ExchangeRateTuple) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ExchangeRateTuple) MarshalTo(dAtA []byte) (int, error) {
+func (m *VoteTargetTransition) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ExchangeRateTuple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VoteTargetTransition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.ExchangeRate.Size()
-		i -= size
-		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
+	if m.EndHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	i--
-	dAtA[i] = 0x12
 	if len(m.Denom) > 0 {
 		i -= len(m.Denom)
 		copy(dAtA[i:], m.Denom)
@@ -800,7 +1762,7 @@ func (m *ExchangeRateTuple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *OracleExchangeRate) Marshal() (dAtA []byte, err error) {
+func (m *FeederPermission) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -810,45 +1772,41 @@ func (m *OracleExchangeRate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OracleExchangeRate) MarshalTo(dAtA []byte) (int, error) {
+func (m *FeederPermission) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OracleExchangeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FeederPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LastUpdateTimestamp != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.LastUpdateTimestamp))
+	if m.Expiry != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Expiry))
 		i--
 		dAtA[i] = 0x18
 	}
-	{
-		size := m.LastUpdate.Size()
-		i -= size
-		if _, err := m.LastUpdate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.ExchangeRate.Size()
-		i -= size
-		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
+	if len(m.Feeder) > 0 {
+		i -= len(m.Feeder)
+		copy(dAtA[i:], m.Feeder)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Feeder)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *PriceSnapshotItem) Marshal() (dAtA []byte, err error) {
+func (m *AggregateExchangeRatePrevote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -858,37 +1816,39 @@ func (m *PriceSnapshotItem) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PriceSnapshotItem) MarshalTo(dAtA []byte) (int, error) {
+func (m *AggregateExchangeRatePrevote) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PriceSnapshotItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AggregateExchangeRatePrevote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.OracleExchangeRate.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintOracle(dAtA, i, uint64(size))
+	if m.SubmitBlock != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.SubmitBlock))
+		i--
+		dAtA[i] = 0x18
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Hash)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PriceSnapshot) Marshal() (dAtA []byte, err error) {
+func (m *AggregateExchangeRateVote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -898,20 +1858,27 @@ func (m *PriceSnapshot) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PriceSnapshot) MarshalTo(dAtA []byte) (int, error) {
+func (m *AggregateExchangeRateVote) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PriceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AggregateExchangeRateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PriceSnapshotItems) > 0 {
-		for iNdEx := len(m.PriceSnapshotItems) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ExchangeRateTuples) > 0 {
+		for iNdEx := len(m.ExchangeRateTuples) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PriceSnapshotItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ExchangeRateTuples[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -919,18 +1886,13 @@ func (m *PriceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintOracle(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0xa
 		}
 	}
-	if m.SnapshotTimestamp != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.SnapshotTimestamp))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OracleTwap) Marshal() (dAtA []byte, err error) {
+func (m *ExchangeRateTuple) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -940,25 +1902,20 @@ func (m *OracleTwap) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OracleTwap) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExchangeRateTuple) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OracleTwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ExchangeRateTuple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LookbackSeconds != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.LookbackSeconds))
-		i--
-		dAtA[i] = 0x18
-	}
 	{
-		size := m.Twap.Size()
+		size := m.ExchangeRate.Size()
 		i -= size
-		if _, err := m.Twap.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintOracle(dAtA, i, uint64(size))
@@ -975,7 +1932,7 @@ func (m *OracleTwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *VotePenaltyCounter) Marshal() (dAtA []byte, err error) {
+func (m *OracleExchangeRate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -985,216 +1942,2891 @@ func (m *VotePenaltyCounter) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VotePenaltyCounter) MarshalTo(dAtA []byte) (int, error) {
+func (m *OracleExchangeRate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VotePenaltyCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OracleExchangeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.SuccessCount != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.SuccessCount))
+	if m.LastUpdateTimestamp != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.LastUpdateTimestamp))
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.AbstainCount != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.AbstainCount))
-		i--
-		dAtA[i] = 0x10
+	{
+		size := m.LastUpdate.Size()
+		i -= size
+		if _, err := m.LastUpdate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	if m.MissCount != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.MissCount))
-		i--
-		dAtA[i] = 0x8
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOracle(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ExchangeRateConfidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ExchangeRateConfidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeRateConfidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.VotePeriod != 0 {
-		n += 1 + sovOracle(uint64(m.VotePeriod))
+	{
+		size := m.Spread.Size()
+		i -= size
+		if _, err := m.Spread.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	l = m.VoteThreshold.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.RewardBand.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if len(m.Whitelist) > 0 {
-		for _, e := range m.Whitelist {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.StandardDeviation.Size()
+		i -= size
+		if _, err := m.StandardDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	l = m.SlashFraction.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.SlashWindow != 0 {
-		n += 1 + sovOracle(uint64(m.SlashWindow))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.VotingPowerShare.Size()
+		i -= size
+		if _, err := m.VotingPowerShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	l = m.MinValidPerWindow.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.LookbackDuration != 0 {
-		n += 1 + sovOracle(uint64(m.LookbackDuration))
+	i--
+	dAtA[i] = 0x12
+	if m.NumVoters != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.NumVoters))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Denom) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+func (m *PriceSnapshotItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AggregateExchangeRateVote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PriceSnapshotItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceSnapshotItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ExchangeRateTuples) > 0 {
-		for _, e := range m.ExchangeRateTuples {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
+	{
+		size, err := m.OracleExchangeRate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ExchangeRateTuple) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PriceSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if len(m.PriceSnapshotItems) > 0 {
+		for iNdEx := len(m.PriceSnapshotItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceSnapshotItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = m.ExchangeRate.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
+	if m.SnapshotTimestamp != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.SnapshotTimestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *OracleExchangeRate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ExchangeRate.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.LastUpdate.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.LastUpdateTimestamp != 0 {
-		n += 1 + sovOracle(uint64(m.LastUpdateTimestamp))
+func (m *OracleTwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PriceSnapshotItem) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OracleTwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleTwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LookbackSeconds != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.LookbackSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Twap.Size()
+		i -= size
+		if _, err := m.Twap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleEma) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleEma) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleEma) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.LookbackSeconds != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.LookbackSeconds))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = m.OracleExchangeRate.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
+	{
+		size := m.Ema.Size()
+		i -= size
+		if _, err := m.Ema.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PriceSnapshot) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OraclePriceRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OraclePriceRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OraclePriceRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SnapshotTimestamp != 0 {
-		n += 1 + sovOracle(uint64(m.SnapshotTimestamp))
+	if m.LookbackSeconds != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.LookbackSeconds))
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.PriceSnapshotItems) > 0 {
-		for _, e := range m.PriceSnapshotItems {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
+	{
+		size := m.Max.Size()
+		i -= size
+		if _, err := m.Max.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Min.Size()
+		i -= size
+		if _, err := m.Min.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleVolatility) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleVolatility) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleVolatility) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LookbackSeconds != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.LookbackSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Volatility.Size()
+		i -= size
+		if _, err := m.Volatility.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VotePenaltyCounter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotePenaltyCounter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotePenaltyCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SuccessCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.SuccessCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AbstainCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.AbstainCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MissCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MissCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BallotVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BallotVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BallotVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InBand {
+		i--
+		if m.InBand {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorBallot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorBallot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorBallot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Outcome != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Outcome))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VotePeriodBallot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotePeriodBallot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotePeriodBallot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorBallots) > 0 {
+		for iNdEx := len(m.ValidatorBallots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorBallots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Medians) > 0 {
+		for iNdEx := len(m.Medians) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Medians[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.VotePeriod != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VotePeriod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOracle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotePeriod != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriod))
+	}
+	l = m.VoteThreshold.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.RewardBand.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if len(m.Whitelist) > 0 {
+		for _, e := range m.Whitelist {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.SlashWindow != 0 {
+		n += 1 + sovOracle(uint64(m.SlashWindow))
+	}
+	l = m.MinValidPerWindow.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.LookbackDuration != 0 {
+		n += 1 + sovOracle(uint64(m.LookbackDuration))
+	}
+	if m.RequirePrevote {
+		n += 2
+	}
+	if m.RewardDistributionWindow != 0 {
+		n += 1 + sovOracle(uint64(m.RewardDistributionWindow))
+	}
+	if m.MaxFeeders != 0 {
+		n += 1 + sovOracle(uint64(m.MaxFeeders))
+	}
+	if m.VoteHistoryRetention != 0 {
+		n += 1 + sovOracle(uint64(m.VoteHistoryRetention))
+	}
+	l = m.WarningThreshold.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.JailThreshold.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.MissPenaltyWeight.Size()
+	n += 2 + l + sovOracle(uint64(l))
+	l = m.AbstainPenaltyWeight.Size()
+	n += 2 + l + sovOracle(uint64(l))
+	if m.SlashGracePeriod != 0 {
+		n += 2 + sovOracle(uint64(m.SlashGracePeriod))
+	}
+	if len(m.DerivedDenoms) > 0 {
+		for _, e := range m.DerivedDenoms {
+			l = e.Size()
+			n += 2 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.VoteTargetProbationPeriod != 0 {
+		n += 2 + sovOracle(uint64(m.VoteTargetProbationPeriod))
+	}
+	if m.VoteTargetSunsetPeriod != 0 {
+		n += 2 + sovOracle(uint64(m.VoteTargetSunsetPeriod))
+	}
+	return n
+}
+
+func (m *Denom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.VoteThreshold != nil {
+		l = m.VoteThreshold.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.RewardBand != nil {
+		l = m.RewardBand.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.MinVoters != 0 {
+		n += 1 + sovOracle(uint64(m.MinVoters))
+	}
+	return n
+}
+
+func (m *DerivedDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.Numerators) > 0 {
+		for _, s := range m.Numerators {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if len(m.Denominators) > 0 {
+		for _, s := range m.Denominators {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VoteTargetTransition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovOracle(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *FeederPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Feeder)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.Expiry != 0 {
+		n += 1 + sovOracle(uint64(m.Expiry))
+	}
+	return n
+}
+
+func (m *AggregateExchangeRatePrevote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.SubmitBlock != 0 {
+		n += 1 + sovOracle(uint64(m.SubmitBlock))
+	}
+	return n
+}
+
+func (m *AggregateExchangeRateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExchangeRateTuples) > 0 {
+		for _, e := range m.ExchangeRateTuples {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *ExchangeRateTuple) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *OracleExchangeRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.LastUpdate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.LastUpdateTimestamp != 0 {
+		n += 1 + sovOracle(uint64(m.LastUpdateTimestamp))
+	}
+	return n
+}
+
+func (m *ExchangeRateConfidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumVoters != 0 {
+		n += 1 + sovOracle(uint64(m.NumVoters))
+	}
+	l = m.VotingPowerShare.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.StandardDeviation.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.Spread.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *PriceSnapshotItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.OracleExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *PriceSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SnapshotTimestamp != 0 {
+		n += 1 + sovOracle(uint64(m.SnapshotTimestamp))
+	}
+	if len(m.PriceSnapshotItems) > 0 {
+		for _, e := range m.PriceSnapshotItems {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OracleTwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.Twap.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.LookbackSeconds != 0 {
+		n += 1 + sovOracle(uint64(m.LookbackSeconds))
+	}
+	return n
+}
+
+func (m *OracleEma) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.Ema.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.LookbackSeconds != 0 {
+		n += 1 + sovOracle(uint64(m.LookbackSeconds))
+	}
+	return n
+}
+
+func (m *OraclePriceRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.Min.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.Max.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.LookbackSeconds != 0 {
+		n += 1 + sovOracle(uint64(m.LookbackSeconds))
+	}
+	return n
+}
+
+func (m *OracleVolatility) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.Volatility.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.LookbackSeconds != 0 {
+		n += 1 + sovOracle(uint64(m.LookbackSeconds))
+	}
+	return n
+}
+
+func (m *VotePenaltyCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MissCount != 0 {
+		n += 1 + sovOracle(uint64(m.MissCount))
+	}
+	if m.AbstainCount != 0 {
+		n += 1 + sovOracle(uint64(m.AbstainCount))
+	}
+	if m.SuccessCount != 0 {
+		n += 1 + sovOracle(uint64(m.SuccessCount))
+	}
+	return n
+}
+
+func (m *OracleRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BallotVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.InBand {
+		n += 2
+	}
+	return n
+}
+
+func (m *ValidatorBallot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Outcome != 0 {
+		n += 1 + sovOracle(uint64(m.Outcome))
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VotePeriodBallot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotePeriod != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriod))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovOracle(uint64(m.BlockHeight))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovOracle(uint64(m.Timestamp))
+	}
+	if len(m.Medians) > 0 {
+		for _, e := range m.Medians {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if len(m.ValidatorBallots) > 0 {
+		for _, e := range m.ValidatorBallots {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovOracle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOracle(x uint64) (n int) {
+	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			}
+			m.VotePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Whitelist = append(m.Whitelist, Denom{})
+			if err := m.Whitelist[len(m.Whitelist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
+			}
+			m.SlashWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackDuration", wireType)
+			}
+			m.LookbackDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LookbackDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequirePrevote", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequirePrevote = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDistributionWindow", wireType)
+			}
+			m.RewardDistributionWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardDistributionWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeeders", wireType)
+			}
+			m.MaxFeeders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFeeders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteHistoryRetention", wireType)
+			}
+			m.VoteHistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteHistoryRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WarningThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WarningThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.JailThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissPenaltyWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MissPenaltyWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstainPenaltyWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AbstainPenaltyWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashGracePeriod", wireType)
+			}
+			m.SlashGracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashGracePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivedDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivedDenoms = append(m.DerivedDenoms, DerivedDenom{})
+			if err := m.DerivedDenoms[len(m.DerivedDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteTargetProbationPeriod", wireType)
+			}
+			m.VoteTargetProbationPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteTargetProbationPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteTargetSunsetPeriod", wireType)
+			}
+			m.VoteTargetSunsetPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteTargetSunsetPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Denom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Denom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.VoteThreshold = &v
+			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.RewardBand = &v
+			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVoters", wireType)
+			}
+			m.MinVoters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinVoters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DerivedDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DerivedDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DerivedDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Numerators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Numerators = append(m.Numerators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denominators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denominators = append(m.Denominators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoteTargetTransition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteTargetTransition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteTargetTransition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeederPermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeederPermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeederPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Feeder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			m.Expiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
+			}
+			m.SubmitBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateTuples", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRateTuples = append(m.ExchangeRateTuples, ExchangeRateTuple{})
+			if err := m.ExchangeRateTuples[len(m.ExchangeRateTuples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeRateTuple: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeRateTuple: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleExchangeRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleExchangeRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleExchangeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTimestamp", wireType)
+			}
+			m.LastUpdateTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdateTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeRateConfidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeRateConfidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeRateConfidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumVoters", wireType)
+			}
+			m.NumVoters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumVoters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingPowerShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StandardDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StandardDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spread", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spread.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *OracleTwap) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
-	l = m.Twap.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.LookbackSeconds != 0 {
-		n += 1 + sovOracle(uint64(m.LookbackSeconds))
-	}
-	return n
-}
 
-func (m *VotePenaltyCounter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MissCount != 0 {
-		n += 1 + sovOracle(uint64(m.MissCount))
-	}
-	if m.AbstainCount != 0 {
-		n += 1 + sovOracle(uint64(m.AbstainCount))
-	}
-	if m.SuccessCount != 0 {
-		n += 1 + sovOracle(uint64(m.SuccessCount))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovOracle(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozOracle(x uint64) (n int) {
-	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *PriceSnapshotItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1217,34 +4849,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceSnapshotItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceSnapshotItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
-			}
-			m.VotePeriod = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VotePeriod |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1272,15 +4885,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleExchangeRate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1290,29 +4901,97 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OracleExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTimestamp", wireType)
+			}
+			m.SnapshotTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSnapshotItems", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1339,14 +5018,64 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Whitelist = append(m.Whitelist, Denom{})
-			if err := m.Whitelist[len(m.Whitelist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PriceSnapshotItems = append(m.PriceSnapshotItems, PriceSnapshotItem{})
+			if err := m.PriceSnapshotItems[len(m.PriceSnapshotItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleTwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleTwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleTwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1374,32 +5103,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
-			}
-			m.SlashWindow = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SlashWindow |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1427,15 +5135,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LookbackDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
 			}
-			m.LookbackDuration = 0
+			m.LookbackSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1445,7 +5153,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LookbackDuration |= uint64(b&0x7F) << shift
+				m.LookbackSeconds |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1471,7 +5179,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Denom) Unmarshal(dAtA []byte) error {
+func (m *OracleEma) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1494,15 +5202,15 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Denom: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleEma: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleEma: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1530,8 +5238,61 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
+			}
+			m.LookbackSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LookbackSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1553,7 +5314,7 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
+func (m *OraclePriceRange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1576,17 +5337,17 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: OraclePriceRange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OraclePriceRange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateTuples", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1596,29 +5357,61 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExchangeRateTuples = append(m.ExchangeRateTuples, ExchangeRateTuple{})
-			if err := m.ExchangeRateTuples[len(m.ExchangeRateTuples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Min.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1646,8 +5439,29 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			if err := m.Max.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
+			}
+			m.LookbackSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LookbackSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1669,7 +5483,7 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
+func (m *OracleVolatility) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1692,10 +5506,10 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExchangeRateTuple: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleVolatility: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExchangeRateTuple: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleVolatility: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1732,7 +5546,7 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volatility", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1760,10 +5574,29 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Volatility.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
+			}
+			m.LookbackSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LookbackSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1785,7 +5618,7 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleExchangeRate) Unmarshal(dAtA []byte) error {
+func (m *VotePenaltyCounter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1808,51 +5641,17 @@ func (m *OracleExchangeRate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleExchangeRate: wiretype end group for non-group")
+			return fmt.Errorf("proto: VotePenaltyCounter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleExchangeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VotePenaltyCounter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCount", wireType)
 			}
-			var stringLen uint64
+			m.MissCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1862,31 +5661,35 @@ func (m *OracleExchangeRate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MissCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
 			}
-			if err := m.LastUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.AbstainCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AbstainCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCount", wireType)
 			}
-			m.LastUpdateTimestamp = 0
+			m.SuccessCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1896,7 +5699,7 @@ func (m *OracleExchangeRate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastUpdateTimestamp |= int64(b&0x7F) << shift
+				m.SuccessCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1922,7 +5725,7 @@ func (m *OracleExchangeRate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PriceSnapshotItem) Unmarshal(dAtA []byte) error {
+func (m *OracleRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1945,47 +5748,15 @@ func (m *PriceSnapshotItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PriceSnapshotItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriceSnapshotItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleExchangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2012,7 +5783,8 @@ func (m *PriceSnapshotItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OracleExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2037,7 +5809,7 @@ func (m *PriceSnapshotItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
+func (m *BallotVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2060,17 +5832,17 @@ func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PriceSnapshot: wiretype end group for non-group")
+			return fmt.Errorf("proto: BallotVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BallotVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTimestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.SnapshotTimestamp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2080,16 +5852,29 @@ func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SnapshotTimestamp |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceSnapshotItems", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2099,26 +5884,46 @@ func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriceSnapshotItems = append(m.PriceSnapshotItems, PriceSnapshotItem{})
-			if err := m.PriceSnapshotItems[len(m.PriceSnapshotItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InBand", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InBand = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -2140,7 +5945,7 @@ func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleTwap) Unmarshal(dAtA []byte) error {
+func (m *ValidatorBallot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2163,15 +5968,15 @@ func (m *OracleTwap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleTwap: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorBallot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleTwap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorBallot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2199,13 +6004,13 @@ func (m *OracleTwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
 			}
-			var stringLen uint64
+			m.Outcome = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2215,31 +6020,16 @@ func (m *OracleTwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Outcome |= VoteOutcome(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
-			m.LookbackSeconds = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2249,11 +6039,26 @@ func (m *OracleTwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LookbackSeconds |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, BallotVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -2275,7 +6080,7 @@ func (m *OracleTwap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VotePenaltyCounter) Unmarshal(dAtA []byte) error {
+func (m *VotePeriodBallot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2298,17 +6103,17 @@ func (m *VotePenaltyCounter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VotePenaltyCounter: wiretype end group for non-group")
+			return fmt.Errorf("proto: VotePeriodBallot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VotePenaltyCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VotePeriodBallot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
 			}
-			m.MissCount = 0
+			m.VotePeriod = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2318,16 +6123,16 @@ func (m *VotePenaltyCounter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MissCount |= uint64(b&0x7F) << shift
+				m.VotePeriod |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.AbstainCount = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2337,16 +6142,16 @@ func (m *VotePenaltyCounter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AbstainCount |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.SuccessCount = 0
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2356,11 +6161,79 @@ func (m *VotePenaltyCounter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SuccessCount |= uint64(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Medians", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Medians = append(m.Medians, ExchangeRateTuple{})
+			if err := m.Medians[len(m.Medians)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBallots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorBallots = append(m.ValidatorBallots, ValidatorBallot{})
+			if err := m.ValidatorBallots[len(m.ValidatorBallots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -21,12 +21,32 @@ var (
 	KeySlashWindow       = []byte("SlashWindow")
 	KeyMinValidPerWindow = []byte("MinValidPerWindow")
 	KeyLookbackDuration  = []byte("LookbackDuration")
+	KeyRequirePrevote    = []byte("RequirePrevote")
+	// KeyRewardDistributionWindow is the param key for the reward distribution window
+	KeyRewardDistributionWindow  = []byte("RewardDistributionWindow")
+	KeyMaxFeeders                = []byte("MaxFeeders")
+	KeyVoteHistoryRetention      = []byte("VoteHistoryRetention")
+	KeyWarningThreshold          = []byte("WarningThreshold")
+	KeyJailThreshold             = []byte("JailThreshold")
+	KeyMissPenaltyWeight         = []byte("MissPenaltyWeight")
+	KeyAbstainPenaltyWeight      = []byte("AbstainPenaltyWeight")
+	KeySlashGracePeriod          = []byte("SlashGracePeriod")
+	KeyDerivedDenoms             = []byte("DerivedDenoms")
+	KeyVoteTargetProbationPeriod = []byte("VoteTargetProbationPeriod")
+	KeyVoteTargetSunsetPeriod    = []byte("VoteTargetSunsetPeriod")
 )
 
 // Default parameter values
 const (
 	DefaultVotePeriod  = 2                      // Voting every other block
 	DefaultSlashWindow = utils.BlocksPerDay * 2 // 2 days for oracle slashing
+
+	DefaultRewardDistributionWindow  = utils.BlocksPerYear        // 1 year for reward distribution
+	DefaultMaxFeeders                = uint64(3)                  // additional feeders per validator
+	DefaultVoteHistoryRetention      = uint64(100)                // vote periods of ballot summaries
+	DefaultSlashGracePeriod          = uint64(DefaultSlashWindow) // one slash window after bonding
+	DefaultVoteTargetProbationPeriod = uint64(DefaultSlashWindow) // one slash window after being added
+	DefaultVoteTargetSunsetPeriod    = uint64(utils.BlocksPerDay) // one day after being removed
 )
 
 // Default parameter values
@@ -42,6 +62,14 @@ var (
 	DefaultSlashFraction     = sdk.NewDecWithPrec(0, 4) // 0.00%
 	DefaultMinValidPerWindow = sdk.NewDecWithPrec(5, 2) // 5%
 	DefaultLookbackDuration  = uint64(3600)             // in seconds
+	DefaultRequirePrevote    = false
+	// jailing below the slashing threshold keeps the penalty of validators
+	// that fall below MinValidPerWindow unchanged
+	DefaultWarningThreshold     = sdk.NewDecWithPrec(10, 2) // 10%
+	DefaultJailThreshold        = DefaultMinValidPerWindow
+	DefaultMissPenaltyWeight    = sdk.OneDec()
+	DefaultAbstainPenaltyWeight = sdk.OneDec()
+	DefaultDerivedDenoms        = DerivedDenomList{}
 )
 
 var _ paramstypes.ParamSet = &Params{}
@@ -49,14 +77,26 @@ var _ paramstypes.ParamSet = &Params{}
 // DefaultParams creates default oracle module parameters
 func DefaultParams() Params {
 	return Params{
-		VotePeriod:        DefaultVotePeriod,
-		VoteThreshold:     DefaultVoteThreshold,
-		RewardBand:        DefaultRewardBand,
-		Whitelist:         DefaultWhitelist,
-		SlashFraction:     DefaultSlashFraction,
-		SlashWindow:       DefaultSlashWindow,
-		MinValidPerWindow: DefaultMinValidPerWindow,
-		LookbackDuration:  DefaultLookbackDuration,
+		VotePeriod:                DefaultVotePeriod,
+		VoteThreshold:             DefaultVoteThreshold,
+		RewardBand:                DefaultRewardBand,
+		Whitelist:                 DefaultWhitelist,
+		SlashFraction:             DefaultSlashFraction,
+		SlashWindow:               DefaultSlashWindow,
+		MinValidPerWindow:         DefaultMinValidPerWindow,
+		LookbackDuration:          DefaultLookbackDuration,
+		RequirePrevote:            DefaultRequirePrevote,
+		RewardDistributionWindow:  DefaultRewardDistributionWindow,
+		MaxFeeders:                DefaultMaxFeeders,
+		VoteHistoryRetention:      DefaultVoteHistoryRetention,
+		WarningThreshold:          DefaultWarningThreshold,
+		JailThreshold:             DefaultJailThreshold,
+		MissPenaltyWeight:         DefaultMissPenaltyWeight,
+		AbstainPenaltyWeight:      DefaultAbstainPenaltyWeight,
+		SlashGracePeriod:          DefaultSlashGracePeriod,
+		DerivedDenoms:             DefaultDerivedDenoms,
+		VoteTargetProbationPeriod: DefaultVoteTargetProbationPeriod,
+		VoteTargetSunsetPeriod:    DefaultVoteTargetSunsetPeriod,
 	}
 }
 
@@ -77,6 +117,18 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeySlashWindow, &p.SlashWindow, validateSlashWindow),
 		paramstypes.NewParamSetPair(KeyMinValidPerWindow, &p.MinValidPerWindow, validateMinValidPerWindow),
 		paramstypes.NewParamSetPair(KeyLookbackDuration, &p.LookbackDuration, validateLookbackDuration),
+		paramstypes.NewParamSetPair(KeyRequirePrevote, &p.RequirePrevote, validateRequirePrevote),
+		paramstypes.NewParamSetPair(KeyRewardDistributionWindow, &p.RewardDistributionWindow, validateRewardDistributionWindow),
+		paramstypes.NewParamSetPair(KeyMaxFeeders, &p.MaxFeeders, validateMaxFeeders),
+		paramstypes.NewParamSetPair(KeyVoteHistoryRetention, &p.VoteHistoryRetention, validateVoteHistoryRetention),
+		paramstypes.NewParamSetPair(KeyWarningThreshold, &p.WarningThreshold, validateMinValidPerWindow),
+		paramstypes.NewParamSetPair(KeyJailThreshold, &p.JailThreshold, validateMinValidPerWindow),
+		paramstypes.NewParamSetPair(KeyMissPenaltyWeight, &p.MissPenaltyWeight, validatePenaltyWeight),
+		paramstypes.NewParamSetPair(KeyAbstainPenaltyWeight, &p.AbstainPenaltyWeight, validatePenaltyWeight),
+		paramstypes.NewParamSetPair(KeySlashGracePeriod, &p.SlashGracePeriod, validateSlashGracePeriod),
+		paramstypes.NewParamSetPair(KeyDerivedDenoms, &p.DerivedDenoms, validateDerivedDenoms),
+		paramstypes.NewParamSetPair(KeyVoteTargetProbationPeriod, &p.VoteTargetProbationPeriod, validateVoteTargetTransitionPeriod),
+		paramstypes.NewParamSetPair(KeyVoteTargetSunsetPeriod, &p.VoteTargetSunsetPeriod, validateVoteTargetTransitionPeriod),
 	}
 }
 
@@ -111,13 +163,45 @@ func (p Params) Validate() error {
 		return fmt.Errorf("oracle parameter SlashWindow must be divisible by VotePeriod")
 	}
 
+	if p.RewardDistributionWindow < p.VotePeriod {
+		return fmt.Errorf("oracle parameter RewardDistributionWindow must be greater than or equal with VotePeriod")
+	}
+
 	if p.MinValidPerWindow.GT(sdk.OneDec()) || p.MinValidPerWindow.IsNegative() {
 		return fmt.Errorf("oracle parameter MinValidPerWindow must be between [0, 1]")
 	}
 
+	if p.WarningThreshold.GT(sdk.OneDec()) || p.WarningThreshold.LT(p.MinValidPerWindow) {
+		return fmt.Errorf("oracle parameter WarningThreshold must be between [MinValidPerWindow, 1]")
+	}
+
+	if p.JailThreshold.IsNegative() || p.JailThreshold.GT(p.MinValidPerWindow) {
+		return fmt.Errorf("oracle parameter JailThreshold must be between [0, MinValidPerWindow]")
+	}
+
+	if p.MissPenaltyWeight.IsNegative() || p.AbstainPenaltyWeight.IsNegative() {
+		return fmt.Errorf("oracle parameters MissPenaltyWeight and AbstainPenaltyWeight must not be negative")
+	}
+
 	for _, denom := range p.Whitelist {
-		if len(denom.Name) == 0 {
-			return fmt.Errorf("oracle parameter Whitelist Denom must have name")
+		if err := denom.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := validateDerivedDenoms(p.DerivedDenoms); err != nil {
+		return err
+	}
+
+	// derived denoms are computed from the voted denoms only
+	for _, derived := range p.DerivedDenoms {
+		if p.Whitelist.Contains(derived.Name) {
+			return fmt.Errorf("derived denom %s is already in the whitelist", derived.Name)
+		}
+		for _, denom := range derived.Terms() {
+			if !p.Whitelist.Contains(denom) {
+				return fmt.Errorf("derived denom %s references %s which is not in the whitelist", derived.Name, denom)
+			}
 		}
 	}
 	return nil
@@ -177,9 +261,29 @@ func validateWhitelist(i interface{}) error {
 	}
 
 	for _, d := range v {
-		if len(d.Name) == 0 {
-			return fmt.Errorf("oracle parameter Whitelist Denom must have name")
+		if err := d.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateDerivedDenoms(i interface{}) error {
+	v, ok := i.(DerivedDenomList)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	names := make(map[string]struct{}, len(v))
+	for _, d := range v {
+		if err := d.Validate(); err != nil {
+			return err
+		}
+		if _, ok := names[d.Name]; ok {
+			return fmt.Errorf("duplicate derived denom %s", d.Name)
 		}
+		names[d.Name] = struct{}{}
 	}
 
 	return nil
@@ -240,3 +344,74 @@ func validateLookbackDuration(i interface{}) error {
 
 	return nil
 }
+
+func validateRequirePrevote(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateRewardDistributionWindow(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("reward distribution window must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxFeeders(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateVoteHistoryRetention(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validatePenaltyWeight(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("penalty weight must not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateSlashGracePeriod(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateVoteTargetTransitionPeriod(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
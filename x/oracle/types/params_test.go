@@ -6,6 +6,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/oracle/utils"
 )
 
 func TestParamsValid(t *testing.T) {
@@ -64,4 +66,43 @@ func TestParamsValid(t *testing.T) {
 	p9 := DefaultParams()
 	require.NotNil(t, p9.ParamSetPairs())
 	require.NotNil(t, p9.String())
+
+	require.NoError(t, validateRequirePrevote(true))
+	require.Error(t, validateRequirePrevote("true"))
+
+	// warning threshold below min valid per window
+	pw := DefaultParams()
+	pw.WarningThreshold = pw.MinValidPerWindow.Sub(sdk.NewDecWithPrec(1, 2))
+	require.Error(t, pw.Validate())
+
+	// jail threshold above min valid per window
+	pj := DefaultParams()
+	pj.JailThreshold = pj.MinValidPerWindow.Add(sdk.NewDecWithPrec(1, 2))
+	require.Error(t, pj.Validate())
+
+	// negative penalty weight
+	pm := DefaultParams()
+	pm.AbstainPenaltyWeight = sdk.NewDec(-1)
+	require.Error(t, pm.Validate())
+
+	// derived denoms
+	pd := DefaultParams()
+	pd.Whitelist = DenomList{{Name: utils.MicroAtomDenom}, {Name: utils.MicroEthDenom}}
+	pd.DerivedDenoms = DerivedDenomList{{Name: "uatom/ueth", Numerators: []string{utils.MicroAtomDenom}, Denominators: []string{utils.MicroEthDenom}}}
+	require.NoError(t, pd.Validate())
+
+	pd.DerivedDenoms[0].Denominators = []string{"uosmo"}
+	require.Error(t, pd.Validate())
+
+	pd.DerivedDenoms[0] = DerivedDenom{Name: utils.MicroEthDenom, Numerators: []string{utils.MicroAtomDenom}}
+	require.Error(t, pd.Validate())
+
+	pd.DerivedDenoms[0] = DerivedDenom{Name: "uatom/ueth"}
+	require.Error(t, pd.Validate())
+
+	pd.DerivedDenoms = DerivedDenomList{
+		{Name: "uatom/ueth", Numerators: []string{utils.MicroAtomDenom}, Denominators: []string{utils.MicroEthDenom}},
+		{Name: "uatom/ueth", Numerators: []string{utils.MicroAtomDenom}},
+	}
+	require.Error(t, pd.Validate())
 }
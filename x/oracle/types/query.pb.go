@@ -6,6 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -73,6 +76,8 @@ var xxx_messageInfo_QueryExchangeRateRequest proto.InternalMessageInfo
 type QueryExchangeRateResponse struct {
 	// exchange_rate defines the exchange rate of Sei denominated in various Sei
 	OracleExchangeRate OracleExchangeRate `protobuf:"bytes,1,opt,name=oracle_exchange_rate,json=oracleExchangeRate,proto3" json:"oracle_exchange_rate"`
+	// stale is set when the denom was removed from the vote targets and the rate is no longer updated
+	Stale bool `protobuf:"varint,2,opt,name=stale,proto3" json:"stale,omitempty"`
 }
 
 func (m *QueryExchangeRateResponse) Reset()         { *m = QueryExchangeRateResponse{} }
@@ -115,6 +120,108 @@ func (m *QueryExchangeRateResponse) GetOracleExchangeRate() OracleExchangeRate {
 	return OracleExchangeRate{}
 }
 
+func (m *QueryExchangeRateResponse) GetStale() bool {
+	if m != nil {
+		return m.Stale
+	}
+	return false
+}
+
+// QueryFreshExchangeRateRequest is the request type for the Query/FreshExchangeRate RPC method.
+type QueryFreshExchangeRateRequest struct {
+	// denom defines the denomination to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// max_age_seconds is the maximum age of the exchange rate in seconds
+	MaxAgeSeconds uint64 `protobuf:"varint,2,opt,name=max_age_seconds,json=maxAgeSeconds,proto3" json:"max_age_seconds,omitempty"`
+}
+
+func (m *QueryFreshExchangeRateRequest) Reset()         { *m = QueryFreshExchangeRateRequest{} }
+func (m *QueryFreshExchangeRateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFreshExchangeRateRequest) ProtoMessage()    {}
+func (*QueryFreshExchangeRateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{2}
+}
+func (m *QueryFreshExchangeRateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFreshExchangeRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFreshExchangeRateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFreshExchangeRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFreshExchangeRateRequest.Merge(m, src)
+}
+func (m *QueryFreshExchangeRateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFreshExchangeRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFreshExchangeRateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFreshExchangeRateRequest proto.InternalMessageInfo
+
+// QueryFreshExchangeRateResponse is response type for the
+// Query/FreshExchangeRate RPC method.
+type QueryFreshExchangeRateResponse struct {
+	OracleExchangeRate OracleExchangeRate     `protobuf:"bytes,1,opt,name=oracle_exchange_rate,json=oracleExchangeRate,proto3" json:"oracle_exchange_rate"`
+	Confidence         ExchangeRateConfidence `protobuf:"bytes,2,opt,name=confidence,proto3" json:"confidence"`
+}
+
+func (m *QueryFreshExchangeRateResponse) Reset()         { *m = QueryFreshExchangeRateResponse{} }
+func (m *QueryFreshExchangeRateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFreshExchangeRateResponse) ProtoMessage()    {}
+func (*QueryFreshExchangeRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{3}
+}
+func (m *QueryFreshExchangeRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFreshExchangeRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFreshExchangeRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFreshExchangeRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFreshExchangeRateResponse.Merge(m, src)
+}
+func (m *QueryFreshExchangeRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFreshExchangeRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFreshExchangeRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFreshExchangeRateResponse proto.InternalMessageInfo
+
+func (m *QueryFreshExchangeRateResponse) GetOracleExchangeRate() OracleExchangeRate {
+	if m != nil {
+		return m.OracleExchangeRate
+	}
+	return OracleExchangeRate{}
+}
+
+func (m *QueryFreshExchangeRateResponse) GetConfidence() ExchangeRateConfidence {
+	if m != nil {
+		return m.Confidence
+	}
+	return ExchangeRateConfidence{}
+}
+
 // QueryExchangeRatesRequest is the request type for the Query/ExchangeRates RPC method.
 type QueryExchangeRatesRequest struct {
 }
@@ -123,7 +230,7 @@ func (m *QueryExchangeRatesRequest) Reset()         { *m = QueryExchangeRatesReq
 func (m *QueryExchangeRatesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryExchangeRatesRequest) ProtoMessage()    {}
 func (*QueryExchangeRatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{2}
+	return fileDescriptor_562b782cb9ac197e, []int{4}
 }
 func (m *QueryExchangeRatesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -155,13 +262,15 @@ var xxx_messageInfo_QueryExchangeRatesRequest proto.InternalMessageInfo
 type DenomOracleExchangeRatePair struct {
 	Denom              string             `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 	OracleExchangeRate OracleExchangeRate `protobuf:"bytes,2,opt,name=oracle_exchange_rate,json=oracleExchangeRate,proto3" json:"oracle_exchange_rate"`
+	// stale is set when the denom was removed from the vote targets and the rate is no longer updated
+	Stale bool `protobuf:"varint,3,opt,name=stale,proto3" json:"stale,omitempty"`
 }
 
 func (m *DenomOracleExchangeRatePair) Reset()         { *m = DenomOracleExchangeRatePair{} }
 func (m *DenomOracleExchangeRatePair) String() string { return proto.CompactTextString(m) }
 func (*DenomOracleExchangeRatePair) ProtoMessage()    {}
 func (*DenomOracleExchangeRatePair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{3}
+	return fileDescriptor_562b782cb9ac197e, []int{5}
 }
 func (m *DenomOracleExchangeRatePair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -204,6 +313,13 @@ func (m *DenomOracleExchangeRatePair) GetOracleExchangeRate() OracleExchangeRate
 	return OracleExchangeRate{}
 }
 
+func (m *DenomOracleExchangeRatePair) GetStale() bool {
+	if m != nil {
+		return m.Stale
+	}
+	return false
+}
+
 // QueryExchangeRatesResponse is response type for the
 // Query/ExchangeRates RPC method.
 type QueryExchangeRatesResponse struct {
@@ -215,7 +331,7 @@ func (m *QueryExchangeRatesResponse) Reset()         { *m = QueryExchangeRatesRe
 func (m *QueryExchangeRatesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryExchangeRatesResponse) ProtoMessage()    {}
 func (*QueryExchangeRatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{4}
+	return fileDescriptor_562b782cb9ac197e, []int{6}
 }
 func (m *QueryExchangeRatesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -259,7 +375,7 @@ func (m *QueryActivesRequest) Reset()         { *m = QueryActivesRequest{} }
 func (m *QueryActivesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryActivesRequest) ProtoMessage()    {}
 func (*QueryActivesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{5}
+	return fileDescriptor_562b782cb9ac197e, []int{7}
 }
 func (m *QueryActivesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -299,7 +415,7 @@ func (m *QueryActivesResponse) Reset()         { *m = QueryActivesResponse{} }
 func (m *QueryActivesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryActivesResponse) ProtoMessage()    {}
 func (*QueryActivesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{6}
+	return fileDescriptor_562b782cb9ac197e, []int{8}
 }
 func (m *QueryActivesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -343,7 +459,7 @@ func (m *QueryVoteTargetsRequest) Reset()         { *m = QueryVoteTargetsRequest
 func (m *QueryVoteTargetsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteTargetsRequest) ProtoMessage()    {}
 func (*QueryVoteTargetsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{7}
+	return fileDescriptor_562b782cb9ac197e, []int{9}
 }
 func (m *QueryVoteTargetsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -384,7 +500,7 @@ func (m *QueryVoteTargetsResponse) Reset()         { *m = QueryVoteTargetsRespon
 func (m *QueryVoteTargetsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteTargetsResponse) ProtoMessage()    {}
 func (*QueryVoteTargetsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{8}
+	return fileDescriptor_562b782cb9ac197e, []int{10}
 }
 func (m *QueryVoteTargetsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -420,6 +536,97 @@ func (m *QueryVoteTargetsResponse) GetVoteTargets() []string {
 	return nil
 }
 
+// QueryVoteTargetTransitionsRequest is the request type for the Query/VoteTargetTransitions RPC method.
+type QueryVoteTargetTransitionsRequest struct {
+}
+
+func (m *QueryVoteTargetTransitionsRequest) Reset()         { *m = QueryVoteTargetTransitionsRequest{} }
+func (m *QueryVoteTargetTransitionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteTargetTransitionsRequest) ProtoMessage()    {}
+func (*QueryVoteTargetTransitionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{11}
+}
+func (m *QueryVoteTargetTransitionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteTargetTransitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteTargetTransitionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoteTargetTransitionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteTargetTransitionsRequest.Merge(m, src)
+}
+func (m *QueryVoteTargetTransitionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteTargetTransitionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteTargetTransitionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoteTargetTransitionsRequest proto.InternalMessageInfo
+
+// QueryVoteTargetTransitionsResponse is response type for the
+// Query/VoteTargetTransitions RPC method.
+type QueryVoteTargetTransitionsResponse struct {
+	Probations []VoteTargetTransition `protobuf:"bytes,1,rep,name=probations,proto3" json:"probations"`
+	Sunsets    []VoteTargetTransition `protobuf:"bytes,2,rep,name=sunsets,proto3" json:"sunsets"`
+}
+
+func (m *QueryVoteTargetTransitionsResponse) Reset()         { *m = QueryVoteTargetTransitionsResponse{} }
+func (m *QueryVoteTargetTransitionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteTargetTransitionsResponse) ProtoMessage()    {}
+func (*QueryVoteTargetTransitionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{12}
+}
+func (m *QueryVoteTargetTransitionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteTargetTransitionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteTargetTransitionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoteTargetTransitionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteTargetTransitionsResponse.Merge(m, src)
+}
+func (m *QueryVoteTargetTransitionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteTargetTransitionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteTargetTransitionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoteTargetTransitionsResponse proto.InternalMessageInfo
+
+func (m *QueryVoteTargetTransitionsResponse) GetProbations() []VoteTargetTransition {
+	if m != nil {
+		return m.Probations
+	}
+	return nil
+}
+
+func (m *QueryVoteTargetTransitionsResponse) GetSunsets() []VoteTargetTransition {
+	if m != nil {
+		return m.Sunsets
+	}
+	return nil
+}
+
 // request type for price snapshot history RPC method
 type QueryPriceSnapshotHistoryRequest struct {
 }
@@ -428,7 +635,7 @@ func (m *QueryPriceSnapshotHistoryRequest) Reset()         { *m = QueryPriceSnap
 func (m *QueryPriceSnapshotHistoryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPriceSnapshotHistoryRequest) ProtoMessage()    {}
 func (*QueryPriceSnapshotHistoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{9}
+	return fileDescriptor_562b782cb9ac197e, []int{13}
 }
 func (m *QueryPriceSnapshotHistoryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -465,7 +672,7 @@ func (m *QueryPriceSnapshotHistoryResponse) Reset()         { *m = QueryPriceSna
 func (m *QueryPriceSnapshotHistoryResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPriceSnapshotHistoryResponse) ProtoMessage()    {}
 func (*QueryPriceSnapshotHistoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{10}
+	return fileDescriptor_562b782cb9ac197e, []int{14}
 }
 func (m *QueryPriceSnapshotHistoryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -510,7 +717,7 @@ func (m *QueryTwapsRequest) Reset()         { *m = QueryTwapsRequest{} }
 func (m *QueryTwapsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTwapsRequest) ProtoMessage()    {}
 func (*QueryTwapsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{11}
+	return fileDescriptor_562b782cb9ac197e, []int{15}
 }
 func (m *QueryTwapsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -554,7 +761,7 @@ func (m *QueryTwapsResponse) Reset()         { *m = QueryTwapsResponse{} }
 func (m *QueryTwapsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTwapsResponse) ProtoMessage()    {}
 func (*QueryTwapsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{12}
+	return fileDescriptor_562b782cb9ac197e, []int{16}
 }
 func (m *QueryTwapsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -590,24 +797,24 @@ func (m *QueryTwapsResponse) GetOracleTwaps() OracleTwaps {
 	return nil
 }
 
-// QueryFeederDelegationRequest is the request type for the Query/FeederDelegation RPC method.
-type QueryFeederDelegationRequest struct {
-	// validator defines the validator address to query for.
-	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+type QueryEmasRequest struct {
+	LookbackSeconds uint64 `protobuf:"varint,1,opt,name=lookback_seconds,json=lookbackSeconds,proto3" json:"lookback_seconds,omitempty"`
+	// period_seconds is the smoothing period of the moving average
+	PeriodSeconds uint64 `protobuf:"varint,2,opt,name=period_seconds,json=periodSeconds,proto3" json:"period_seconds,omitempty"`
 }
 
-func (m *QueryFeederDelegationRequest) Reset()         { *m = QueryFeederDelegationRequest{} }
-func (m *QueryFeederDelegationRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryFeederDelegationRequest) ProtoMessage()    {}
-func (*QueryFeederDelegationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{13}
+func (m *QueryEmasRequest) Reset()         { *m = QueryEmasRequest{} }
+func (m *QueryEmasRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEmasRequest) ProtoMessage()    {}
+func (*QueryEmasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{17}
 }
-func (m *QueryFeederDelegationRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryEmasRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryFeederDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEmasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryFeederDelegationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEmasRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -617,37 +824,48 @@ func (m *QueryFeederDelegationRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryFeederDelegationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryFeederDelegationRequest.Merge(m, src)
+func (m *QueryEmasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmasRequest.Merge(m, src)
 }
-func (m *QueryFeederDelegationRequest) XXX_Size() int {
+func (m *QueryEmasRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryFeederDelegationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryFeederDelegationRequest.DiscardUnknown(m)
+func (m *QueryEmasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmasRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryFeederDelegationRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryEmasRequest proto.InternalMessageInfo
 
-// QueryFeederDelegationResponse is response type for the
-// Query/FeederDelegation RPC method.
-type QueryFeederDelegationResponse struct {
-	// feeder_addr defines the feeder delegation of a validator
-	FeederAddr string `protobuf:"bytes,1,opt,name=feeder_addr,json=feederAddr,proto3" json:"feeder_addr,omitempty"`
+func (m *QueryEmasRequest) GetLookbackSeconds() uint64 {
+	if m != nil {
+		return m.LookbackSeconds
+	}
+	return 0
 }
 
-func (m *QueryFeederDelegationResponse) Reset()         { *m = QueryFeederDelegationResponse{} }
-func (m *QueryFeederDelegationResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryFeederDelegationResponse) ProtoMessage()    {}
-func (*QueryFeederDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{14}
+func (m *QueryEmasRequest) GetPeriodSeconds() uint64 {
+	if m != nil {
+		return m.PeriodSeconds
+	}
+	return 0
 }
-func (m *QueryFeederDelegationResponse) XXX_Unmarshal(b []byte) error {
+
+type QueryEmasResponse struct {
+	OracleEmas OracleEmas `protobuf:"bytes,1,rep,name=oracle_emas,json=oracleEmas,proto3,castrepeated=OracleEmas" json:"oracle_emas"`
+}
+
+func (m *QueryEmasResponse) Reset()         { *m = QueryEmasResponse{} }
+func (m *QueryEmasResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEmasResponse) ProtoMessage()    {}
+func (*QueryEmasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{18}
+}
+func (m *QueryEmasResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryFeederDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEmasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryFeederDelegationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEmasResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -657,43 +875,41 @@ func (m *QueryFeederDelegationResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryFeederDelegationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryFeederDelegationResponse.Merge(m, src)
+func (m *QueryEmasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmasResponse.Merge(m, src)
 }
-func (m *QueryFeederDelegationResponse) XXX_Size() int {
+func (m *QueryEmasResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryFeederDelegationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryFeederDelegationResponse.DiscardUnknown(m)
+func (m *QueryEmasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmasResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryFeederDelegationResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryEmasResponse proto.InternalMessageInfo
 
-func (m *QueryFeederDelegationResponse) GetFeederAddr() string {
+func (m *QueryEmasResponse) GetOracleEmas() OracleEmas {
 	if m != nil {
-		return m.FeederAddr
+		return m.OracleEmas
 	}
-	return ""
+	return nil
 }
 
-// QueryVotePenaltyCounterRequest is the request type for the Query/MissCounter RPC method.
-type QueryVotePenaltyCounterRequest struct {
-	// validator defines the validator address to query for.
-	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+type QueryPriceRangesRequest struct {
+	LookbackSeconds uint64 `protobuf:"varint,1,opt,name=lookback_seconds,json=lookbackSeconds,proto3" json:"lookback_seconds,omitempty"`
 }
 
-func (m *QueryVotePenaltyCounterRequest) Reset()         { *m = QueryVotePenaltyCounterRequest{} }
-func (m *QueryVotePenaltyCounterRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryVotePenaltyCounterRequest) ProtoMessage()    {}
-func (*QueryVotePenaltyCounterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{15}
+func (m *QueryPriceRangesRequest) Reset()         { *m = QueryPriceRangesRequest{} }
+func (m *QueryPriceRangesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceRangesRequest) ProtoMessage()    {}
+func (*QueryPriceRangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{19}
 }
-func (m *QueryVotePenaltyCounterRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPriceRangesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryVotePenaltyCounterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPriceRangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryVotePenaltyCounterRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPriceRangesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -703,36 +919,41 @@ func (m *QueryVotePenaltyCounterRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryVotePenaltyCounterRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryVotePenaltyCounterRequest.Merge(m, src)
+func (m *QueryPriceRangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceRangesRequest.Merge(m, src)
 }
-func (m *QueryVotePenaltyCounterRequest) XXX_Size() int {
+func (m *QueryPriceRangesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryVotePenaltyCounterRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryVotePenaltyCounterRequest.DiscardUnknown(m)
+func (m *QueryPriceRangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceRangesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryVotePenaltyCounterRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPriceRangesRequest proto.InternalMessageInfo
 
-// QueryVotePenaltyCounterResponse is response type for the
-// Query/VotePenaltyCounter RPC method.
-type QueryVotePenaltyCounterResponse struct {
-	VotePenaltyCounter *VotePenaltyCounter `protobuf:"bytes,1,opt,name=vote_penalty_counter,json=votePenaltyCounter,proto3" json:"vote_penalty_counter,omitempty"`
+func (m *QueryPriceRangesRequest) GetLookbackSeconds() uint64 {
+	if m != nil {
+		return m.LookbackSeconds
+	}
+	return 0
 }
 
-func (m *QueryVotePenaltyCounterResponse) Reset()         { *m = QueryVotePenaltyCounterResponse{} }
-func (m *QueryVotePenaltyCounterResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryVotePenaltyCounterResponse) ProtoMessage()    {}
-func (*QueryVotePenaltyCounterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{16}
+type QueryPriceRangesResponse struct {
+	OraclePriceRanges OraclePriceRanges `protobuf:"bytes,1,rep,name=oracle_price_ranges,json=oraclePriceRanges,proto3,castrepeated=OraclePriceRanges" json:"oracle_price_ranges"`
 }
-func (m *QueryVotePenaltyCounterResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryPriceRangesResponse) Reset()         { *m = QueryPriceRangesResponse{} }
+func (m *QueryPriceRangesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceRangesResponse) ProtoMessage()    {}
+func (*QueryPriceRangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{20}
+}
+func (m *QueryPriceRangesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryVotePenaltyCounterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPriceRangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryVotePenaltyCounterResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPriceRangesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -742,42 +963,41 @@ func (m *QueryVotePenaltyCounterResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryVotePenaltyCounterResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryVotePenaltyCounterResponse.Merge(m, src)
+func (m *QueryPriceRangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceRangesResponse.Merge(m, src)
 }
-func (m *QueryVotePenaltyCounterResponse) XXX_Size() int {
+func (m *QueryPriceRangesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryVotePenaltyCounterResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryVotePenaltyCounterResponse.DiscardUnknown(m)
+func (m *QueryPriceRangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceRangesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryVotePenaltyCounterResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPriceRangesResponse proto.InternalMessageInfo
 
-func (m *QueryVotePenaltyCounterResponse) GetVotePenaltyCounter() *VotePenaltyCounter {
+func (m *QueryPriceRangesResponse) GetOraclePriceRanges() OraclePriceRanges {
 	if m != nil {
-		return m.VotePenaltyCounter
+		return m.OraclePriceRanges
 	}
 	return nil
 }
 
-// QuerySlashWindow is the request type for the
-// Query/SlashWindow RPC method.
-type QuerySlashWindowRequest struct {
+type QueryVolatilitiesRequest struct {
+	LookbackSeconds uint64 `protobuf:"varint,1,opt,name=lookback_seconds,json=lookbackSeconds,proto3" json:"lookback_seconds,omitempty"`
 }
 
-func (m *QuerySlashWindowRequest) Reset()         { *m = QuerySlashWindowRequest{} }
-func (m *QuerySlashWindowRequest) String() string { return proto.CompactTextString(m) }
-func (*QuerySlashWindowRequest) ProtoMessage()    {}
-func (*QuerySlashWindowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{17}
+func (m *QueryVolatilitiesRequest) Reset()         { *m = QueryVolatilitiesRequest{} }
+func (m *QueryVolatilitiesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVolatilitiesRequest) ProtoMessage()    {}
+func (*QueryVolatilitiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{21}
 }
-func (m *QuerySlashWindowRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryVolatilitiesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySlashWindowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryVolatilitiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySlashWindowRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryVolatilitiesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -787,38 +1007,41 @@ func (m *QuerySlashWindowRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QuerySlashWindowRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySlashWindowRequest.Merge(m, src)
+func (m *QueryVolatilitiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVolatilitiesRequest.Merge(m, src)
 }
-func (m *QuerySlashWindowRequest) XXX_Size() int {
+func (m *QueryVolatilitiesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySlashWindowRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySlashWindowRequest.DiscardUnknown(m)
+func (m *QueryVolatilitiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVolatilitiesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySlashWindowRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryVolatilitiesRequest proto.InternalMessageInfo
 
-// QuerySlashWindowResponse is response type for the
-// Query/SlashWindow RPC method.
-type QuerySlashWindowResponse struct {
-	// window_progress defines the number of voting periods
-	// since the last slashing event would have taken place.
-	WindowProgress uint64 `protobuf:"varint,1,opt,name=window_progress,json=windowProgress,proto3" json:"window_progress,omitempty"`
+func (m *QueryVolatilitiesRequest) GetLookbackSeconds() uint64 {
+	if m != nil {
+		return m.LookbackSeconds
+	}
+	return 0
 }
 
-func (m *QuerySlashWindowResponse) Reset()         { *m = QuerySlashWindowResponse{} }
-func (m *QuerySlashWindowResponse) String() string { return proto.CompactTextString(m) }
-func (*QuerySlashWindowResponse) ProtoMessage()    {}
-func (*QuerySlashWindowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{18}
+type QueryVolatilitiesResponse struct {
+	OracleVolatilities OracleVolatilities `protobuf:"bytes,1,rep,name=oracle_volatilities,json=oracleVolatilities,proto3,castrepeated=OracleVolatilities" json:"oracle_volatilities"`
 }
-func (m *QuerySlashWindowResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryVolatilitiesResponse) Reset()         { *m = QueryVolatilitiesResponse{} }
+func (m *QueryVolatilitiesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVolatilitiesResponse) ProtoMessage()    {}
+func (*QueryVolatilitiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{22}
+}
+func (m *QueryVolatilitiesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySlashWindowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryVolatilitiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySlashWindowResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryVolatilitiesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -828,41 +1051,43 @@ func (m *QuerySlashWindowResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QuerySlashWindowResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySlashWindowResponse.Merge(m, src)
+func (m *QueryVolatilitiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVolatilitiesResponse.Merge(m, src)
 }
-func (m *QuerySlashWindowResponse) XXX_Size() int {
+func (m *QueryVolatilitiesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySlashWindowResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySlashWindowResponse.DiscardUnknown(m)
+func (m *QueryVolatilitiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVolatilitiesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySlashWindowResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryVolatilitiesResponse proto.InternalMessageInfo
 
-func (m *QuerySlashWindowResponse) GetWindowProgress() uint64 {
+func (m *QueryVolatilitiesResponse) GetOracleVolatilities() OracleVolatilities {
 	if m != nil {
-		return m.WindowProgress
+		return m.OracleVolatilities
 	}
-	return 0
+	return nil
 }
 
-// QueryParamsRequest is the request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+type QueryPriceAtTimestampRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// timestamp is the unix timestamp in seconds
+	Timestamp int64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{19}
+func (m *QueryPriceAtTimestampRequest) Reset()         { *m = QueryPriceAtTimestampRequest{} }
+func (m *QueryPriceAtTimestampRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceAtTimestampRequest) ProtoMessage()    {}
+func (*QueryPriceAtTimestampRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{23}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPriceAtTimestampRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPriceAtTimestampRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPriceAtTimestampRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -872,36 +1097,50 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryPriceAtTimestampRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceAtTimestampRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryPriceAtTimestampRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryPriceAtTimestampRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceAtTimestampRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPriceAtTimestampRequest proto.InternalMessageInfo
 
-// QueryParamsResponse is the response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
-	// params defines the parameters of the module.
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+func (m *QueryPriceAtTimestampRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{20}
+func (m *QueryPriceAtTimestampRequest) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+
+type QueryPriceAtTimestampResponse struct {
+	OracleExchangeRate OracleExchangeRate `protobuf:"bytes,1,opt,name=oracle_exchange_rate,json=oracleExchangeRate,proto3" json:"oracle_exchange_rate"`
+	// snapshot_timestamp is the timestamp of the price snapshot the exchange rate was taken from
+	SnapshotTimestamp int64 `protobuf:"varint,2,opt,name=snapshot_timestamp,json=snapshotTimestamp,proto3" json:"snapshot_timestamp,omitempty"`
+}
+
+func (m *QueryPriceAtTimestampResponse) Reset()         { *m = QueryPriceAtTimestampResponse{} }
+func (m *QueryPriceAtTimestampResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceAtTimestampResponse) ProtoMessage()    {}
+func (*QueryPriceAtTimestampResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{24}
+}
+func (m *QueryPriceAtTimestampResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPriceAtTimestampResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPriceAtTimestampResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -911,1449 +1150,4941 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QueryPriceAtTimestampResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceAtTimestampResponse.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QueryPriceAtTimestampResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QueryPriceAtTimestampResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceAtTimestampResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPriceAtTimestampResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() Params {
+func (m *QueryPriceAtTimestampResponse) GetOracleExchangeRate() OracleExchangeRate {
 	if m != nil {
-		return m.Params
+		return m.OracleExchangeRate
 	}
-	return Params{}
+	return OracleExchangeRate{}
 }
 
-func init() {
-	proto.RegisterType((*QueryExchangeRateRequest)(nil), "seiprotocol.seichain.oracle.QueryExchangeRateRequest")
-	proto.RegisterType((*QueryExchangeRateResponse)(nil), "seiprotocol.seichain.oracle.QueryExchangeRateResponse")
-	proto.RegisterType((*QueryExchangeRatesRequest)(nil), "seiprotocol.seichain.oracle.QueryExchangeRatesRequest")
-	proto.RegisterType((*DenomOracleExchangeRatePair)(nil), "seiprotocol.seichain.oracle.DenomOracleExchangeRatePair")
-	proto.RegisterType((*QueryExchangeRatesResponse)(nil), "seiprotocol.seichain.oracle.QueryExchangeRatesResponse")
-	proto.RegisterType((*QueryActivesRequest)(nil), "seiprotocol.seichain.oracle.QueryActivesRequest")
-	proto.RegisterType((*QueryActivesResponse)(nil), "seiprotocol.seichain.oracle.QueryActivesResponse")
-	proto.RegisterType((*QueryVoteTargetsRequest)(nil), "seiprotocol.seichain.oracle.QueryVoteTargetsRequest")
-	proto.RegisterType((*QueryVoteTargetsResponse)(nil), "seiprotocol.seichain.oracle.QueryVoteTargetsResponse")
-	proto.RegisterType((*QueryPriceSnapshotHistoryRequest)(nil), "seiprotocol.seichain.oracle.QueryPriceSnapshotHistoryRequest")
-	proto.RegisterType((*QueryPriceSnapshotHistoryResponse)(nil), "seiprotocol.seichain.oracle.QueryPriceSnapshotHistoryResponse")
-	proto.RegisterType((*QueryTwapsRequest)(nil), "seiprotocol.seichain.oracle.QueryTwapsRequest")
-	proto.RegisterType((*QueryTwapsResponse)(nil), "seiprotocol.seichain.oracle.QueryTwapsResponse")
-	proto.RegisterType((*QueryFeederDelegationRequest)(nil), "seiprotocol.seichain.oracle.QueryFeederDelegationRequest")
-	proto.RegisterType((*QueryFeederDelegationResponse)(nil), "seiprotocol.seichain.oracle.QueryFeederDelegationResponse")
-	proto.RegisterType((*QueryVotePenaltyCounterRequest)(nil), "seiprotocol.seichain.oracle.QueryVotePenaltyCounterRequest")
-	proto.RegisterType((*QueryVotePenaltyCounterResponse)(nil), "seiprotocol.seichain.oracle.QueryVotePenaltyCounterResponse")
-	proto.RegisterType((*QuerySlashWindowRequest)(nil), "seiprotocol.seichain.oracle.QuerySlashWindowRequest")
-	proto.RegisterType((*QuerySlashWindowResponse)(nil), "seiprotocol.seichain.oracle.QuerySlashWindowResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "seiprotocol.seichain.oracle.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "seiprotocol.seichain.oracle.QueryParamsResponse")
+func (m *QueryPriceAtTimestampResponse) GetSnapshotTimestamp() int64 {
+	if m != nil {
+		return m.SnapshotTimestamp
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("oracle/query.proto", fileDescriptor_562b782cb9ac197e) }
-
-var fileDescriptor_562b782cb9ac197e = []byte{
-	// 1104 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x94, 0x26, 0xa5, 0xcf, 0x69, 0x52, 0x26, 0x06, 0xd2, 0x4d, 0xb0, 0xd3, 0x85, 0x2a,
-	0x05, 0x14, 0x6f, 0x48, 0x93, 0x02, 0x69, 0x12, 0x35, 0x3f, 0xa8, 0x80, 0x4b, 0x12, 0xa7, 0xa2,
-	0x88, 0xcb, 0x6a, 0x62, 0x0f, 0xeb, 0x55, 0x9c, 0x9d, 0xed, 0xce, 0x26, 0x69, 0x14, 0xe5, 0x82,
-	0x7a, 0xe0, 0x18, 0x89, 0x1b, 0xe2, 0xd0, 0x0b, 0x1c, 0xb8, 0xc0, 0x89, 0x23, 0x07, 0x24, 0xa4,
-	0x1e, 0x2b, 0xc1, 0x01, 0x09, 0x09, 0x50, 0xc2, 0xa1, 0x7f, 0x06, 0xf2, 0xec, 0x5b, 0x67, 0xb7,
-	0x59, 0xaf, 0xd7, 0xa1, 0xa7, 0xf5, 0xbc, 0x37, 0xef, 0x9b, 0xef, 0x1b, 0xbf, 0x79, 0x1f, 0x50,
-	0xe1, 0xb1, 0x6a, 0x83, 0x1b, 0xf7, 0xb7, 0xb9, 0xb7, 0x57, 0x76, 0x3d, 0xe1, 0x0b, 0x3a, 0x2c,
-	0xb9, 0xad, 0x7e, 0x55, 0x45, 0xa3, 0x2c, 0xb9, 0x5d, 0xad, 0x33, 0xdb, 0x29, 0x07, 0x1b, 0xb5,
-	0x82, 0x25, 0x2c, 0xa1, 0xb2, 0x46, 0xf3, 0x57, 0x50, 0xa2, 0x8d, 0x58, 0x42, 0x58, 0x0d, 0x6e,
-	0x30, 0xd7, 0x36, 0x98, 0xe3, 0x08, 0x9f, 0xf9, 0xb6, 0x70, 0x24, 0x66, 0x07, 0xf1, 0x90, 0xe0,
-	0x13, 0x04, 0xf5, 0x19, 0x18, 0x5a, 0x6b, 0x1e, 0xfa, 0xc1, 0x83, 0x6a, 0x9d, 0x39, 0x16, 0xaf,
-	0x30, 0x9f, 0x57, 0xf8, 0xfd, 0x6d, 0x2e, 0x7d, 0x5a, 0x80, 0x9e, 0x1a, 0x77, 0xc4, 0xd6, 0x10,
-	0x19, 0x25, 0xd7, 0x2f, 0x56, 0x82, 0xc5, 0xcc, 0x8b, 0x5f, 0x3e, 0x2a, 0xe5, 0x9e, 0x3e, 0x2a,
-	0xe5, 0xf4, 0x87, 0x04, 0xae, 0x24, 0x14, 0x4b, 0x57, 0x38, 0x92, 0x53, 0x0b, 0x0a, 0xc1, 0x49,
-	0x26, 0xc7, 0xb4, 0xe9, 0x31, 0x9f, 0x2b, 0xb0, 0xfc, 0xa4, 0x51, 0x4e, 0x91, 0x57, 0x5e, 0x51,
-	0x9f, 0x28, 0xec, 0xe2, 0xf9, 0xc7, 0x7f, 0x95, 0x72, 0x15, 0xbc, 0xa8, 0x68, 0x46, 0x1f, 0x4e,
-	0x60, 0x21, 0x51, 0x83, 0xfe, 0x0d, 0x81, 0xe1, 0xe5, 0x26, 0xef, 0xd3, 0x90, 0xab, 0xcc, 0xf6,
-	0x92, 0x35, 0xb6, 0xe5, 0x7e, 0xee, 0x79, 0x73, 0xff, 0x95, 0x80, 0x96, 0x44, 0x1e, 0xef, 0xf0,
-	0x3b, 0x02, 0xa3, 0x8a, 0x91, 0x99, 0x44, 0xc7, 0x74, 0x99, 0xed, 0xc9, 0x21, 0x32, 0xfa, 0xc2,
-	0xf5, 0xfc, 0xe4, 0x7b, 0xa9, 0xa4, 0x52, 0xae, 0x60, 0xf1, 0x8d, 0x26, 0xbb, 0xef, 0xff, 0x2e,
-	0x8d, 0xa4, 0x6c, 0x92, 0x95, 0x91, 0x5a, 0x4a, 0x56, 0x7f, 0x19, 0x06, 0x95, 0x8c, 0x85, 0xaa,
-	0x6f, 0xef, 0x9c, 0xdc, 0xfe, 0x04, 0x14, 0xe2, 0x61, 0xd4, 0x35, 0x04, 0x17, 0x58, 0x10, 0x52,
-	0xec, 0x2f, 0x56, 0xc2, 0xa5, 0x7e, 0x05, 0x5e, 0x55, 0x15, 0x9f, 0x08, 0x9f, 0xdf, 0x65, 0x9e,
-	0xc5, 0xfd, 0x16, 0xd8, 0x1c, 0xb6, 0x6a, 0x2c, 0x85, 0x80, 0x57, 0xa1, 0x6f, 0x47, 0xf8, 0xdc,
-	0xf4, 0x83, 0x38, 0xa2, 0xe6, 0x77, 0x4e, 0xb6, 0xea, 0x3a, 0x8c, 0xaa, 0xf2, 0x55, 0xcf, 0xae,
-	0xf2, 0x75, 0x87, 0xb9, 0xb2, 0x2e, 0xfc, 0x0f, 0x6d, 0xe9, 0x0b, 0x6f, 0x2f, 0x3c, 0xe2, 0x90,
-	0xc0, 0xd5, 0x94, 0x4d, 0x78, 0xd8, 0x26, 0x0c, 0xb8, 0xcd, 0xbc, 0x29, 0x71, 0x43, 0xf8, 0x1f,
-	0xbc, 0x95, 0xfa, 0x1f, 0xc4, 0x30, 0x17, 0x5f, 0xc1, 0x5b, 0xef, 0x8f, 0x85, 0x65, 0xa5, 0xdf,
-	0x8d, 0xad, 0xf5, 0x79, 0x78, 0x49, 0x31, 0xba, 0xbb, 0xcb, 0xdc, 0xf0, 0x2a, 0xe8, 0x9b, 0x70,
-	0xb9, 0x21, 0xc4, 0xe6, 0x06, 0xab, 0x6e, 0x9a, 0x92, 0x57, 0x85, 0x53, 0x93, 0xaa, 0x81, 0xcf,
-	0x57, 0x06, 0xc2, 0xf8, 0x7a, 0x10, 0xd6, 0xb7, 0x81, 0x46, 0xeb, 0x51, 0x82, 0x09, 0x7d, 0xd8,
-	0x51, 0x7e, 0x33, 0x8e, 0xfc, 0xc7, 0x32, 0x34, 0x76, 0x13, 0x67, 0x71, 0x10, 0xc9, 0xe7, 0x4f,
-	0x62, 0xb2, 0x92, 0x17, 0x27, 0x0b, 0x7d, 0x05, 0x46, 0xd4, 0xb1, 0x77, 0x38, 0xaf, 0x71, 0x6f,
-	0x99, 0x37, 0xb8, 0xa5, 0x86, 0x51, 0xa8, 0xe0, 0x1a, 0xf4, 0xef, 0xb0, 0x86, 0x5d, 0x63, 0xbe,
-	0xf0, 0x4c, 0x56, 0xab, 0x79, 0xf8, 0x00, 0x2f, 0xb5, 0xa2, 0x0b, 0xb5, 0x9a, 0x17, 0x19, 0x36,
-	0xb7, 0xe1, 0xb5, 0x36, 0x80, 0x28, 0xa9, 0x04, 0xf9, 0xcf, 0x55, 0x2e, 0x0a, 0x07, 0x41, 0xa8,
-	0x89, 0xa5, 0xaf, 0x41, 0xb1, 0xd5, 0x3f, 0xab, 0xdc, 0x61, 0x0d, 0x7f, 0x6f, 0x49, 0x6c, 0x3b,
-	0x3e, 0xf7, 0xce, 0x4c, 0xea, 0x21, 0x81, 0x52, 0x5b, 0x4c, 0xe4, 0xc5, 0xa0, 0xa0, 0x5a, 0xd3,
-	0x0d, 0xd2, 0x66, 0x35, 0xc8, 0x67, 0x9a, 0x83, 0x09, 0xb0, 0x74, 0xe7, 0x54, 0xac, 0xf5, 0x68,
-	0xd6, 0x1b, 0x4c, 0xd6, 0xef, 0xd9, 0x4e, 0x4d, 0xec, 0x86, 0x1d, 0xbd, 0x84, 0x8f, 0x26, 0x96,
-	0x42, 0x66, 0x63, 0x30, 0xb0, 0xab, 0x22, 0xa6, 0xeb, 0x09, 0xcb, 0xe3, 0x32, 0x6c, 0xa2, 0xfe,
-	0x20, 0xbc, 0x8a, 0x51, 0xbd, 0x80, 0x3d, 0xb4, 0xca, 0x3c, 0xb6, 0xd5, 0x7a, 0x8f, 0x9f, 0xe2,
-	0x9b, 0x0f, 0xa3, 0x88, 0xba, 0x00, 0xbd, 0xae, 0x8a, 0xa0, 0xc2, 0xd7, 0xd3, 0x1f, 0x85, 0xda,
-	0x8a, 0x13, 0x12, 0x0b, 0x27, 0x0f, 0x07, 0xa0, 0x47, 0x41, 0xd3, 0x5f, 0x08, 0xf4, 0x45, 0xa7,
-	0x0d, 0x9d, 0x4e, 0x45, 0x6b, 0x67, 0x65, 0xda, 0xcd, 0x6e, 0xcb, 0x02, 0x31, 0xfa, 0xd2, 0x17,
-	0xbf, 0xfd, 0xfb, 0xd5, 0xb9, 0x39, 0x7a, 0xcb, 0x90, 0xdc, 0x1e, 0x0f, 0x01, 0xd4, 0x42, 0x21,
-	0xa0, 0x99, 0x1a, 0x6a, 0x38, 0x4a, 0x63, 0x5f, 0x7d, 0x0f, 0x8c, 0xd8, 0x98, 0xa6, 0x3f, 0x13,
-	0xb8, 0x14, 0x9b, 0xef, 0xb4, 0x4b, 0x3a, 0xe1, 0x95, 0x6b, 0xef, 0x76, 0x5d, 0x87, 0x3a, 0x66,
-	0x95, 0x8e, 0x9b, 0x74, 0x2a, 0x9b, 0x8e, 0x18, 0x7f, 0x49, 0xbf, 0x25, 0x70, 0x01, 0x47, 0x38,
-	0x9d, 0xe8, 0x4c, 0x21, 0x6e, 0x02, 0xda, 0x3b, 0x5d, 0x54, 0x20, 0xdd, 0x69, 0x45, 0xd7, 0xa0,
-	0xe3, 0xd9, 0xe8, 0xa2, 0x79, 0xd0, 0x9f, 0x08, 0xe4, 0x23, 0xee, 0x40, 0xa7, 0x3a, 0x9f, 0x7c,
-	0xda, 0x67, 0xb4, 0xe9, 0x2e, 0xab, 0x90, 0xf3, 0x8c, 0xe2, 0x3c, 0x45, 0x27, 0xb3, 0x71, 0x8e,
-	0xda, 0x15, 0xfd, 0x93, 0x40, 0x21, 0xc9, 0x72, 0xe8, 0x5c, 0x67, 0x2e, 0x29, 0x7e, 0xa6, 0xcd,
-	0x9f, 0xb5, 0x1c, 0x35, 0x2d, 0x2b, 0x4d, 0xf3, 0x74, 0x36, 0x9b, 0xa6, 0xb8, 0x2b, 0x9a, 0x75,
-	0x14, 0xf1, 0x23, 0x81, 0x1e, 0xe5, 0x0a, 0xb4, 0xdc, 0x99, 0x4f, 0xd4, 0xe7, 0x34, 0x23, 0xf3,
-	0x7e, 0x24, 0x7c, 0x47, 0x11, 0xbe, 0x4d, 0xe7, 0xb3, 0x11, 0x56, 0xe6, 0x67, 0xec, 0x3f, 0xeb,
-	0xa5, 0x07, 0xf4, 0x77, 0x02, 0x97, 0x9f, 0x75, 0x1a, 0xfa, 0x7e, 0x67, 0x36, 0x6d, 0xec, 0x4e,
-	0x9b, 0x39, 0x4b, 0x29, 0x6a, 0xfa, 0x48, 0x69, 0x5a, 0xa2, 0x0b, 0x1d, 0x34, 0xb5, 0x4c, 0x4a,
-	0x1a, 0xfb, 0x71, 0x1b, 0x3b, 0x30, 0x02, 0x1b, 0xa4, 0x4f, 0x09, 0xd0, 0xd3, 0x9e, 0x42, 0x6f,
-	0x65, 0xeb, 0xf8, 0x44, 0xd3, 0xd4, 0x66, 0xcf, 0x56, 0x8c, 0xe2, 0xee, 0x29, 0x71, 0x6b, 0x74,
-	0xe5, 0x7f, 0x88, 0x4b, 0xb2, 0x57, 0xfa, 0x03, 0x81, 0x7c, 0xc4, 0xf4, 0xb2, 0xcc, 0x82, 0xd3,
-	0xf6, 0x99, 0x65, 0x16, 0x24, 0x38, 0xab, 0x7e, 0x43, 0xa9, 0x1a, 0xa7, 0x6f, 0x77, 0x50, 0x25,
-	0x9b, 0xb5, 0x66, 0xe0, 0xb6, 0xf4, 0x6b, 0x02, 0xbd, 0x81, 0x1d, 0xd2, 0x0c, 0x7d, 0x1f, 0xf3,
-	0x62, 0x6d, 0x22, 0x7b, 0x01, 0x52, 0x1c, 0x57, 0x14, 0xc7, 0xe8, 0xb5, 0x0e, 0x14, 0x03, 0x4b,
-	0x5e, 0xfc, 0xf8, 0xf1, 0x51, 0x91, 0x3c, 0x39, 0x2a, 0x92, 0x7f, 0x8e, 0x8a, 0xe4, 0xf0, 0xb8,
-	0x98, 0x7b, 0x72, 0x5c, 0xcc, 0xfd, 0x71, 0x5c, 0xcc, 0x7d, 0x36, 0x61, 0xd9, 0x7e, 0x7d, 0x7b,
-	0xa3, 0x5c, 0x15, 0x5b, 0xed, 0xa0, 0x1e, 0x84, 0x60, 0xfe, 0x9e, 0xcb, 0xe5, 0x46, 0xaf, 0xda,
-	0x72, 0xe3, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x58, 0x07, 0x8e, 0x14, 0xf6, 0x0e, 0x00, 0x00,
+// QueryFeederDelegationRequest is the request type for the Query/FeederDelegation RPC method.
+type QueryFeederDelegationRequest struct {
+	// validator defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryFeederDelegationRequest) Reset()         { *m = QueryFeederDelegationRequest{} }
+func (m *QueryFeederDelegationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeederDelegationRequest) ProtoMessage()    {}
+func (*QueryFeederDelegationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{25}
+}
+func (m *QueryFeederDelegationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeederDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeederDelegationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeederDelegationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeederDelegationRequest.Merge(m, src)
+}
+func (m *QueryFeederDelegationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeederDelegationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeederDelegationRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryFeederDelegationRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// ExchangeRate returns exchange rate of a denom
-	ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error)
-	// ExchangeRates returns exchange rates of all denoms
-	ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error)
-	// Actives returns all active denoms
-	Actives(ctx context.Context, in *QueryActivesRequest, opts ...grpc.CallOption) (*QueryActivesResponse, error)
-	// VoteTargets returns all vote target denoms
-	VoteTargets(ctx context.Context, in *QueryVoteTargetsRequest, opts ...grpc.CallOption) (*QueryVoteTargetsResponse, error)
-	// PriceSnapshotHistory returns the history of price snapshots for all assets
-	PriceSnapshotHistory(ctx context.Context, in *QueryPriceSnapshotHistoryRequest, opts ...grpc.CallOption) (*QueryPriceSnapshotHistoryResponse, error)
-	Twaps(ctx context.Context, in *QueryTwapsRequest, opts ...grpc.CallOption) (*QueryTwapsResponse, error)
-	// FeederDelegation returns feeder delegation of a validator
-	FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error)
-	// MissCounter returns oracle miss counter of a validator
-	VotePenaltyCounter(ctx context.Context, in *QueryVotePenaltyCounterRequest, opts ...grpc.CallOption) (*QueryVotePenaltyCounterResponse, error)
-	// SlashWindow returns slash window information
-	SlashWindow(ctx context.Context, in *QuerySlashWindowRequest, opts ...grpc.CallOption) (*QuerySlashWindowResponse, error)
-	// Params queries all parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+// QueryFeederDelegationResponse is response type for the
+// Query/FeederDelegation RPC method.
+type QueryFeederDelegationResponse struct {
+	// feeder_addr defines the feeder delegation of a validator
+	FeederAddr string `protobuf:"bytes,1,opt,name=feeder_addr,json=feederAddr,proto3" json:"feeder_addr,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryFeederDelegationResponse) Reset()         { *m = QueryFeederDelegationResponse{} }
+func (m *QueryFeederDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeederDelegationResponse) ProtoMessage()    {}
+func (*QueryFeederDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{26}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryFeederDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error) {
-	out := new(QueryExchangeRateResponse)
-	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/ExchangeRate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFeederDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeederDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error) {
-	out := new(QueryExchangeRatesResponse)
-	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/ExchangeRates", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryFeederDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeederDelegationResponse.Merge(m, src)
 }
-
-func (c *queryClient) Actives(ctx context.Context, in *QueryActivesRequest, opts ...grpc.CallOption) (*QueryActivesResponse, error) {
-	out := new(QueryActivesResponse)
-	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/Actives", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryFeederDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeederDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeederDelegationResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) VoteTargets(ctx context.Context, in *QueryVoteTargetsRequest, opts ...grpc.CallOption) (*QueryVoteTargetsResponse, error) {
-	out := new(QueryVoteTargetsResponse)
-	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/VoteTargets", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeederDelegationResponse proto.InternalMessageInfo
+
+func (m *QueryFeederDelegationResponse) GetFeederAddr() string {
+	if m != nil {
+		return m.FeederAddr
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) PriceSnapshotHistory(ctx context.Context, in *QueryPriceSnapshotHistoryRequest, opts ...grpc.CallOption) (*QueryPriceSnapshotHistoryResponse, error) {
-	out := new(QueryPriceSnapshotHistoryResponse)
-	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/PriceSnapshotHistory", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryAggregatePrevoteRequest is the request type for the Query/AggregatePrevote RPC method.
+type QueryAggregatePrevoteRequest struct {
+	// validator defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
 }
 
-func (c *queryClient) Twaps(ctx context.Context, in *QueryTwapsRequest, opts ...grpc.CallOption) (*QueryTwapsResponse, error) {
-	out := new(QueryTwapsResponse)
-	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/Twaps", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryAggregatePrevoteRequest) Reset()         { *m = QueryAggregatePrevoteRequest{} }
+func (m *QueryAggregatePrevoteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregatePrevoteRequest) ProtoMessage()    {}
+func (*QueryAggregatePrevoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{27}
 }
-
-func (c *queryClient) FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error) {
-	out := new(QueryFeederDelegationResponse)
-	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/FeederDelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAggregatePrevoteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregatePrevoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregatePrevoteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAggregatePrevoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregatePrevoteRequest.Merge(m, src)
+}
+func (m *QueryAggregatePrevoteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAggregatePrevoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregatePrevoteRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) VotePenaltyCounter(ctx context.Context, in *QueryVotePenaltyCounterRequest, opts ...grpc.CallOption) (*QueryVotePenaltyCounterResponse, error) {
-	out := new(QueryVotePenaltyCounterResponse)
-	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/VotePenaltyCounter", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryAggregatePrevoteRequest proto.InternalMessageInfo
+
+// QueryAggregatePrevoteResponse is response type for the
+// Query/AggregatePrevote RPC method.
+type QueryAggregatePrevoteResponse struct {
+	AggregatePrevote AggregateExchangeRatePrevote `protobuf:"bytes,1,opt,name=aggregate_prevote,json=aggregatePrevote,proto3" json:"aggregate_prevote"`
 }
 
-func (c *queryClient) SlashWindow(ctx context.Context, in *QuerySlashWindowRequest, opts ...grpc.CallOption) (*QuerySlashWindowResponse, error) {
-	out := new(QuerySlashWindowResponse)
-	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/SlashWindow", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAggregatePrevoteResponse) Reset()         { *m = QueryAggregatePrevoteResponse{} }
+func (m *QueryAggregatePrevoteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregatePrevoteResponse) ProtoMessage()    {}
+func (*QueryAggregatePrevoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{28}
+}
+func (m *QueryAggregatePrevoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregatePrevoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregatePrevoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAggregatePrevoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregatePrevoteResponse.Merge(m, src)
+}
+func (m *QueryAggregatePrevoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAggregatePrevoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregatePrevoteResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAggregatePrevoteResponse proto.InternalMessageInfo
+
+func (m *QueryAggregatePrevoteResponse) GetAggregatePrevote() AggregateExchangeRatePrevote {
+	if m != nil {
+		return m.AggregatePrevote
 	}
-	return out, nil
+	return AggregateExchangeRatePrevote{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// ExchangeRate returns exchange rate of a denom
-	ExchangeRate(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error)
-	// ExchangeRates returns exchange rates of all denoms
-	ExchangeRates(context.Context, *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error)
-	// Actives returns all active denoms
-	Actives(context.Context, *QueryActivesRequest) (*QueryActivesResponse, error)
-	// VoteTargets returns all vote target denoms
-	VoteTargets(context.Context, *QueryVoteTargetsRequest) (*QueryVoteTargetsResponse, error)
-	// PriceSnapshotHistory returns the history of price snapshots for all assets
-	PriceSnapshotHistory(context.Context, *QueryPriceSnapshotHistoryRequest) (*QueryPriceSnapshotHistoryResponse, error)
-	Twaps(context.Context, *QueryTwapsRequest) (*QueryTwapsResponse, error)
-	// FeederDelegation returns feeder delegation of a validator
-	FeederDelegation(context.Context, *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error)
-	// MissCounter returns oracle miss counter of a validator
-	VotePenaltyCounter(context.Context, *QueryVotePenaltyCounterRequest) (*QueryVotePenaltyCounterResponse, error)
-	// SlashWindow returns slash window information
-	SlashWindow(context.Context, *QuerySlashWindowRequest) (*QuerySlashWindowResponse, error)
-	// Params queries all parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+// QueryFeederPermissionsRequest is the request type for the Query/FeederPermissions RPC method.
+type QueryFeederPermissionsRequest struct {
+	// validator defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryFeederPermissionsRequest) Reset()         { *m = QueryFeederPermissionsRequest{} }
+func (m *QueryFeederPermissionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeederPermissionsRequest) ProtoMessage()    {}
+func (*QueryFeederPermissionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{29}
 }
-
-func (*UnimplementedQueryServer) ExchangeRate(ctx context.Context, req *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRate not implemented")
+func (m *QueryFeederPermissionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ExchangeRates(ctx context.Context, req *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRates not implemented")
+func (m *QueryFeederPermissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeederPermissionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Actives(ctx context.Context, req *QueryActivesRequest) (*QueryActivesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Actives not implemented")
+func (m *QueryFeederPermissionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeederPermissionsRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) VoteTargets(ctx context.Context, req *QueryVoteTargetsRequest) (*QueryVoteTargetsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VoteTargets not implemented")
+func (m *QueryFeederPermissionsRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) PriceSnapshotHistory(ctx context.Context, req *QueryPriceSnapshotHistoryRequest) (*QueryPriceSnapshotHistoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PriceSnapshotHistory not implemented")
+func (m *QueryFeederPermissionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeederPermissionsRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) Twaps(ctx context.Context, req *QueryTwapsRequest) (*QueryTwapsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Twaps not implemented")
+
+var xxx_messageInfo_QueryFeederPermissionsRequest proto.InternalMessageInfo
+
+// QueryFeederPermissionsResponse is response type for the
+// Query/FeederPermissions RPC method.
+type QueryFeederPermissionsResponse struct {
+	FeederPermissions []FeederPermission `protobuf:"bytes,1,rep,name=feeder_permissions,json=feederPermissions,proto3" json:"feeder_permissions"`
 }
-func (*UnimplementedQueryServer) FeederDelegation(ctx context.Context, req *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FeederDelegation not implemented")
+
+func (m *QueryFeederPermissionsResponse) Reset()         { *m = QueryFeederPermissionsResponse{} }
+func (m *QueryFeederPermissionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeederPermissionsResponse) ProtoMessage()    {}
+func (*QueryFeederPermissionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{30}
 }
-func (*UnimplementedQueryServer) VotePenaltyCounter(ctx context.Context, req *QueryVotePenaltyCounterRequest) (*QueryVotePenaltyCounterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VotePenaltyCounter not implemented")
+func (m *QueryFeederPermissionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) SlashWindow(ctx context.Context, req *QuerySlashWindowRequest) (*QuerySlashWindowResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SlashWindow not implemented")
+func (m *QueryFeederPermissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeederPermissionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryFeederPermissionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeederPermissionsResponse.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryFeederPermissionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeederPermissionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeederPermissionsResponse.DiscardUnknown(m)
 }
 
-func _Query_ExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryExchangeRateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ExchangeRate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/seiprotocol.seichain.oracle.Query/ExchangeRate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ExchangeRate(ctx, req.(*QueryExchangeRateRequest))
+var xxx_messageInfo_QueryFeederPermissionsResponse proto.InternalMessageInfo
+
+func (m *QueryFeederPermissionsResponse) GetFeederPermissions() []FeederPermission {
+	if m != nil {
+		return m.FeederPermissions
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryExchangeRatesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ExchangeRates(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/seiprotocol.seichain.oracle.Query/ExchangeRates",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ExchangeRates(ctx, req.(*QueryExchangeRatesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryOracleRewardsRequest is the request type for the Query/OracleRewards RPC method.
+type QueryOracleRewardsRequest struct {
+	// validator defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
 }
 
-func _Query_Actives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryActivesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Actives(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/seiprotocol.seichain.oracle.Query/Actives",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Actives(ctx, req.(*QueryActivesRequest))
+func (m *QueryOracleRewardsRequest) Reset()         { *m = QueryOracleRewardsRequest{} }
+func (m *QueryOracleRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleRewardsRequest) ProtoMessage()    {}
+func (*QueryOracleRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{31}
+}
+func (m *QueryOracleRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryOracleRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleRewardsRequest.Merge(m, src)
+}
+func (m *QueryOracleRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleRewardsRequest.DiscardUnknown(m)
 }
 
-func _Query_VoteTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVoteTargetsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).VoteTargets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/seiprotocol.seichain.oracle.Query/VoteTargets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).VoteTargets(ctx, req.(*QueryVoteTargetsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryOracleRewardsRequest proto.InternalMessageInfo
+
+// QueryOracleRewardsResponse is response type for the
+// Query/OracleRewards RPC method.
+type QueryOracleRewardsResponse struct {
+	// rewards defines the total oracle rewards distributed to the validator
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
 }
 
-func _Query_PriceSnapshotHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPriceSnapshotHistoryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PriceSnapshotHistory(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/seiprotocol.seichain.oracle.Query/PriceSnapshotHistory",
+func (m *QueryOracleRewardsResponse) Reset()         { *m = QueryOracleRewardsResponse{} }
+func (m *QueryOracleRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleRewardsResponse) ProtoMessage()    {}
+func (*QueryOracleRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{32}
+}
+func (m *QueryOracleRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PriceSnapshotHistory(ctx, req.(*QueryPriceSnapshotHistoryRequest))
+}
+func (m *QueryOracleRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleRewardsResponse.Merge(m, src)
+}
+func (m *QueryOracleRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOracleRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryOracleRewardsResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Twaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTwapsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Twaps(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/seiprotocol.seichain.oracle.Query/Twaps",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Twaps(ctx, req.(*QueryTwapsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryVotePenaltyCounterRequest is the request type for the Query/MissCounter RPC method.
+type QueryVotePenaltyCounterRequest struct {
+	// validator defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
 }
 
-func _Query_FeederDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFeederDelegationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FeederDelegation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/seiprotocol.seichain.oracle.Query/FeederDelegation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FeederDelegation(ctx, req.(*QueryFeederDelegationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryVotePenaltyCounterRequest) Reset()         { *m = QueryVotePenaltyCounterRequest{} }
+func (m *QueryVotePenaltyCounterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVotePenaltyCounterRequest) ProtoMessage()    {}
+func (*QueryVotePenaltyCounterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{33}
 }
-
-func _Query_VotePenaltyCounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVotePenaltyCounterRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).VotePenaltyCounter(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/seiprotocol.seichain.oracle.Query/VotePenaltyCounter",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).VotePenaltyCounter(ctx, req.(*QueryVotePenaltyCounterRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryVotePenaltyCounterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_SlashWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySlashWindowRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SlashWindow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/seiprotocol.seichain.oracle.Query/SlashWindow",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SlashWindow(ctx, req.(*QuerySlashWindowRequest))
+func (m *QueryVotePenaltyCounterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotePenaltyCounterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/seiprotocol.seichain.oracle.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryVotePenaltyCounterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotePenaltyCounterRequest.Merge(m, src)
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "seiprotocol.seichain.oracle.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ExchangeRate",
-			Handler:    _Query_ExchangeRate_Handler,
-		},
-		{
-			MethodName: "ExchangeRates",
-			Handler:    _Query_ExchangeRates_Handler,
-		},
-		{
-			MethodName: "Actives",
-			Handler:    _Query_Actives_Handler,
-		},
-		{
-			MethodName: "VoteTargets",
-			Handler:    _Query_VoteTargets_Handler,
-		},
-		{
-			MethodName: "PriceSnapshotHistory",
-			Handler:    _Query_PriceSnapshotHistory_Handler,
-		},
-		{
-			MethodName: "Twaps",
-			Handler:    _Query_Twaps_Handler,
-		},
-		{
-			MethodName: "FeederDelegation",
-			Handler:    _Query_FeederDelegation_Handler,
-		},
-		{
-			MethodName: "VotePenaltyCounter",
-			Handler:    _Query_VotePenaltyCounter_Handler,
-		},
-		{
-			MethodName: "SlashWindow",
-			Handler:    _Query_SlashWindow_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "oracle/query.proto",
+func (m *QueryVotePenaltyCounterRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryExchangeRateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryVotePenaltyCounterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotePenaltyCounterRequest.DiscardUnknown(m)
 }
 
-func (m *QueryExchangeRateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_QueryVotePenaltyCounterRequest proto.InternalMessageInfo
 
-func (m *QueryExchangeRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryVotePenaltyCounterResponse is response type for the
+// Query/VotePenaltyCounter RPC method.
+type QueryVotePenaltyCounterResponse struct {
+	VotePenaltyCounter *VotePenaltyCounter `protobuf:"bytes,1,opt,name=vote_penalty_counter,json=votePenaltyCounter,proto3" json:"vote_penalty_counter,omitempty"`
 }
 
-func (m *QueryExchangeRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryVotePenaltyCounterResponse) Reset()         { *m = QueryVotePenaltyCounterResponse{} }
+func (m *QueryVotePenaltyCounterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVotePenaltyCounterResponse) ProtoMessage()    {}
+func (*QueryVotePenaltyCounterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{34}
 }
-
-func (m *QueryExchangeRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryVotePenaltyCounterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryExchangeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.OracleExchangeRate.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryVotePenaltyCounterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotePenaltyCounterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryVotePenaltyCounterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotePenaltyCounterResponse.Merge(m, src)
+}
+func (m *QueryVotePenaltyCounterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotePenaltyCounterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotePenaltyCounterResponse.DiscardUnknown(m)
 }
 
-func (m *QueryExchangeRatesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVotePenaltyCounterResponse proto.InternalMessageInfo
+
+func (m *QueryVotePenaltyCounterResponse) GetVotePenaltyCounter() *VotePenaltyCounter {
+	if m != nil {
+		return m.VotePenaltyCounter
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryExchangeRatesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySlashWindow is the request type for the
+// Query/SlashWindow RPC method.
+type QuerySlashWindowRequest struct {
 }
 
-func (m *QueryExchangeRatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QuerySlashWindowRequest) Reset()         { *m = QuerySlashWindowRequest{} }
+func (m *QuerySlashWindowRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashWindowRequest) ProtoMessage()    {}
+func (*QuerySlashWindowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{35}
 }
-
-func (m *DenomOracleExchangeRatePair) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySlashWindowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashWindowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashWindowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QuerySlashWindowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashWindowRequest.Merge(m, src)
+}
+func (m *QuerySlashWindowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashWindowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashWindowRequest.DiscardUnknown(m)
 }
 
-func (m *DenomOracleExchangeRatePair) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QuerySlashWindowRequest proto.InternalMessageInfo
+
+// QuerySlashWindowResponse is response type for the
+// Query/SlashWindow RPC method.
+type QuerySlashWindowResponse struct {
+	// window_progress defines the number of voting periods
+	// since the last slashing event would have taken place.
+	WindowProgress uint64 `protobuf:"varint,1,opt,name=window_progress,json=windowProgress,proto3" json:"window_progress,omitempty"`
 }
 
-func (m *DenomOracleExchangeRatePair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.OracleExchangeRate.MarshalToSizedBuffer(dAtA[:i])
+func (m *QuerySlashWindowResponse) Reset()         { *m = QuerySlashWindowResponse{} }
+func (m *QuerySlashWindowResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashWindowResponse) ProtoMessage()    {}
+func (*QuerySlashWindowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{36}
+}
+func (m *QuerySlashWindowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashWindowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashWindowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySlashWindowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashWindowResponse.Merge(m, src)
+}
+func (m *QuerySlashWindowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashWindowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashWindowResponse.DiscardUnknown(m)
 }
 
-func (m *QueryExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySlashWindowResponse proto.InternalMessageInfo
+
+func (m *QuerySlashWindowResponse) GetWindowProgress() uint64 {
+	if m != nil {
+		return m.WindowProgress
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryExchangeRatesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
 }
 
-func (m *QueryExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DenomOracleExchangeRatePairs) > 0 {
-		for iNdEx := len(m.DenomOracleExchangeRatePairs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DenomOracleExchangeRatePairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{37}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryActivesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
 }
-
-func (m *QueryActivesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryActivesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryActivesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 
-func (m *QueryActivesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func (m *QueryActivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Actives) > 0 {
-		for iNdEx := len(m.Actives) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Actives[iNdEx])
-			copy(dAtA[i:], m.Actives[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Actives[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{38}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryVoteTargetsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return dAtA[:n], nil
+	return Params{}
 }
 
-func (m *QueryVoteTargetsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryVoteHistoryRequest is the request type for the Query/VoteHistory RPC method.
+type QueryVoteHistoryRequest struct {
+	// validator defines the validator address to query for.
+	ValidatorAddr string             `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryVoteTargetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryVoteHistoryRequest) Reset()         { *m = QueryVoteHistoryRequest{} }
+func (m *QueryVoteHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteHistoryRequest) ProtoMessage()    {}
+func (*QueryVoteHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{39}
 }
-
-func (m *QueryVoteTargetsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVoteHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryVoteHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteHistoryRequest.Merge(m, src)
+}
+func (m *QueryVoteHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryVoteTargetsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryVoteHistoryRequest proto.InternalMessageInfo
+
+// QueryVoteHistoryResponse is response type for the
+// Query/VoteHistory RPC method.
+type QueryVoteHistoryResponse struct {
+	// ballots only contain the ballot of the queried validator
+	Ballots    []VotePeriodBallot  `protobuf:"bytes,1,rep,name=ballots,proto3" json:"ballots"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryVoteTargetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VoteTargets) > 0 {
-		for iNdEx := len(m.VoteTargets) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.VoteTargets[iNdEx])
-			copy(dAtA[i:], m.VoteTargets[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.VoteTargets[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryVoteHistoryResponse) Reset()         { *m = QueryVoteHistoryResponse{} }
+func (m *QueryVoteHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteHistoryResponse) ProtoMessage()    {}
+func (*QueryVoteHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{40}
+}
+func (m *QueryVoteHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryVoteHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteHistoryResponse.Merge(m, src)
+}
+func (m *QueryVoteHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPriceSnapshotHistoryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVoteHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryVoteHistoryResponse) GetBallots() []VotePeriodBallot {
+	if m != nil {
+		return m.Ballots
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPriceSnapshotHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryVoteHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryPriceSnapshotHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterType((*QueryExchangeRateRequest)(nil), "seiprotocol.seichain.oracle.QueryExchangeRateRequest")
+	proto.RegisterType((*QueryExchangeRateResponse)(nil), "seiprotocol.seichain.oracle.QueryExchangeRateResponse")
+	proto.RegisterType((*QueryFreshExchangeRateRequest)(nil), "seiprotocol.seichain.oracle.QueryFreshExchangeRateRequest")
+	proto.RegisterType((*QueryFreshExchangeRateResponse)(nil), "seiprotocol.seichain.oracle.QueryFreshExchangeRateResponse")
+	proto.RegisterType((*QueryExchangeRatesRequest)(nil), "seiprotocol.seichain.oracle.QueryExchangeRatesRequest")
+	proto.RegisterType((*DenomOracleExchangeRatePair)(nil), "seiprotocol.seichain.oracle.DenomOracleExchangeRatePair")
+	proto.RegisterType((*QueryExchangeRatesResponse)(nil), "seiprotocol.seichain.oracle.QueryExchangeRatesResponse")
+	proto.RegisterType((*QueryActivesRequest)(nil), "seiprotocol.seichain.oracle.QueryActivesRequest")
+	proto.RegisterType((*QueryActivesResponse)(nil), "seiprotocol.seichain.oracle.QueryActivesResponse")
+	proto.RegisterType((*QueryVoteTargetsRequest)(nil), "seiprotocol.seichain.oracle.QueryVoteTargetsRequest")
+	proto.RegisterType((*QueryVoteTargetsResponse)(nil), "seiprotocol.seichain.oracle.QueryVoteTargetsResponse")
+	proto.RegisterType((*QueryVoteTargetTransitionsRequest)(nil), "seiprotocol.seichain.oracle.QueryVoteTargetTransitionsRequest")
+	proto.RegisterType((*QueryVoteTargetTransitionsResponse)(nil), "seiprotocol.seichain.oracle.QueryVoteTargetTransitionsResponse")
+	proto.RegisterType((*QueryPriceSnapshotHistoryRequest)(nil), "seiprotocol.seichain.oracle.QueryPriceSnapshotHistoryRequest")
+	proto.RegisterType((*QueryPriceSnapshotHistoryResponse)(nil), "seiprotocol.seichain.oracle.QueryPriceSnapshotHistoryResponse")
+	proto.RegisterType((*QueryTwapsRequest)(nil), "seiprotocol.seichain.oracle.QueryTwapsRequest")
+	proto.RegisterType((*QueryTwapsResponse)(nil), "seiprotocol.seichain.oracle.QueryTwapsResponse")
+	proto.RegisterType((*QueryEmasRequest)(nil), "seiprotocol.seichain.oracle.QueryEmasRequest")
+	proto.RegisterType((*QueryEmasResponse)(nil), "seiprotocol.seichain.oracle.QueryEmasResponse")
+	proto.RegisterType((*QueryPriceRangesRequest)(nil), "seiprotocol.seichain.oracle.QueryPriceRangesRequest")
+	proto.RegisterType((*QueryPriceRangesResponse)(nil), "seiprotocol.seichain.oracle.QueryPriceRangesResponse")
+	proto.RegisterType((*QueryVolatilitiesRequest)(nil), "seiprotocol.seichain.oracle.QueryVolatilitiesRequest")
+	proto.RegisterType((*QueryVolatilitiesResponse)(nil), "seiprotocol.seichain.oracle.QueryVolatilitiesResponse")
+	proto.RegisterType((*QueryPriceAtTimestampRequest)(nil), "seiprotocol.seichain.oracle.QueryPriceAtTimestampRequest")
+	proto.RegisterType((*QueryPriceAtTimestampResponse)(nil), "seiprotocol.seichain.oracle.QueryPriceAtTimestampResponse")
+	proto.RegisterType((*QueryFeederDelegationRequest)(nil), "seiprotocol.seichain.oracle.QueryFeederDelegationRequest")
+	proto.RegisterType((*QueryFeederDelegationResponse)(nil), "seiprotocol.seichain.oracle.QueryFeederDelegationResponse")
+	proto.RegisterType((*QueryAggregatePrevoteRequest)(nil), "seiprotocol.seichain.oracle.QueryAggregatePrevoteRequest")
+	proto.RegisterType((*QueryAggregatePrevoteResponse)(nil), "seiprotocol.seichain.oracle.QueryAggregatePrevoteResponse")
+	proto.RegisterType((*QueryFeederPermissionsRequest)(nil), "seiprotocol.seichain.oracle.QueryFeederPermissionsRequest")
+	proto.RegisterType((*QueryFeederPermissionsResponse)(nil), "seiprotocol.seichain.oracle.QueryFeederPermissionsResponse")
+	proto.RegisterType((*QueryOracleRewardsRequest)(nil), "seiprotocol.seichain.oracle.QueryOracleRewardsRequest")
+	proto.RegisterType((*QueryOracleRewardsResponse)(nil), "seiprotocol.seichain.oracle.QueryOracleRewardsResponse")
+	proto.RegisterType((*QueryVotePenaltyCounterRequest)(nil), "seiprotocol.seichain.oracle.QueryVotePenaltyCounterRequest")
+	proto.RegisterType((*QueryVotePenaltyCounterResponse)(nil), "seiprotocol.seichain.oracle.QueryVotePenaltyCounterResponse")
+	proto.RegisterType((*QuerySlashWindowRequest)(nil), "seiprotocol.seichain.oracle.QuerySlashWindowRequest")
+	proto.RegisterType((*QuerySlashWindowResponse)(nil), "seiprotocol.seichain.oracle.QuerySlashWindowResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "seiprotocol.seichain.oracle.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "seiprotocol.seichain.oracle.QueryParamsResponse")
+	proto.RegisterType((*QueryVoteHistoryRequest)(nil), "seiprotocol.seichain.oracle.QueryVoteHistoryRequest")
+	proto.RegisterType((*QueryVoteHistoryResponse)(nil), "seiprotocol.seichain.oracle.QueryVoteHistoryResponse")
 }
 
-func (m *QueryPriceSnapshotHistoryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() { proto.RegisterFile("oracle/query.proto", fileDescriptor_562b782cb9ac197e) }
+
+var fileDescriptor_562b782cb9ac197e = []byte{
+	// 2018 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x77, 0xe5, 0xcb, 0x9b, 0x37, 0x89, 0x63, 0x57, 0xbc, 0xe0, 0x74, 0x1c, 0xdb, 0xe9, 0x25,
+	0x1f, 0x2c, 0xf2, 0xb4, 0xe3, 0xc4, 0x59, 0xd6, 0x49, 0xbc, 0xeb, 0x71, 0x3e, 0x08, 0x6c, 0xf0,
+	0x78, 0x62, 0x08, 0x1f, 0x87, 0x56, 0x79, 0xa6, 0x32, 0x6e, 0x65, 0xa6, 0xab, 0xb7, 0xab, 0xed,
+	0xd8, 0x44, 0xb9, 0x00, 0x07, 0x2e, 0x48, 0x2b, 0x40, 0x42, 0xda, 0xd3, 0x5e, 0xe0, 0x80, 0x90,
+	0x40, 0x7b, 0x80, 0x1b, 0x07, 0x24, 0xa4, 0x45, 0x70, 0x88, 0xb4, 0x48, 0x20, 0x21, 0x11, 0x94,
+	0xe4, 0xb0, 0x7f, 0x06, 0xea, 0xaa, 0xd7, 0x33, 0xdd, 0xd3, 0x33, 0xe3, 0xee, 0xb1, 0xd8, 0xd3,
+	0x4c, 0xbf, 0x57, 0xef, 0xd5, 0xef, 0xf7, 0x5e, 0x75, 0xf5, 0x7b, 0x0f, 0xa8, 0xf0, 0x59, 0xb5,
+	0xc1, 0xad, 0xf7, 0xb7, 0xb8, 0xbf, 0x5b, 0xf4, 0x7c, 0x11, 0x08, 0x7a, 0x5a, 0x72, 0x47, 0xfd,
+	0xab, 0x8a, 0x46, 0x51, 0x72, 0xa7, 0xba, 0xc9, 0x1c, 0xb7, 0xa8, 0x17, 0x1a, 0xe3, 0x75, 0x51,
+	0x17, 0x4a, 0x6b, 0x85, 0xff, 0xb4, 0x89, 0x31, 0x59, 0x17, 0xa2, 0xde, 0xe0, 0x16, 0xf3, 0x1c,
+	0x8b, 0xb9, 0xae, 0x08, 0x58, 0xe0, 0x08, 0x57, 0xa2, 0xf6, 0x24, 0x6e, 0xa2, 0x7f, 0x50, 0x38,
+	0x55, 0x15, 0xb2, 0x29, 0xa4, 0xb5, 0xc1, 0x24, 0xb7, 0xb6, 0x2f, 0x6d, 0xf0, 0x80, 0x5d, 0xb2,
+	0xaa, 0xc2, 0x71, 0x51, 0xff, 0x66, 0x5c, 0xaf, 0xe0, 0xb5, 0x56, 0x79, 0xac, 0xee, 0xb8, 0x6a,
+	0x07, 0xbd, 0xd6, 0x5c, 0x84, 0x89, 0xb5, 0x70, 0xc5, 0xad, 0x9d, 0xea, 0x26, 0x73, 0xeb, 0xbc,
+	0xc2, 0x02, 0x5e, 0xe1, 0xef, 0x6f, 0x71, 0x19, 0xd0, 0x71, 0x38, 0x5c, 0xe3, 0xae, 0x68, 0x4e,
+	0x90, 0x19, 0x72, 0xf1, 0x68, 0x45, 0x3f, 0x2c, 0xbe, 0xf6, 0x93, 0x8f, 0xa6, 0x87, 0x3e, 0xfb,
+	0x68, 0x7a, 0xc8, 0xfc, 0x90, 0xc0, 0xa9, 0x2e, 0xc6, 0xd2, 0x13, 0xae, 0xe4, 0xb4, 0x0e, 0xe3,
+	0x1a, 0xb5, 0xcd, 0x51, 0x6d, 0xfb, 0x2c, 0xe0, 0xca, 0x59, 0x61, 0xde, 0x2a, 0xf6, 0x09, 0x55,
+	0x71, 0x55, 0xfd, 0xc4, 0xdd, 0x96, 0x0e, 0x7d, 0xf2, 0x9f, 0xe9, 0xa1, 0x0a, 0x15, 0x29, 0x4d,
+	0x08, 0x53, 0x06, 0xac, 0xc1, 0x27, 0x0e, 0xcc, 0x90, 0x8b, 0xaf, 0x55, 0xf4, 0x83, 0x59, 0x87,
+	0x33, 0x0a, 0xdb, 0x6d, 0x9f, 0xcb, 0xcd, 0xcc, 0xec, 0xe8, 0x79, 0x38, 0xd1, 0x64, 0x3b, 0x36,
+	0xab, 0x73, 0x5b, 0xf2, 0xaa, 0x70, 0x6b, 0x52, 0xb9, 0x3d, 0x54, 0x39, 0xde, 0x64, 0x3b, 0xcb,
+	0x75, 0x7e, 0x5f, 0x0b, 0x63, 0x51, 0x78, 0x45, 0x60, 0xaa, 0xd7, 0x4e, 0x9f, 0x77, 0x28, 0xbe,
+	0x0b, 0x50, 0x15, 0xee, 0x43, 0xa7, 0xc6, 0xdd, 0xaa, 0x8e, 0x47, 0x61, 0xfe, 0x72, 0x5f, 0xf7,
+	0x71, 0xf3, 0x95, 0x96, 0x29, 0x6e, 0x11, 0x73, 0x66, 0x9e, 0xee, 0x92, 0x6b, 0x89, 0xb1, 0x34,
+	0x3f, 0x26, 0x70, 0xfa, 0x66, 0x18, 0xbf, 0x34, 0xda, 0x32, 0x73, 0xfc, 0x1e, 0xb1, 0xee, 0x15,
+	0x96, 0x03, 0xff, 0xb7, 0x13, 0x72, 0x30, 0x7e, 0x42, 0xfe, 0x42, 0xc0, 0xe8, 0x46, 0x09, 0x93,
+	0xf6, 0x6b, 0x02, 0x33, 0x0a, 0xa7, 0xdd, 0x0d, 0xa4, 0xed, 0x31, 0xc7, 0x97, 0x13, 0x64, 0xe6,
+	0xe0, 0xc5, 0xc2, 0xfc, 0x57, 0xfb, 0x42, 0xed, 0x13, 0x98, 0xd2, 0x97, 0x42, 0xcc, 0xbf, 0x79,
+	0x3e, 0x3d, 0xd9, 0x67, 0x91, 0xac, 0x4c, 0xd6, 0xfa, 0x68, 0xcd, 0xd7, 0xe1, 0xa4, 0xa2, 0xb1,
+	0x5c, 0x0d, 0x9c, 0xed, 0x76, 0x4e, 0xe6, 0x60, 0x3c, 0x29, 0x46, 0x5e, 0x13, 0x30, 0xcc, 0xb4,
+	0x48, 0xa1, 0x3f, 0x5a, 0x89, 0x1e, 0xcd, 0x53, 0xf0, 0x45, 0x65, 0xf1, 0x6d, 0x11, 0xf0, 0x75,
+	0xe6, 0xd7, 0x79, 0xd0, 0x72, 0x76, 0x03, 0x26, 0xd2, 0x2a, 0x74, 0x78, 0x16, 0x8e, 0x6d, 0x8b,
+	0x80, 0xdb, 0x81, 0x96, 0xa3, 0xd7, 0xc2, 0x76, 0x7b, 0xa9, 0xf9, 0x06, 0x9c, 0xed, 0x30, 0x5f,
+	0xf7, 0x99, 0x2b, 0x1d, 0x75, 0xd5, 0x45, 0x7b, 0x3c, 0x23, 0x60, 0xf6, 0x5b, 0x85, 0xdb, 0x3d,
+	0x00, 0xf0, 0x7c, 0xb1, 0xa1, 0xaf, 0x49, 0x4c, 0xc0, 0xa5, 0xbe, 0x09, 0xe8, 0xe6, 0x2f, 0x3a,
+	0xe1, 0x6d, 0x57, 0x74, 0x0d, 0x86, 0xe5, 0x96, 0x2b, 0x43, 0x0a, 0x07, 0xf6, 0xe7, 0x35, 0xf2,
+	0x63, 0x9a, 0x30, 0xa3, 0x18, 0x95, 0x7d, 0xa7, 0xca, 0xef, 0xbb, 0xcc, 0x93, 0x9b, 0x22, 0xf8,
+	0x9a, 0x23, 0x03, 0xe1, 0xef, 0x46, 0xb4, 0x3f, 0x20, 0x70, 0xb6, 0xcf, 0x22, 0x64, 0xfd, 0x08,
+	0x4e, 0x78, 0xa1, 0xde, 0x96, 0xb8, 0x20, 0xa2, 0xfe, 0x66, 0x5f, 0x90, 0x09, 0x9f, 0xa5, 0x2f,
+	0xe0, 0x69, 0x1b, 0x49, 0x88, 0x65, 0x65, 0xc4, 0x4b, 0x3c, 0x9b, 0x4b, 0x30, 0xa6, 0x10, 0xad,
+	0x3f, 0x66, 0x5e, 0x94, 0x1e, 0xfa, 0x65, 0x18, 0x6d, 0x08, 0xf1, 0x68, 0x83, 0x55, 0x1f, 0xb5,
+	0xae, 0x46, 0xa2, 0xae, 0xc6, 0x13, 0x91, 0x1c, 0x2f, 0x47, 0x73, 0x0b, 0x68, 0xdc, 0x1e, 0x29,
+	0xd8, 0x70, 0x0c, 0xdf, 0xa4, 0x20, 0x94, 0x23, 0xfe, 0x0b, 0x19, 0x5e, 0xf3, 0xd0, 0x4f, 0xe9,
+	0x24, 0x82, 0x2f, 0xb4, 0x65, 0xb2, 0x52, 0x10, 0xed, 0x07, 0xb3, 0x06, 0xa3, 0xfa, 0x7d, 0x6e,
+	0xb2, 0x01, 0x50, 0xd3, 0x73, 0x30, 0xe2, 0x71, 0xdf, 0x11, 0xb5, 0xce, 0x9b, 0x5f, 0x4b, 0x23,
+	0x72, 0x1e, 0x8c, 0xc5, 0x76, 0x41, 0x6e, 0xdf, 0x07, 0x44, 0x62, 0xf3, 0x26, 0x8b, 0xa8, 0x9d,
+	0xcf, 0x72, 0x83, 0x35, 0x59, 0x89, 0x22, 0x33, 0x68, 0x89, 0x64, 0x05, 0x44, 0xeb, 0xbf, 0x79,
+	0x13, 0xdf, 0x4b, 0x95, 0xb5, 0x4a, 0xf8, 0xf2, 0x0f, 0x92, 0x94, 0x5f, 0x10, 0x98, 0x48, 0xbb,
+	0x41, 0xfc, 0x3b, 0x80, 0x95, 0x86, 0xad, 0x4f, 0x99, 0xaf, 0xd4, 0xc8, 0x63, 0x36, 0x03, 0x8f,
+	0xb6, 0xd3, 0xd2, 0x29, 0xa4, 0x33, 0xd6, 0xa9, 0x91, 0x95, 0x31, 0xd1, 0x29, 0x32, 0x6f, 0xb5,
+	0x6e, 0x96, 0x06, 0x0b, 0x9c, 0x86, 0x13, 0x38, 0x03, 0xb1, 0xfb, 0x65, 0x54, 0x8b, 0x24, 0xfd,
+	0x20, 0xbd, 0x1f, 0xb4, 0xe8, 0x6d, 0xc7, 0xd4, 0x39, 0xe8, 0xb5, 0xbc, 0xee, 0x96, 0x0c, 0xa4,
+	0x47, 0x3b, 0x34, 0xe1, 0x7e, 0x54, 0xa4, 0x64, 0x66, 0x05, 0x26, 0xdb, 0x61, 0x5f, 0x0e, 0xd6,
+	0x9d, 0x26, 0x97, 0x01, 0x6b, 0x7a, 0xfd, 0xeb, 0x90, 0x49, 0x38, 0x1a, 0x44, 0x2b, 0xd5, 0x39,
+	0x3c, 0x58, 0x69, 0x0b, 0xcc, 0x3f, 0x12, 0x38, 0xd3, 0xc3, 0xe9, 0xe7, 0x5d, 0x72, 0xcc, 0x02,
+	0x8d, 0xae, 0x24, 0xbb, 0x13, 0xf1, 0x58, 0xa4, 0x69, 0xe1, 0x33, 0x57, 0x31, 0x1a, 0xb7, 0x39,
+	0xaf, 0x71, 0xff, 0x26, 0x6f, 0xf0, 0xba, 0xba, 0x7e, 0xa3, 0x68, 0x9c, 0x83, 0x91, 0x6d, 0xd6,
+	0x70, 0x6a, 0x2c, 0x10, 0xbe, 0xcd, 0x6a, 0x35, 0x1f, 0xc3, 0x72, 0xbc, 0x25, 0x5d, 0xae, 0xd5,
+	0xfc, 0x58, 0xf9, 0xf5, 0x2e, 0x9c, 0xe9, 0xe1, 0x10, 0x23, 0x31, 0x0d, 0x85, 0x87, 0x4a, 0x17,
+	0x77, 0x07, 0x5a, 0x14, 0xfa, 0x6a, 0x41, 0x5a, 0xae, 0xd7, 0xfd, 0xd0, 0x98, 0x97, 0x7d, 0x1e,
+	0x7e, 0xbc, 0x06, 0x86, 0xf4, 0xd3, 0x28, 0x3b, 0x69, 0x8f, 0x88, 0xa9, 0x01, 0x63, 0x2c, 0xd2,
+	0xd9, 0x9e, 0x56, 0x62, 0x6a, 0xde, 0xee, 0x9b, 0x9a, 0x96, 0xc7, 0x44, 0x19, 0xa0, 0x1d, 0x60,
+	0x92, 0x46, 0x59, 0xc7, 0xae, 0x66, 0x39, 0x11, 0xa2, 0x32, 0xf7, 0x9b, 0x8e, 0x94, 0xb1, 0x2f,
+	0x6f, 0x7e, 0x86, 0x3f, 0x6e, 0xd5, 0xbc, 0x69, 0x97, 0x48, 0x71, 0x03, 0x28, 0x86, 0xdd, 0x6b,
+	0x6b, 0x33, 0xbd, 0x71, 0x9d, 0x3e, 0x91, 0xd7, 0xd8, 0xc3, 0xce, 0xbd, 0xcc, 0xf7, 0xf0, 0x9d,
+	0xd7, 0x07, 0xb6, 0xc2, 0x1f, 0x33, 0xbf, 0x36, 0x38, 0xa9, 0x1f, 0x45, 0xf5, 0x60, 0x87, 0x3b,
+	0x24, 0xc4, 0x61, 0xd8, 0xd7, 0x22, 0x64, 0x71, 0xaa, 0xa8, 0xfb, 0xac, 0x62, 0xd8, 0x67, 0x15,
+	0xb1, 0xc3, 0x2a, 0xae, 0x08, 0xc7, 0x2d, 0xcd, 0xe1, 0x1d, 0x71, 0xb1, 0xee, 0x04, 0x9b, 0x5b,
+	0x1b, 0xc5, 0xaa, 0x68, 0x5a, 0x7a, 0x31, 0xfe, 0xcc, 0xca, 0xda, 0x23, 0x2b, 0xd8, 0xf5, 0xb8,
+	0x54, 0x06, 0xb2, 0x12, 0xf9, 0x36, 0xd7, 0x30, 0xb2, 0x61, 0x79, 0x51, 0xe6, 0x2e, 0x6b, 0x04,
+	0xbb, 0x2b, 0x62, 0xcb, 0x0d, 0xb8, 0xbf, 0x9f, 0x6c, 0x4d, 0xf7, 0xf4, 0x89, 0xec, 0x18, 0x8c,
+	0xab, 0x22, 0xce, 0xd3, 0x6a, 0xbb, 0xaa, 0xf5, 0x99, 0xee, 0x8b, 0x2e, 0x6e, 0xe9, 0x76, 0x4a,
+	0xd6, 0x2a, 0x2f, 0xef, 0x37, 0x98, 0xdc, 0x7c, 0xe0, 0xb8, 0x35, 0xf1, 0x38, 0xaa, 0x81, 0x56,
+	0x60, 0x22, 0xad, 0x42, 0x64, 0x17, 0xe0, 0xc4, 0x63, 0x25, 0xb1, 0x3d, 0x5f, 0xd4, 0x7d, 0x2e,
+	0xa3, 0x6f, 0xc0, 0x88, 0x16, 0x97, 0x51, 0x6a, 0x8e, 0x63, 0xd5, 0x51, 0x66, 0x3e, 0x6b, 0xb6,
+	0xaa, 0xca, 0xef, 0xc0, 0xc9, 0x84, 0x14, 0xbd, 0x2e, 0xc3, 0x11, 0x4f, 0x49, 0x90, 0xe1, 0x1b,
+	0xfd, 0xcb, 0x28, 0xb5, 0x14, 0x0f, 0x22, 0x1a, 0x9a, 0x3f, 0x23, 0xb1, 0x7a, 0x39, 0x59, 0xd4,
+	0x65, 0xcc, 0x11, 0xbd, 0x0d, 0xd0, 0xee, 0xc8, 0xb1, 0xef, 0x39, 0x9f, 0x38, 0x56, 0x7a, 0xba,
+	0x10, 0x1d, 0xae, 0x32, 0xab, 0x47, 0xd7, 0x52, 0x25, 0x66, 0x19, 0xcb, 0xf5, 0xc7, 0x04, 0x26,
+	0xd2, 0xa0, 0x90, 0xf4, 0x3d, 0x18, 0xde, 0x60, 0x8d, 0x86, 0x08, 0xb2, 0xbd, 0x88, 0x3a, 0xaf,
+	0x61, 0xed, 0x53, 0x52, 0x56, 0x51, 0x75, 0x8b, 0x3e, 0xe8, 0x9d, 0x2e, 0xe8, 0x2f, 0xec, 0x89,
+	0x5e, 0x63, 0x89, 0xc3, 0x9f, 0x7f, 0x35, 0x0d, 0x87, 0x15, 0x68, 0xfa, 0x67, 0x02, 0xc7, 0x12,
+	0x9f, 0x97, 0x85, 0xbe, 0x08, 0x7b, 0x8d, 0x2e, 0x8c, 0xab, 0x79, 0xcd, 0x34, 0x2a, 0x73, 0xe5,
+	0x87, 0x9f, 0xbe, 0xfa, 0xf9, 0x81, 0x1b, 0xf4, 0x9a, 0x25, 0xb9, 0x33, 0x1b, 0x39, 0x50, 0x0f,
+	0xca, 0x03, 0x0e, 0x62, 0x2c, 0xf5, 0x91, 0x96, 0xd6, 0x13, 0xf5, 0xfb, 0xd4, 0x4a, 0x7c, 0x63,
+	0xe9, 0x3f, 0x09, 0x8c, 0xa5, 0x86, 0x01, 0x74, 0x71, 0x6f, 0x48, 0xbd, 0x66, 0x15, 0xc6, 0xb5,
+	0x81, 0x6c, 0x91, 0xd3, 0x5d, 0xc5, 0x69, 0x85, 0x2e, 0xe7, 0xe3, 0xf4, 0x30, 0x74, 0x98, 0xac,
+	0x1e, 0xe8, 0x9f, 0x08, 0x1c, 0x8f, 0xef, 0x21, 0x69, 0xce, 0x40, 0x47, 0xaf, 0xa5, 0xf1, 0x56,
+	0x6e, 0x3b, 0x64, 0x73, 0x5d, 0xb1, 0xb9, 0x4a, 0xaf, 0x64, 0x63, 0x93, 0xc0, 0x2f, 0xe9, 0xaf,
+	0x08, 0x0c, 0x63, 0x43, 0x4c, 0xe7, 0xf6, 0x86, 0x90, 0x6c, 0xa9, 0x8d, 0x4b, 0x39, 0x2c, 0x10,
+	0xee, 0x82, 0x82, 0x6b, 0xd1, 0xd9, 0x6c, 0x70, 0xb1, 0x15, 0xa7, 0x7f, 0x20, 0x50, 0x88, 0xf5,
+	0xda, 0xf4, 0xca, 0xde, 0x3b, 0xa7, 0xbb, 0x76, 0x63, 0x21, 0xa7, 0x15, 0x62, 0x5e, 0x54, 0x98,
+	0xaf, 0xd0, 0xf9, 0x6c, 0x98, 0xe3, 0xcd, 0x3f, 0x7d, 0x4e, 0xe0, 0xf5, 0xae, 0xfd, 0x3b, 0x5d,
+	0xca, 0x03, 0x26, 0x3d, 0x1e, 0x30, 0xde, 0x19, 0xd8, 0x1e, 0x69, 0xdd, 0x52, 0xb4, 0xde, 0xa1,
+	0x37, 0x72, 0xd3, 0xb2, 0x83, 0x18, 0x8f, 0x7f, 0x13, 0x18, 0xef, 0xd6, 0xaa, 0xd3, 0x1b, 0x7b,
+	0x03, 0xec, 0x33, 0x07, 0x30, 0x96, 0x06, 0x35, 0x47, 0x7a, 0x37, 0x15, 0xbd, 0x25, 0x7a, 0x3d,
+	0x1b, 0xbd, 0xe4, 0x34, 0xc1, 0xde, 0x44, 0x12, 0xbf, 0x27, 0x70, 0x58, 0x75, 0xd3, 0xb4, 0xb8,
+	0x37, 0x9e, 0xf8, 0x7c, 0xc0, 0xb0, 0x32, 0xaf, 0x47, 0xc0, 0xb7, 0x15, 0xe0, 0x77, 0xe9, 0x52,
+	0x36, 0xc0, 0x6a, 0x68, 0x60, 0x3d, 0xe9, 0x6c, 0x08, 0x9f, 0xd2, 0xdf, 0x12, 0x38, 0x14, 0xf6,
+	0xc9, 0x74, 0x36, 0xc3, 0x9d, 0xd2, 0x1e, 0x0d, 0x18, 0xc5, 0xac, 0xcb, 0x07, 0x3b, 0x3f, 0xbc,
+	0xc9, 0xba, 0xc2, 0xfd, 0x2b, 0x81, 0x42, 0xac, 0x01, 0xce, 0xf2, 0x6a, 0xa7, 0x1b, 0x7f, 0x63,
+	0x21, 0xa7, 0x15, 0x72, 0xb8, 0xa7, 0x38, 0xdc, 0xa1, 0xb7, 0xf2, 0x1c, 0x12, 0x3d, 0x0c, 0xe8,
+	0xc6, 0xe5, 0xef, 0x04, 0x8e, 0xc5, 0x9b, 0x5d, 0x9a, 0xe9, 0xc6, 0x49, 0x35, 0xfa, 0xc6, 0xd5,
+	0xbc, 0x66, 0x83, 0xd1, 0x89, 0x37, 0xff, 0xdd, 0xe8, 0x3c, 0x27, 0x30, 0xda, 0xd9, 0x51, 0xd3,
+	0xb7, 0x33, 0x46, 0x3a, 0xdd, 0xda, 0x1b, 0x8b, 0x83, 0x98, 0x22, 0xb5, 0x75, 0x45, 0xed, 0x9b,
+	0xf4, 0xbd, 0x7c, 0x5f, 0x6d, 0x9d, 0x31, 0x16, 0xeb, 0xc5, 0xad, 0x27, 0xad, 0xbf, 0x4f, 0xe9,
+	0x3f, 0x08, 0x8c, 0x76, 0x76, 0xca, 0x59, 0x18, 0xf6, 0x68, 0xd7, 0x8d, 0xc5, 0x41, 0x4c, 0x73,
+	0xd6, 0x25, 0xad, 0x92, 0x59, 0x5a, 0x4f, 0x92, 0x45, 0xf5, 0x53, 0x4b, 0xf7, 0x84, 0x2a, 0x71,
+	0x9d, 0xcd, 0x76, 0x16, 0x5a, 0x3d, 0x5a, 0x7e, 0x63, 0x71, 0x10, 0xd3, 0x9c, 0x89, 0xeb, 0x47,
+	0x2b, 0x35, 0x1c, 0xa0, 0x2f, 0xc3, 0x9a, 0xb2, 0xb3, 0x01, 0xa6, 0x99, 0xc3, 0x9f, 0x6e, 0xfa,
+	0x8d, 0x6b, 0x03, 0xd9, 0x22, 0xc9, 0x6f, 0x29, 0x92, 0xab, 0xf4, 0xde, 0xbe, 0x73, 0x17, 0x1f,
+	0x0f, 0xd0, 0xbf, 0x61, 0xd9, 0x13, 0x7d, 0x52, 0x33, 0x96, 0x3d, 0x1d, 0x5f, 0xd2, 0x85, 0x9c,
+	0x56, 0xc8, 0x69, 0x55, 0x71, 0xba, 0x4b, 0xef, 0xec, 0x83, 0x93, 0x2a, 0x1a, 0xa2, 0x6f, 0xe9,
+	0xa7, 0x04, 0x8e, 0x27, 0x66, 0x09, 0x59, 0xaa, 0xe5, 0x6e, 0xb3, 0x0c, 0xe3, 0xad, 0xdc, 0x76,
+	0xc8, 0x69, 0x4d, 0x71, 0xfa, 0x06, 0xbd, 0xbb, 0x0f, 0x4e, 0x7a, 0x89, 0x8d, 0x03, 0x0a, 0xfa,
+	0x19, 0x01, 0x9a, 0xee, 0xf8, 0xe9, 0xb5, 0x6c, 0x41, 0xef, 0x3a, 0xd2, 0x30, 0xae, 0x0f, 0x66,
+	0x8c, 0x24, 0x1f, 0x28, 0x92, 0x6b, 0x74, 0x75, 0xbf, 0x89, 0xeb, 0x18, 0x7e, 0xd0, 0xdf, 0x11,
+	0x28, 0xc4, 0x46, 0x12, 0x59, 0x8e, 0x63, 0x7a, 0xb8, 0x61, 0x2c, 0xe4, 0xb4, 0x42, 0x56, 0x97,
+	0x15, 0xab, 0x59, 0xfa, 0x95, 0x3d, 0x58, 0xc9, 0xd0, 0xd6, 0xd6, 0xb3, 0x10, 0xfa, 0x21, 0x81,
+	0x23, 0x7a, 0x58, 0x41, 0x33, 0xd4, 0x63, 0x89, 0x49, 0x89, 0x31, 0x97, 0xdd, 0x00, 0x21, 0xce,
+	0x2a, 0x88, 0x17, 0xe8, 0xb9, 0x3d, 0x20, 0xea, 0x81, 0x49, 0xe9, 0xeb, 0x9f, 0xbc, 0x98, 0x22,
+	0xcf, 0x5e, 0x4c, 0x91, 0xff, 0xbe, 0x98, 0x22, 0x1f, 0xbc, 0x9c, 0x1a, 0x7a, 0xf6, 0x72, 0x6a,
+	0xe8, 0x5f, 0x2f, 0xa7, 0x86, 0xbe, 0x37, 0x17, 0x9b, 0x93, 0xf5, 0x70, 0xb5, 0x13, 0x39, 0x53,
+	0x53, 0xb3, 0x8d, 0x23, 0x6a, 0xc9, 0xe5, 0xff, 0x0d, 0x00, 0x62, 0x57, 0x65, 0xdb, 0x86, 0x21,
+	0x00, 0x00,
 }
 
-func (m *QueryPriceSnapshotHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ExchangeRate returns exchange rate of a denom
+	ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error)
+	// FreshExchangeRate returns exchange rate of a denom along with the confidence
+	// of its tally, failing if the rate is older than the given max age
+	FreshExchangeRate(ctx context.Context, in *QueryFreshExchangeRateRequest, opts ...grpc.CallOption) (*QueryFreshExchangeRateResponse, error)
+	// ExchangeRates returns exchange rates of all denoms
+	ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error)
+	// Actives returns all active denoms
+	Actives(ctx context.Context, in *QueryActivesRequest, opts ...grpc.CallOption) (*QueryActivesResponse, error)
+	// VoteTargets returns all vote target denoms
+	VoteTargets(ctx context.Context, in *QueryVoteTargetsRequest, opts ...grpc.CallOption) (*QueryVoteTargetsResponse, error)
+	// VoteTargetTransitions returns the vote targets on probation and the removed vote targets in their sunset period
+	VoteTargetTransitions(ctx context.Context, in *QueryVoteTargetTransitionsRequest, opts ...grpc.CallOption) (*QueryVoteTargetTransitionsResponse, error)
+	// PriceSnapshotHistory returns the history of price snapshots for all assets
+	PriceSnapshotHistory(ctx context.Context, in *QueryPriceSnapshotHistoryRequest, opts ...grpc.CallOption) (*QueryPriceSnapshotHistoryResponse, error)
+	Twaps(ctx context.Context, in *QueryTwapsRequest, opts ...grpc.CallOption) (*QueryTwapsResponse, error)
+	// Emas returns the exponential moving averages of the exchange rates over the lookback
+	Emas(ctx context.Context, in *QueryEmasRequest, opts ...grpc.CallOption) (*QueryEmasResponse, error)
+	// PriceRanges returns the lowest and highest exchange rates over the lookback
+	PriceRanges(ctx context.Context, in *QueryPriceRangesRequest, opts ...grpc.CallOption) (*QueryPriceRangesResponse, error)
+	// Volatilities returns the realised volatilities of the exchange rates over the lookback
+	Volatilities(ctx context.Context, in *QueryVolatilitiesRequest, opts ...grpc.CallOption) (*QueryVolatilitiesResponse, error)
+	// PriceAtTimestamp returns the exchange rate of a denom in effect at a past timestamp
+	PriceAtTimestamp(ctx context.Context, in *QueryPriceAtTimestampRequest, opts ...grpc.CallOption) (*QueryPriceAtTimestampResponse, error)
+	// FeederDelegation returns feeder delegation of a validator
+	FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error)
+	// AggregatePrevote returns the aggregate prevote of a validator
+	AggregatePrevote(ctx context.Context, in *QueryAggregatePrevoteRequest, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error)
+	// FeederPermissions returns the feeders a validator authorised in addition to its feeder delegation
+	FeederPermissions(ctx context.Context, in *QueryFeederPermissionsRequest, opts ...grpc.CallOption) (*QueryFeederPermissionsResponse, error)
+	// VoteHistory returns the ballot summaries of a validator for the retained vote periods
+	VoteHistory(ctx context.Context, in *QueryVoteHistoryRequest, opts ...grpc.CallOption) (*QueryVoteHistoryResponse, error)
+	// OracleRewards returns the oracle rewards distributed to a validator
+	OracleRewards(ctx context.Context, in *QueryOracleRewardsRequest, opts ...grpc.CallOption) (*QueryOracleRewardsResponse, error)
+	// MissCounter returns oracle miss counter of a validator
+	VotePenaltyCounter(ctx context.Context, in *QueryVotePenaltyCounterRequest, opts ...grpc.CallOption) (*QueryVotePenaltyCounterResponse, error)
+	// SlashWindow returns slash window information
+	SlashWindow(ctx context.Context, in *QuerySlashWindowRequest, opts ...grpc.CallOption) (*QuerySlashWindowResponse, error)
+	// Params queries all parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
-func (m *QueryPriceSnapshotHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PriceSnapshots) > 0 {
-		for iNdEx := len(m.PriceSnapshots) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PriceSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryTwapsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error) {
+	out := new(QueryExchangeRateResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/ExchangeRate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryTwapsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) FreshExchangeRate(ctx context.Context, in *QueryFreshExchangeRateRequest, opts ...grpc.CallOption) (*QueryFreshExchangeRateResponse, error) {
+	out := new(QueryFreshExchangeRateResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/FreshExchangeRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryTwapsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LookbackSeconds != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LookbackSeconds))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error) {
+	out := new(QueryExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/ExchangeRates", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryTwapsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Actives(ctx context.Context, in *QueryActivesRequest, opts ...grpc.CallOption) (*QueryActivesResponse, error) {
+	out := new(QueryActivesResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/Actives", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryTwapsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) VoteTargets(ctx context.Context, in *QueryVoteTargetsRequest, opts ...grpc.CallOption) (*QueryVoteTargetsResponse, error) {
+	out := new(QueryVoteTargetsResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/VoteTargets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryTwapsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OracleTwaps) > 0 {
-		for iNdEx := len(m.OracleTwaps) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.OracleTwaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) VoteTargetTransitions(ctx context.Context, in *QueryVoteTargetTransitionsRequest, opts ...grpc.CallOption) (*QueryVoteTargetTransitionsResponse, error) {
+	out := new(QueryVoteTargetTransitionsResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/VoteTargetTransitions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryFeederDelegationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PriceSnapshotHistory(ctx context.Context, in *QueryPriceSnapshotHistoryRequest, opts ...grpc.CallOption) (*QueryPriceSnapshotHistoryResponse, error) {
+	out := new(QueryPriceSnapshotHistoryResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/PriceSnapshotHistory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryFeederDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Twaps(ctx context.Context, in *QueryTwapsRequest, opts ...grpc.CallOption) (*QueryTwapsResponse, error) {
+	out := new(QueryTwapsResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/Twaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryFeederDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) Emas(ctx context.Context, in *QueryEmasRequest, opts ...grpc.CallOption) (*QueryEmasResponse, error) {
+	out := new(QueryEmasResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/Emas", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryFeederDelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PriceRanges(ctx context.Context, in *QueryPriceRangesRequest, opts ...grpc.CallOption) (*QueryPriceRangesResponse, error) {
+	out := new(QueryPriceRangesResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/PriceRanges", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryFeederDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryFeederDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FeederAddr) > 0 {
-		i -= len(m.FeederAddr)
-		copy(dAtA[i:], m.FeederAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeederAddr)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) Volatilities(ctx context.Context, in *QueryVolatilitiesRequest, opts ...grpc.CallOption) (*QueryVolatilitiesResponse, error) {
+	out := new(QueryVolatilitiesResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/Volatilities", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryVotePenaltyCounterRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PriceAtTimestamp(ctx context.Context, in *QueryPriceAtTimestampRequest, opts ...grpc.CallOption) (*QueryPriceAtTimestampResponse, error) {
+	out := new(QueryPriceAtTimestampResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/PriceAtTimestamp", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryVotePenaltyCounterRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryVotePenaltyCounterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error) {
+	out := new(QueryFeederDelegationResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/FeederDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryVotePenaltyCounterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AggregatePrevote(ctx context.Context, in *QueryAggregatePrevoteRequest, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error) {
+	out := new(QueryAggregatePrevoteResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/AggregatePrevote", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryVotePenaltyCounterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryVotePenaltyCounterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VotePenaltyCounter != nil {
-		{
-			size, err := m.VotePenaltyCounter.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) FeederPermissions(ctx context.Context, in *QueryFeederPermissionsRequest, opts ...grpc.CallOption) (*QueryFeederPermissionsResponse, error) {
+	out := new(QueryFeederPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/FeederPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QuerySlashWindowRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) VoteHistory(ctx context.Context, in *QueryVoteHistoryRequest, opts ...grpc.CallOption) (*QueryVoteHistoryResponse, error) {
+	out := new(QueryVoteHistoryResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/VoteHistory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QuerySlashWindowRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QuerySlashWindowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QuerySlashWindowResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OracleRewards(ctx context.Context, in *QueryOracleRewardsRequest, opts ...grpc.CallOption) (*QueryOracleRewardsResponse, error) {
+	out := new(QueryOracleRewardsResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/OracleRewards", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QuerySlashWindowResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QuerySlashWindowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.WindowProgress != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.WindowProgress))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) VotePenaltyCounter(ctx context.Context, in *QueryVotePenaltyCounterRequest, opts ...grpc.CallOption) (*QueryVotePenaltyCounterResponse, error) {
+	out := new(QueryVotePenaltyCounterResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/VotePenaltyCounter", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SlashWindow(ctx context.Context, in *QuerySlashWindowRequest, opts ...grpc.CallOption) (*QuerySlashWindowResponse, error) {
+	out := new(QuerySlashWindowResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/SlashWindow", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ExchangeRate returns exchange rate of a denom
+	ExchangeRate(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error)
+	// FreshExchangeRate returns exchange rate of a denom along with the confidence
+	// of its tally, failing if the rate is older than the given max age
+	FreshExchangeRate(context.Context, *QueryFreshExchangeRateRequest) (*QueryFreshExchangeRateResponse, error)
+	// ExchangeRates returns exchange rates of all denoms
+	ExchangeRates(context.Context, *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error)
+	// Actives returns all active denoms
+	Actives(context.Context, *QueryActivesRequest) (*QueryActivesResponse, error)
+	// VoteTargets returns all vote target denoms
+	VoteTargets(context.Context, *QueryVoteTargetsRequest) (*QueryVoteTargetsResponse, error)
+	// VoteTargetTransitions returns the vote targets on probation and the removed vote targets in their sunset period
+	VoteTargetTransitions(context.Context, *QueryVoteTargetTransitionsRequest) (*QueryVoteTargetTransitionsResponse, error)
+	// PriceSnapshotHistory returns the history of price snapshots for all assets
+	PriceSnapshotHistory(context.Context, *QueryPriceSnapshotHistoryRequest) (*QueryPriceSnapshotHistoryResponse, error)
+	Twaps(context.Context, *QueryTwapsRequest) (*QueryTwapsResponse, error)
+	// Emas returns the exponential moving averages of the exchange rates over the lookback
+	Emas(context.Context, *QueryEmasRequest) (*QueryEmasResponse, error)
+	// PriceRanges returns the lowest and highest exchange rates over the lookback
+	PriceRanges(context.Context, *QueryPriceRangesRequest) (*QueryPriceRangesResponse, error)
+	// Volatilities returns the realised volatilities of the exchange rates over the lookback
+	Volatilities(context.Context, *QueryVolatilitiesRequest) (*QueryVolatilitiesResponse, error)
+	// PriceAtTimestamp returns the exchange rate of a denom in effect at a past timestamp
+	PriceAtTimestamp(context.Context, *QueryPriceAtTimestampRequest) (*QueryPriceAtTimestampResponse, error)
+	// FeederDelegation returns feeder delegation of a validator
+	FeederDelegation(context.Context, *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error)
+	// AggregatePrevote returns the aggregate prevote of a validator
+	AggregatePrevote(context.Context, *QueryAggregatePrevoteRequest) (*QueryAggregatePrevoteResponse, error)
+	// FeederPermissions returns the feeders a validator authorised in addition to its feeder delegation
+	FeederPermissions(context.Context, *QueryFeederPermissionsRequest) (*QueryFeederPermissionsResponse, error)
+	// VoteHistory returns the ballot summaries of a validator for the retained vote periods
+	VoteHistory(context.Context, *QueryVoteHistoryRequest) (*QueryVoteHistoryResponse, error)
+	// OracleRewards returns the oracle rewards distributed to a validator
+	OracleRewards(context.Context, *QueryOracleRewardsRequest) (*QueryOracleRewardsResponse, error)
+	// MissCounter returns oracle miss counter of a validator
+	VotePenaltyCounter(context.Context, *QueryVotePenaltyCounterRequest) (*QueryVotePenaltyCounterResponse, error)
+	// SlashWindow returns slash window information
+	SlashWindow(context.Context, *QuerySlashWindowRequest) (*QuerySlashWindowResponse, error)
+	// Params queries all parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (*UnimplementedQueryServer) ExchangeRate(ctx context.Context, req *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRate not implemented")
 }
-func (m *QueryExchangeRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (*UnimplementedQueryServer) FreshExchangeRate(ctx context.Context, req *QueryFreshExchangeRateRequest) (*QueryFreshExchangeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreshExchangeRate not implemented")
 }
-
-func (m *QueryExchangeRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.OracleExchangeRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (*UnimplementedQueryServer) ExchangeRates(ctx context.Context, req *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRates not implemented")
 }
-
-func (m *QueryExchangeRatesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (*UnimplementedQueryServer) Actives(ctx context.Context, req *QueryActivesRequest) (*QueryActivesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Actives not implemented")
+}
+func (*UnimplementedQueryServer) VoteTargets(ctx context.Context, req *QueryVoteTargetsRequest) (*QueryVoteTargetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteTargets not implemented")
+}
+func (*UnimplementedQueryServer) VoteTargetTransitions(ctx context.Context, req *QueryVoteTargetTransitionsRequest) (*QueryVoteTargetTransitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteTargetTransitions not implemented")
+}
+func (*UnimplementedQueryServer) PriceSnapshotHistory(ctx context.Context, req *QueryPriceSnapshotHistoryRequest) (*QueryPriceSnapshotHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceSnapshotHistory not implemented")
+}
+func (*UnimplementedQueryServer) Twaps(ctx context.Context, req *QueryTwapsRequest) (*QueryTwapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Twaps not implemented")
+}
+func (*UnimplementedQueryServer) Emas(ctx context.Context, req *QueryEmasRequest) (*QueryEmasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Emas not implemented")
+}
+func (*UnimplementedQueryServer) PriceRanges(ctx context.Context, req *QueryPriceRangesRequest) (*QueryPriceRangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceRanges not implemented")
+}
+func (*UnimplementedQueryServer) Volatilities(ctx context.Context, req *QueryVolatilitiesRequest) (*QueryVolatilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Volatilities not implemented")
+}
+func (*UnimplementedQueryServer) PriceAtTimestamp(ctx context.Context, req *QueryPriceAtTimestampRequest) (*QueryPriceAtTimestampResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceAtTimestamp not implemented")
+}
+func (*UnimplementedQueryServer) FeederDelegation(ctx context.Context, req *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeederDelegation not implemented")
+}
+func (*UnimplementedQueryServer) AggregatePrevote(ctx context.Context, req *QueryAggregatePrevoteRequest) (*QueryAggregatePrevoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregatePrevote not implemented")
+}
+func (*UnimplementedQueryServer) FeederPermissions(ctx context.Context, req *QueryFeederPermissionsRequest) (*QueryFeederPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeederPermissions not implemented")
+}
+func (*UnimplementedQueryServer) VoteHistory(ctx context.Context, req *QueryVoteHistoryRequest) (*QueryVoteHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteHistory not implemented")
+}
+func (*UnimplementedQueryServer) OracleRewards(ctx context.Context, req *QueryOracleRewardsRequest) (*QueryOracleRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleRewards not implemented")
+}
+func (*UnimplementedQueryServer) VotePenaltyCounter(ctx context.Context, req *QueryVotePenaltyCounterRequest) (*QueryVotePenaltyCounterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotePenaltyCounter not implemented")
+}
+func (*UnimplementedQueryServer) SlashWindow(ctx context.Context, req *QuerySlashWindowRequest) (*QuerySlashWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashWindow not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
 
-func (m *DenomOracleExchangeRatePair) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = m.OracleExchangeRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryExchangeRatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.DenomOracleExchangeRatePairs) > 0 {
-		for _, e := range m.DenomOracleExchangeRatePairs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ExchangeRate(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryActivesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.oracle.Query/ExchangeRate",
 	}
-	var l int
-	_ = l
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExchangeRate(ctx, req.(*QueryExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryActivesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_FreshExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFreshExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Actives) > 0 {
-		for _, s := range m.Actives {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).FreshExchangeRate(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryVoteTargetsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.oracle.Query/FreshExchangeRate",
 	}
-	var l int
-	_ = l
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FreshExchangeRate(ctx, req.(*QueryFreshExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVoteTargetsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.VoteTargets) > 0 {
-		for _, s := range m.VoteTargets {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ExchangeRates(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryPriceSnapshotHistoryRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.oracle.Query/ExchangeRates",
 	}
-	var l int
-	_ = l
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExchangeRates(ctx, req.(*QueryExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPriceSnapshotHistoryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Actives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActivesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.PriceSnapshots) > 0 {
-		for _, e := range m.PriceSnapshots {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Actives(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryTwapsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.oracle.Query/Actives",
 	}
-	var l int
-	_ = l
-	if m.LookbackSeconds != 0 {
-		n += 1 + sovQuery(uint64(m.LookbackSeconds))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Actives(ctx, req.(*QueryActivesRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTwapsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_VoteTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteTargetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.OracleTwaps) > 0 {
-		for _, e := range m.OracleTwaps {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).VoteTargets(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryFeederDelegationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.oracle.Query/VoteTargets",
 	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteTargets(ctx, req.(*QueryVoteTargetsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFeederDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_VoteTargetTransitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteTargetTransitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.FeederAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).VoteTargetTransitions(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryVotePenaltyCounterRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.oracle.Query/VoteTargetTransitions",
 	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteTargetTransitions(ctx, req.(*QueryVoteTargetTransitionsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVotePenaltyCounterResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PriceSnapshotHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceSnapshotHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.VotePenaltyCounter != nil {
-		l = m.VotePenaltyCounter.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).PriceSnapshotHistory(ctx, in)
 	}
-	return n
-}
-
-func (m *QuerySlashWindowRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.oracle.Query/PriceSnapshotHistory",
 	}
-	var l int
-	_ = l
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceSnapshotHistory(ctx, req.(*QueryPriceSnapshotHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySlashWindowResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Twaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTwapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.WindowProgress != 0 {
-		n += 1 + sovQuery(uint64(m.WindowProgress))
+	if interceptor == nil {
+		return srv.(QueryServer).Twaps(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.oracle.Query/Twaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Twaps(ctx, req.(*QueryTwapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Emas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEmasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Emas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.oracle.Query/Emas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Emas(ctx, req.(*QueryEmasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceRanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceRangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceRanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.oracle.Query/PriceRanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceRanges(ctx, req.(*QueryPriceRangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Volatilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVolatilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Volatilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.oracle.Query/Volatilities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Volatilities(ctx, req.(*QueryVolatilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceAtTimestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceAtTimestampRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceAtTimestamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.oracle.Query/PriceAtTimestamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceAtTimestamp(ctx, req.(*QueryPriceAtTimestampRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeederDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeederDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeederDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.oracle.Query/FeederDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeederDelegation(ctx, req.(*QueryFeederDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AggregatePrevote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregatePrevoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AggregatePrevote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.oracle.Query/AggregatePrevote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregatePrevote(ctx, req.(*QueryAggregatePrevoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeederPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeederPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeederPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.oracle.Query/FeederPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeederPermissions(ctx, req.(*QueryFeederPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VoteHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoteHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.oracle.Query/VoteHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteHistory(ctx, req.(*QueryVoteHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OracleRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOracleRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OracleRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.oracle.Query/OracleRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleRewards(ctx, req.(*QueryOracleRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VotePenaltyCounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotePenaltyCounterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VotePenaltyCounter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.oracle.Query/VotePenaltyCounter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VotePenaltyCounter(ctx, req.(*QueryVotePenaltyCounterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SlashWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.oracle.Query/SlashWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashWindow(ctx, req.(*QuerySlashWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.oracle.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "seiprotocol.seichain.oracle.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExchangeRate",
+			Handler:    _Query_ExchangeRate_Handler,
+		},
+		{
+			MethodName: "FreshExchangeRate",
+			Handler:    _Query_FreshExchangeRate_Handler,
+		},
+		{
+			MethodName: "ExchangeRates",
+			Handler:    _Query_ExchangeRates_Handler,
+		},
+		{
+			MethodName: "Actives",
+			Handler:    _Query_Actives_Handler,
+		},
+		{
+			MethodName: "VoteTargets",
+			Handler:    _Query_VoteTargets_Handler,
+		},
+		{
+			MethodName: "VoteTargetTransitions",
+			Handler:    _Query_VoteTargetTransitions_Handler,
+		},
+		{
+			MethodName: "PriceSnapshotHistory",
+			Handler:    _Query_PriceSnapshotHistory_Handler,
+		},
+		{
+			MethodName: "Twaps",
+			Handler:    _Query_Twaps_Handler,
+		},
+		{
+			MethodName: "Emas",
+			Handler:    _Query_Emas_Handler,
+		},
+		{
+			MethodName: "PriceRanges",
+			Handler:    _Query_PriceRanges_Handler,
+		},
+		{
+			MethodName: "Volatilities",
+			Handler:    _Query_Volatilities_Handler,
+		},
+		{
+			MethodName: "PriceAtTimestamp",
+			Handler:    _Query_PriceAtTimestamp_Handler,
+		},
+		{
+			MethodName: "FeederDelegation",
+			Handler:    _Query_FeederDelegation_Handler,
+		},
+		{
+			MethodName: "AggregatePrevote",
+			Handler:    _Query_AggregatePrevote_Handler,
+		},
+		{
+			MethodName: "FeederPermissions",
+			Handler:    _Query_FeederPermissions_Handler,
+		},
+		{
+			MethodName: "VoteHistory",
+			Handler:    _Query_VoteHistory_Handler,
+		},
+		{
+			MethodName: "OracleRewards",
+			Handler:    _Query_OracleRewards_Handler,
+		},
+		{
+			MethodName: "VotePenaltyCounter",
+			Handler:    _Query_VotePenaltyCounter_Handler,
+		},
+		{
+			MethodName: "SlashWindow",
+			Handler:    _Query_SlashWindow_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "oracle/query.proto",
+}
+
+func (m *QueryExchangeRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Stale {
+		i--
+		if m.Stale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.OracleExchangeRate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFreshExchangeRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFreshExchangeRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFreshExchangeRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxAgeSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxAgeSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFreshExchangeRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFreshExchangeRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFreshExchangeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Confidence.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.OracleExchangeRate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomOracleExchangeRatePair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomOracleExchangeRatePair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomOracleExchangeRatePair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Stale {
+		i--
+		if m.Stale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.OracleExchangeRate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomOracleExchangeRatePairs) > 0 {
+		for iNdEx := len(m.DenomOracleExchangeRatePairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomOracleExchangeRatePairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActivesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActivesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActivesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActivesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActivesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Actives) > 0 {
+		for iNdEx := len(m.Actives) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Actives[iNdEx])
+			copy(dAtA[i:], m.Actives[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Actives[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteTargetsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteTargetsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteTargetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteTargetsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteTargetsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteTargetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VoteTargets) > 0 {
+		for iNdEx := len(m.VoteTargets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VoteTargets[iNdEx])
+			copy(dAtA[i:], m.VoteTargets[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.VoteTargets[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteTargetTransitionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteTargetTransitionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteTargetTransitionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteTargetTransitionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteTargetTransitionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteTargetTransitionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sunsets) > 0 {
+		for iNdEx := len(m.Sunsets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sunsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Probations) > 0 {
+		for iNdEx := len(m.Probations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Probations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceSnapshotHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceSnapshotHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceSnapshotHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceSnapshotHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceSnapshotHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceSnapshotHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PriceSnapshots) > 0 {
+		for iNdEx := len(m.PriceSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTwapsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTwapsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTwapsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LookbackSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LookbackSeconds))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTwapsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTwapsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTwapsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OracleTwaps) > 0 {
+		for iNdEx := len(m.OracleTwaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleTwaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PeriodSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PeriodSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LookbackSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LookbackSeconds))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OracleEmas) > 0 {
+		for iNdEx := len(m.OracleEmas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleEmas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceRangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceRangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceRangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LookbackSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LookbackSeconds))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceRangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceRangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceRangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OraclePriceRanges) > 0 {
+		for iNdEx := len(m.OraclePriceRanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OraclePriceRanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVolatilitiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVolatilitiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVolatilitiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LookbackSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LookbackSeconds))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVolatilitiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVolatilitiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVolatilitiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OracleVolatilities) > 0 {
+		for iNdEx := len(m.OracleVolatilities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleVolatilities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceAtTimestampRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceAtTimestampRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceAtTimestampRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceAtTimestampResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceAtTimestampResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceAtTimestampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SnapshotTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SnapshotTimestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.OracleExchangeRate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeederDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeederDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeederDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeederDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeederDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeederDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeederAddr) > 0 {
+		i -= len(m.FeederAddr)
+		copy(dAtA[i:], m.FeederAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeederAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregatePrevoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregatePrevoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregatePrevoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregatePrevoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregatePrevoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregatePrevoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AggregatePrevote.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeederPermissionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeederPermissionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeederPermissionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeederPermissionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeederPermissionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeederPermissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeederPermissions) > 0 {
+		for iNdEx := len(m.FeederPermissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeederPermissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotePenaltyCounterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotePenaltyCounterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotePenaltyCounterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotePenaltyCounterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotePenaltyCounterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotePenaltyCounterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotePenaltyCounter != nil {
+		{
+			size, err := m.VotePenaltyCounter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashWindowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashWindowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashWindowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashWindowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashWindowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashWindowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowProgress != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowProgress))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Ballots) > 0 {
+		for iNdEx := len(m.Ballots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ballots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryExchangeRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExchangeRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OracleExchangeRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Stale {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryFreshExchangeRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxAgeSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.MaxAgeSeconds))
+	}
+	return n
+}
+
+func (m *QueryFreshExchangeRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OracleExchangeRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Confidence.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryExchangeRatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DenomOracleExchangeRatePair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.OracleExchangeRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Stale {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryExchangeRatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DenomOracleExchangeRatePairs) > 0 {
+		for _, e := range m.DenomOracleExchangeRatePairs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryActivesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryActivesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Actives) > 0 {
+		for _, s := range m.Actives {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVoteTargetsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryVoteTargetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VoteTargets) > 0 {
+		for _, s := range m.VoteTargets {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVoteTargetTransitionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryVoteTargetTransitionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Probations) > 0 {
+		for _, e := range m.Probations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Sunsets) > 0 {
+		for _, e := range m.Sunsets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPriceSnapshotHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPriceSnapshotHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PriceSnapshots) > 0 {
+		for _, e := range m.PriceSnapshots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTwapsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LookbackSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.LookbackSeconds))
+	}
+	return n
+}
+
+func (m *QueryTwapsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OracleTwaps) > 0 {
+		for _, e := range m.OracleTwaps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEmasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LookbackSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.LookbackSeconds))
+	}
+	if m.PeriodSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.PeriodSeconds))
+	}
+	return n
+}
+
+func (m *QueryEmasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OracleEmas) > 0 {
+		for _, e := range m.OracleEmas {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPriceRangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LookbackSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.LookbackSeconds))
+	}
+	return n
+}
+
+func (m *QueryPriceRangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OraclePriceRanges) > 0 {
+		for _, e := range m.OraclePriceRanges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVolatilitiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LookbackSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.LookbackSeconds))
+	}
+	return n
+}
+
+func (m *QueryVolatilitiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OracleVolatilities) > 0 {
+		for _, e := range m.OracleVolatilities {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPriceAtTimestampRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovQuery(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *QueryPriceAtTimestampResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OracleExchangeRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.SnapshotTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.SnapshotTimestamp))
+	}
+	return n
+}
+
+func (m *QueryFeederDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeederDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeederAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAggregatePrevoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAggregatePrevoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AggregatePrevote.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFeederPermissionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeederPermissionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeederPermissions) > 0 {
+		for _, e := range m.FeederPermissions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryOracleRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOracleRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVotePenaltyCounterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVotePenaltyCounterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotePenaltyCounter != nil {
+		l = m.VotePenaltyCounter.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySlashWindowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySlashWindowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WindowProgress != 0 {
+		n += 1 + sovQuery(uint64(m.WindowProgress))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVoteHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ballots) > 0 {
+		for _, e := range m.Ballots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleExchangeRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stale = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFreshExchangeRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFreshExchangeRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFreshExchangeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAgeSeconds", wireType)
+			}
+			m.MaxAgeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAgeSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFreshExchangeRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFreshExchangeRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFreshExchangeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleExchangeRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Confidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomOracleExchangeRatePair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomOracleExchangeRatePair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomOracleExchangeRatePair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleExchangeRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stale = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomOracleExchangeRatePairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomOracleExchangeRatePairs = append(m.DenomOracleExchangeRatePairs, DenomOracleExchangeRatePair{})
+			if err := m.DenomOracleExchangeRatePairs[len(m.DenomOracleExchangeRatePairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActivesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActivesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actives", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actives = append(m.Actives, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteTargetsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteTargetsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteTargetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteTargetsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteTargetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteTargets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteTargets = append(m.VoteTargets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteTargetTransitionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteTargetTransitionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteTargetTransitionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteTargetTransitionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteTargetTransitionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteTargetTransitionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Probations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Probations = append(m.Probations, VoteTargetTransition{})
+			if err := m.Probations[len(m.Probations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sunsets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sunsets = append(m.Sunsets, VoteTargetTransition{})
+			if err := m.Sunsets[len(m.Sunsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceSnapshotHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceSnapshotHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceSnapshotHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceSnapshotHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceSnapshotHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceSnapshotHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceSnapshots = append(m.PriceSnapshots, PriceSnapshot{})
+			if err := m.PriceSnapshots[len(m.PriceSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTwapsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTwapsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTwapsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
+			}
+			m.LookbackSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LookbackSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTwapsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2376,17 +6107,17 @@ func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTwapsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTwapsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleTwaps", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2396,23 +6127,25 @@ func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.OracleTwaps = append(m.OracleTwaps, OracleTwap{})
+			if err := m.OracleTwaps[len(m.OracleTwaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2435,7 +6168,7 @@ func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEmasRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2458,15 +6191,103 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEmasRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEmasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
+			}
+			m.LookbackSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LookbackSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSeconds", wireType)
+			}
+			m.PeriodSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleExchangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleEmas", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2493,7 +6314,8 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OracleExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OracleEmas = append(m.OracleEmas, OracleEma{})
+			if err := m.OracleEmas[len(m.OracleEmas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2518,7 +6340,7 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExchangeRatesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceRangesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2541,12 +6363,115 @@ func (m *QueryExchangeRatesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRatesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceRangesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceRangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
+			}
+			m.LookbackSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LookbackSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceRangesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceRangesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceRangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OraclePriceRanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OraclePriceRanges = append(m.OraclePriceRanges, OraclePriceRange{})
+			if err := m.OraclePriceRanges[len(m.OraclePriceRanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2568,7 +6493,7 @@ func (m *QueryExchangeRatesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DenomOracleExchangeRatePair) Unmarshal(dAtA []byte) error {
+func (m *QueryVolatilitiesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2591,49 +6516,17 @@ func (m *DenomOracleExchangeRatePair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DenomOracleExchangeRatePair: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVolatilitiesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenomOracleExchangeRatePair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVolatilitiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleExchangeRate", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
 			}
-			var msglen int
+			m.LookbackSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2643,25 +6536,11 @@ func (m *DenomOracleExchangeRatePair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LookbackSeconds |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.OracleExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2683,7 +6562,7 @@ func (m *DenomOracleExchangeRatePair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVolatilitiesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2706,15 +6585,15 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVolatilitiesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVolatilitiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomOracleExchangeRatePairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleVolatilities", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2741,8 +6620,8 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DenomOracleExchangeRatePairs = append(m.DenomOracleExchangeRatePairs, DenomOracleExchangeRatePair{})
-			if err := m.DenomOracleExchangeRatePairs[len(m.DenomOracleExchangeRatePairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OracleVolatilities = append(m.OracleVolatilities, OracleVolatility{})
+			if err := m.OracleVolatilities[len(m.OracleVolatilities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2767,7 +6646,7 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceAtTimestampRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2790,12 +6669,63 @@ func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActivesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceAtTimestampRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceAtTimestampRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2817,7 +6747,7 @@ func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceAtTimestampResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2840,17 +6770,17 @@ func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActivesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceAtTimestampResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceAtTimestampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Actives", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleExchangeRate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2860,24 +6790,44 @@ func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Actives = append(m.Actives, string(dAtA[iNdEx:postIndex]))
+			if err := m.OracleExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTimestamp", wireType)
+			}
+			m.SnapshotTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2899,7 +6849,7 @@ func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteTargetsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2922,12 +6872,44 @@ func (m *QueryVoteTargetsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteTargetsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeederDelegationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteTargetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeederDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2949,7 +6931,7 @@ func (m *QueryVoteTargetsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2972,15 +6954,15 @@ func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteTargetsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeederDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteTargetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeederDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteTargets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3008,7 +6990,7 @@ func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VoteTargets = append(m.VoteTargets, string(dAtA[iNdEx:postIndex]))
+			m.FeederAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3031,7 +7013,7 @@ func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPriceSnapshotHistoryRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregatePrevoteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3054,12 +7036,44 @@ func (m *QueryPriceSnapshotHistoryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPriceSnapshotHistoryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregatePrevoteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPriceSnapshotHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregatePrevoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3081,7 +7095,7 @@ func (m *QueryPriceSnapshotHistoryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPriceSnapshotHistoryResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3104,15 +7118,15 @@ func (m *QueryPriceSnapshotHistoryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPriceSnapshotHistoryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPriceSnapshotHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceSnapshots", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevote", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3139,8 +7153,7 @@ func (m *QueryPriceSnapshotHistoryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriceSnapshots = append(m.PriceSnapshots, PriceSnapshot{})
-			if err := m.PriceSnapshots[len(m.PriceSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AggregatePrevote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3165,7 +7178,7 @@ func (m *QueryPriceSnapshotHistoryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTwapsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeederPermissionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3188,17 +7201,17 @@ func (m *QueryTwapsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTwapsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeederPermissionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTwapsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeederPermissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
 			}
-			m.LookbackSeconds = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3208,11 +7221,24 @@ func (m *QueryTwapsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LookbackSeconds |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3234,7 +7260,7 @@ func (m *QueryTwapsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTwapsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeederPermissionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3257,15 +7283,15 @@ func (m *QueryTwapsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTwapsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeederPermissionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTwapsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeederPermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleTwaps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederPermissions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3292,8 +7318,8 @@ func (m *QueryTwapsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OracleTwaps = append(m.OracleTwaps, OracleTwap{})
-			if err := m.OracleTwaps[len(m.OracleTwaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FeederPermissions = append(m.FeederPermissions, FeederPermission{})
+			if err := m.FeederPermissions[len(m.FeederPermissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3318,7 +7344,7 @@ func (m *QueryTwapsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOracleRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3341,10 +7367,10 @@ func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeederDelegationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOracleRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeederDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOracleRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3400,7 +7426,7 @@ func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOracleRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3423,17 +7449,17 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeederDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOracleRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeederDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOracleRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3443,23 +7469,25 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeederAddr = string(dAtA[iNdEx:postIndex])
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3902,6 +7930,244 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryVoteHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ballots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ballots = append(m.Ballots, VotePeriodBallot{})
+			if err := m.Ballots[len(m.Ballots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
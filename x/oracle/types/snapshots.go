@@ -10,6 +10,9 @@ type PriceSnapshots []PriceSnapshot
 type (
 	PriceSnapshotItems []PriceSnapshotItem
 	OracleTwaps        []OracleTwap
+	OracleEmas         []OracleEma
+	OraclePriceRanges  []OraclePriceRange
+	OracleVolatilities []OracleVolatility
 )
 
 // String implements fmt.Stringer interface
@@ -28,10 +28,91 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MsgAggregateExchangeRatePrevote represents a message to submit
+// aggregate exchange rate prevote.
+type MsgAggregateExchangeRatePrevote struct {
+	Hash      string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty" yaml:"hash"`
+	Feeder    string `protobuf:"bytes,2,opt,name=feeder,proto3" json:"feeder,omitempty" yaml:"feeder"`
+	Validator string `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty" yaml:"validator"`
+}
+
+func (m *MsgAggregateExchangeRatePrevote) Reset()         { *m = MsgAggregateExchangeRatePrevote{} }
+func (m *MsgAggregateExchangeRatePrevote) String() string { return proto.CompactTextString(m) }
+func (*MsgAggregateExchangeRatePrevote) ProtoMessage()    {}
+func (*MsgAggregateExchangeRatePrevote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5390096518ffda, []int{0}
+}
+func (m *MsgAggregateExchangeRatePrevote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAggregateExchangeRatePrevote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAggregateExchangeRatePrevote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAggregateExchangeRatePrevote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAggregateExchangeRatePrevote.Merge(m, src)
+}
+func (m *MsgAggregateExchangeRatePrevote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAggregateExchangeRatePrevote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAggregateExchangeRatePrevote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAggregateExchangeRatePrevote proto.InternalMessageInfo
+
+// MsgAggregateExchangeRatePrevoteResponse defines the Msg/AggregateExchangeRatePrevote response type.
+type MsgAggregateExchangeRatePrevoteResponse struct {
+}
+
+func (m *MsgAggregateExchangeRatePrevoteResponse) Reset() {
+	*m = MsgAggregateExchangeRatePrevoteResponse{}
+}
+func (m *MsgAggregateExchangeRatePrevoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAggregateExchangeRatePrevoteResponse) ProtoMessage()    {}
+func (*MsgAggregateExchangeRatePrevoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5390096518ffda, []int{1}
+}
+func (m *MsgAggregateExchangeRatePrevoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAggregateExchangeRatePrevoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAggregateExchangeRatePrevoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAggregateExchangeRatePrevoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAggregateExchangeRatePrevoteResponse.Merge(m, src)
+}
+func (m *MsgAggregateExchangeRatePrevoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAggregateExchangeRatePrevoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAggregateExchangeRatePrevoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAggregateExchangeRatePrevoteResponse proto.InternalMessageInfo
+
 // MsgAggregateExchangeRateVote represents a message to submit
 // aggregate exchange rate vote.
 type MsgAggregateExchangeRateVote struct {
-	// 1 reserved from old field `salt`
+	// only used to reveal a prevote when prevotes are required
+	Salt          string `protobuf:"bytes,1,opt,name=salt,proto3" json:"salt,omitempty" yaml:"salt"`
 	ExchangeRates string `protobuf:"bytes,2,opt,name=exchange_rates,json=exchangeRates,proto3" json:"exchange_rates,omitempty" yaml:"exchange_rates"`
 	Feeder        string `protobuf:"bytes,3,opt,name=feeder,proto3" json:"feeder,omitempty" yaml:"feeder"`
 	Validator     string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty" yaml:"validator"`
@@ -41,7 +122,7 @@ func (m *MsgAggregateExchangeRateVote) Reset()         { *m = MsgAggregateExchan
 func (m *MsgAggregateExchangeRateVote) String() string { return proto.CompactTextString(m) }
 func (*MsgAggregateExchangeRateVote) ProtoMessage()    {}
 func (*MsgAggregateExchangeRateVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb5390096518ffda, []int{0}
+	return fileDescriptor_cb5390096518ffda, []int{2}
 }
 func (m *MsgAggregateExchangeRateVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -78,7 +159,7 @@ func (m *MsgAggregateExchangeRateVoteResponse) Reset()         { *m = MsgAggrega
 func (m *MsgAggregateExchangeRateVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAggregateExchangeRateVoteResponse) ProtoMessage()    {}
 func (*MsgAggregateExchangeRateVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb5390096518ffda, []int{1}
+	return fileDescriptor_cb5390096518ffda, []int{3}
 }
 func (m *MsgAggregateExchangeRateVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -118,7 +199,7 @@ func (m *MsgDelegateFeedConsent) Reset()         { *m = MsgDelegateFeedConsent{}
 func (m *MsgDelegateFeedConsent) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegateFeedConsent) ProtoMessage()    {}
 func (*MsgDelegateFeedConsent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb5390096518ffda, []int{2}
+	return fileDescriptor_cb5390096518ffda, []int{4}
 }
 func (m *MsgDelegateFeedConsent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -155,7 +236,7 @@ func (m *MsgDelegateFeedConsentResponse) Reset()         { *m = MsgDelegateFeedC
 func (m *MsgDelegateFeedConsentResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegateFeedConsentResponse) ProtoMessage()    {}
 func (*MsgDelegateFeedConsentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb5390096518ffda, []int{3}
+	return fileDescriptor_cb5390096518ffda, []int{5}
 }
 func (m *MsgDelegateFeedConsentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -184,44 +265,219 @@ func (m *MsgDelegateFeedConsentResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDelegateFeedConsentResponse proto.InternalMessageInfo
 
+// MsgAuthorizeFeeder represents a message to authorise an additional
+// feeder to vote on behalf of a validator.
+type MsgAuthorizeFeeder struct {
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty" yaml:"operator"`
+	Feeder   string `protobuf:"bytes,2,opt,name=feeder,proto3" json:"feeder,omitempty" yaml:"feeder"`
+	// denoms the feeder may vote for, all vote targets when empty
+	Denoms []string `protobuf:"bytes,3,rep,name=denoms,proto3" json:"denoms,omitempty" yaml:"denoms"`
+	// unix timestamp in seconds after which the permission expires, never when zero
+	Expiry int64 `protobuf:"varint,4,opt,name=expiry,proto3" json:"expiry,omitempty" yaml:"expiry"`
+}
+
+func (m *MsgAuthorizeFeeder) Reset()         { *m = MsgAuthorizeFeeder{} }
+func (m *MsgAuthorizeFeeder) String() string { return proto.CompactTextString(m) }
+func (*MsgAuthorizeFeeder) ProtoMessage()    {}
+func (*MsgAuthorizeFeeder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5390096518ffda, []int{6}
+}
+func (m *MsgAuthorizeFeeder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAuthorizeFeeder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAuthorizeFeeder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAuthorizeFeeder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAuthorizeFeeder.Merge(m, src)
+}
+func (m *MsgAuthorizeFeeder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAuthorizeFeeder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAuthorizeFeeder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAuthorizeFeeder proto.InternalMessageInfo
+
+// MsgAuthorizeFeederResponse defines the Msg/AuthorizeFeeder response type.
+type MsgAuthorizeFeederResponse struct {
+}
+
+func (m *MsgAuthorizeFeederResponse) Reset()         { *m = MsgAuthorizeFeederResponse{} }
+func (m *MsgAuthorizeFeederResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAuthorizeFeederResponse) ProtoMessage()    {}
+func (*MsgAuthorizeFeederResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5390096518ffda, []int{7}
+}
+func (m *MsgAuthorizeFeederResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAuthorizeFeederResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAuthorizeFeederResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAuthorizeFeederResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAuthorizeFeederResponse.Merge(m, src)
+}
+func (m *MsgAuthorizeFeederResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAuthorizeFeederResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAuthorizeFeederResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAuthorizeFeederResponse proto.InternalMessageInfo
+
+// MsgRevokeFeeder represents a message to revoke an additional feeder.
+type MsgRevokeFeeder struct {
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty" yaml:"operator"`
+	Feeder   string `protobuf:"bytes,2,opt,name=feeder,proto3" json:"feeder,omitempty" yaml:"feeder"`
+}
+
+func (m *MsgRevokeFeeder) Reset()         { *m = MsgRevokeFeeder{} }
+func (m *MsgRevokeFeeder) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeFeeder) ProtoMessage()    {}
+func (*MsgRevokeFeeder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5390096518ffda, []int{8}
+}
+func (m *MsgRevokeFeeder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeFeeder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeFeeder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeFeeder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeFeeder.Merge(m, src)
+}
+func (m *MsgRevokeFeeder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeFeeder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeFeeder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeFeeder proto.InternalMessageInfo
+
+// MsgRevokeFeederResponse defines the Msg/RevokeFeeder response type.
+type MsgRevokeFeederResponse struct {
+}
+
+func (m *MsgRevokeFeederResponse) Reset()         { *m = MsgRevokeFeederResponse{} }
+func (m *MsgRevokeFeederResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeFeederResponse) ProtoMessage()    {}
+func (*MsgRevokeFeederResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5390096518ffda, []int{9}
+}
+func (m *MsgRevokeFeederResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeFeederResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeFeederResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeFeederResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeFeederResponse.Merge(m, src)
+}
+func (m *MsgRevokeFeederResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeFeederResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeFeederResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeFeederResponse proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterType((*MsgAggregateExchangeRatePrevote)(nil), "seiprotocol.seichain.oracle.MsgAggregateExchangeRatePrevote")
+	proto.RegisterType((*MsgAggregateExchangeRatePrevoteResponse)(nil), "seiprotocol.seichain.oracle.MsgAggregateExchangeRatePrevoteResponse")
 	proto.RegisterType((*MsgAggregateExchangeRateVote)(nil), "seiprotocol.seichain.oracle.MsgAggregateExchangeRateVote")
 	proto.RegisterType((*MsgAggregateExchangeRateVoteResponse)(nil), "seiprotocol.seichain.oracle.MsgAggregateExchangeRateVoteResponse")
 	proto.RegisterType((*MsgDelegateFeedConsent)(nil), "seiprotocol.seichain.oracle.MsgDelegateFeedConsent")
 	proto.RegisterType((*MsgDelegateFeedConsentResponse)(nil), "seiprotocol.seichain.oracle.MsgDelegateFeedConsentResponse")
+	proto.RegisterType((*MsgAuthorizeFeeder)(nil), "seiprotocol.seichain.oracle.MsgAuthorizeFeeder")
+	proto.RegisterType((*MsgAuthorizeFeederResponse)(nil), "seiprotocol.seichain.oracle.MsgAuthorizeFeederResponse")
+	proto.RegisterType((*MsgRevokeFeeder)(nil), "seiprotocol.seichain.oracle.MsgRevokeFeeder")
+	proto.RegisterType((*MsgRevokeFeederResponse)(nil), "seiprotocol.seichain.oracle.MsgRevokeFeederResponse")
 }
 
 func init() { proto.RegisterFile("oracle/tx.proto", fileDescriptor_cb5390096518ffda) }
 
 var fileDescriptor_cb5390096518ffda = []byte{
-	// 417 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xbf, 0xcf, 0xd2, 0x40,
-	0x1c, 0xc6, 0x7b, 0x60, 0x08, 0x5c, 0x82, 0x68, 0x41, 0x03, 0x68, 0x5a, 0x72, 0x31, 0x46, 0x07,
-	0x5b, 0x03, 0x93, 0xb8, 0x08, 0xfe, 0x18, 0x4c, 0x58, 0x3a, 0x38, 0xb8, 0x98, 0xa3, 0xfd, 0x7a,
-	0x34, 0x29, 0xbd, 0xa6, 0x77, 0x1a, 0xd8, 0x4d, 0x74, 0x74, 0x75, 0x63, 0xf7, 0x1f, 0xd1, 0x8d,
-	0xd1, 0xa9, 0x31, 0xb0, 0x38, 0xf7, 0x2f, 0x30, 0x5c, 0x5b, 0x5e, 0xde, 0x84, 0x97, 0x37, 0x61,
-	0x3b, 0xee, 0xf9, 0x3c, 0xdf, 0xe7, 0xe1, 0x7b, 0x29, 0x6e, 0xf0, 0x98, 0xba, 0x01, 0xd8, 0x72,
-	0x61, 0x45, 0x31, 0x97, 0x5c, 0xbf, 0x27, 0xc0, 0x57, 0x27, 0x97, 0x07, 0x96, 0x00, 0xdf, 0x9d,
-	0x51, 0x3f, 0xb4, 0x32, 0xaa, 0xdb, 0x62, 0x9c, 0x71, 0xa5, 0xda, 0xbb, 0x53, 0x66, 0x21, 0xbf,
-	0x11, 0xbe, 0x3f, 0x11, 0x6c, 0xc4, 0x58, 0x0c, 0x8c, 0x4a, 0x78, 0xbd, 0x70, 0x67, 0x34, 0x64,
-	0xe0, 0x50, 0x09, 0xef, 0xb8, 0x04, 0xfd, 0x05, 0xbe, 0x09, 0xf9, 0xdd, 0x87, 0x98, 0x4a, 0x10,
-	0xed, 0x52, 0x0f, 0x3d, 0xaa, 0x8d, 0x3b, 0x69, 0x62, 0xde, 0x59, 0xd2, 0x79, 0x30, 0x24, 0x97,
-	0x75, 0xe2, 0xd4, 0xe1, 0x60, 0x88, 0xd0, 0x1f, 0xe3, 0xca, 0x47, 0x00, 0x0f, 0xe2, 0x76, 0x59,
-	0x39, 0x6f, 0xa7, 0x89, 0x59, 0xcf, 0x9c, 0xd9, 0x3d, 0x71, 0x72, 0x40, 0xef, 0xe3, 0xda, 0x67,
-	0x1a, 0xf8, 0x1e, 0x95, 0x3c, 0x6e, 0xdf, 0x50, 0x74, 0x2b, 0x4d, 0xcc, 0x5b, 0x19, 0xbd, 0x97,
-	0x88, 0x73, 0x81, 0x0d, 0xab, 0xdf, 0x56, 0xa6, 0xf6, 0x6f, 0x65, 0x6a, 0xe4, 0x21, 0x7e, 0x70,
-	0xea, 0xaf, 0x38, 0x20, 0x22, 0x1e, 0x0a, 0x20, 0x5f, 0x10, 0xbe, 0x3b, 0x11, 0xec, 0x15, 0x04,
-	0x8a, 0x7b, 0x03, 0xe0, 0xbd, 0xdc, 0x09, 0xa1, 0xd4, 0x6d, 0x5c, 0xe5, 0x11, 0xc4, 0x2a, 0x1f,
-	0xa9, 0xfc, 0x66, 0x9a, 0x98, 0x8d, 0x2c, 0xbf, 0x50, 0x88, 0xb3, 0x87, 0x76, 0x06, 0x2f, 0x9f,
-	0x93, 0x2f, 0xe6, 0xc0, 0x50, 0x28, 0xc4, 0xd9, 0x43, 0x07, 0x75, 0x7b, 0xd8, 0x38, 0xde, 0xa2,
-	0x28, 0xda, 0xff, 0x59, 0xc2, 0xe5, 0x89, 0x60, 0xfa, 0x0f, 0x84, 0x3b, 0x57, 0xbf, 0xd0, 0x33,
-	0xeb, 0xc4, 0xb3, 0x5b, 0xa7, 0x36, 0xd2, 0x1d, 0x9d, 0x6d, 0x2d, 0x3a, 0xea, 0x5f, 0x11, 0x6e,
-	0x1e, 0xdb, 0xe4, 0xe0, 0xba, 0xd1, 0x47, 0x4c, 0xdd, 0xe7, 0x67, 0x98, 0x8a, 0x26, 0xe3, 0xb7,
-	0xbf, 0x36, 0x06, 0x5a, 0x6f, 0x0c, 0xf4, 0x77, 0x63, 0xa0, 0xef, 0x5b, 0x43, 0x5b, 0x6f, 0x0d,
-	0xed, 0xcf, 0xd6, 0xd0, 0xde, 0x3f, 0x65, 0xbe, 0x9c, 0x7d, 0x9a, 0x5a, 0x2e, 0x9f, 0xdb, 0x02,
-	0xfc, 0x27, 0x45, 0x82, 0xfa, 0xa1, 0x22, 0xec, 0x85, 0x5d, 0x7c, 0x4c, 0xcb, 0x08, 0xc4, 0xb4,
-	0xa2, 0x90, 0xc1, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4a, 0xa4, 0x0e, 0xd3, 0x63, 0x03, 0x00,
-	0x00,
+	// 613 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0x73, 0x75, 0xa9, 0xda, 0x83, 0x12, 0x70, 0x0b, 0xa4, 0xa6, 0xb2, 0xab, 0x03, 0x01,
+	0x95, 0xc0, 0x46, 0x2d, 0x12, 0xa2, 0x30, 0xd0, 0x52, 0x18, 0x90, 0x22, 0xa1, 0x1b, 0x18, 0x58,
+	0x90, 0x9b, 0xbc, 0xd8, 0x16, 0x4e, 0x2e, 0xba, 0x73, 0x43, 0x02, 0x2b, 0x12, 0x8c, 0xac, 0x6c,
+	0x15, 0x5f, 0x80, 0xcf, 0xc0, 0xc6, 0x98, 0x91, 0x29, 0x42, 0xc9, 0xc2, 0xc4, 0x90, 0x4f, 0x80,
+	0x7c, 0xfe, 0x83, 0xd3, 0xa4, 0x89, 0x1c, 0x24, 0x36, 0xf7, 0xde, 0xdf, 0xf3, 0xbe, 0xcf, 0xf3,
+	0xd6, 0xe7, 0xe0, 0x22, 0xe3, 0x76, 0xc5, 0x07, 0x2b, 0x68, 0x99, 0x0d, 0xce, 0x02, 0xa6, 0x5e,
+	0x16, 0xe0, 0xc9, 0xa7, 0x0a, 0xf3, 0x4d, 0x01, 0x5e, 0xc5, 0xb5, 0xbd, 0xba, 0x19, 0x51, 0xda,
+	0xaa, 0xc3, 0x1c, 0x26, 0xab, 0x56, 0xf8, 0x14, 0x49, 0xc8, 0x57, 0x84, 0x8d, 0xb2, 0x70, 0x76,
+	0x1d, 0x87, 0x83, 0x63, 0x07, 0xf0, 0xb8, 0x55, 0x71, 0xed, 0xba, 0x03, 0xd4, 0x0e, 0xe0, 0x19,
+	0x87, 0x26, 0x0b, 0x40, 0xbd, 0x82, 0xe7, 0x5d, 0x5b, 0xb8, 0x25, 0xb4, 0x81, 0x6e, 0x2c, 0xed,
+	0x15, 0x07, 0x5d, 0xe3, 0x74, 0xdb, 0xae, 0xf9, 0x3b, 0x24, 0x3c, 0x25, 0x54, 0x16, 0xd5, 0x4d,
+	0xbc, 0xf0, 0x0a, 0xa0, 0x0a, 0xbc, 0x34, 0x27, 0xb1, 0xf3, 0x83, 0xae, 0xb1, 0x1c, 0x61, 0xd1,
+	0x39, 0xa1, 0x31, 0xa0, 0x6e, 0xe1, 0xa5, 0xa6, 0xed, 0x7b, 0x55, 0x3b, 0x60, 0xbc, 0xa4, 0x48,
+	0x7a, 0x75, 0xd0, 0x35, 0xce, 0x45, 0x74, 0x5a, 0x22, 0xf4, 0x2f, 0xb6, 0xb3, 0xf8, 0xf1, 0xc8,
+	0x28, 0xfc, 0x3a, 0x32, 0x0a, 0x64, 0x13, 0x5f, 0x9f, 0x62, 0x98, 0x82, 0x68, 0xb0, 0xba, 0x00,
+	0xf2, 0x1b, 0xe1, 0xf5, 0x93, 0xd8, 0xe7, 0x71, 0x32, 0x61, 0xfb, 0xc1, 0x68, 0xb2, 0xf0, 0x94,
+	0x50, 0x59, 0x54, 0x1f, 0xe2, 0xb3, 0x10, 0x0b, 0x5f, 0x72, 0x3b, 0x00, 0x11, 0x27, 0x5c, 0x1b,
+	0x74, 0x8d, 0x0b, 0x11, 0x3e, 0x5c, 0x27, 0x74, 0x19, 0x32, 0x93, 0x44, 0x66, 0x37, 0x4a, 0xae,
+	0xdd, 0xcc, 0xe7, 0xdd, 0xcd, 0x35, 0x7c, 0x75, 0x52, 0xde, 0x74, 0x31, 0xef, 0x11, 0xbe, 0x58,
+	0x16, 0xce, 0x3e, 0xf8, 0x92, 0x7b, 0x02, 0x50, 0x7d, 0x14, 0x16, 0xea, 0x81, 0x6a, 0xe1, 0x45,
+	0xd6, 0x00, 0x2e, 0xe7, 0x47, 0x6b, 0x59, 0x19, 0x74, 0x8d, 0x62, 0x34, 0x3f, 0xa9, 0x10, 0x9a,
+	0x42, 0xa1, 0xa0, 0x1a, 0xf7, 0x29, 0xcd, 0x1d, 0x17, 0x24, 0x15, 0x42, 0x53, 0x28, 0x63, 0x77,
+	0x03, 0xeb, 0xe3, 0x5d, 0xa4, 0x46, 0x3b, 0x08, 0xab, 0x61, 0xa2, 0xc3, 0xc0, 0x65, 0xdc, 0x7b,
+	0x2b, 0x19, 0xe0, 0xf9, 0x4d, 0xe6, 0x78, 0x3b, 0x37, 0xf1, 0x42, 0x15, 0xea, 0xac, 0x26, 0x4a,
+	0xca, 0x86, 0x32, 0x8c, 0x46, 0xe7, 0x84, 0xc6, 0x40, 0x88, 0x42, 0xab, 0xe1, 0xf1, 0xb6, 0xfc,
+	0x4f, 0x29, 0x59, 0x34, 0x3a, 0x27, 0x34, 0x06, 0x32, 0xa1, 0xd7, 0xb1, 0x36, 0x9a, 0x28, 0x0d,
+	0xfc, 0x06, 0x17, 0xcb, 0xc2, 0xa1, 0xd0, 0x64, 0xaf, 0xff, 0x43, 0xd8, 0x8c, 0xad, 0x35, 0x7c,
+	0xe9, 0xd8, 0xe0, 0xc4, 0xd3, 0xd6, 0xb7, 0x53, 0x58, 0x29, 0x0b, 0x47, 0xfd, 0x82, 0xf0, 0xfa,
+	0xc4, 0x0f, 0xc5, 0x03, 0x73, 0xc2, 0x07, 0xc8, 0x9c, 0x72, 0x6b, 0xb5, 0xfd, 0x7f, 0x51, 0x27,
+	0x66, 0xd5, 0xcf, 0x08, 0xaf, 0x9d, 0x7c, 0xe1, 0xef, 0xcd, 0x34, 0x23, 0x94, 0x6a, 0xbb, 0x33,
+	0x4b, 0x53, 0x6f, 0x1f, 0x10, 0x5e, 0x19, 0x77, 0xe7, 0xb6, 0xa7, 0xb5, 0x1e, 0x23, 0xd2, 0xee,
+	0xcf, 0x20, 0x4a, 0x9d, 0xbc, 0xc3, 0xc5, 0x91, 0x3b, 0x35, 0x35, 0xdf, 0xb0, 0x40, 0xbb, 0x9b,
+	0x53, 0x90, 0x0e, 0xe7, 0xf8, 0xcc, 0xd0, 0x0b, 0x7e, 0x73, 0x5a, 0xa3, 0x2c, 0xad, 0xdd, 0xc9,
+	0x43, 0x27, 0x33, 0xf7, 0x9e, 0x7e, 0xef, 0xe9, 0xa8, 0xd3, 0xd3, 0xd1, 0xcf, 0x9e, 0x8e, 0x3e,
+	0xf5, 0xf5, 0x42, 0xa7, 0xaf, 0x17, 0x7e, 0xf4, 0xf5, 0xc2, 0x8b, 0xdb, 0x8e, 0x17, 0xb8, 0x87,
+	0x07, 0x66, 0x85, 0xd5, 0x2c, 0x01, 0xde, 0xad, 0xa4, 0xb5, 0xfc, 0x43, 0xf6, 0xb6, 0x5a, 0x56,
+	0xf2, 0x4b, 0xdb, 0x6e, 0x80, 0x38, 0x58, 0x90, 0xc8, 0xf6, 0x9f, 0x01, 0x00, 0x52, 0xeb, 0x37,
+	0x5e, 0x80, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -236,11 +492,18 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
+	// AggregateExchangeRatePrevote defines a method for submitting
+	// aggregate exchange rate prevote
+	AggregateExchangeRatePrevote(ctx context.Context, in *MsgAggregateExchangeRatePrevote, opts ...grpc.CallOption) (*MsgAggregateExchangeRatePrevoteResponse, error)
 	// AggregateExchangeRateVote defines a method for submitting
 	// aggregate exchange rate vote
 	AggregateExchangeRateVote(ctx context.Context, in *MsgAggregateExchangeRateVote, opts ...grpc.CallOption) (*MsgAggregateExchangeRateVoteResponse, error)
 	// DelegateFeedConsent defines a method for setting the feeder delegation
 	DelegateFeedConsent(ctx context.Context, in *MsgDelegateFeedConsent, opts ...grpc.CallOption) (*MsgDelegateFeedConsentResponse, error)
+	// AuthorizeFeeder defines a method for authorising an additional feeder
+	AuthorizeFeeder(ctx context.Context, in *MsgAuthorizeFeeder, opts ...grpc.CallOption) (*MsgAuthorizeFeederResponse, error)
+	// RevokeFeeder defines a method for revoking an additional feeder
+	RevokeFeeder(ctx context.Context, in *MsgRevokeFeeder, opts ...grpc.CallOption) (*MsgRevokeFeederResponse, error)
 }
 
 type msgClient struct {
@@ -251,6 +514,15 @@ func NewMsgClient(cc grpc1.ClientConn) MsgClient {
 	return &msgClient{cc}
 }
 
+func (c *msgClient) AggregateExchangeRatePrevote(ctx context.Context, in *MsgAggregateExchangeRatePrevote, opts ...grpc.CallOption) (*MsgAggregateExchangeRatePrevoteResponse, error) {
+	out := new(MsgAggregateExchangeRatePrevoteResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Msg/AggregateExchangeRatePrevote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) AggregateExchangeRateVote(ctx context.Context, in *MsgAggregateExchangeRateVote, opts ...grpc.CallOption) (*MsgAggregateExchangeRateVoteResponse, error) {
 	out := new(MsgAggregateExchangeRateVoteResponse)
 	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Msg/AggregateExchangeRateVote", in, out, opts...)
@@ -269,30 +541,82 @@ func (c *msgClient) DelegateFeedConsent(ctx context.Context, in *MsgDelegateFeed
 	return out, nil
 }
 
+func (c *msgClient) AuthorizeFeeder(ctx context.Context, in *MsgAuthorizeFeeder, opts ...grpc.CallOption) (*MsgAuthorizeFeederResponse, error) {
+	out := new(MsgAuthorizeFeederResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Msg/AuthorizeFeeder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeFeeder(ctx context.Context, in *MsgRevokeFeeder, opts ...grpc.CallOption) (*MsgRevokeFeederResponse, error) {
+	out := new(MsgRevokeFeederResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Msg/RevokeFeeder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
+	// AggregateExchangeRatePrevote defines a method for submitting
+	// aggregate exchange rate prevote
+	AggregateExchangeRatePrevote(context.Context, *MsgAggregateExchangeRatePrevote) (*MsgAggregateExchangeRatePrevoteResponse, error)
 	// AggregateExchangeRateVote defines a method for submitting
 	// aggregate exchange rate vote
 	AggregateExchangeRateVote(context.Context, *MsgAggregateExchangeRateVote) (*MsgAggregateExchangeRateVoteResponse, error)
 	// DelegateFeedConsent defines a method for setting the feeder delegation
 	DelegateFeedConsent(context.Context, *MsgDelegateFeedConsent) (*MsgDelegateFeedConsentResponse, error)
+	// AuthorizeFeeder defines a method for authorising an additional feeder
+	AuthorizeFeeder(context.Context, *MsgAuthorizeFeeder) (*MsgAuthorizeFeederResponse, error)
+	// RevokeFeeder defines a method for revoking an additional feeder
+	RevokeFeeder(context.Context, *MsgRevokeFeeder) (*MsgRevokeFeederResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
 type UnimplementedMsgServer struct {
 }
 
+func (*UnimplementedMsgServer) AggregateExchangeRatePrevote(ctx context.Context, req *MsgAggregateExchangeRatePrevote) (*MsgAggregateExchangeRatePrevoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateExchangeRatePrevote not implemented")
+}
 func (*UnimplementedMsgServer) AggregateExchangeRateVote(ctx context.Context, req *MsgAggregateExchangeRateVote) (*MsgAggregateExchangeRateVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AggregateExchangeRateVote not implemented")
 }
 func (*UnimplementedMsgServer) DelegateFeedConsent(ctx context.Context, req *MsgDelegateFeedConsent) (*MsgDelegateFeedConsentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegateFeedConsent not implemented")
 }
+func (*UnimplementedMsgServer) AuthorizeFeeder(ctx context.Context, req *MsgAuthorizeFeeder) (*MsgAuthorizeFeederResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeFeeder not implemented")
+}
+func (*UnimplementedMsgServer) RevokeFeeder(ctx context.Context, req *MsgRevokeFeeder) (*MsgRevokeFeederResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeFeeder not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
+func _Msg_AggregateExchangeRatePrevote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAggregateExchangeRatePrevote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AggregateExchangeRatePrevote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.oracle.Msg/AggregateExchangeRatePrevote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AggregateExchangeRatePrevote(ctx, req.(*MsgAggregateExchangeRatePrevote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_AggregateExchangeRateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgAggregateExchangeRateVote)
 	if err := dec(in); err != nil {
@@ -329,10 +653,50 @@ func _Msg_DelegateFeedConsent_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AuthorizeFeeder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAuthorizeFeeder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AuthorizeFeeder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.oracle.Msg/AuthorizeFeeder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AuthorizeFeeder(ctx, req.(*MsgAuthorizeFeeder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeFeeder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeFeeder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeFeeder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.oracle.Msg/RevokeFeeder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeFeeder(ctx, req.(*MsgRevokeFeeder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "seiprotocol.seichain.oracle.Msg",
 	HandlerType: (*MsgServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AggregateExchangeRatePrevote",
+			Handler:    _Msg_AggregateExchangeRatePrevote_Handler,
+		},
 		{
 			MethodName: "AggregateExchangeRateVote",
 			Handler:    _Msg_AggregateExchangeRateVote_Handler,
@@ -341,12 +705,20 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelegateFeedConsent",
 			Handler:    _Msg_DelegateFeedConsent_Handler,
 		},
+		{
+			MethodName: "AuthorizeFeeder",
+			Handler:    _Msg_AuthorizeFeeder_Handler,
+		},
+		{
+			MethodName: "RevokeFeeder",
+			Handler:    _Msg_RevokeFeeder_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "oracle/tx.proto",
 }
 
-func (m *MsgAggregateExchangeRateVote) Marshal() (dAtA []byte, err error) {
+func (m *MsgAggregateExchangeRatePrevote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -356,12 +728,12 @@ func (m *MsgAggregateExchangeRateVote) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAggregateExchangeRateVote) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAggregateExchangeRatePrevote) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAggregateExchangeRateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAggregateExchangeRatePrevote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -371,26 +743,26 @@ func (m *MsgAggregateExchangeRateVote) MarshalToSizedBuffer(dAtA []byte) (int, e
 		copy(dAtA[i:], m.Validator)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x1a
 	}
 	if len(m.Feeder) > 0 {
 		i -= len(m.Feeder)
 		copy(dAtA[i:], m.Feeder)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.Feeder)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
-	if len(m.ExchangeRates) > 0 {
-		i -= len(m.ExchangeRates)
-		copy(dAtA[i:], m.ExchangeRates)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ExchangeRates)))
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAggregateExchangeRateVoteResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAggregateExchangeRatePrevoteResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -400,12 +772,12 @@ func (m *MsgAggregateExchangeRateVoteResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *MsgAggregateExchangeRateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAggregateExchangeRatePrevoteResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAggregateExchangeRateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAggregateExchangeRatePrevoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -413,7 +785,7 @@ func (m *MsgAggregateExchangeRateVoteResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegateFeedConsent) Marshal() (dAtA []byte, err error) {
+func (m *MsgAggregateExchangeRateVote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -423,34 +795,48 @@ func (m *MsgDelegateFeedConsent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDelegateFeedConsent) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAggregateExchangeRateVote) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDelegateFeedConsent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAggregateExchangeRateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Delegate) > 0 {
-		i -= len(m.Delegate)
-		copy(dAtA[i:], m.Delegate)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegate)))
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Feeder) > 0 {
+		i -= len(m.Feeder)
+		copy(dAtA[i:], m.Feeder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Feeder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ExchangeRates) > 0 {
+		i -= len(m.ExchangeRates)
+		copy(dAtA[i:], m.ExchangeRates)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExchangeRates)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegateFeedConsentResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAggregateExchangeRateVoteResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -460,12 +846,206 @@ func (m *MsgDelegateFeedConsentResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDelegateFeedConsentResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAggregateExchangeRateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDelegateFeedConsentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAggregateExchangeRateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateFeedConsent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateFeedConsent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateFeedConsent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateFeedConsentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateFeedConsentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateFeedConsentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAuthorizeFeeder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAuthorizeFeeder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAuthorizeFeeder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiry != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Expiry))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Feeder) > 0 {
+		i -= len(m.Feeder)
+		copy(dAtA[i:], m.Feeder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Feeder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAuthorizeFeederResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAuthorizeFeederResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAuthorizeFeederResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeFeeder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeFeeder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeFeeder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Feeder) > 0 {
+		i -= len(m.Feeder)
+		copy(dAtA[i:], m.Feeder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Feeder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeFeederResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeFeederResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeFeederResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -484,12 +1064,46 @@ func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *MsgAggregateExchangeRatePrevote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Feeder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAggregateExchangeRatePrevoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgAggregateExchangeRateVote) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	l = len(m.ExchangeRates)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
@@ -502,51 +1116,700 @@ func (m *MsgAggregateExchangeRateVote) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgAggregateExchangeRateVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelegateFeedConsent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDelegateFeedConsentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAuthorizeFeeder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Feeder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Expiry != 0 {
+		n += 1 + sovTx(uint64(m.Expiry))
+	}
+	return n
+}
+
+func (m *MsgAuthorizeFeederResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeFeeder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Feeder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeFeederResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Feeder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAggregateExchangeRatePrevoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRateVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRates = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Feeder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAggregateExchangeRateVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRateVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgAggregateExchangeRateVoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgDelegateFeedConsent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Delegate)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgDelegateFeedConsent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateFeedConsent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateFeedConsent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgDelegateFeedConsentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgDelegateFeedConsentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateFeedConsentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateFeedConsentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
+func (m *MsgAuthorizeFeeder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -569,15 +1832,15 @@ func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRateVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAuthorizeFeeder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAuthorizeFeeder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -605,9 +1868,9 @@ func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExchangeRates = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
 			}
@@ -639,9 +1902,9 @@ func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 			}
 			m.Feeder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -669,8 +1932,27 @@ func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			m.Expiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -692,7 +1974,7 @@ func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAggregateExchangeRateVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAuthorizeFeederResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -715,10 +1997,10 @@ func (m *MsgAggregateExchangeRateVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRateVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAuthorizeFeederResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAuthorizeFeederResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -742,7 +2024,7 @@ func (m *MsgAggregateExchangeRateVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateFeedConsent) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeFeeder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -765,10 +2047,10 @@ func (m *MsgDelegateFeedConsent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateFeedConsent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeFeeder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateFeedConsent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeFeeder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -805,7 +2087,7 @@ func (m *MsgDelegateFeedConsent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -833,7 +2115,7 @@ func (m *MsgDelegateFeedConsent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegate = string(dAtA[iNdEx:postIndex])
+			m.Feeder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -856,7 +2138,7 @@ func (m *MsgDelegateFeedConsent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateFeedConsentResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeFeederResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -879,10 +2161,10 @@ func (m *MsgDelegateFeedConsentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateFeedConsentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeFeederResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateFeedConsentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeFeederResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default: